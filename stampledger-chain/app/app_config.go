@@ -207,7 +207,10 @@ var (
 			},
 			{
 				Name:   "tx",
-				Config: appconfig.WrapAny(&txconfigv1.Config{}),
+				Config: appconfig.WrapAny(&txconfigv1.Config{
+					// the app sets its own ante handler, see NewAnteHandler
+					SkipAnteHandler: true,
+				}),
 			},
 			{
 				Name:   genutiltypes.ModuleName,
@@ -0,0 +1,99 @@
+package ante
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// SponsorKeeper is the subset of the stampledgerchain keeper the fee
+// sponsorship decorator needs.
+type SponsorKeeper interface {
+	IsEntityTreasury(ctx context.Context, addr sdk.AccAddress) (bool, error)
+	SponsorFee(ctx context.Context, treasury, member sdk.AccAddress, fee sdk.Coins) error
+}
+
+// SponsoredFeeDecorator lets an entity treasury pay the fees of its editors
+// and admins. A member opts in by setting the entity's treasury address as
+// the tx fee granter. The tx may then only contain MsgCreateStamp and
+// MsgStoreDocument messages signed by the fee payer, and the fee must fit in
+// the member's monthly cap; the wrapped fee decorator then deducts the fee
+// from the treasury. Txs whose fee granter is not an entity treasury are
+// passed to the wrapped decorator unchanged, so x/feegrant keeps working.
+type SponsoredFeeDecorator struct {
+	keeper    SponsorKeeper
+	deductFee sdk.AnteDecorator
+}
+
+// NewSponsoredFeeDecorator wraps deductFee, normally the SDK's
+// DeductFeeDecorator, with entity fee sponsorship.
+func NewSponsoredFeeDecorator(k SponsorKeeper, deductFee sdk.AnteDecorator) SponsoredFeeDecorator {
+	return SponsoredFeeDecorator{keeper: k, deductFee: deductFee}
+}
+
+func (d SponsoredFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.FeeGranter() == nil {
+		return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	// 1. Only fee granters that are entity treasuries are sponsorships
+	treasury := sdk.AccAddress(feeTx.FeeGranter())
+	isTreasury, err := d.keeper.IsEntityTreasury(ctx, treasury)
+	if err != nil {
+		return ctx, err
+	}
+	if !isTreasury {
+		return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	// 2. Every message must be a sponsorable message from the fee payer
+	member := sdk.AccAddress(feeTx.FeePayer())
+	for _, msg := range tx.GetMsgs() {
+		creator, ok := sponsoredCreator(msg)
+		if !ok {
+			return ctx, types.ErrFeeNotSponsored.Wrapf("message %s cannot be sponsored", sdk.MsgTypeURL(msg))
+		}
+		if creator != member.String() {
+			return ctx, sdkerrors.ErrUnauthorized.Wrapf("sponsored message creator %s is not the fee payer %s", creator, member)
+		}
+	}
+
+	// 3. Check the member's role and monthly cap, and record the fee
+	if err := d.keeper.SponsorFee(ctx, treasury, member, feeTx.GetFee()); err != nil {
+		return ctx, err
+	}
+
+	// 4. Deduct the fee from the treasury itself rather than through a grant
+	return d.deductFee.AnteHandle(ctx, treasuryPaidTx{FeeTx: feeTx, treasury: treasury}, simulate,
+		func(ctx sdk.Context, _ sdk.Tx, simulate bool) (sdk.Context, error) {
+			return next(ctx, tx, simulate)
+		},
+	)
+}
+
+// sponsoredCreator returns the creator of a message an entity may sponsor.
+func sponsoredCreator(msg sdk.Msg) (string, bool) {
+	switch msg := msg.(type) {
+	case *types.MsgCreateStamp:
+		return msg.Creator, true
+	case *types.MsgStoreDocument:
+		return msg.Creator, true
+	default:
+		return "", false
+	}
+}
+
+// treasuryPaidTx presents a sponsored tx to the fee decorator with the
+// treasury as its fee payer.
+type treasuryPaidTx struct {
+	sdk.FeeTx
+	treasury sdk.AccAddress
+}
+
+func (tx treasuryPaidTx) FeePayer() []byte {
+	return tx.treasury
+}
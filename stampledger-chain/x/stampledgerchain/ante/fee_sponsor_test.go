@@ -0,0 +1,127 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"stampledger-chain/x/stampledgerchain/ante"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+var (
+	treasury = sdk.AccAddress([]byte("entity-treasury-addr"))
+	member   = sdk.AccAddress([]byte("entity-member-addr--"))
+	fee      = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+)
+
+type mockFeeTx struct {
+	msgs    []sdk.Msg
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+func (tx mockFeeTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx mockFeeTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx mockFeeTx) GetGas() uint64                        { return 200000 }
+func (tx mockFeeTx) GetFee() sdk.Coins                     { return fee }
+func (tx mockFeeTx) FeePayer() []byte                      { return tx.payer }
+func (tx mockFeeTx) FeeGranter() []byte                    { return tx.granter }
+
+// mockSponsorKeeper sponsors every fee charged to treasury and records them.
+type mockSponsorKeeper struct {
+	sponsored []sdk.Coins
+}
+
+func (k *mockSponsorKeeper) IsEntityTreasury(_ context.Context, addr sdk.AccAddress) (bool, error) {
+	return addr.Equals(treasury), nil
+}
+
+func (k *mockSponsorKeeper) SponsorFee(_ context.Context, _, _ sdk.AccAddress, fee sdk.Coins) error {
+	k.sponsored = append(k.sponsored, fee)
+	return nil
+}
+
+// payerRecorder stands in for the SDK fee decorator and records who it
+// would deduct the fee from.
+type payerRecorder struct {
+	payer *sdk.AccAddress
+}
+
+func (d payerRecorder) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx := tx.(sdk.FeeTx)
+	*d.payer = feeTx.FeePayer()
+	if feeTx.FeeGranter() != nil {
+		*d.payer = feeTx.FeeGranter()
+	}
+	return next(ctx, tx, simulate)
+}
+
+func TestSponsoredFeeDecorator(t *testing.T) {
+	stampMsg := &types.MsgCreateStamp{Creator: member.String()}
+	docMsg := &types.MsgStoreDocument{Creator: member.String()}
+
+	tests := []struct {
+		name      string
+		tx        mockFeeTx
+		err       error
+		payer     sdk.AccAddress
+		sponsored bool
+	}{
+		{
+			name:  "no fee granter",
+			tx:    mockFeeTx{msgs: []sdk.Msg{stampMsg}, payer: member},
+			payer: member,
+		},
+		{
+			name:  "fee granter is not a treasury",
+			tx:    mockFeeTx{msgs: []sdk.Msg{stampMsg}, payer: member, granter: sdk.AccAddress([]byte("some-fee-granter----"))},
+			payer: sdk.AccAddress([]byte("some-fee-granter----")),
+		},
+		{
+			name:      "stamp and document sponsored",
+			tx:        mockFeeTx{msgs: []sdk.Msg{stampMsg, docMsg}, payer: member, granter: treasury},
+			payer:     treasury,
+			sponsored: true,
+		},
+		{
+			name: "other message types",
+			tx:   mockFeeTx{msgs: []sdk.Msg{stampMsg, &types.MsgCreateEntity{Creator: member.String()}}, payer: member, granter: treasury},
+			err:  types.ErrFeeNotSponsored,
+		},
+		{
+			name: "message from another creator",
+			tx:   mockFeeTx{msgs: []sdk.Msg{&types.MsgCreateStamp{Creator: treasury.String()}}, payer: member, granter: treasury},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			k := &mockSponsorKeeper{}
+			var payer sdk.AccAddress
+			decorator := ante.NewSponsoredFeeDecorator(k, payerRecorder{payer: &payer})
+
+			var nextTx sdk.Tx
+			next := func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+				nextTx = tx
+				return ctx, nil
+			}
+			_, err := decorator.AnteHandle(sdk.Context{}, tc.tx, false, next)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Empty(t, k.sponsored)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.payer, payer)
+			require.Equal(t, tc.sponsored, len(k.sponsored) == 1)
+			// later decorators still see the original tx
+			require.Equal(t, tc.tx, nextTx)
+		})
+	}
+}
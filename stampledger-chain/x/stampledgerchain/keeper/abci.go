@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// expiryBatchSize bounds how many stamps, pin schedule entries and invites
+// a single EndBlocker processes, keeping block processing time predictable.
+// Any remainder is handled in the next block; VerifyStamp already reports
+// such stamps as expired in the meantime.
+const expiryBatchSize = 1000
+
+// EndBlocker expires stamps, advances the document pin schedule and prunes
+// expired entity invites
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	if err := k.expireStamps(ctx); err != nil {
+		return err
+	}
+	if err := k.processPinSchedule(ctx); err != nil {
+		return err
+	}
+	return k.pruneInvites(ctx)
+}
+
+// expireStamps marks every stamp whose valid_until has passed as expired
+func (k Keeper) expireStamps(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+
+	// 1. Collect the next batch of stamps whose validity ended before now
+	rng := collections.NewPrefixUntilPairRange[int64, string](now - 1)
+	iter, err := k.StampsByExpiry.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	var keys []collections.Pair[int64, string]
+	for ; iter.Valid() && len(keys) < expiryBatchSize; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		keys = append(keys, key)
+	}
+	iter.Close()
+
+	// 2. Flip each stamp to expired and drop it from the queue
+	for _, key := range keys {
+		stampID := key.K2()
+		stamp, err := k.Stamps.Get(ctx, stampID)
+		if err != nil {
+			return err
+		}
+		stamp.Expired = true
+		if err := k.Stamps.Set(ctx, stampID, stamp); err != nil {
+			return err
+		}
+		if err := k.StampsByExpiry.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"stamp_expired",
+				sdk.NewAttribute("stamp_id", stampID),
+				sdk.NewAttribute("valid_until", strconv.FormatInt(stamp.ValidUntil, 10)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// processPinSchedule handles due pin schedule entries: it emits
+// document_pin_expiring ahead of a pin's expiry, and lapses the pin once it
+// expires. Entries that no longer match the document's pin are skipped.
+func (k Keeper) processPinSchedule(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	// 1. Collect the next batch of entries due at or before this height
+	rng := collections.NewPrefixUntilPairRange[int64, string](height)
+	iter, err := k.PinSchedule.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	var entries []collections.KeyValue[collections.Pair[int64, string], int64]
+	for ; iter.Valid() && len(entries) < expiryBatchSize; iter.Next() {
+		entry, err := iter.KeyValue()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		entries = append(entries, entry)
+	}
+	iter.Close()
+
+	// 2. Drop each entry from the schedule, then warn or lapse the pin
+	for _, entry := range entries {
+		if err := k.PinSchedule.Remove(ctx, entry.Key); err != nil {
+			return err
+		}
+		doc, err := k.Documents.Get(ctx, entry.Key.K2())
+		if err != nil {
+			return err
+		}
+		expiresHeight := entry.Value
+		if !doc.Pinned || doc.PinExpiresHeight != expiresHeight {
+			continue
+		}
+
+		eventType := "document_pin_expiring"
+		if entry.Key.K1() >= expiresHeight {
+			eventType = "document_pin_expired"
+			doc.Pinned = false
+			if err := k.Documents.Set(ctx, doc.Id, doc); err != nil {
+				return err
+			}
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute("document_id", doc.Id),
+				sdk.NewAttribute("stamp_id", doc.StampId),
+				sdk.NewAttribute("ipfs_hash", doc.IpfsHash),
+				sdk.NewAttribute("pin_expires_height", strconv.FormatInt(expiresHeight, 10)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// pruneInvites deletes entity invites that have reached their expiry height
+func (k Keeper) pruneInvites(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	// 1. Collect the next batch of invites expiring at or before this height
+	rng := collections.NewPrefixUntilTripleRange[int64, string, string](height)
+	iter, err := k.InviteExpiry.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	var keys []collections.Triple[int64, string, string]
+	for ; iter.Valid() && len(keys) < expiryBatchSize; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		keys = append(keys, key)
+	}
+	iter.Close()
+
+	// 2. Drop each invite and its queue entry
+	for _, key := range keys {
+		invitee, entityID := key.K2(), key.K3()
+		if err := k.EntityInvites.Remove(ctx, collections.Join(invitee, entityID)); err != nil {
+			return err
+		}
+		if err := k.InviteExpiry.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"entity_invite_expired",
+				sdk.NewAttribute("entity_id", entityID),
+				sdk.NewAttribute("invitee", invitee),
+				sdk.NewAttribute("expires_height", strconv.FormatInt(key.K1(), 10)),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// TestDeterministicState runs the same messages through two independent
+// keepers and asserts that they commit identical app hashes.
+func TestDeterministicState(t *testing.T) {
+	creator := sample.AccAddress()
+	blockTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	pe := newPEKey("pe")
+
+	run := func() ([]byte, []string) {
+		f := initFixture(t)
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(1).WithBlockTime(blockTime)
+		ms := keeper.NewMsgServerImpl(f.keeper)
+
+		f.registerPE(t, ctx, creator, pe)
+
+		stampRes, err := ms.CreateStamp(ctx, newCreateStampMsg(creator, pe, "drawing.pdf"))
+		require.NoError(t, err)
+
+		docRes, err := ms.StoreDocument(ctx, &types.MsgStoreDocument{
+			Creator:  creator,
+			StampId:  stampRes.StampId,
+			IpfsHash: "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+		})
+		require.NoError(t, err)
+
+		entityRes, err := ms.CreateEntity(ctx, &types.MsgCreateEntity{
+			Creator:    creator,
+			Name:       "Acme Engineering",
+			EntityType: "firm",
+		})
+		require.NoError(t, err)
+
+		specRes, err := ms.CreateSpecVersion(ctx, &types.MsgCreateSpecVersion{
+			Creator:   creator,
+			ProjectId: "project-1",
+			Version:   "1.0.0",
+		})
+		require.NoError(t, err)
+
+		_, err = ms.RevokeStamp(ctx, &types.MsgRevokeStamp{
+			Creator:    creator,
+			StampId:    stampRes.StampId,
+			ReasonCode: types.RevocationSuperseded,
+			Reason:     "superseded",
+		})
+		require.NoError(t, err)
+
+		stamp, err := f.keeper.GetStamp(ctx, stampRes.StampId)
+		require.NoError(t, err)
+		require.Equal(t, blockTime.Unix(), stamp.CreatedAt)
+		require.Equal(t, blockTime.Unix(), stamp.RevokedAt)
+
+		ids := []string{stampRes.StampId, docRes.DocumentId, entityRes.EntityId, specRes.VersionId}
+		return f.cms.Commit().Hash, ids
+	}
+
+	hashA, idsA := run()
+	hashB, idsB := run()
+
+	require.Equal(t, idsA, idsB)
+	require.Equal(t, hashA, hashB)
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// chargeModuleFee pays a module fee from payer into the fee collector, from
+// which x/distribution pays it out. Zero fees and fee exempt payers are
+// skipped.
+func (k Keeper) chargeModuleFee(ctx context.Context, payer string, fee sdk.Coins, feeType string) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.IsFeeExempt(payer) {
+		return nil
+	}
+
+	payerAddr, err := k.addressCodec.StringToBytes(payer)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payerAddr, types.FeeCollectorName, fee); err != nil {
+		return errorsmod.Wrapf(err, "%s fee", feeType)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"module_fee_charged",
+			sdk.NewAttribute("fee_type", feeType),
+			sdk.NewAttribute("payer", payer),
+			sdk.NewAttribute("fee", fee.String()),
+		),
+	)
+	return nil
+}
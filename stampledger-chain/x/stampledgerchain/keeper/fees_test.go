@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+func TestModuleFees(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.StampFee = stake(10)
+	params.DocumentFeeBuckets = []types.DocumentFeeBucket{
+		{MaxSize: 1 << 10, Fee: stake(1)},
+		{MaxSize: 1 << 20, Fee: stake(5)},
+	}
+	params.EntityCreationFee = stake(100)
+	params.FeeExemptAddresses = []string{testBoard}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	creator := sample.AccAddress()
+	creatorAddr := sdk.MustAccAddressFromBech32(creator)
+	f.bankKeeper.balances[string(creatorAddr)] = stake(200)
+	feeCollector := authtypes.NewModuleAddress(types.FeeCollectorName)
+	balances := func() (sdk.Coins, sdk.Coins) {
+		return f.bankKeeper.balances[string(creatorAddr)], f.bankKeeper.balances[string(feeCollector)]
+	}
+
+	// Entity creation
+	_, err = ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: creator, Name: "Acme", EntityType: "firm"})
+	require.NoError(t, err)
+	paid, collected := balances()
+	require.Equal(t, stake(100), paid)
+	require.Equal(t, stake(100), collected)
+
+	// Stamps
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+	stampRes, err := ms.CreateStamp(f.ctx, newCreateStampMsg(creator, pe, "sheet-A"))
+	require.NoError(t, err)
+	paid, collected = balances()
+	require.Equal(t, stake(90), paid)
+	require.Equal(t, stake(110), collected)
+
+	// Documents pay the first bucket that fits, or the last one
+	for _, tc := range []struct {
+		size int64
+		fee  int64
+	}{
+		{size: 512, fee: 1},
+		{size: 1 << 10, fee: 1},
+		{size: 4096, fee: 5},
+		{size: 1 << 30, fee: 5},
+	} {
+		before, _ := balances()
+		_, err = ms.StoreDocument(f.ctx, &types.MsgStoreDocument{
+			Creator:  creator,
+			StampId:  stampRes.StampId,
+			IpfsHash: "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+			Size_:    tc.size,
+		})
+		require.NoError(t, err)
+		after, _ := balances()
+		require.Equal(t, stake(tc.fee), before.Sub(after...), "size %d", tc.size)
+	}
+
+	// Insufficient funds fail the message
+	poor := sample.AccAddress()
+	_, err = ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: poor, Name: "Broke", EntityType: "firm"})
+	require.ErrorContains(t, err, "entity creation fee")
+
+	// Exempt jurisdiction authorities pay nothing
+	_, collected = balances()
+	_, err = ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: testBoard, Name: "Board", EntityType: "municipality"})
+	require.NoError(t, err)
+	_, after := balances()
+	require.Equal(t, collected, after)
+}
@@ -3,17 +3,168 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+
 	"stampledger-chain/x/stampledgerchain/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
-// NOTE: After proto regeneration, this should also import stamps, documents, entities, and spec versions
+// Secondary indexes are not part of the genesis state and are rebuilt here.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
-	return k.Params.Set(ctx, genState.Params)
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+	if err := k.IDSequence.Set(ctx, genState.IdSequence); err != nil {
+		return err
+	}
+
+	// 1. Stamps, indexed by PE public key, jurisdiction, document hash,
+	// pending expiry, batch and entity, with their signatures marked used
+	for _, stamp := range genState.Stamps {
+		if err := k.Stamps.Set(ctx, stamp.Id, stamp); err != nil {
+			return err
+		}
+		for _, pePublicKey := range stamp.SignerKeys() {
+			if err := k.StampsByPE.Set(ctx, collections.Join(pePublicKey, stamp.Id), []byte{}); err != nil {
+				return err
+			}
+		}
+		if stamp.JurisdictionId != "" {
+			if err := k.StampsByJurisdiction.Set(ctx, collections.Join(stamp.JurisdictionId, stamp.Id), []byte{}); err != nil {
+				return err
+			}
+		}
+		if err := k.StampsByDocumentHash.Set(ctx, collections.Join(stamp.DocumentHash, stamp.Id), []byte{}); err != nil {
+			return err
+		}
+		if stamp.ValidUntil != 0 && !stamp.Expired {
+			if err := k.StampsByExpiry.Set(ctx, collections.Join(stamp.ValidUntil, stamp.Id), []byte{}); err != nil {
+				return err
+			}
+		}
+		if stamp.BatchId != "" {
+			if err := k.StampsByBatch.Set(ctx, collections.Join(stamp.BatchId, stamp.Id), []byte{}); err != nil {
+				return err
+			}
+		}
+		if stamp.EntityId != "" {
+			if err := k.StampsByEntity.Set(ctx, collections.Join(stamp.EntityId, stamp.Id), []byte{}); err != nil {
+				return err
+			}
+		}
+		if err := k.recordStampSignature(ctx, stamp); err != nil {
+			return err
+		}
+	}
+
+	// 2. Stamp batches
+	for _, batch := range genState.StampBatches {
+		if err := k.StampBatches.Set(ctx, batch.Id, batch); err != nil {
+			return err
+		}
+	}
+
+	// 3. PE registry
+	for _, pe := range genState.ProfessionalEngineers {
+		if err := k.ProfessionalEngineers.Set(ctx, pe.PublicKey, pe); err != nil {
+			return err
+		}
+	}
+
+	// 4. Stamping delegations
+	for _, delegation := range genState.StampingDelegations {
+		if err := k.StampingDelegations.Set(ctx, collections.Join(delegation.Granter, delegation.Grantee), delegation); err != nil {
+			return err
+		}
+	}
+
+	// 5. Jurisdiction licenses
+	for _, license := range genState.Licenses {
+		if err := k.Licenses.Set(ctx, collections.Join(license.JurisdictionId, license.LicenseNumber), license); err != nil {
+			return err
+		}
+	}
+
+	// 6. Documents, indexed by stamp ID with their pins scheduled, and pin
+	// attestations
+	for _, doc := range genState.Documents {
+		if err := k.Documents.Set(ctx, doc.Id, doc); err != nil {
+			return err
+		}
+		if err := k.DocumentsByStamp.Set(ctx, collections.Join(doc.StampId, doc.Id), []byte{}); err != nil {
+			return err
+		}
+		if err := k.schedulePin(ctx, doc, genState.Params); err != nil {
+			return err
+		}
+	}
+	for _, attestation := range genState.PinAttestations {
+		if err := k.PinAttestations.Set(ctx, collections.Join(attestation.DocumentId, attestation.Provider), attestation); err != nil {
+			return err
+		}
+	}
+
+	// 7. Entities, indexed by owner and treasury address, their members,
+	// indexed by member address, open invites, queued for expiry, and their
+	// members' sponsored fee usage.
+	// Entities exported before treasuries existed get their derived treasury
+	// address here, and entities exported with embedded membership have it
+	// moved into member records.
+	for _, member := range genState.EntityMembers {
+		if err := k.setEntityMember(ctx, member); err != nil {
+			return err
+		}
+	}
+	for _, entity := range genState.Entities {
+		if entity.TreasuryAddress == "" {
+			treasury, err := k.addressCodec.BytesToString(types.EntityTreasuryAddress(entity.Id))
+			if err != nil {
+				return err
+			}
+			entity.TreasuryAddress = treasury
+		}
+		if hasEmbeddedMembers(entity) {
+			migrated, err := k.migrateEntityMembers(ctx, entity)
+			if err != nil {
+				return err
+			}
+			entity = migrated
+		}
+		if err := k.Entities.Set(ctx, entity.Id, entity); err != nil {
+			return err
+		}
+		if err := k.EntitiesByOwner.Set(ctx, collections.Join(entity.OwnerAddress, entity.Id), []byte{}); err != nil {
+			return err
+		}
+		if err := k.EntitiesByTreasury.Set(ctx, entity.TreasuryAddress, entity.Id); err != nil {
+			return err
+		}
+	}
+	for _, invite := range genState.EntityInvites {
+		if err := k.setEntityInvite(ctx, invite); err != nil {
+			return err
+		}
+	}
+	for _, usage := range genState.MemberFeeUsages {
+		if err := k.MemberFeeUsage.Set(ctx, collections.Join(usage.EntityId, usage.Member), usage); err != nil {
+			return err
+		}
+	}
+
+	// 8. Spec versions, indexed by project ID
+	for _, spec := range genState.SpecVersions {
+		if err := k.SpecVersions.Set(ctx, spec.Id, spec); err != nil {
+			return err
+		}
+		if err := k.SpecVersionsByProject.Set(ctx, collections.Join(spec.ProjectId, spec.Id), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // ExportGenesis returns the module's exported genesis.
-// NOTE: After proto regeneration, this should also export stamps, documents, entities, and spec versions
 func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
 	var err error
 
@@ -22,14 +173,94 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	if err != nil {
 		return nil, err
 	}
+	genesis.IdSequence, err = k.IDSequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Stamps.Walk(ctx, nil, func(_ string, stamp types.Stamp) (bool, error) {
+		genesis.Stamps = append(genesis.Stamps, stamp)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.StampBatches.Walk(ctx, nil, func(_ string, batch types.StampBatch) (bool, error) {
+		genesis.StampBatches = append(genesis.StampBatches, batch)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.ProfessionalEngineers.Walk(ctx, nil, func(_ string, pe types.ProfessionalEngineer) (bool, error) {
+		genesis.ProfessionalEngineers = append(genesis.ProfessionalEngineers, pe)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.StampingDelegations.Walk(ctx, nil, func(_ collections.Pair[string, string], delegation types.StampingDelegation) (bool, error) {
+		genesis.StampingDelegations = append(genesis.StampingDelegations, delegation)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
 
-	// TODO: After proto regeneration, uncomment to export all state:
-	// stampIter, _ := k.Stamps.Iterate(ctx, nil)
-	// for ; stampIter.Valid(); stampIter.Next() {
-	//     stamp, _ := stampIter.Value()
-	//     genesis.Stamps = append(genesis.Stamps, stamp)
-	// }
-	// stampIter.Close()
+	if err := k.Licenses.Walk(ctx, nil, func(_ collections.Pair[string, string], license types.License) (bool, error) {
+		genesis.Licenses = append(genesis.Licenses, license)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Documents.Walk(ctx, nil, func(_ string, doc types.DocumentStorage) (bool, error) {
+		genesis.Documents = append(genesis.Documents, doc)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.PinAttestations.Walk(ctx, nil, func(_ collections.Pair[string, string], attestation types.PinAttestation) (bool, error) {
+		genesis.PinAttestations = append(genesis.PinAttestations, attestation)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Entities.Walk(ctx, nil, func(_ string, entity types.EntityAccount) (bool, error) {
+		genesis.Entities = append(genesis.Entities, entity)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.EntityMembers.Walk(ctx, nil, func(_ collections.Pair[string, string], member types.EntityMember) (bool, error) {
+		genesis.EntityMembers = append(genesis.EntityMembers, member)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.EntityInvites.Walk(ctx, nil, func(_ collections.Pair[string, string], invite types.EntityInvite) (bool, error) {
+		genesis.EntityInvites = append(genesis.EntityInvites, invite)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.MemberFeeUsage.Walk(ctx, nil, func(_ collections.Pair[string, string], usage types.MemberFeeUsage) (bool, error) {
+		genesis.MemberFeeUsages = append(genesis.MemberFeeUsages, usage)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.SpecVersions.Walk(ctx, nil, func(_ string, spec types.SpecVersion) (bool, error) {
+		genesis.SpecVersions = append(genesis.SpecVersions, spec)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
 
 	return genesis, nil
 }
@@ -3,15 +3,45 @@ package keeper_test
 import (
 	"testing"
 
-	"stampledger-chain/x/stampledgerchain/types"
-
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/types"
 )
 
 func TestGenesis(t *testing.T) {
+	owner, invitee := sample.AccAddress(), sample.AccAddress()
+	treasury := sdk.AccAddress(types.EntityTreasuryAddress("entity-1"))
+	stake := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
-		
+		Stamps: []types.Stamp{
+			{Id: "stamp-1", PePublicKey: "pe-key", JurisdictionId: "wisconsin", Creator: owner},
+			{Id: "stamp-2", PePublicKey: "pe-key", Creator: owner, EntityId: "entity-1"},
+		},
+		Documents: []types.DocumentStorage{
+			{Id: "doc-1", StampId: "stamp-1", UploadedBy: owner},
+		},
+		Entities: []types.EntityAccount{
+			{Id: "entity-1", OwnerAddress: owner, TreasuryAddress: treasury.String(), MemberMonthlyCap: stake},
+		},
+		EntityMembers: []types.EntityMember{
+			{EntityId: "entity-1", Address: owner, Role: "admin", JoinedHeight: 3},
+		},
+		EntityInvites: []types.EntityInvite{
+			{EntityId: "entity-1", Invitee: invitee, Role: "editor", InvitedBy: owner, CreatedHeight: 3, ExpiresHeight: 50},
+		},
+		MemberFeeUsages: []types.MemberFeeUsage{
+			{EntityId: "entity-1", Member: owner, Period: "2026-01", Spent: stake},
+		},
+		SpecVersions: []types.SpecVersion{
+			{Id: "spec-1", ProjectId: "project-1"},
+			{Id: "spec-2", ProjectId: "project-1", ParentVersionId: "spec-1"},
+		},
+		IdSequence: 5,
 	}
 
 	f := initFixture(t)
@@ -21,6 +51,52 @@ func TestGenesis(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, got)
 
-	
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
-}
\ No newline at end of file
+	require.Equal(t, genesisState.IdSequence, got.IdSequence)
+	require.ElementsMatch(t, genesisState.Stamps, got.Stamps)
+	require.ElementsMatch(t, genesisState.Documents, got.Documents)
+	require.ElementsMatch(t, genesisState.Entities, got.Entities)
+	require.ElementsMatch(t, genesisState.EntityMembers, got.EntityMembers)
+	require.ElementsMatch(t, genesisState.EntityInvites, got.EntityInvites)
+	require.ElementsMatch(t, genesisState.MemberFeeUsages, got.MemberFeeUsages)
+	require.ElementsMatch(t, genesisState.SpecVersions, got.SpecVersions)
+
+	// Indexes are rebuilt on import
+	stamps, _, err := f.keeper.GetStampsByPE(f.ctx, "pe-key", nil)
+	require.NoError(t, err)
+	require.Len(t, stamps, 2)
+
+	ok, err := f.keeper.StampsByJurisdiction.Has(f.ctx, collections.Join("wisconsin", "stamp-1"))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	stamps, _, err = f.keeper.GetStampsByEntity(f.ctx, "entity-1", nil)
+	require.NoError(t, err)
+	require.Len(t, stamps, 1)
+	require.Equal(t, "stamp-2", stamps[0].Id)
+
+	docs, _, err := f.keeper.GetDocumentsByStamp(f.ctx, "stamp-1", types.DocumentRoleUnspecified, nil)
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+
+	entities, _, err := f.keeper.GetEntitiesByOwner(f.ctx, owner, nil)
+	require.NoError(t, err)
+	require.Len(t, entities, 1)
+
+	memberships, _, err := f.keeper.GetEntitiesByMember(f.ctx, owner, nil)
+	require.NoError(t, err)
+	require.Len(t, memberships, 1)
+	require.Equal(t, "admin", memberships[0].Role)
+
+	ok, err = f.keeper.InviteExpiry.Has(f.ctx, collections.Join3(int64(50), invitee, "entity-1"))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = f.keeper.IsEntityTreasury(f.ctx, treasury)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	versions, _, err := f.keeper.GetSpecVersionsByProject(f.ctx, "project-1", nil)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+}
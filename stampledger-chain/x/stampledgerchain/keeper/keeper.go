@@ -1,12 +1,14 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/google/uuid"
 
 	"stampledger-chain/x/stampledgerchain/types"
 )
@@ -19,21 +21,51 @@ type Keeper struct {
 	// Typically, this should be the x/gov module account.
 	authority []byte
 
+	bankKeeper types.BankKeeper
+
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 
+	// IDSequence feeds deterministic record ID generation
+	IDSequence collections.Sequence
+
 	// Stamp storage
 	Stamps               collections.Map[string, types.Stamp]
 	StampsByPE           collections.Map[collections.Pair[string, string], []byte] // PE public key -> stamp IDs
 	StampsByJurisdiction collections.Map[collections.Pair[string, string], []byte] // Jurisdiction -> stamp IDs
+	StampsByDocumentHash collections.Map[collections.Pair[string, string], []byte] // Document hash -> stamp IDs
+	StampsByExpiry       collections.Map[collections.Pair[int64, string], []byte]  // Valid-until time -> stamp IDs pending expiry
+	StampsByBatch        collections.Map[collections.Pair[string, string], []byte] // Batch ID -> stamp IDs
+	StampsByEntity       collections.Map[collections.Pair[string, string], []byte] // Entity ID -> stamp IDs
+	UsedSignatures       collections.Map[collections.Pair[string, string], []byte] // PE public key -> SHA-256 of each signature it has stamped with
+
+	// Stamp batches
+	StampBatches collections.Map[string, types.StampBatch]
+
+	// PE registry
+	ProfessionalEngineers collections.Map[string, types.ProfessionalEngineer] // PE public key -> PE
+
+	// Stamping delegations
+	StampingDelegations collections.Map[collections.Pair[string, string], types.StampingDelegation] // (granter, grantee) -> delegation
+
+	// Jurisdiction licenses
+	Licenses collections.Map[collections.Pair[string, string], types.License] // (jurisdiction, license number) -> license
 
 	// Document storage
 	Documents        collections.Map[string, types.DocumentStorage]
-	DocumentsByStamp collections.Map[collections.Pair[string, string], []byte] // Stamp ID -> document IDs
+	DocumentsByStamp collections.Map[collections.Pair[string, string], []byte]               // Stamp ID -> document IDs
+	PinSchedule      collections.Map[collections.Pair[int64, string], int64]                 // (action height, document ID) -> pin expiry it acts on
+	PinAttestations  collections.Map[collections.Pair[string, string], types.PinAttestation] // (document, provider) -> attestation
 
 	// Entity storage
-	Entities        collections.Map[string, types.EntityAccount]
-	EntitiesByOwner collections.Map[collections.Pair[string, string], []byte] // Owner address -> entity IDs
+	Entities           collections.Map[string, types.EntityAccount]
+	EntitiesByOwner    collections.Map[collections.Pair[string, string], []byte]               // Owner address -> entity IDs
+	EntitiesByTreasury collections.Map[string, string]                                         // Treasury address -> entity ID
+	MemberFeeUsage     collections.Map[collections.Pair[string, string], types.MemberFeeUsage] // (entity, member) -> sponsored fees
+	EntityMembers      collections.Map[collections.Pair[string, string], types.EntityMember]   // (entity, member) -> role
+	EntitiesByMember   collections.Map[collections.Pair[string, string], []byte]               // Member address -> entity IDs
+	EntityInvites      collections.Map[collections.Pair[string, string], types.EntityInvite]   // (invitee, entity) -> open invite
+	InviteExpiry       collections.Map[collections.Triple[int64, string, string], []byte]      // (expiry height, invitee, entity)
 
 	// Spec version storage
 	SpecVersions          collections.Map[string, types.SpecVersion]
@@ -45,6 +77,7 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
+	bankKeeper types.BankKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -57,8 +90,10 @@ func NewKeeper(
 		cdc:          cdc,
 		addressCodec: addressCodec,
 		authority:    authority,
+		bankKeeper:   bankKeeper,
 
-		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Params:     collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		IDSequence: collections.NewSequence(sb, types.IDSequenceKey, "id_sequence"),
 
 		// Stamp collections using JSON codec
 		Stamps: collections.NewMap(
@@ -75,6 +110,57 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
 			collections.BytesValue,
 		),
+		StampsByDocumentHash: collections.NewMap(
+			sb, types.StampsByDocumentHashKey, "stamps_by_document_hash",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collections.BytesValue,
+		),
+		StampsByExpiry: collections.NewMap(
+			sb, types.StampsByExpiryKey, "stamps_by_expiry",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+			collections.BytesValue,
+		),
+		StampsByBatch: collections.NewMap(
+			sb, types.StampsByBatchKey, "stamps_by_batch",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collections.BytesValue,
+		),
+		StampsByEntity: collections.NewMap(
+			sb, types.StampsByEntityKey, "stamps_by_entity",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collections.BytesValue,
+		),
+		UsedSignatures: collections.NewMap(
+			sb, types.UsedSignaturesKey, "used_signatures",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collections.BytesValue,
+		),
+
+		// Stamp batch collections using JSON codec
+		StampBatches: collections.NewMap(
+			sb, types.StampBatchesKey, "stamp_batches",
+			collections.StringKey, types.NewJSONValueCodec[types.StampBatch](),
+		),
+
+		// PE registry collections using JSON codec
+		ProfessionalEngineers: collections.NewMap(
+			sb, types.ProfessionalEngineersKey, "professional_engineers",
+			collections.StringKey, types.NewJSONValueCodec[types.ProfessionalEngineer](),
+		),
+
+		// Stamping delegation collections using JSON codec
+		StampingDelegations: collections.NewMap(
+			sb, types.StampingDelegationsKey, "stamping_delegations",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			types.NewJSONValueCodec[types.StampingDelegation](),
+		),
+
+		// License collections using JSON codec
+		Licenses: collections.NewMap(
+			sb, types.LicensesKey, "licenses",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			types.NewJSONValueCodec[types.License](),
+		),
 
 		// Document collections using JSON codec
 		Documents: collections.NewMap(
@@ -86,6 +172,16 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
 			collections.BytesValue,
 		),
+		PinSchedule: collections.NewMap(
+			sb, types.PinScheduleKey, "pin_schedule",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+			collections.Int64Value,
+		),
+		PinAttestations: collections.NewMap(
+			sb, types.PinAttestationsKey, "pin_attestations",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			types.NewJSONValueCodec[types.PinAttestation](),
+		),
 
 		// Entity collections using JSON codec
 		Entities: collections.NewMap(
@@ -97,6 +193,35 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
 			collections.BytesValue,
 		),
+		EntitiesByTreasury: collections.NewMap(
+			sb, types.EntitiesByTreasuryKey, "entities_by_treasury",
+			collections.StringKey, collections.StringValue,
+		),
+		MemberFeeUsage: collections.NewMap(
+			sb, types.MemberFeeUsageKey, "member_fee_usage",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			types.NewJSONValueCodec[types.MemberFeeUsage](),
+		),
+		EntityMembers: collections.NewMap(
+			sb, types.EntityMembersKey, "entity_members",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			types.NewJSONValueCodec[types.EntityMember](),
+		),
+		EntitiesByMember: collections.NewMap(
+			sb, types.EntitiesByMemberKey, "entities_by_member",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collections.BytesValue,
+		),
+		EntityInvites: collections.NewMap(
+			sb, types.EntityInvitesKey, "entity_invites",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			types.NewJSONValueCodec[types.EntityInvite](),
+		),
+		InviteExpiry: collections.NewMap(
+			sb, types.InviteExpiryKey, "invite_expiry",
+			collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.StringKey),
+			collections.BytesValue,
+		),
 
 		// Spec version collections using JSON codec
 		SpecVersions: collections.NewMap(
@@ -123,3 +248,16 @@ func NewKeeper(
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
 }
+
+// idNamespace is the UUID namespace for record IDs derived from IDSequence.
+var idNamespace = uuid.NewSHA1(uuid.NameSpaceOID, []byte(types.ModuleName))
+
+// nextID returns a new record ID. IDs are name-based UUIDs over the module
+// sequence so that every validator derives the same ID for the same message.
+func (k Keeper) nextID(ctx context.Context) (string, error) {
+	seq, err := k.IDSequence.Next(ctx)
+	if err != nil {
+		return "", err
+	}
+	return uuid.NewSHA1(idNamespace, []byte(fmt.Sprintf("%d", seq))).String(), nil
+}
@@ -2,28 +2,40 @@ package keeper_test
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/core/address"
-	"cosmossdk.io/log"
+	"cosmossdk.io/store"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
-	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
 
 	"stampledger-chain/x/stampledgerchain/keeper"
 	module "stampledger-chain/x/stampledgerchain/module"
 	"stampledger-chain/x/stampledgerchain/types"
 )
 
+// testChainID is the chain ID of the fixture context, bound into stamp signatures.
+const testChainID = "stampledger-test"
+
+// testBoard is the licensing board address of the fixture's "wisconsin" jurisdiction.
+var testBoard = sdk.AccAddress([]byte("wisconsin-board-addr")).String()
+
 type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	cms          store.CommitMultiStore
+	bankKeeper   *mockBankKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -34,19 +46,26 @@ func initFixture(t *testing.T) *fixture {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	storeService := runtime.NewKVStoreService(storeKey)
-	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	testCtx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithChainID(testChainID)
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := newMockBankKeeper()
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		bankKeeper,
 	)
 
-	// Initialize params
-	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
+	// Initialize params with a single licensing jurisdiction
+	params := types.DefaultParams()
+	params.Jurisdictions = []types.Jurisdiction{
+		{Id: "wisconsin", Name: "Wisconsin Board of Engineers", BoardAddresses: []string{testBoard}},
+	}
+	if err := k.Params.Set(ctx, params); err != nil {
 		t.Fatalf("failed to set params: %v", err)
 	}
 
@@ -54,5 +73,110 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		cms:          testCtx.CMS,
+		bankKeeper:   bankKeeper,
+	}
+}
+
+// newPEKey returns a deterministic Ed25519 stamp key for a test PE.
+func newPEKey(name string) ed25519.PrivateKey {
+	seed := sha256.Sum256([]byte(name))
+	return ed25519.NewKeyFromSeed(seed[:])
+}
+
+// newCreateStampMsg returns a MsgCreateStamp for the given document content
+// signed by the PE key over the fixture chain's sign bytes.
+func newCreateStampMsg(creator string, pe ed25519.PrivateKey, content string) *types.MsgCreateStamp {
+	docHash := sha256.Sum256([]byte(content))
+	msg := &types.MsgCreateStamp{
+		Creator:         creator,
+		DocumentHash:    hex.EncodeToString(docHash[:]),
+		PePublicKey:     hex.EncodeToString(pe.Public().(ed25519.PublicKey)),
+		JurisdictionId:  "wisconsin",
+		PeLicenseNumber: "PE-12345",
+		PeName:          "Jane Doe",
+		Nonce:           1,
+	}
+	signCreateStampMsg(pe, msg)
+	return msg
+}
+
+// signCreateStampMsg (re)signs msg with the PE key after its fields change.
+func signCreateStampMsg(pe ed25519.PrivateKey, msg *types.MsgCreateStamp) {
+	signBytes := types.StampSignBytes(testChainID, msg.JurisdictionId, msg.PeLicenseNumber, msg.DocumentHash, msg.SignatureExpiry, msg.Nonce)
+	msg.Signature = hex.EncodeToString(ed25519.Sign(pe, signBytes))
+}
+
+// newRegisterPEMsg returns a MsgRegisterPE binding the PE key to creator with
+// the metadata used by newCreateStampMsg.
+func newRegisterPEMsg(creator string, pe ed25519.PrivateKey) *types.MsgRegisterPE {
+	signBytes := types.PERegistrationSignBytes(testChainID, creator, "PE-12345")
+	return &types.MsgRegisterPE{
+		Creator:       creator,
+		PublicKey:     hex.EncodeToString(pe.Public().(ed25519.PublicKey)),
+		Name:          "Jane Doe",
+		LicenseNumber: "PE-12345",
+		Jurisdictions: []string{"wisconsin"},
+		PopSignature:  hex.EncodeToString(ed25519.Sign(pe, signBytes)),
 	}
-}
\ No newline at end of file
+}
+
+// registerPE registers the PE key to creator and has the fixture's board
+// attest the license used by newCreateStampMsg.
+func (f *fixture) registerPE(t *testing.T, ctx context.Context, creator string, pe ed25519.PrivateKey) {
+	t.Helper()
+
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	_, err := ms.RegisterPE(ctx, newRegisterPEMsg(creator, pe))
+	require.NoError(t, err)
+
+	if _, err := f.keeper.GetLicense(ctx, "wisconsin", "PE-12345"); err == nil {
+		return
+	}
+	_, err = ms.AttestLicense(ctx, &types.MsgAttestLicense{
+		Creator:        testBoard,
+		JurisdictionId: "wisconsin",
+		LicenseNumber:  "PE-12345",
+		PeAccount:      creator,
+	})
+	require.NoError(t, err)
+}
+
+// addMember has admin invite member to the entity with role, and member
+// accept the invite.
+func (f *fixture) addMember(t *testing.T, ctx context.Context, admin, entityID, member, role string) {
+	t.Helper()
+
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	_, err := ms.InviteMember(ctx, &types.MsgInviteMember{Creator: admin, EntityId: entityID, Invitee: member, Role: role})
+	require.NoError(t, err)
+	_, err = ms.AcceptInvite(ctx, &types.MsgAcceptInvite{Creator: member, EntityId: entityID})
+	require.NoError(t, err)
+}
+
+// mockBankKeeper is an in-memory bank keeper for keeper tests.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (b *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[string(addr)]
+}
+
+func (b *mockBankKeeper) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance, ok := b.balances[string(fromAddr)].SafeSub(amt...)
+	if ok {
+		return fmt.Errorf("insufficient funds: %s < %s", b.balances[string(fromAddr)], amt)
+	}
+	b.balances[string(fromAddr)] = balance
+	b.balances[string(toAddr)] = b.balances[string(toAddr)].Add(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.SendCoins(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// Migrator performs in-place store migrations of the module
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 fills the params added since v1 with their defaults, moves
+// entity membership from the lists embedded in each EntityAccount into
+// EntityMember records, marks the signatures of existing stamps as used and
+// indexes the stamps by document hash, and binds attested licenses to their
+// PE accounts.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	// 1. Default the params v1 did not have, which decode as zero values
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params = withV2ParamDefaults(params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	// 2. Collect entities that still embed their membership
+	var legacy []types.EntityAccount
+	if err := m.keeper.Entities.Walk(ctx, nil, func(_ string, entity types.EntityAccount) (bool, error) {
+		if hasEmbeddedMembers(entity) {
+			legacy = append(legacy, entity)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	// 3. Move their members into the EntityMembers store
+	for _, entity := range legacy {
+		entity, err := m.keeper.migrateEntityMembers(ctx, entity)
+		if err != nil {
+			return err
+		}
+		if err := m.keeper.Entities.Set(ctx, entity.Id, entity); err != nil {
+			return err
+		}
+	}
+
+	ctx.Logger().Info("migrated entity members", "entities", len(legacy))
+
+	// 4. Record the signatures of existing stamps so they cannot be replayed,
+	// and index them by document hash so restamps are caught as duplicates
+	if err := m.keeper.Stamps.Walk(ctx, nil, func(_ string, stamp types.Stamp) (bool, error) {
+		if err := m.keeper.recordStampSignature(ctx, stamp); err != nil {
+			return true, err
+		}
+		return false, m.keeper.StampsByDocumentHash.Set(ctx, collections.Join(stamp.DocumentHash, stamp.Id), []byte{})
+	}); err != nil {
+		return err
+	}
+
+	// 5. Bind licenses attested before they named a PE account
+	return m.keeper.bindLegacyLicenses(ctx)
+}
+
+// bindLegacyLicenses binds each license without a PE account to the one
+// account whose registered keys claim it in the jurisdiction. Licenses
+// claimed by several accounts, or by none, cannot be bound safely and return
+// to unattested until their board attests them again.
+func (k Keeper) bindLegacyLicenses(ctx sdk.Context) error {
+	claims := make(map[[2]string][]string)
+	if err := k.ProfessionalEngineers.Walk(ctx, nil, func(_ string, pe types.ProfessionalEngineer) (bool, error) {
+		for _, jurisdictionID := range pe.Jurisdictions {
+			key := [2]string{jurisdictionID, pe.LicenseNumber}
+			if !slices.Contains(claims[key], pe.Account) {
+				claims[key] = append(claims[key], pe.Account)
+			}
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	var legacy []types.License
+	if err := k.Licenses.Walk(ctx, nil, func(_ collections.Pair[string, string], license types.License) (bool, error) {
+		if license.PeAccount == "" && license.Status != types.LicenseUnattested {
+			legacy = append(legacy, license)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	var unbound int
+	for _, license := range legacy {
+		if accounts := claims[[2]string{license.JurisdictionId, license.LicenseNumber}]; len(accounts) == 1 {
+			license.PeAccount = accounts[0]
+		} else {
+			license.Status = types.LicenseUnattested
+			license.History = append(license.History, types.LicenseStatusChange{
+				Status:    types.LicenseUnattested,
+				ChangedAt: ctx.BlockTime().Unix(),
+				Reason:    "PE account could not be determined at upgrade; attest again",
+			})
+			unbound++
+		}
+		if err := k.Licenses.Set(ctx, collections.Join(license.JurisdictionId, license.LicenseNumber), license); err != nil {
+			return err
+		}
+	}
+
+	ctx.Logger().Info("bound legacy licenses", "licenses", len(legacy), "unbound", unbound)
+	return nil
+}
+
+// withV2ParamDefaults returns params with every field added since v1 that is
+// still zero or empty set to its default. The pin expiry warning may be zero
+// by choice, so it is only defaulted along with the pin duration.
+func withV2ParamDefaults(params types.Params) types.Params {
+	defaults := types.DefaultParams()
+	for _, limit := range []struct {
+		value    *uint32
+		fallback uint32
+	}{
+		{&params.MaxNameLength, defaults.MaxNameLength},
+		{&params.MaxProjectNameLength, defaults.MaxProjectNameLength},
+		{&params.MaxFilenameLength, defaults.MaxFilenameLength},
+		{&params.MaxReasonLength, defaults.MaxReasonLength},
+		{&params.MaxChangelogLength, defaults.MaxChangelogLength},
+		{&params.MaxMetadataLength, defaults.MaxMetadataLength},
+	} {
+		if *limit.value == 0 {
+			*limit.value = limit.fallback
+		}
+	}
+	if params.MaxDocumentSize == 0 {
+		params.MaxDocumentSize = defaults.MaxDocumentSize
+	}
+	if len(params.AllowedEntityTypes) == 0 {
+		params.AllowedEntityTypes = defaults.AllowedEntityTypes
+	}
+	if len(params.IpfsCidPrefixes) == 0 {
+		params.IpfsCidPrefixes = defaults.IpfsCidPrefixes
+	}
+	if params.PinDuration == 0 {
+		params.PinDuration = defaults.PinDuration
+		params.PinExpiryWarning = defaults.PinExpiryWarning
+	}
+	if params.InviteDuration == 0 {
+		params.InviteDuration = defaults.InviteDuration
+	}
+	return params
+}
+
+// hasEmbeddedMembers reports whether an entity predates the EntityMembers store
+func hasEmbeddedMembers(entity types.EntityAccount) bool {
+	return len(entity.MemberAddresses) > 0 || len(entity.AdminAddresses) > 0 || len(entity.Permissions) > 0
+}
+
+// migrateEntityMembers writes an EntityMember record for every address in
+// the membership embedded in entity, and returns the entity with it cleared.
+// Listed members take their role from the permissions map, defaulting to
+// viewer; admin_addresses and the owner are admins. The join heights were
+// never recorded, so members join at the current height. Existing records
+// are kept.
+func (k Keeper) migrateEntityMembers(ctx context.Context, entity types.EntityAccount) (types.EntityAccount, error) {
+	roles := make(map[string]string)
+	var order []string
+	assign := func(addr string, role string) {
+		if !types.ValidRoles[role] {
+			role = "viewer"
+		}
+		if _, ok := roles[addr]; !ok {
+			order = append(order, addr)
+		}
+		roles[addr] = role
+	}
+	for _, addr := range entity.MemberAddresses {
+		assign(addr, entity.Permissions[addr])
+	}
+	for _, addr := range slices.Sorted(maps.Keys(entity.Permissions)) {
+		assign(addr, entity.Permissions[addr])
+	}
+	for _, addr := range entity.AdminAddresses {
+		assign(addr, "admin")
+	}
+	assign(entity.OwnerAddress, "admin")
+
+	for _, addr := range order {
+		exists, err := k.EntityMembers.Has(ctx, collections.Join(entity.Id, addr))
+		if err != nil {
+			return entity, err
+		}
+		if exists {
+			continue
+		}
+		if err := k.setEntityMember(ctx, newEntityMember(ctx, entity.Id, addr, roles[addr])); err != nil {
+			return entity, err
+		}
+	}
+
+	entity.MemberAddresses = nil
+	entity.AdminAddresses = nil
+	entity.Permissions = nil
+	return entity, nil
+}
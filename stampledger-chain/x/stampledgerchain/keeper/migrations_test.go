@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(50)
+
+	owner, admin, editor, viewer := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	require.NoError(t, f.keeper.Entities.Set(ctx, "entity-1", types.EntityAccount{
+		Id:              "entity-1",
+		OwnerAddress:    owner,
+		Active:          true,
+		MemberAddresses: []string{owner, admin, editor, viewer, viewer},
+		AdminAddresses:  []string{owner, admin},
+		Permissions:     map[string]string{owner: "admin", editor: "editor", admin: "viewer"},
+	}))
+	require.NoError(t, f.keeper.Entities.Set(ctx, "entity-2", types.EntityAccount{Id: "entity-2", OwnerAddress: owner}))
+
+	// Stamps from before signatures were recorded and stamps were indexed by
+	// document hash
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	pe := newPEKey("pe-1")
+	f.registerPE(t, ctx, owner, pe)
+	stampMsg := newCreateStampMsg(owner, pe, "sheet-A")
+	stampRes, err := ms.CreateStamp(ctx, stampMsg)
+	require.NoError(t, err)
+	_, err = ms.RevokeStamp(ctx, &types.MsgRevokeStamp{Creator: owner, StampId: stampRes.StampId, ReasonCode: types.RevocationErrorInDesign})
+	require.NoError(t, err)
+	liveMsg := newCreateStampMsg(owner, pe, "sheet-B")
+	liveRes, err := ms.CreateStamp(ctx, liveMsg)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.UsedSignatures.Clear(ctx, nil))
+	require.NoError(t, f.keeper.StampsByDocumentHash.Clear(ctx, nil))
+
+	// Licenses attested before they named a PE account: one claimed by a
+	// single account, one claimed by two
+	impostor := sample.AccAddress()
+	f.registerPE(t, ctx, owner, newPEKey("pe-2"))
+	for _, number := range []string{"PE-12345", "PE-2"} {
+		require.NoError(t, f.keeper.Licenses.Set(ctx, collections.Join("wisconsin", number), types.License{
+			JurisdictionId: "wisconsin",
+			LicenseNumber:  number,
+			Status:         types.LicenseActive,
+		}))
+	}
+	for _, account := range []string{owner, impostor} {
+		key := newPEKey("pe-2-" + account)
+		msg := newRegisterPEMsg(account, key)
+		msg.LicenseNumber = "PE-2"
+		msg.PopSignature = hex.EncodeToString(ed25519.Sign(key, types.PERegistrationSignBytes(testChainID, account, "PE-2")))
+		_, err = ms.RegisterPE(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	// Embedded membership is moved into member records, admins winning
+	entity, err := f.keeper.GetEntity(ctx, "entity-1")
+	require.NoError(t, err)
+	require.Empty(t, entity.MemberAddresses)
+	require.Empty(t, entity.AdminAddresses)
+	require.Empty(t, entity.Permissions)
+	members, _, err := f.keeper.GetEntityMembers(ctx, "entity-1", nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.EntityMember{
+		{EntityId: "entity-1", Address: owner, Role: "admin", JoinedHeight: 50},
+		{EntityId: "entity-1", Address: admin, Role: "admin", JoinedHeight: 50},
+		{EntityId: "entity-1", Address: editor, Role: "editor", JoinedHeight: 50},
+		{EntityId: "entity-1", Address: viewer, Role: "viewer", JoinedHeight: 50},
+	}, members)
+
+	// Entities without embedded membership are left alone
+	members, _, err = f.keeper.GetEntityMembers(ctx, "entity-2", nil)
+	require.NoError(t, err)
+	require.Empty(t, members)
+
+	// Existing stamps cannot be replayed
+	_, err = ms.CreateStamp(ctx, stampMsg)
+	require.ErrorIs(t, err, types.ErrSignatureReused)
+
+	// Existing stamps are found by document hash, and live ones block restamps
+	stamps, _, err := f.keeper.GetStampsByDocumentHash(ctx, liveMsg.DocumentHash, nil)
+	require.NoError(t, err)
+	require.Len(t, stamps, 1)
+	require.Equal(t, liveRes.StampId, stamps[0].Id)
+	stamps, _, err = f.keeper.GetStampsByDocumentHash(ctx, stampMsg.DocumentHash, nil)
+	require.NoError(t, err)
+	require.Len(t, stamps, 1)
+	require.Equal(t, stampRes.StampId, stamps[0].Id)
+	liveMsg.Nonce = 2
+	signCreateStampMsg(pe, liveMsg)
+	_, err = ms.CreateStamp(ctx, liveMsg)
+	require.ErrorIs(t, err, types.ErrDuplicateStamp)
+
+	// Unambiguous licenses are bound, ambiguous ones must be attested again
+	license, err := f.keeper.GetLicense(ctx, "wisconsin", "PE-12345")
+	require.NoError(t, err)
+	require.Equal(t, owner, license.PeAccount)
+	require.Equal(t, types.LicenseActive, license.Status)
+	license, err = f.keeper.GetLicense(ctx, "wisconsin", "PE-2")
+	require.NoError(t, err)
+	require.Empty(t, license.PeAccount)
+	require.Equal(t, types.LicenseUnattested, license.Status)
+}
+
+func TestMigrate1to2Params(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// Params as stored by v1, which only had the fee and jurisdiction fields
+	v2, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	v1 := types.Params{
+		Jurisdictions:      v2.Jurisdictions,
+		StampFee:           sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		FeeExemptAddresses: []string{sample.AccAddress()},
+	}
+	require.NoError(t, f.keeper.Params.Set(ctx, v1))
+	require.Error(t, v1.Validate())
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	// New fields take their defaults, and existing ones are kept
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, params.Validate())
+	expected := types.DefaultParams()
+	expected.Jurisdictions = v1.Jurisdictions
+	expected.StampFee = v1.StampFee
+	expected.FeeExemptAddresses = v1.FeeExemptAddresses
+	require.Equal(t, expected, params)
+
+	// Names and CIDs are accepted again
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	_, err = ms.CreateEntity(ctx, &types.MsgCreateEntity{Creator: sample.AccAddress(), Name: "Acme", EntityType: "firm"})
+	require.NoError(t, err)
+	require.NoError(t, params.CheckIpfsCID("bafkreigh2akiscaildcqabsyg3dfr6chu3fgpregiymsck7e7aqa4s52zy"))
+
+	// Params already set to non-default values survive the migration
+	params.MaxNameLength = 64
+	params.PinDuration = 1000
+	params.PinExpiryWarning = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+	migrated, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, migrated)
+}
@@ -36,6 +36,10 @@ func (m msgServer) CreateStamp(ctx context.Context, msg *types.MsgCreateStamp) (
 		msg.DocumentIpfsHash,
 		msg.DocumentSize,
 		msg.DocumentFilename,
+		msg.SignatureExpiry,
+		msg.Nonce,
+		msg.ValidUntil,
+		msg.EntityId,
 	)
 	if err != nil {
 		return nil, err
@@ -52,7 +56,15 @@ func (m msgServer) CreateStamp(ctx context.Context, msg *types.MsgCreateStamp) (
 
 // RevokeStamp handles MsgRevokeStamp
 func (m msgServer) RevokeStamp(ctx context.Context, msg *types.MsgRevokeStamp) (*types.MsgRevokeStampResponse, error) {
-	err := m.Keeper.RevokeStamp(ctx, msg.Creator, msg.StampId, msg.Reason)
+	err := m.Keeper.RevokeStamp(
+		ctx,
+		msg.Creator,
+		msg.StampId,
+		msg.ReasonCode,
+		msg.Reason,
+		msg.EvidenceHash,
+		msg.EntityId,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +74,251 @@ func (m msgServer) RevokeStamp(ctx context.Context, msg *types.MsgRevokeStamp) (
 	}, nil
 }
 
+// CreateMerkleStamp handles MsgCreateMerkleStamp
+func (m msgServer) CreateMerkleStamp(ctx context.Context, msg *types.MsgCreateMerkleStamp) (*types.MsgCreateMerkleStampResponse, error) {
+	stampID, err := m.Keeper.CreateMerkleStamp(
+		ctx,
+		msg.Creator,
+		msg.MerkleRoot,
+		msg.LeafCount,
+		msg.PePublicKey,
+		msg.Signature,
+		msg.JurisdictionId,
+		msg.PeLicenseNumber,
+		msg.PeName,
+		msg.ProjectName,
+		msg.ManifestIpfsHash,
+		msg.SignatureExpiry,
+		msg.Nonce,
+		msg.ValidUntil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateMerkleStampResponse{
+		StampId: stampID,
+	}, nil
+}
+
+// ProposeCoStamp handles MsgProposeCoStamp
+func (m msgServer) ProposeCoStamp(ctx context.Context, msg *types.MsgProposeCoStamp) (*types.MsgProposeCoStampResponse, error) {
+	stampID, err := m.Keeper.ProposeCoStamp(
+		ctx,
+		msg.Creator,
+		msg.DocumentHash,
+		msg.JurisdictionId,
+		msg.ProjectName,
+		msg.DocumentIpfsHash,
+		msg.DocumentSize,
+		msg.DocumentFilename,
+		msg.CoSigners,
+		msg.Threshold,
+		msg.SignatureExpiry,
+		msg.Nonce,
+		msg.ValidUntil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeCoStampResponse{
+		StampId: stampID,
+	}, nil
+}
+
+// AddCoSignature handles MsgAddCoSignature
+func (m msgServer) AddCoSignature(ctx context.Context, msg *types.MsgAddCoSignature) (*types.MsgAddCoSignatureResponse, error) {
+	count, complete, err := m.Keeper.AddCoSignature(ctx, msg.Creator, msg.StampId, msg.PePublicKey, msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddCoSignatureResponse{
+		SignatureCount: count,
+		Complete:       complete,
+	}, nil
+}
+
+// SupersedeStamp handles MsgSupersedeStamp
+func (m msgServer) SupersedeStamp(ctx context.Context, msg *types.MsgSupersedeStamp) (*types.MsgSupersedeStampResponse, error) {
+	stampID, err := m.Keeper.SupersedeStamp(
+		ctx,
+		msg.Creator,
+		msg.SupersededStampId,
+		msg.DocumentHash,
+		msg.PePublicKey,
+		msg.Signature,
+		msg.JurisdictionId,
+		msg.PeLicenseNumber,
+		msg.PeName,
+		msg.ProjectName,
+		msg.DocumentIpfsHash,
+		msg.DocumentSize,
+		msg.DocumentFilename,
+		msg.SignatureExpiry,
+		msg.Nonce,
+		msg.ValidUntil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSupersedeStampResponse{
+		StampId: stampID,
+	}, nil
+}
+
+// CreateStampBatch handles MsgCreateStampBatch
+func (m msgServer) CreateStampBatch(ctx context.Context, msg *types.MsgCreateStampBatch) (*types.MsgCreateStampBatchResponse, error) {
+	batchID, stampIDs, err := m.Keeper.CreateStampBatch(
+		ctx,
+		msg.Creator,
+		msg.PePublicKey,
+		msg.JurisdictionId,
+		msg.PeLicenseNumber,
+		msg.PeName,
+		msg.ProjectName,
+		msg.SignatureExpiry,
+		msg.Nonce,
+		msg.ValidUntil,
+		msg.Entries,
+		msg.EntityId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateStampBatchResponse{
+		BatchId:  batchID,
+		StampIds: stampIDs,
+	}, nil
+}
+
+// RevokeStampBatch handles MsgRevokeStampBatch
+func (m msgServer) RevokeStampBatch(ctx context.Context, msg *types.MsgRevokeStampBatch) (*types.MsgRevokeStampBatchResponse, error) {
+	revoked, err := m.Keeper.RevokeStampBatch(
+		ctx,
+		msg.Creator,
+		msg.BatchId,
+		msg.ReasonCode,
+		msg.Reason,
+		msg.EvidenceHash,
+		msg.EntityId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeStampBatchResponse{
+		RevokedCount: revoked,
+	}, nil
+}
+
+// RegisterPE handles MsgRegisterPE
+func (m msgServer) RegisterPE(ctx context.Context, msg *types.MsgRegisterPE) (*types.MsgRegisterPEResponse, error) {
+	err := m.Keeper.RegisterPE(
+		ctx,
+		msg.Creator,
+		msg.PublicKey,
+		msg.Name,
+		msg.LicenseNumber,
+		msg.Jurisdictions,
+		msg.PopSignature,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterPEResponse{}, nil
+}
+
+// GrantStampingDelegation handles MsgGrantStampingDelegation
+func (m msgServer) GrantStampingDelegation(ctx context.Context, msg *types.MsgGrantStampingDelegation) (*types.MsgGrantStampingDelegationResponse, error) {
+	err := m.Keeper.GrantStampingDelegation(
+		ctx,
+		msg.Creator,
+		msg.Grantee,
+		msg.JurisdictionIds,
+		msg.ProjectNames,
+		msg.Expiration,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantStampingDelegationResponse{}, nil
+}
+
+// RevokeStampingDelegation handles MsgRevokeStampingDelegation
+func (m msgServer) RevokeStampingDelegation(ctx context.Context, msg *types.MsgRevokeStampingDelegation) (*types.MsgRevokeStampingDelegationResponse, error) {
+	if err := m.Keeper.RevokeStampingDelegation(ctx, msg.Creator, msg.Grantee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeStampingDelegationResponse{}, nil
+}
+
+// AttestLicense handles MsgAttestLicense
+func (m msgServer) AttestLicense(ctx context.Context, msg *types.MsgAttestLicense) (*types.MsgAttestLicenseResponse, error) {
+	err := m.Keeper.AttestLicense(ctx, msg.Creator, msg.JurisdictionId, msg.LicenseNumber, msg.PeAccount, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAttestLicenseResponse{}, nil
+}
+
+// SuspendLicense handles MsgSuspendLicense
+func (m msgServer) SuspendLicense(ctx context.Context, msg *types.MsgSuspendLicense) (*types.MsgSuspendLicenseResponse, error) {
+	err := m.Keeper.SuspendLicense(ctx, msg.Creator, msg.JurisdictionId, msg.LicenseNumber, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSuspendLicenseResponse{}, nil
+}
+
+// ReinstateLicense handles MsgReinstateLicense
+func (m msgServer) ReinstateLicense(ctx context.Context, msg *types.MsgReinstateLicense) (*types.MsgReinstateLicenseResponse, error) {
+	err := m.Keeper.ReinstateLicense(ctx, msg.Creator, msg.JurisdictionId, msg.LicenseNumber, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReinstateLicenseResponse{}, nil
+}
+
+// RotatePEKey handles MsgRotatePEKey
+func (m msgServer) RotatePEKey(ctx context.Context, msg *types.MsgRotatePEKey) (*types.MsgRotatePEKeyResponse, error) {
+	err := m.Keeper.RotatePEKey(
+		ctx,
+		msg.Creator,
+		msg.OldPublicKey,
+		msg.NewPublicKey,
+		msg.OldKeySignature,
+		msg.NewKeySignature,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotatePEKeyResponse{}, nil
+}
+
+// ReportKeyCompromise handles MsgReportKeyCompromise
+func (m msgServer) ReportKeyCompromise(ctx context.Context, msg *types.MsgReportKeyCompromise) (*types.MsgReportKeyCompromiseResponse, error) {
+	revoked, complete, err := m.Keeper.ReportKeyCompromise(ctx, msg.Creator, msg.PublicKey, msg.CompromisedSinceHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReportKeyCompromiseResponse{
+		RevokedCount: revoked,
+		Complete:     complete,
+	}, nil
+}
+
 // StoreDocument handles MsgStoreDocument
 func (m msgServer) StoreDocument(ctx context.Context, msg *types.MsgStoreDocument) (*types.MsgStoreDocumentResponse, error) {
 	docID, ipfsURL, err := m.Keeper.StoreDocument(
@@ -73,6 +330,9 @@ func (m msgServer) StoreDocument(ctx context.Context, msg *types.MsgStoreDocumen
 		msg.Size_,
 		msg.MimeType,
 		msg.PinForever,
+		msg.ContentSha256,
+		msg.Role,
+		msg.EntityId,
 	)
 	if err != nil {
 		return nil, err
@@ -84,6 +344,42 @@ func (m msgServer) StoreDocument(ctx context.Context, msg *types.MsgStoreDocumen
 	}, nil
 }
 
+// RenewPin handles MsgRenewPin
+func (m msgServer) RenewPin(ctx context.Context, msg *types.MsgRenewPin) (*types.MsgRenewPinResponse, error) {
+	expiresHeight, err := m.Keeper.RenewPin(ctx, msg.Creator, msg.DocumentId, msg.PinForever)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenewPinResponse{PinExpiresHeight: expiresHeight}, nil
+}
+
+// Unpin handles MsgUnpin
+func (m msgServer) Unpin(ctx context.Context, msg *types.MsgUnpin) (*types.MsgUnpinResponse, error) {
+	if err := m.Keeper.Unpin(ctx, msg.Creator, msg.DocumentId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnpinResponse{}, nil
+}
+
+// AttestPinned handles MsgAttestPinned
+func (m msgServer) AttestPinned(ctx context.Context, msg *types.MsgAttestPinned) (*types.MsgAttestPinnedResponse, error) {
+	err := m.Keeper.AttestPinned(
+		ctx,
+		msg.Creator,
+		msg.DocumentId,
+		msg.ReplicaCount,
+		msg.PinnedUntilHeight,
+		msg.Signature,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAttestPinnedResponse{}, nil
+}
+
 // CreateEntity handles MsgCreateEntity
 func (m msgServer) CreateEntity(ctx context.Context, msg *types.MsgCreateEntity) (*types.MsgCreateEntityResponse, error) {
 	entityID, err := m.Keeper.CreateEntity(ctx, msg.Creator, msg.Name, msg.EntityType)
@@ -96,18 +392,54 @@ func (m msgServer) CreateEntity(ctx context.Context, msg *types.MsgCreateEntity)
 	}, nil
 }
 
-// AddEntityMember handles MsgAddEntityMember
+// AddEntityMember handles the deprecated MsgAddEntityMember, which is always
+// rejected in favour of MsgInviteMember
 func (m msgServer) AddEntityMember(ctx context.Context, msg *types.MsgAddEntityMember) (*types.MsgAddEntityMemberResponse, error) {
-	err := m.Keeper.AddEntityMember(ctx, msg.Creator, msg.EntityId, msg.MemberAddress, msg.Role)
+	return nil, types.ErrDirectAdd.Wrapf("invite %s to entity %s instead", msg.MemberAddress, msg.EntityId)
+}
+
+// InviteMember handles MsgInviteMember
+func (m msgServer) InviteMember(ctx context.Context, msg *types.MsgInviteMember) (*types.MsgInviteMemberResponse, error) {
+	expiresHeight, err := m.Keeper.InviteMember(ctx, msg.Creator, msg.EntityId, msg.Invitee, msg.Role)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgAddEntityMemberResponse{
-		Success: true,
+	return &types.MsgInviteMemberResponse{
+		ExpiresHeight: expiresHeight,
 	}, nil
 }
 
+// AcceptInvite handles MsgAcceptInvite
+func (m msgServer) AcceptInvite(ctx context.Context, msg *types.MsgAcceptInvite) (*types.MsgAcceptInviteResponse, error) {
+	role, err := m.Keeper.AcceptInvite(ctx, msg.Creator, msg.EntityId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptInviteResponse{
+		Role: role,
+	}, nil
+}
+
+// DeclineInvite handles MsgDeclineInvite
+func (m msgServer) DeclineInvite(ctx context.Context, msg *types.MsgDeclineInvite) (*types.MsgDeclineInviteResponse, error) {
+	if err := m.Keeper.DeclineInvite(ctx, msg.Creator, msg.EntityId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeclineInviteResponse{}, nil
+}
+
+// LeaveEntity handles MsgLeaveEntity
+func (m msgServer) LeaveEntity(ctx context.Context, msg *types.MsgLeaveEntity) (*types.MsgLeaveEntityResponse, error) {
+	if err := m.Keeper.LeaveEntity(ctx, msg.Creator, msg.EntityId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLeaveEntityResponse{}, nil
+}
+
 // RemoveEntityMember handles MsgRemoveEntityMember
 func (m msgServer) RemoveEntityMember(ctx context.Context, msg *types.MsgRemoveEntityMember) (*types.MsgRemoveEntityMemberResponse, error) {
 	err := m.Keeper.RemoveEntityMember(ctx, msg.Creator, msg.EntityId, msg.MemberAddress)
@@ -120,6 +452,81 @@ func (m msgServer) RemoveEntityMember(ctx context.Context, msg *types.MsgRemoveE
 	}, nil
 }
 
+// UpdateMemberRole handles MsgUpdateMemberRole
+func (m msgServer) UpdateMemberRole(ctx context.Context, msg *types.MsgUpdateMemberRole) (*types.MsgUpdateMemberRoleResponse, error) {
+	if err := m.Keeper.UpdateMemberRole(ctx, msg.Creator, msg.EntityId, msg.MemberAddress, msg.Role); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateMemberRoleResponse{}, nil
+}
+
+// FundEntity handles MsgFundEntity
+func (m msgServer) FundEntity(ctx context.Context, msg *types.MsgFundEntity) (*types.MsgFundEntityResponse, error) {
+	treasury, err := m.Keeper.FundEntity(ctx, msg.Creator, msg.EntityId, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundEntityResponse{
+		TreasuryAddress: treasury,
+	}, nil
+}
+
+// SetEntityFeeCap handles MsgSetEntityFeeCap
+func (m msgServer) SetEntityFeeCap(ctx context.Context, msg *types.MsgSetEntityFeeCap) (*types.MsgSetEntityFeeCapResponse, error) {
+	if err := m.Keeper.SetEntityFeeCap(ctx, msg.Creator, msg.EntityId, msg.MemberMonthlyCap); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetEntityFeeCapResponse{}, nil
+}
+
+// UpdateEntity handles MsgUpdateEntity
+func (m msgServer) UpdateEntity(ctx context.Context, msg *types.MsgUpdateEntity) (*types.MsgUpdateEntityResponse, error) {
+	if err := m.Keeper.UpdateEntity(ctx, msg.Creator, msg.EntityId, msg.Name, msg.EntityType, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEntityResponse{}, nil
+}
+
+// TransferEntityOwnership handles MsgTransferEntityOwnership
+func (m msgServer) TransferEntityOwnership(ctx context.Context, msg *types.MsgTransferEntityOwnership) (*types.MsgTransferEntityOwnershipResponse, error) {
+	if err := m.Keeper.TransferEntityOwnership(ctx, msg.Creator, msg.EntityId, msg.NewOwner); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferEntityOwnershipResponse{}, nil
+}
+
+// AcceptEntityOwnership handles MsgAcceptEntityOwnership
+func (m msgServer) AcceptEntityOwnership(ctx context.Context, msg *types.MsgAcceptEntityOwnership) (*types.MsgAcceptEntityOwnershipResponse, error) {
+	if err := m.Keeper.AcceptEntityOwnership(ctx, msg.Creator, msg.EntityId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptEntityOwnershipResponse{}, nil
+}
+
+// DeactivateEntity handles MsgDeactivateEntity
+func (m msgServer) DeactivateEntity(ctx context.Context, msg *types.MsgDeactivateEntity) (*types.MsgDeactivateEntityResponse, error) {
+	if err := m.Keeper.DeactivateEntity(ctx, msg.Creator, msg.EntityId, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeactivateEntityResponse{}, nil
+}
+
+// ReactivateEntity handles MsgReactivateEntity
+func (m msgServer) ReactivateEntity(ctx context.Context, msg *types.MsgReactivateEntity) (*types.MsgReactivateEntityResponse, error) {
+	if err := m.Keeper.ReactivateEntity(ctx, msg.Creator, msg.EntityId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReactivateEntityResponse{}, nil
+}
+
 // CreateSpecVersion handles MsgCreateSpecVersion
 func (m msgServer) CreateSpecVersion(ctx context.Context, msg *types.MsgCreateSpecVersion) (*types.MsgCreateSpecVersionResponse, error) {
 	versionID, err := m.Keeper.CreateSpecVersion(
@@ -131,6 +538,7 @@ func (m msgServer) CreateSpecVersion(ctx context.Context, msg *types.MsgCreateSp
 		msg.SpecIpfs,
 		msg.Changelog,
 		msg.ParentVersionId,
+		msg.EntityId,
 	)
 	if err != nil {
 		return nil, err
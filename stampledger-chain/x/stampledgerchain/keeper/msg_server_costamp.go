@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// ProposeCoStamp opens a pending co-sealed stamp that requires threshold of
+// the given registered PEs to sign the document
+func (k Keeper) ProposeCoStamp(
+	ctx context.Context,
+	creator string,
+	documentHash string,
+	jurisdictionId string,
+	projectName string,
+	documentIpfsHash string,
+	documentSize int64,
+	documentFilename string,
+	signers []types.CoSigner,
+	threshold uint32,
+	signatureExpiry int64,
+	nonce uint64,
+	validUntil int64,
+) (string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Validate document hash (SHA-256 = 64 hex chars) and descriptive fields
+	if hashBytes, err := hex.DecodeString(documentHash); err != nil || len(hashBytes) != 32 {
+		return "", types.ErrInvalidDocumentHash.Wrap("must be 64 hex characters")
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := params.CheckStampFields("", projectName, documentFilename, documentSize); err != nil {
+		return "", err
+	}
+
+	// 2. Validate the signer set and threshold
+	if len(signers) < 2 {
+		return "", types.ErrInvalidCoStamp.Wrap("a co-sealed stamp needs at least two signers")
+	}
+	if threshold == 0 || int(threshold) > len(signers) {
+		return "", types.ErrInvalidCoStamp.Wrapf("threshold %d out of range for %d signers", threshold, len(signers))
+	}
+	coSigners := make([]types.CoSigner, len(signers))
+	seen := make(map[string]bool, len(signers))
+	for i, signer := range signers {
+		if seen[signer.PePublicKey] {
+			return "", types.ErrInvalidCoStamp.Wrapf("duplicate signer %s", signer.PePublicKey)
+		}
+		seen[signer.PePublicKey] = true
+
+		if _, err := k.GetProfessionalEngineer(ctx, signer.PePublicKey); err != nil {
+			return "", err
+		}
+		if err := k.checkDuplicateStamp(ctx, documentHash, signer.PePublicKey); err != nil {
+			return "", err
+		}
+		coSigners[i] = types.CoSigner{
+			PePublicKey: signer.PePublicKey,
+			Discipline:  signer.Discipline,
+		}
+	}
+
+	// 3. Validate the time bounds
+	if validUntil != 0 && validUntil <= sdkCtx.BlockTime().Unix() {
+		return "", types.ErrInvalidValidUntil.Wrapf("valid_until %d is not after block time", validUntil)
+	}
+	if signatureExpiry != 0 && sdkCtx.BlockTime().Unix() > signatureExpiry {
+		return "", types.ErrSignatureExpired.Wrapf("expired at %d", signatureExpiry)
+	}
+
+	// 4. Generate unique stamp ID
+	stampID, err := k.nextID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// 5. Charge the stamp fee, then store and index the pending stamp
+	stamp := types.Stamp{
+		Id:               stampID,
+		DocumentHash:     documentHash,
+		JurisdictionId:   jurisdictionId,
+		CreatedAt:        sdkCtx.BlockTime().Unix(),
+		CreatedHeight:    sdkCtx.BlockHeight(),
+		Creator:          creator,
+		ProjectName:      projectName,
+		DocumentIpfsHash: documentIpfsHash,
+		DocumentSize:     documentSize,
+		DocumentFilename: documentFilename,
+		SignBytesVersion: types.StampSignBytesVersion,
+		SignatureExpiry:  signatureExpiry,
+		Nonce:            nonce,
+		ValidUntil:       validUntil,
+		CoSigners:        coSigners,
+		CoSignThreshold:  threshold,
+		Pending:          true,
+	}
+	if err := k.storeNewStamp(ctx, stamp); err != nil {
+		return "", err
+	}
+
+	// 6. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"co_stamp_proposed",
+			sdk.NewAttribute("stamp_id", stampID),
+			sdk.NewAttribute("document_hash", documentHash),
+			sdk.NewAttribute("signer_count", strconv.Itoa(len(coSigners))),
+			sdk.NewAttribute("threshold", strconv.FormatUint(uint64(threshold), 10)),
+			sdk.NewAttribute("creator", creator),
+		),
+	)
+
+	return stampID, nil
+}
+
+// AddCoSignature records one required signer's seal on a co-sealed stamp. The
+// stamp stops being pending once threshold signers have signed.
+func (k Keeper) AddCoSignature(
+	ctx context.Context,
+	creator string,
+	stampID string,
+	pePublicKey string,
+	signature string,
+) (uint32, bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Get the stamp, which must still be in force, and the signer's slot
+	stamp, err := k.GetStamp(ctx, stampID)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(stamp.CoSigners) == 0 {
+		return 0, false, types.ErrInvalidCoSignature.Wrapf("stamp %s is not co-sealed", stampID)
+	}
+	switch stamp.StatusAt(sdkCtx.BlockTime().Unix()) {
+	case types.StampRevoked:
+		return 0, false, types.ErrStampAlreadyRevoked.Wrapf("stamp ID: %s", stampID)
+	case types.StampSuperseded:
+		return 0, false, types.ErrStampAlreadySuperseded.Wrapf("stamp ID: %s", stampID)
+	case types.StampExpired:
+		return 0, false, types.ErrInvalidCoSignature.Wrapf("stamp %s has expired", stampID)
+	}
+	slot := -1
+	for i, signer := range stamp.CoSigners {
+		if signer.PePublicKey == pePublicKey {
+			slot = i
+			break
+		}
+	}
+	if slot < 0 {
+		return 0, false, types.ErrInvalidCoSignature.Wrapf("%s is not a required signer", pePublicKey)
+	}
+	if stamp.CoSigners[slot].Signature != "" {
+		return 0, false, types.ErrInvalidCoSignature.Wrapf("%s has already signed", pePublicKey)
+	}
+
+	// 2. Verify the key is registered to the creator, or delegated to it, and
+	// licensed in the jurisdiction
+	pe, err := k.GetProfessionalEngineer(ctx, pePublicKey)
+	if err != nil {
+		return 0, false, err
+	}
+	if _, err := k.checkStampAgainstRegistry(ctx, creator, pePublicKey, pe.Name, pe.LicenseNumber, stamp.JurisdictionId, stamp.ProjectName); err != nil {
+		return 0, false, err
+	}
+	if status := k.getLicenseStatus(ctx, stamp.JurisdictionId, pe.LicenseNumber, pe.Account); status != types.LicenseActive {
+		return 0, false, types.ErrLicenseNotActive.Wrapf("%s license %s is %s", stamp.JurisdictionId, pe.LicenseNumber, status)
+	}
+
+	// 3. Verify Ed25519 signature over the signer's sign bytes
+	if stamp.SignatureExpiry != 0 && sdkCtx.BlockTime().Unix() > stamp.SignatureExpiry {
+		return 0, false, types.ErrSignatureExpired.Wrapf("expired at %d", stamp.SignatureExpiry)
+	}
+	pubKeyBytes, _ := hex.DecodeString(pePublicKey)
+	sigBytes, err := hex.DecodeString(signature)
+	if err != nil || len(sigBytes) != ed25519.SignatureSize {
+		return 0, false, types.ErrInvalidSignature.Wrap("invalid hex encoding or length")
+	}
+	signer := stamp.CoSigners[slot]
+	signer.PeName = pe.Name
+	signer.PeLicenseNumber = pe.LicenseNumber
+	signer.Delegate = delegateOf(pe, creator)
+	if !ed25519.Verify(pubKeyBytes, coSignBytes(sdkCtx.ChainID(), stamp, signer), sigBytes) {
+		return 0, false, types.ErrInvalidSignature.Wrap("signature verification failed")
+	}
+
+	// 4. Record the signature and complete the stamp at the threshold
+	signer.Signature = signature
+	signer.SignedAt = sdkCtx.BlockTime().Unix()
+	stamp.CoSigners[slot] = signer
+	count := stamp.CoSignatureCount()
+	completed := stamp.Pending && count >= stamp.CoSignThreshold
+	if completed {
+		stamp.Pending = false
+	}
+	if err := k.Stamps.Set(ctx, stampID, stamp); err != nil {
+		return 0, false, err
+	}
+
+	// 5. Emit events
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"co_signature_added",
+			sdk.NewAttribute("stamp_id", stampID),
+			sdk.NewAttribute("pe_public_key", pePublicKey),
+			sdk.NewAttribute("discipline", signer.Discipline),
+			sdk.NewAttribute("signature_count", strconv.FormatUint(uint64(count), 10)),
+		),
+	)
+	if completed {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"co_stamp_completed",
+				sdk.NewAttribute("stamp_id", stampID),
+			),
+		)
+	}
+
+	return count, !stamp.Pending, nil
+}
+
+// coSignBytes returns the payload a co-signer signs: the CoStampSignDoc for
+// the stamp's proposal under the signer's own license number
+func coSignBytes(chainID string, stamp types.Stamp, signer types.CoSigner) []byte {
+	return types.CoStampSignBytes(
+		chainID,
+		stamp.Id,
+		stamp.JurisdictionId,
+		signer.PeLicenseNumber,
+		stamp.DocumentHash,
+		stamp.CoSigners,
+		stamp.CoSignThreshold,
+		stamp.SignatureExpiry,
+		stamp.Nonce,
+	)
+}
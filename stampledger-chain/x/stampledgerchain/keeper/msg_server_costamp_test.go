@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// coSigner is a PE with their own account and license taking part in a
+// co-sealed stamp
+type coSigner struct {
+	account    string
+	key        ed25519.PrivateKey
+	license    string
+	discipline string
+}
+
+func (s coSigner) publicKey() string {
+	return hex.EncodeToString(s.key.Public().(ed25519.PublicKey))
+}
+
+func (f *fixture) registerCoSigner(t *testing.T, name string, license string, discipline string) coSigner {
+	t.Helper()
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	s := coSigner{account: sample.AccAddress(), key: newPEKey(name), license: license, discipline: discipline}
+
+	signBytes := types.PERegistrationSignBytes(testChainID, s.account, license)
+	_, err := ms.RegisterPE(f.ctx, &types.MsgRegisterPE{
+		Creator:       s.account,
+		PublicKey:     s.publicKey(),
+		Name:          name,
+		LicenseNumber: license,
+		Jurisdictions: []string{"wisconsin"},
+		PopSignature:  hex.EncodeToString(ed25519.Sign(s.key, signBytes)),
+	})
+	require.NoError(t, err)
+	_, err = ms.AttestLicense(f.ctx, &types.MsgAttestLicense{Creator: testBoard, JurisdictionId: "wisconsin", LicenseNumber: license, PeAccount: s.account})
+	require.NoError(t, err)
+	return s
+}
+
+// coSignMsg returns the signer's MsgAddCoSignature for the proposal stored
+// as stampID.
+func coSignMsg(s coSigner, stampID string, proposal *types.MsgProposeCoStamp) *types.MsgAddCoSignature {
+	signBytes := types.CoStampSignBytes(
+		testChainID, stampID, proposal.JurisdictionId, s.license, proposal.DocumentHash,
+		proposal.CoSigners, proposal.Threshold, proposal.SignatureExpiry, proposal.Nonce,
+	)
+	return &types.MsgAddCoSignature{
+		Creator:     s.account,
+		StampId:     stampID,
+		PePublicKey: s.publicKey(),
+		Signature:   hex.EncodeToString(ed25519.Sign(s.key, signBytes)),
+	}
+}
+
+func TestCoStamp(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	proposer := sample.AccAddress()
+
+	structural := f.registerCoSigner(t, "Ada Structural", "PE-1001", "structural")
+	electrical := f.registerCoSigner(t, "Bo Electrical", "PE-1002", "electrical")
+	mechanical := f.registerCoSigner(t, "Cy Mechanical", "PE-1003", "mechanical")
+
+	docHash := sha256.Sum256([]byte("sheet-M201.pdf"))
+	proposal := &types.MsgProposeCoStamp{
+		Creator:        proposer,
+		DocumentHash:   hex.EncodeToString(docHash[:]),
+		JurisdictionId: "wisconsin",
+		Threshold:      2,
+		Nonce:          1,
+	}
+	for _, s := range []coSigner{structural, electrical, mechanical} {
+		proposal.CoSigners = append(proposal.CoSigners, types.CoSigner{PePublicKey: s.publicKey(), Discipline: s.discipline})
+	}
+
+	t.Run("threshold out of range", func(t *testing.T) {
+		bad := *proposal
+		bad.Threshold = 4
+		_, err := ms.ProposeCoStamp(f.ctx, &bad)
+		require.ErrorIs(t, err, types.ErrInvalidCoStamp)
+	})
+
+	res, err := ms.ProposeCoStamp(f.ctx, proposal)
+	require.NoError(t, err)
+
+	cosign := func(s coSigner) (*types.MsgAddCoSignatureResponse, error) {
+		return ms.AddCoSignature(f.ctx, coSignMsg(s, res.StampId, proposal))
+	}
+
+	verify := func() types.StampVerification {
+		res, err := qs.VerifyStamp(f.ctx, &types.QueryVerifyStampRequest{Id: res.StampId})
+		require.NoError(t, err)
+		return res.Verification
+	}
+
+	// Pending until the threshold is met
+	signed, err := cosign(structural)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), signed.SignatureCount)
+	require.False(t, signed.Complete)
+
+	v := verify()
+	require.False(t, v.Valid)
+	require.Equal(t, types.StampPending, v.Status)
+	require.Len(t, v.CoSigners, 3)
+	require.True(t, v.CoSigners[0].Signed)
+	require.Equal(t, "structural", v.CoSigners[0].Discipline)
+	require.False(t, v.CoSigners[1].Signed)
+
+	// A solo-stamp signature over the same document is not a co-signature
+	solo := coSignMsg(electrical, res.StampId, proposal)
+	solo.Signature = hex.EncodeToString(ed25519.Sign(electrical.key, types.StampSignBytes(testChainID, "wisconsin", electrical.license, proposal.DocumentHash, 0, 1)))
+	_, err = ms.AddCoSignature(f.ctx, solo)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	// Signers cannot sign twice, and only with their own account
+	_, err = cosign(structural)
+	require.ErrorIs(t, err, types.ErrInvalidCoSignature)
+	impostor := electrical
+	impostor.account = sample.AccAddress()
+	_, err = cosign(impostor)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	signed, err = cosign(electrical)
+	require.NoError(t, err)
+	require.True(t, signed.Complete)
+
+	v = verify()
+	require.True(t, v.Valid, v.Reason)
+	require.Equal(t, types.StampValid, v.Status)
+	for i, signer := range v.CoSigners[:2] {
+		require.True(t, signer.SignatureValid, "signer %d", i)
+		require.Equal(t, types.LicenseActive, signer.LicenseStatusAtSigning)
+		require.Equal(t, types.LicenseActive, signer.LicenseStatusNow)
+	}
+	require.Equal(t, types.LicenseActive, v.LicenseStatusAtStamp)
+	require.Equal(t, types.LicenseActive, v.LicenseStatusNow)
+	require.Equal(t, "electrical", v.CoSigners[1].Discipline)
+
+	// The remaining signer may still seal the completed stamp
+	_, err = cosign(mechanical)
+	require.NoError(t, err)
+
+	// The co-stamp is listed under each signer's key
+	byPE, err := qs.StampsByPE(f.ctx, &types.QueryStampsByPERequest{PePublicKey: mechanical.publicKey()})
+	require.NoError(t, err)
+	require.Len(t, byPE.Stamps, 1)
+
+	// And blocks the same signers from stamping the document again
+	_, err = ms.ProposeCoStamp(f.ctx, proposal)
+	require.ErrorIs(t, err, types.ErrDuplicateStamp)
+}
+
+func TestCoSignatureBinding(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	proposer := sample.AccAddress()
+
+	structural := f.registerCoSigner(t, "Ada Structural", "PE-1001", "structural")
+	electrical := f.registerCoSigner(t, "Bo Electrical", "PE-1002", "electrical")
+	mechanical := f.registerCoSigner(t, "Cy Mechanical", "PE-1003", "mechanical")
+
+	propose := func(content string, signers ...coSigner) (*types.MsgProposeCoStamp, string) {
+		docHash := sha256.Sum256([]byte(content))
+		proposal := &types.MsgProposeCoStamp{
+			Creator:        proposer,
+			DocumentHash:   hex.EncodeToString(docHash[:]),
+			JurisdictionId: "wisconsin",
+			Threshold:      2,
+			Nonce:          1,
+		}
+		for _, s := range signers {
+			proposal.CoSigners = append(proposal.CoSigners, types.CoSigner{PePublicKey: s.publicKey(), Discipline: s.discipline})
+		}
+		res, err := ms.ProposeCoStamp(f.ctx, proposal)
+		require.NoError(t, err)
+		return proposal, res.StampId
+	}
+
+	// A co-signature cannot be replayed into another proposal over the same
+	// document, hash, expiry and nonce
+	first, firstID := propose("sheet-M201.pdf", structural, electrical)
+	signed := coSignMsg(structural, firstID, first)
+	_, err := ms.AddCoSignature(f.ctx, signed)
+	require.NoError(t, err)
+	_, err = ms.RevokeStamp(f.ctx, &types.MsgRevokeStamp{Creator: proposer, StampId: firstID, ReasonCode: types.RevocationErrorInDesign})
+	require.NoError(t, err)
+	_, secondID := propose("sheet-M201.pdf", structural, mechanical)
+	replayed := *signed
+	replayed.StampId = secondID
+	_, err = ms.AddCoSignature(f.ctx, &replayed)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	// Revoked, superseded and expired proposals take no more co-signatures
+	_, err = ms.AddCoSignature(f.ctx, coSignMsg(electrical, firstID, first))
+	require.ErrorIs(t, err, types.ErrStampAlreadyRevoked)
+
+	superseded, supersededID := propose("sheet-M202.pdf", structural, electrical)
+	stamp, err := f.keeper.GetStamp(f.ctx, supersededID)
+	require.NoError(t, err)
+	stamp.SupersededBy = secondID
+	require.NoError(t, f.keeper.Stamps.Set(f.ctx, supersededID, stamp))
+	_, err = ms.AddCoSignature(f.ctx, coSignMsg(structural, supersededID, superseded))
+	require.ErrorIs(t, err, types.ErrStampAlreadySuperseded)
+
+	expired, expiredID := propose("sheet-M203.pdf", structural, electrical)
+	stamp, err = f.keeper.GetStamp(f.ctx, expiredID)
+	require.NoError(t, err)
+	stamp.Expired = true
+	require.NoError(t, f.keeper.Stamps.Set(f.ctx, expiredID, stamp))
+	_, err = ms.AddCoSignature(f.ctx, coSignMsg(structural, expiredID, expired))
+	require.ErrorIs(t, err, types.ErrInvalidCoSignature)
+
+	// A co-stamp reports the weakest signer's license
+	third, thirdID := propose("sheet-M204.pdf", structural, electrical)
+	for _, s := range []coSigner{structural, electrical} {
+		_, err = ms.AddCoSignature(f.ctx, coSignMsg(s, thirdID, third))
+		require.NoError(t, err)
+	}
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err = ms.SuspendLicense(later, &types.MsgSuspendLicense{Creator: testBoard, JurisdictionId: "wisconsin", LicenseNumber: electrical.license})
+	require.NoError(t, err)
+	res, err := qs.VerifyStamp(later, &types.QueryVerifyStampRequest{Id: thirdID})
+	require.NoError(t, err)
+	require.Equal(t, types.LicenseActive, res.Verification.LicenseStatusAtStamp)
+	require.Equal(t, types.LicenseSuspended, res.Verification.LicenseStatusNow)
+	require.Equal(t, types.LicenseSuspended, res.Verification.CoSigners[1].LicenseStatusNow)
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// GrantStampingDelegation lets grantee submit stamps made with any PE key
+// registered to creator, optionally limited to jurisdictions, projects and an
+// expiration time. An existing grant to the same grantee is replaced.
+func (k Keeper) GrantStampingDelegation(
+	ctx context.Context,
+	creator string,
+	grantee string,
+	jurisdictionIds []string,
+	projectNames []string,
+	expiration int64,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Validate grantee, expiration and project names
+	if _, err := k.addressCodec.StringToBytes(grantee); err != nil {
+		return types.ErrInvalidDelegation.Wrapf("invalid grantee address: %s", err)
+	}
+	if grantee == creator {
+		return types.ErrInvalidDelegation.Wrap("cannot delegate to self")
+	}
+	if expiration != 0 && expiration <= sdkCtx.BlockTime().Unix() {
+		return types.ErrInvalidDelegation.Wrapf("expiration %d is not after block time", expiration)
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	for _, projectName := range projectNames {
+		if err := types.CheckLength("project_name", projectName, params.MaxProjectNameLength); err != nil {
+			return err
+		}
+	}
+
+	// 2. Store the delegation
+	delegation := types.StampingDelegation{
+		Granter:         creator,
+		Grantee:         grantee,
+		JurisdictionIds: jurisdictionIds,
+		ProjectNames:    projectNames,
+		Expiration:      expiration,
+		CreatedAt:       sdkCtx.BlockTime().Unix(),
+	}
+	if err := k.StampingDelegations.Set(ctx, collections.Join(creator, grantee), delegation); err != nil {
+		return err
+	}
+
+	// 3. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"stamping_delegation_granted",
+			sdk.NewAttribute("granter", creator),
+			sdk.NewAttribute("grantee", grantee),
+			sdk.NewAttribute("jurisdictions", strings.Join(jurisdictionIds, ",")),
+			sdk.NewAttribute("projects", strings.Join(projectNames, ",")),
+			sdk.NewAttribute("expiration", strconv.FormatInt(expiration, 10)),
+		),
+	)
+
+	return nil
+}
+
+// RevokeStampingDelegation removes creator's delegation to grantee
+func (k Keeper) RevokeStampingDelegation(ctx context.Context, creator string, grantee string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Verify the delegation exists
+	key := collections.Join(creator, grantee)
+	has, err := k.StampingDelegations.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return types.ErrDelegationNotFound.Wrapf("granter %s, grantee %s", creator, grantee)
+	}
+
+	// 2. Remove it
+	if err := k.StampingDelegations.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	// 3. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"stamping_delegation_revoked",
+			sdk.NewAttribute("granter", creator),
+			sdk.NewAttribute("grantee", grantee),
+		),
+	)
+
+	return nil
+}
+
+// GetStampingDelegations returns a page of the delegations granted by a PE account
+func (k Keeper) GetStampingDelegations(ctx context.Context, granter string, pagination *query.PageRequest) ([]types.StampingDelegation, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.StampingDelegations, pagination,
+		func(_ collections.Pair[string, string], delegation types.StampingDelegation) (types.StampingDelegation, error) {
+			return delegation, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](granter),
+	)
+}
+
+// checkStampingDelegation verifies that granter has delegated stamping in the
+// jurisdiction and project to grantee, and that the grant has not expired
+func (k Keeper) checkStampingDelegation(
+	ctx context.Context,
+	granter string,
+	grantee string,
+	jurisdictionId string,
+	projectName string,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	delegation, err := k.StampingDelegations.Get(ctx, collections.Join(granter, grantee))
+	if err != nil {
+		return types.ErrUnauthorized.Wrap("PE key is registered to a different account")
+	}
+	if delegation.Expiration != 0 && sdkCtx.BlockTime().Unix() > delegation.Expiration {
+		return types.ErrUnauthorized.Wrapf("stamping delegation expired at %d", delegation.Expiration)
+	}
+	if len(delegation.JurisdictionIds) > 0 && !slices.Contains(delegation.JurisdictionIds, jurisdictionId) {
+		return types.ErrUnauthorized.Wrapf("stamping delegation does not cover jurisdiction %s", jurisdictionId)
+	}
+	if len(delegation.ProjectNames) > 0 && !slices.Contains(delegation.ProjectNames, projectName) {
+		return types.ErrUnauthorized.Wrapf("stamping delegation does not cover project %q", projectName)
+	}
+	return nil
+}
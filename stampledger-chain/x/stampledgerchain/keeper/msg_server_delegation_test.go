@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+func TestStampingDelegation(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	engineer, drafter := sample.AccAddress(), sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, ctx, engineer, pe)
+
+	stampAs := func(ctx sdk.Context, creator, content, project string) (*types.MsgCreateStampResponse, error) {
+		msg := newCreateStampMsg(creator, pe, content)
+		msg.ProjectName = project
+		return ms.CreateStamp(ctx, msg)
+	}
+
+	// Without a delegation the drafter cannot submit the PE's stamps
+	_, err := stampAs(ctx, drafter, "sheet-A", "bridge")
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = ms.GrantStampingDelegation(ctx, &types.MsgGrantStampingDelegation{
+		Creator:         engineer,
+		Grantee:         drafter,
+		JurisdictionIds: []string{"wisconsin"},
+		ProjectNames:    []string{"bridge"},
+		Expiration:      2000,
+	})
+	require.NoError(t, err)
+
+	delegations, err := qs.StampingDelegations(ctx, &types.QueryStampingDelegationsRequest{Granter: engineer})
+	require.NoError(t, err)
+	require.Len(t, delegations.Delegations, 1)
+	require.Equal(t, drafter, delegations.Delegations[0].Grantee)
+
+	// In scope: the stamp records both the PE and the delegate
+	res, err := stampAs(ctx, drafter, "sheet-A", "bridge")
+	require.NoError(t, err)
+	stamp, err := f.keeper.GetStamp(ctx, res.StampId)
+	require.NoError(t, err)
+	require.Equal(t, drafter, stamp.Creator)
+	require.Equal(t, engineer, stamp.PeAccount)
+	require.Equal(t, drafter, stamp.Delegate)
+
+	// The PE can manage stamps submitted on their behalf
+	_, err = ms.StoreDocument(ctx, &types.MsgStoreDocument{
+		Creator:  engineer,
+		StampId:  res.StampId,
+		IpfsHash: "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+		Filename: "sheet-A.pdf",
+	})
+	require.NoError(t, err)
+	_, err = ms.RevokeStamp(ctx, &types.MsgRevokeStamp{Creator: engineer, StampId: res.StampId, ReasonCode: types.RevocationErrorInDesign})
+	require.NoError(t, err)
+
+	// Out of scope project
+	_, err = stampAs(ctx, drafter, "sheet-B", "tower")
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// Expired
+	_, err = stampAs(ctx.WithBlockTime(time.Unix(2001, 0)), drafter, "sheet-C", "bridge")
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// Revoked
+	_, err = ms.RevokeStampingDelegation(ctx, &types.MsgRevokeStampingDelegation{Creator: engineer, Grantee: drafter})
+	require.NoError(t, err)
+	_, err = stampAs(ctx, drafter, "sheet-D", "bridge")
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.RevokeStampingDelegation(ctx, &types.MsgRevokeStampingDelegation{Creator: engineer, Grantee: drafter})
+	require.ErrorIs(t, err, types.ErrDelegationNotFound)
+}
@@ -2,13 +2,14 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"strconv"
 	"strings"
-	"time"
 
 	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/google/uuid"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"stampledger-chain/x/stampledgerchain/types"
 )
@@ -23,6 +24,9 @@ func (k Keeper) StoreDocument(
 	size int64,
 	mimeType string,
 	pinForever bool,
+	contentSHA256 string,
+	role types.DocumentRole,
+	entityID string,
 ) (string, string, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -32,45 +36,120 @@ func (k Keeper) StoreDocument(
 		return "", "", types.ErrStampNotFound.Wrapf("stamp ID: %s", stampID)
 	}
 
-	// 2. Verify creator is the PE who created the stamp
-	if stamp.Creator != creator {
-		return "", "", types.ErrUnauthorized.Wrap("only stamp creator can store documents")
+	// 2. Verify creator is the submitter of the stamp or the PE it was made
+	// for, and that its role in the entity, if storing under one, permits it
+	if !canManageDocuments(stamp, creator) {
+		return "", "", types.ErrUnauthorized.Wrap("only the stamp creator or its PE can store documents")
+	}
+	if entityID != "" {
+		if err := k.requireEntityPermission(ctx, entityID, creator, types.EntityActionStoreDocument); err != nil {
+			return "", "", err
+		}
 	}
 
-	// 3. Validate IPFS hash (CIDv0 starts with "Qm", CIDv1 starts with "bafy")
-	if !strings.HasPrefix(ipfsHash, "Qm") && !strings.HasPrefix(ipfsHash, "bafy") {
-		return "", "", types.ErrInvalidIpfsHash.Wrap("must start with 'Qm' or 'bafy'")
+	// 3. Validate the IPFS CID and file metadata against the governance-set
+	// limits, and normalize the CID to CIDv1
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", "", err
 	}
-	if len(ipfsHash) < 46 {
-		return "", "", types.ErrInvalidIpfsHash.Wrap("hash too short")
+	if err := params.CheckIpfsCID(ipfsHash); err != nil {
+		return "", "", err
+	}
+	cid, err := types.ParseCID(ipfsHash)
+	if err != nil {
+		return "", "", err
+	}
+	ipfsHash = cid.V1().String()
+	if err := types.CheckLength("filename", filename, params.MaxFilenameLength); err != nil {
+		return "", "", err
+	}
+	if err := params.CheckDocumentSize(size); err != nil {
+		return "", "", err
+	}
+	if err := params.CheckMimeType(mimeType); err != nil {
+		return "", "", err
+	}
+
+	// 4. Establish the content hash, which a raw SHA-256 CID carries, and
+	// check a sealed original against the stamp. A Merkle stamp covers many
+	// documents, so none of them is its sealed original.
+	contentHash := strings.ToLower(contentSHA256)
+	if contentHash != "" {
+		if hashBytes, err := hex.DecodeString(contentHash); err != nil || len(hashBytes) != 32 {
+			return "", "", types.ErrInvalidDocumentHash.Wrap("content hash must be 64 hex characters")
+		}
+	}
+	if cid.IsRawSHA256() {
+		digest := hex.EncodeToString(cid.Digest)
+		if contentHash != "" && contentHash != digest {
+			return "", "", types.ErrContentHashMismatch.Wrapf("CID digest %s, content hash %s", digest, contentHash)
+		}
+		contentHash = digest
+	}
+	if role == types.DocumentRoleUnspecified {
+		role = types.DocumentAttachment
+	}
+	if role == types.DocumentSealedOriginal {
+		if stamp.MerkleLeafCount > 0 {
+			return "", "", types.ErrInvalidDocumentRole.Wrap("a Merkle stamp has no single sealed original")
+		}
+		if contentHash == "" {
+			return "", "", types.ErrDocumentHashMismatch.Wrap("a sealed original needs a content hash or a raw SHA-256 CID")
+		}
+		if !strings.EqualFold(contentHash, stamp.DocumentHash) {
+			if cid.IsRawSHA256() {
+				return "", "", types.ErrCIDDigestMismatch.Wrapf("CID digest %s, stamp %s", contentHash, stamp.DocumentHash)
+			}
+			return "", "", types.ErrDocumentHashMismatch.Wrapf("content hash %s, stamp %s", contentHash, stamp.DocumentHash)
+		}
 	}
 
-	// 4. Create document record
-	docID := uuid.New().String()
+	// 5. Create document record, pinned for the pin duration or forever
+	docID, err := k.nextID(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	var pinExpiresHeight int64
+	if !pinForever {
+		pinExpiresHeight = sdkCtx.BlockHeight() + params.PinDuration
+	}
 	doc := types.DocumentStorage{
-		Id:         docID,
-		StampId:    stampID,
-		IpfsHash:   ipfsHash,
-		Filename:   filename,
-		Size_:      size,
-		MimeType:   mimeType,
-		UploadedAt: time.Now().Unix(),
-		UploadedBy: creator,
-		Pinned:     pinForever,
-	}
-
-	// 5. Store document
+		Id:               docID,
+		StampId:          stampID,
+		IpfsHash:         ipfsHash,
+		Filename:         filename,
+		Size_:            size,
+		MimeType:         mimeType,
+		UploadedAt:       sdkCtx.BlockTime().Unix(),
+		UploadedBy:       creator,
+		Pinned:           true,
+		ContentSha256:    contentHash,
+		Role:             role,
+		PinExpiresHeight: pinExpiresHeight,
+		EntityId:         entityID,
+	}
+
+	// 6. Charge the storage fee for the document's size bucket
+	if err := k.chargeModuleFee(ctx, creator, params.DocumentFee(size), "document storage"); err != nil {
+		return "", "", err
+	}
+
+	// 7. Store document
 	if err := k.Documents.Set(ctx, docID, doc); err != nil {
 		return "", "", err
 	}
 
-	// 6. Index by stamp ID
+	// 8. Index by stamp ID and schedule the pin's expiry
 	docStampKey := collections.Join(stampID, docID)
 	if err := k.DocumentsByStamp.Set(ctx, docStampKey, []byte{}); err != nil {
 		return "", "", err
 	}
+	if err := k.schedulePin(ctx, doc, params); err != nil {
+		return "", "", err
+	}
 
-	// 7. Emit event
+	// 9. Emit event
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"document_stored",
@@ -78,6 +157,8 @@ func (k Keeper) StoreDocument(
 			sdk.NewAttribute("stamp_id", stampID),
 			sdk.NewAttribute("ipfs_hash", ipfsHash),
 			sdk.NewAttribute("filename", filename),
+			sdk.NewAttribute("role", role.String()),
+			sdk.NewAttribute("pin_expires_height", strconv.FormatInt(pinExpiresHeight, 10)),
 		),
 	)
 
@@ -85,6 +166,12 @@ func (k Keeper) StoreDocument(
 	return docID, ipfsURL, nil
 }
 
+// canManageDocuments reports whether addr may store and unpin documents for
+// a stamp: its submitter or the PE it was made for
+func canManageDocuments(stamp types.Stamp, addr string) bool {
+	return stamp.Creator == addr || (stamp.PeAccount != "" && stamp.PeAccount == addr)
+}
+
 // GetDocument retrieves a document by ID
 func (k Keeper) GetDocument(ctx context.Context, docID string) (types.DocumentStorage, error) {
 	doc, err := k.Documents.Get(ctx, docID)
@@ -94,30 +181,29 @@ func (k Keeper) GetDocument(ctx context.Context, docID string) (types.DocumentSt
 	return doc, nil
 }
 
-// GetDocumentsByStamp returns all documents associated with a stamp
-func (k Keeper) GetDocumentsByStamp(ctx context.Context, stampID string) ([]types.DocumentStorage, error) {
-	var documents []types.DocumentStorage
-
-	rng := collections.NewPrefixedPairRange[string, string](stampID)
-	iter, err := k.DocumentsByStamp.Iterate(ctx, rng)
-	if err != nil {
-		return nil, err
-	}
-	defer iter.Close()
-
-	for ; iter.Valid(); iter.Next() {
-		key, err := iter.Key()
-		if err != nil {
-			return nil, err
-		}
-		docID := key.K2()
-
-		doc, err := k.Documents.Get(ctx, docID)
-		if err != nil {
-			continue
-		}
-		documents = append(documents, doc)
-	}
-
-	return documents, nil
+// GetDocumentsByStamp returns a page of documents associated with a stamp,
+// limited to one role unless role is unspecified
+func (k Keeper) GetDocumentsByStamp(
+	ctx context.Context,
+	stampID string,
+	role types.DocumentRole,
+	pagination *query.PageRequest,
+) ([]types.DocumentStorage, *query.PageResponse, error) {
+	return query.CollectionFilteredPaginate(
+		ctx, k.DocumentsByStamp, pagination,
+		func(key collections.Pair[string, string], _ []byte) (bool, error) {
+			if role == types.DocumentRoleUnspecified {
+				return true, nil
+			}
+			doc, err := k.GetDocument(ctx, key.K2())
+			if err != nil {
+				return false, err
+			}
+			return doc.Role == role, nil
+		},
+		func(key collections.Pair[string, string], _ []byte) (types.DocumentStorage, error) {
+			return k.GetDocument(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](stampID),
+	)
 }
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// sha256Hex returns the hex SHA-256 of content.
+func sha256Hex(content string) string {
+	digest := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(digest[:])
+}
+
+// rawCID returns the CIDv1 of content stored as a single raw block.
+func rawCID(content string) string {
+	digest := sha256.Sum256([]byte(content))
+	return types.CID{Version: 1, Codec: types.CodecRaw, HashCode: types.MultihashSHA256, Digest: digest[:]}.String()
+}
+
+func TestStoreDocumentCID(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+	stampRes, err := ms.CreateStamp(f.ctx, newCreateStampMsg(creator, pe, "sheet-A"))
+	require.NoError(t, err)
+
+	store := func(ipfsHash string) (*types.MsgStoreDocumentResponse, error) {
+		return ms.StoreDocument(f.ctx, &types.MsgStoreDocument{Creator: creator, StampId: stampRes.StampId, IpfsHash: ipfsHash})
+	}
+
+	// CIDv0 is stored as CIDv1
+	res, err := store("QmbWqxBEKC3P8tqsKc98xmWNzrzDtRLMiMPL8wBuTGsMnR")
+	require.NoError(t, err)
+	require.Equal(t, "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", res.IpfsUrl)
+	doc, err := f.keeper.GetDocument(f.ctx, res.DocumentId)
+	require.NoError(t, err)
+	require.Equal(t, "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", doc.IpfsHash)
+
+	// A raw SHA-256 CID carries its content hash
+	res, err = store(rawCID("sheet-B"))
+	require.NoError(t, err)
+	doc, err = f.keeper.GetDocument(f.ctx, res.DocumentId)
+	require.NoError(t, err)
+	require.Equal(t, sha256Hex("sheet-B"), doc.ContentSha256)
+	require.Equal(t, types.DocumentAttachment, doc.Role)
+
+	// Malformed CIDs are rejected with the specific failure
+	_, err = store("QmbWqxBEKC3P8tqsKc98xmWNzrzDtRLMiMPL8wBuTGsMn0")
+	require.ErrorIs(t, err, types.ErrInvalidCIDEncoding)
+	_, err = store("bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvy")
+	require.ErrorIs(t, err, types.ErrInvalidMultihash)
+
+	// A base58btc CIDv1 is stored as base32
+	res, err = store("zb2rhe5P4gXftAwvA4eXQ5HJwsER2owDyS9sKaQRRVQPn93bA")
+	require.NoError(t, err)
+	doc, err = f.keeper.GetDocument(f.ctx, res.DocumentId)
+	require.NoError(t, err)
+	require.Equal(t, "bafkreidon73zkcrwdb5iafqtijxildoonbwnpv7dyd6ef3qdgads2jc4su", doc.IpfsHash)
+
+	// Prefixes outside the governance allow list are rejected before parsing
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.IpfsCidPrefixes = []string{"Qm", "bafy", "bafk"}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	_, err = store("zb2rhe5P4gXftAwvA4eXQ5HJwsER2owDyS9sKaQRRVQPn93bA")
+	require.ErrorIs(t, err, types.ErrInvalidIpfsHash)
+}
+
+func TestStoreDocumentRoles(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+	stampRes, err := ms.CreateStamp(f.ctx, newCreateStampMsg(creator, pe, "sheet-A"))
+	require.NoError(t, err)
+
+	const dagCID = "QmbWqxBEKC3P8tqsKc98xmWNzrzDtRLMiMPL8wBuTGsMnR"
+	store := func(ipfsHash, contentSHA256 string, role types.DocumentRole) (*types.MsgStoreDocumentResponse, error) {
+		return ms.StoreDocument(f.ctx, &types.MsgStoreDocument{
+			Creator:       creator,
+			StampId:       stampRes.StampId,
+			IpfsHash:      ipfsHash,
+			ContentSha256: contentSHA256,
+			Role:          role,
+		})
+	}
+
+	// A sealed original must hash to the stamp's document hash
+	_, err = store(dagCID, "", types.DocumentSealedOriginal)
+	require.ErrorIs(t, err, types.ErrDocumentHashMismatch)
+	_, err = store(dagCID, sha256Hex("sheet-B"), types.DocumentSealedOriginal)
+	require.ErrorIs(t, err, types.ErrDocumentHashMismatch)
+	_, err = store(rawCID("sheet-B"), "", types.DocumentSealedOriginal)
+	require.ErrorIs(t, err, types.ErrCIDDigestMismatch)
+	sealed, err := store(dagCID, sha256Hex("sheet-A"), types.DocumentSealedOriginal)
+	require.NoError(t, err)
+	sealedRaw, err := store(rawCID("sheet-A"), "", types.DocumentSealedOriginal)
+	require.NoError(t, err)
+
+	// A raw CID must agree with the declared content hash
+	_, err = store(rawCID("calcs"), sha256Hex("other"), types.DocumentCalcPackage)
+	require.ErrorIs(t, err, types.ErrContentHashMismatch)
+	calcs, err := store(rawCID("calcs"), sha256Hex("calcs"), types.DocumentCalcPackage)
+	require.NoError(t, err)
+	redline, err := store(dagCID, "", types.DocumentRedline)
+	require.NoError(t, err)
+	attachment, err := store(dagCID, "", types.DocumentRoleUnspecified)
+	require.NoError(t, err)
+
+	// Documents can be listed by role
+	ids := func(role types.DocumentRole) []string {
+		res, err := qs.DocumentsByStamp(f.ctx, &types.QueryDocumentsByStampRequest{StampId: stampRes.StampId, Role: role})
+		require.NoError(t, err)
+		var ids []string
+		for _, doc := range res.Documents {
+			ids = append(ids, doc.Id)
+		}
+		return ids
+	}
+	require.ElementsMatch(t, []string{sealed.DocumentId, sealedRaw.DocumentId}, ids(types.DocumentSealedOriginal))
+	require.ElementsMatch(t, []string{calcs.DocumentId}, ids(types.DocumentCalcPackage))
+	require.ElementsMatch(t, []string{redline.DocumentId}, ids(types.DocumentRedline))
+	require.ElementsMatch(t, []string{attachment.DocumentId}, ids(types.DocumentAttachment))
+	require.Len(t, ids(types.DocumentRoleUnspecified), 5)
+}
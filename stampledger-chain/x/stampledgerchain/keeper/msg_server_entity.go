@@ -2,11 +2,12 @@ package keeper
 
 import (
 	"context"
-	"time"
+	"errors"
 
 	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/google/uuid"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"stampledger-chain/x/stampledgerchain/types"
 )
@@ -20,39 +21,64 @@ func (k Keeper) CreateEntity(
 ) (string, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	// 1. Validate entity type
-	if !types.ValidEntityTypes[entityType] {
-		return "", types.ErrInvalidEntityType.Wrapf("got '%s'", entityType)
+	// 1. Validate the name and entity type against the governance-set limits
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := types.CheckLength("name", name, params.MaxNameLength); err != nil {
+		return "", err
+	}
+	if err := params.CheckEntityType(entityType); err != nil {
+		return "", err
 	}
 
 	// 2. Generate entity ID
-	entityID := uuid.New().String()
+	entityID, err := k.nextID(ctx)
+	if err != nil {
+		return "", err
+	}
 
-	// 3. Create entity
+	// 3. Derive the entity's treasury account
+	treasury, err := k.addressCodec.BytesToString(types.EntityTreasuryAddress(entityID))
+	if err != nil {
+		return "", err
+	}
+
+	// 4. Create entity
 	entity := types.EntityAccount{
 		Id:              entityID,
 		Name:            name,
 		EntityType:      entityType,
 		OwnerAddress:    creator,
-		MemberAddresses: []string{creator},
-		AdminAddresses:  []string{creator},
-		CreatedAt:       time.Now().Unix(),
+		CreatedAt:       sdkCtx.BlockTime().Unix(),
 		Active:          true,
-		Permissions:     map[string]string{creator: "admin"},
+		TreasuryAddress: treasury,
 	}
 
-	// 4. Store entity
+	// 5. Charge the entity creation fee
+	if err := k.chargeModuleFee(ctx, creator, params.EntityCreationFee, "entity creation"); err != nil {
+		return "", err
+	}
+
+	// 6. Store entity, with the owner as its first admin
 	if err := k.Entities.Set(ctx, entityID, entity); err != nil {
 		return "", err
 	}
+	if err := k.setEntityMember(ctx, newEntityMember(ctx, entityID, creator, "admin")); err != nil {
+		return "", err
+	}
 
-	// 5. Index by owner
+	// 7. Index by owner and treasury
 	ownerEntityKey := collections.Join(creator, entityID)
 	if err := k.EntitiesByOwner.Set(ctx, ownerEntityKey, []byte{}); err != nil {
 		return "", err
 	}
+	if err := k.EntitiesByTreasury.Set(ctx, treasury, entityID); err != nil {
+		return "", err
+	}
 
-	// 6. Emit event
+	// 8. Emit event
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"entity_created",
@@ -60,14 +86,112 @@ func (k Keeper) CreateEntity(
 			sdk.NewAttribute("name", name),
 			sdk.NewAttribute("type", entityType),
 			sdk.NewAttribute("owner", creator),
+			sdk.NewAttribute("treasury_address", treasury),
 		),
 	)
 
 	return entityID, nil
 }
 
-// AddEntityMember adds a member to an entity
-func (k Keeper) AddEntityMember(
+// RemoveEntityMember removes a member from an entity
+func (k Keeper) RemoveEntityMember(
+	ctx context.Context,
+	creator string,
+	entityID string,
+	memberAddress string,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Get entity
+	entity, err := k.GetEntity(ctx, entityID)
+	if err != nil {
+		return err
+	}
+
+	// 2. Verify creator is admin
+	if err := k.requireEntityAdmin(ctx, entityID, creator, "remove members"); err != nil {
+		return err
+	}
+
+	// 3. Cannot remove owner
+	if memberAddress == entity.OwnerAddress {
+		return types.ErrUnauthorized.Wrap("cannot remove the entity owner")
+	}
+
+	// 4. Find member, and keep at least one admin
+	member, err := k.GetEntityMember(ctx, entityID, memberAddress)
+	if err != nil {
+		return err
+	}
+	if member.Role == "admin" {
+		if err := k.requireOtherAdmin(ctx, entityID); err != nil {
+			return err
+		}
+	}
+
+	// 5. Remove member
+	if err := k.removeEntityMember(ctx, entityID, memberAddress); err != nil {
+		return err
+	}
+
+	// 6. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"entity_member_removed",
+			sdk.NewAttribute("entity_id", entityID),
+			sdk.NewAttribute("member_address", memberAddress),
+			sdk.NewAttribute("removed_by", creator),
+		),
+	)
+
+	return nil
+}
+
+// LeaveEntity removes the creator from an entity. The owner cannot leave
+// without first transferring ownership, and the last admin cannot leave.
+func (k Keeper) LeaveEntity(ctx context.Context, creator string, entityID string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Get entity; the owner must stay
+	entity, err := k.GetEntity(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	if creator == entity.OwnerAddress {
+		return types.ErrUnauthorized.Wrap("the entity owner cannot leave; transfer ownership first")
+	}
+
+	// 2. Find member, and keep at least one admin
+	member, err := k.GetEntityMember(ctx, entityID, creator)
+	if err != nil {
+		return err
+	}
+	if member.Role == "admin" {
+		if err := k.requireOtherAdmin(ctx, entityID); err != nil {
+			return err
+		}
+	}
+
+	// 3. Remove member
+	if err := k.removeEntityMember(ctx, entityID, creator); err != nil {
+		return err
+	}
+
+	// 4. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"entity_member_left",
+			sdk.NewAttribute("entity_id", entityID),
+			sdk.NewAttribute("member_address", creator),
+			sdk.NewAttribute("role", member.Role),
+		),
+	)
+
+	return nil
+}
+
+// UpdateMemberRole changes the role of an existing entity member
+func (k Keeper) UpdateMemberRole(
 	ctx context.Context,
 	creator string,
 	entityID string,
@@ -81,128 +205,286 @@ func (k Keeper) AddEntityMember(
 		return types.ErrInvalidRole.Wrapf("got '%s'", role)
 	}
 
-	// 2. Get entity
-	entity, err := k.Entities.Get(ctx, entityID)
+	// 2. Verify the entity exists and creator is admin
+	entity, err := k.GetEntity(ctx, entityID)
 	if err != nil {
-		return types.ErrEntityNotFound.Wrapf("entity ID: %s", entityID)
+		return err
+	}
+	if err := k.requireEntityAdmin(ctx, entityID, creator, "change member roles"); err != nil {
+		return err
 	}
 
-	// 3. Verify creator is admin
-	isAdmin := false
-	for _, admin := range entity.AdminAddresses {
-		if admin == creator {
-			isAdmin = true
-			break
+	// 3. Cannot change the owner's role
+	if memberAddress == entity.OwnerAddress {
+		return types.ErrUnauthorized.Wrap("cannot change the entity owner's role")
+	}
+
+	// 4. Find member, and keep at least one admin
+	member, err := k.GetEntityMember(ctx, entityID, memberAddress)
+	if err != nil {
+		return err
+	}
+	previousRole := member.Role
+	if previousRole == "admin" && role != "admin" {
+		if err := k.requireOtherAdmin(ctx, entityID); err != nil {
+			return err
 		}
 	}
-	if !isAdmin {
-		return types.ErrUnauthorized.Wrap("only admins can add members")
+
+	// 5. Update role, keeping the join height
+	member.Role = role
+	if err := k.setEntityMember(ctx, member); err != nil {
+		return err
+	}
+
+	// 6. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"entity_member_role_updated",
+			sdk.NewAttribute("entity_id", entityID),
+			sdk.NewAttribute("member_address", memberAddress),
+			sdk.NewAttribute("previous_role", previousRole),
+			sdk.NewAttribute("role", role),
+			sdk.NewAttribute("updated_by", creator),
+		),
+	)
+
+	return nil
+}
+
+// UpdateEntity replaces an entity's name, type and metadata
+func (k Keeper) UpdateEntity(
+	ctx context.Context,
+	creator string,
+	entityID string,
+	name string,
+	entityType string,
+	metadata string,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Get entity
+	entity, err := k.GetEntity(ctx, entityID)
+	if err != nil {
+		return err
 	}
 
-	// 4. Add member
-	entity.MemberAddresses = append(entity.MemberAddresses, memberAddress)
-	if entity.Permissions == nil {
-		entity.Permissions = make(map[string]string)
+	// 2. Verify creator is admin
+	if err := k.requireEntityAdmin(ctx, entityID, creator, "update the entity"); err != nil {
+		return err
 	}
-	entity.Permissions[memberAddress] = role
 
-	// 5. If role is admin, add to admin list
-	if role == "admin" {
-		entity.AdminAddresses = append(entity.AdminAddresses, memberAddress)
+	// 3. Validate the fields against the governance-set limits
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := types.CheckLength("name", name, params.MaxNameLength); err != nil {
+		return err
+	}
+	if err := params.CheckEntityType(entityType); err != nil {
+		return err
+	}
+	if err := types.CheckLength("metadata", metadata, params.MaxMetadataLength); err != nil {
+		return err
 	}
 
-	// 6. Update entity
+	// 4. Update entity
+	entity.Name = name
+	entity.EntityType = entityType
+	entity.Metadata = metadata
 	if err := k.Entities.Set(ctx, entityID, entity); err != nil {
 		return err
 	}
 
-	// 7. Emit event
+	// 5. Emit event
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			"entity_member_added",
+			"entity_updated",
 			sdk.NewAttribute("entity_id", entityID),
-			sdk.NewAttribute("member_address", memberAddress),
-			sdk.NewAttribute("role", role),
-			sdk.NewAttribute("added_by", creator),
+			sdk.NewAttribute("name", name),
+			sdk.NewAttribute("type", entityType),
+			sdk.NewAttribute("updated_by", creator),
 		),
 	)
 
 	return nil
 }
 
-// RemoveEntityMember removes a member from an entity
-func (k Keeper) RemoveEntityMember(
+// TransferEntityOwnership offers ownership of an entity to newOwner, which
+// takes effect when newOwner accepts it. Offering ownership to the current
+// owner cancels a pending offer.
+func (k Keeper) TransferEntityOwnership(
 	ctx context.Context,
 	creator string,
 	entityID string,
-	memberAddress string,
+	newOwner string,
 ) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	// 1. Get entity
-	entity, err := k.Entities.Get(ctx, entityID)
+	entity, err := k.GetEntity(ctx, entityID)
 	if err != nil {
-		return types.ErrEntityNotFound.Wrapf("entity ID: %s", entityID)
+		return err
 	}
 
-	// 2. Verify creator is admin
-	isAdmin := false
-	for _, admin := range entity.AdminAddresses {
-		if admin == creator {
-			isAdmin = true
-			break
-		}
+	// 2. Verify creator is the owner
+	if entity.OwnerAddress != creator {
+		return types.ErrUnauthorized.Wrap("only the owner can transfer ownership")
+	}
+
+	// 3. Record the offer, or clear it
+	entity.PendingOwner = newOwner
+	if newOwner == entity.OwnerAddress {
+		entity.PendingOwner = ""
 	}
-	if !isAdmin {
-		return types.ErrUnauthorized.Wrap("only admins can remove members")
+	if err := k.Entities.Set(ctx, entityID, entity); err != nil {
+		return err
 	}
 
-	// 3. Cannot remove owner
-	if memberAddress == entity.OwnerAddress {
-		return types.ErrUnauthorized.Wrap("cannot remove the entity owner")
+	// 4. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"entity_ownership_offered",
+			sdk.NewAttribute("entity_id", entityID),
+			sdk.NewAttribute("owner", creator),
+			sdk.NewAttribute("pending_owner", entity.PendingOwner),
+		),
+	)
+
+	return nil
+}
+
+// AcceptEntityOwnership completes a pending ownership transfer. The new owner
+// becomes an admin; the previous owner stays on as an admin until removed.
+func (k Keeper) AcceptEntityOwnership(ctx context.Context, creator string, entityID string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Get entity
+	entity, err := k.GetEntity(ctx, entityID)
+	if err != nil {
+		return err
 	}
 
-	// 4. Find and remove member
-	found := false
-	newMembers := make([]string, 0, len(entity.MemberAddresses))
-	for _, m := range entity.MemberAddresses {
-		if m == memberAddress {
-			found = true
-			continue
-		}
-		newMembers = append(newMembers, m)
+	// 2. Verify creator is the pending owner
+	if entity.PendingOwner == "" || entity.PendingOwner != creator {
+		return types.ErrNoPendingTransfer.Wrapf("entity ID: %s", entityID)
+	}
+
+	// 3. Transfer ownership and make the new owner an admin, keeping its
+	// join height if it is already a member
+	previousOwner := entity.OwnerAddress
+	entity.OwnerAddress = creator
+	entity.PendingOwner = ""
+	if err := k.Entities.Set(ctx, entityID, entity); err != nil {
+		return err
+	}
+	member, err := k.EntityMembers.Get(ctx, collections.Join(entityID, creator))
+	switch {
+	case err == nil:
+		member.Role = "admin"
+		err = k.setEntityMember(ctx, member)
+	case errors.Is(err, collections.ErrNotFound):
+		err = k.setEntityMember(ctx, newEntityMember(ctx, entityID, creator, "admin"))
+	}
+	if err != nil {
+		return err
 	}
 
-	if !found {
-		return types.ErrMemberNotFound.Wrapf("member: %s", memberAddress)
+	// 4. Move the owner index entry
+	if err := k.EntitiesByOwner.Remove(ctx, collections.Join(previousOwner, entityID)); err != nil {
+		return err
+	}
+	if err := k.EntitiesByOwner.Set(ctx, collections.Join(creator, entityID), []byte{}); err != nil {
+		return err
 	}
 
-	entity.MemberAddresses = newMembers
+	// 5. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"entity_ownership_transferred",
+			sdk.NewAttribute("entity_id", entityID),
+			sdk.NewAttribute("previous_owner", previousOwner),
+			sdk.NewAttribute("owner", creator),
+		),
+	)
 
-	// 5. Remove from admins if present
-	newAdmins := make([]string, 0, len(entity.AdminAddresses))
-	for _, a := range entity.AdminAddresses {
-		if a != memberAddress {
-			newAdmins = append(newAdmins, a)
-		}
+	return nil
+}
+
+// DeactivateEntity suspends an entity. An inactive entity sponsors no fees,
+// cannot be funded and cannot take on members until it is reactivated.
+func (k Keeper) DeactivateEntity(ctx context.Context, creator string, entityID string, reason string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Get entity and verify creator is the owner
+	entity, err := k.GetEntity(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	if entity.OwnerAddress != creator {
+		return types.ErrUnauthorized.Wrap("only the owner can deactivate the entity")
+	}
+	if !entity.Active {
+		return types.ErrEntityInactive.Wrapf("entity ID: %s", entityID)
 	}
-	entity.AdminAddresses = newAdmins
 
-	// 6. Remove permission
-	delete(entity.Permissions, memberAddress)
+	// 2. Validate the reason against the governance-set limit
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := types.CheckLength("reason", reason, params.MaxReasonLength); err != nil {
+		return err
+	}
 
-	// 7. Update entity
+	// 3. Deactivate
+	entity.Active = false
 	if err := k.Entities.Set(ctx, entityID, entity); err != nil {
 		return err
 	}
 
-	// 8. Emit event
+	// 4. Emit event
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			"entity_member_removed",
+			"entity_deactivated",
 			sdk.NewAttribute("entity_id", entityID),
-			sdk.NewAttribute("member_address", memberAddress),
-			sdk.NewAttribute("removed_by", creator),
+			sdk.NewAttribute("reason", reason),
+			sdk.NewAttribute("deactivated_by", creator),
+		),
+	)
+
+	return nil
+}
+
+// ReactivateEntity restores a deactivated entity
+func (k Keeper) ReactivateEntity(ctx context.Context, creator string, entityID string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Get entity and verify creator is the owner
+	entity, err := k.GetEntity(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	if entity.OwnerAddress != creator {
+		return types.ErrUnauthorized.Wrap("only the owner can reactivate the entity")
+	}
+	if entity.Active {
+		return sdkerrors.ErrInvalidRequest.Wrap("entity is already active")
+	}
+
+	// 2. Reactivate
+	entity.Active = true
+	if err := k.Entities.Set(ctx, entityID, entity); err != nil {
+		return err
+	}
+
+	// 3. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"entity_reactivated",
+			sdk.NewAttribute("entity_id", entityID),
+			sdk.NewAttribute("reactivated_by", creator),
 		),
 	)
 
@@ -218,30 +500,146 @@ func (k Keeper) GetEntity(ctx context.Context, entityID string) (types.EntityAcc
 	return entity, nil
 }
 
-// GetEntitiesByOwner returns all entities owned by an address
-func (k Keeper) GetEntitiesByOwner(ctx context.Context, ownerAddress string) ([]types.EntityAccount, error) {
-	var entities []types.EntityAccount
+// GetEntityMember retrieves an entity member
+func (k Keeper) GetEntityMember(ctx context.Context, entityID string, addr string) (types.EntityMember, error) {
+	member, err := k.EntityMembers.Get(ctx, collections.Join(entityID, addr))
+	if err != nil {
+		return types.EntityMember{}, types.ErrMemberNotFound.Wrapf("member: %s", addr)
+	}
+	return member, nil
+}
 
-	rng := collections.NewPrefixedPairRange[string, string](ownerAddress)
-	iter, err := k.EntitiesByOwner.Iterate(ctx, rng)
+// GetEntityMembers returns a page of an entity's members
+func (k Keeper) GetEntityMembers(ctx context.Context, entityID string, pagination *query.PageRequest) ([]types.EntityMember, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.EntityMembers, pagination,
+		func(_ collections.Pair[string, string], member types.EntityMember) (types.EntityMember, error) {
+			return member, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](entityID),
+	)
+}
+
+// memberRole returns addr's role in the entity, or "" if it is not a member
+func (k Keeper) memberRole(ctx context.Context, entityID string, addr string) (string, error) {
+	member, err := k.EntityMembers.Get(ctx, collections.Join(entityID, addr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", nil
+	}
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	defer iter.Close()
+	return member.Role, nil
+}
 
-	for ; iter.Valid(); iter.Next() {
-		key, err := iter.Key()
-		if err != nil {
-			return nil, err
-		}
-		entityID := key.K2()
+// requireEntityAdmin returns ErrUnauthorized unless addr is an admin of the
+// entity. action completes the message "only admins can ...".
+func (k Keeper) requireEntityAdmin(ctx context.Context, entityID string, addr string, action string) error {
+	role, err := k.memberRole(ctx, entityID, addr)
+	if err != nil {
+		return err
+	}
+	if role != "admin" {
+		return types.ErrUnauthorized.Wrapf("only admins can %s", action)
+	}
+	return nil
+}
 
-		entity, err := k.Entities.Get(ctx, entityID)
-		if err != nil {
-			continue
-		}
-		entities = append(entities, entity)
+// requireEntityPermission returns ErrEntityInactive unless the entity is
+// active, and ErrUnauthorized unless addr holds a role in it that permits
+// action
+func (k Keeper) requireEntityPermission(ctx context.Context, entityID string, addr string, action types.EntityAction) error {
+	entity, err := k.GetEntity(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	if !entity.Active {
+		return types.ErrEntityInactive.Wrapf("entity ID: %s", entityID)
+	}
+	role, err := k.memberRole(ctx, entityID, addr)
+	if err != nil {
+		return err
+	}
+	if !types.RolePermits(role, action) {
+		return types.ErrUnauthorized.Wrapf("role '%s' in entity %s does not permit %s", role, entityID, action)
+	}
+	return nil
+}
+
+// requireOtherAdmin returns ErrLastAdmin unless the entity has more than one
+// admin, so that one may be removed or demoted
+func (k Keeper) requireOtherAdmin(ctx context.Context, entityID string) error {
+	admins := 0
+	err := k.EntityMembers.Walk(ctx, collections.NewPrefixedPairRange[string, string](entityID),
+		func(_ collections.Pair[string, string], member types.EntityMember) (bool, error) {
+			if member.Role == "admin" {
+				admins++
+			}
+			return admins > 1, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if admins < 2 {
+		return types.ErrLastAdmin.Wrapf("entity ID: %s", entityID)
 	}
+	return nil
+}
+
+// newEntityMember returns a member joining the entity at the current height
+func newEntityMember(ctx context.Context, entityID string, addr string, role string) types.EntityMember {
+	return types.EntityMember{
+		EntityId:     entityID,
+		Address:      addr,
+		Role:         role,
+		JoinedHeight: sdk.UnwrapSDKContext(ctx).BlockHeight(),
+	}
+}
+
+// setEntityMember stores a member and indexes the entity under its address
+func (k Keeper) setEntityMember(ctx context.Context, member types.EntityMember) error {
+	if err := k.EntityMembers.Set(ctx, collections.Join(member.EntityId, member.Address), member); err != nil {
+		return err
+	}
+	return k.EntitiesByMember.Set(ctx, collections.Join(member.Address, member.EntityId), []byte{})
+}
 
-	return entities, nil
+// removeEntityMember deletes a member and its index entry
+func (k Keeper) removeEntityMember(ctx context.Context, entityID string, addr string) error {
+	if err := k.EntityMembers.Remove(ctx, collections.Join(entityID, addr)); err != nil {
+		return err
+	}
+	return k.EntitiesByMember.Remove(ctx, collections.Join(addr, entityID))
+}
+
+// GetEntitiesByMember returns a page of the entities an address is a member
+// of, with its role in each
+func (k Keeper) GetEntitiesByMember(ctx context.Context, memberAddress string, pagination *query.PageRequest) ([]types.EntityMembership, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.EntitiesByMember, pagination,
+		func(key collections.Pair[string, string], _ []byte) (types.EntityMembership, error) {
+			entity, err := k.GetEntity(ctx, key.K2())
+			if err != nil {
+				return types.EntityMembership{}, err
+			}
+			member, err := k.GetEntityMember(ctx, key.K2(), memberAddress)
+			if err != nil {
+				return types.EntityMembership{}, err
+			}
+			return types.EntityMembership{Entity: entity, Role: member.Role, JoinedHeight: member.JoinedHeight}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](memberAddress),
+	)
+}
+
+// GetEntitiesByOwner returns a page of entities owned by an address
+func (k Keeper) GetEntitiesByOwner(ctx context.Context, ownerAddress string, pagination *query.PageRequest) ([]types.EntityAccount, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.EntitiesByOwner, pagination,
+		func(key collections.Pair[string, string], _ []byte) (types.EntityAccount, error) {
+			return k.GetEntity(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](ownerAddress),
+	)
 }
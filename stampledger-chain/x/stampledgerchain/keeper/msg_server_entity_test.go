@@ -0,0 +1,287 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+func TestUpdateEntity(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, viewer := sample.AccAddress(), sample.AccAddress()
+	entityRes, err := ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: owner, Name: "Acme", EntityType: "firm"})
+	require.NoError(t, err)
+	entityID := entityRes.EntityId
+	f.addMember(t, f.ctx, owner, entityID, viewer, "viewer")
+
+	update := func(creator, name, entityType string) error {
+		_, err := ms.UpdateEntity(f.ctx, &types.MsgUpdateEntity{
+			Creator:    creator,
+			EntityId:   entityID,
+			Name:       name,
+			EntityType: entityType,
+			Metadata:   `{"email":"ops@acme.example"}`,
+		})
+		return err
+	}
+
+	require.ErrorIs(t, update(viewer, "Acme Engineering", "company"), types.ErrUnauthorized)
+	require.ErrorIs(t, update(owner, "Acme Engineering", "guild"), types.ErrInvalidEntityType)
+	require.NoError(t, update(owner, "Acme Engineering", "company"))
+
+	entity, err := f.keeper.GetEntity(f.ctx, entityID)
+	require.NoError(t, err)
+	require.Equal(t, "Acme Engineering", entity.Name)
+	require.Equal(t, "company", entity.EntityType)
+	require.Equal(t, `{"email":"ops@acme.example"}`, entity.Metadata)
+}
+
+func TestTransferEntityOwnership(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, admin, newOwner := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	entityRes, err := ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: owner, Name: "Acme", EntityType: "firm"})
+	require.NoError(t, err)
+	entityID := entityRes.EntityId
+	f.addMember(t, f.ctx, owner, entityID, admin, "admin")
+
+	ownedBy := func(addr string) []string {
+		res, err := qs.EntitiesByOwner(f.ctx, &types.QueryEntitiesByOwnerRequest{OwnerAddress: addr})
+		require.NoError(t, err)
+		var ids []string
+		for _, entity := range res.Entities {
+			ids = append(ids, entity.Id)
+		}
+		return ids
+	}
+
+	// Only the owner can offer ownership, and nothing changes until accepted
+	_, err = ms.TransferEntityOwnership(f.ctx, &types.MsgTransferEntityOwnership{Creator: admin, EntityId: entityID, NewOwner: newOwner})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.TransferEntityOwnership(f.ctx, &types.MsgTransferEntityOwnership{Creator: owner, EntityId: entityID, NewOwner: newOwner})
+	require.NoError(t, err)
+	entity, err := f.keeper.GetEntity(f.ctx, entityID)
+	require.NoError(t, err)
+	require.Equal(t, owner, entity.OwnerAddress)
+	require.Equal(t, newOwner, entity.PendingOwner)
+
+	// Only the pending owner can accept
+	_, err = ms.AcceptEntityOwnership(f.ctx, &types.MsgAcceptEntityOwnership{Creator: admin, EntityId: entityID})
+	require.ErrorIs(t, err, types.ErrNoPendingTransfer)
+
+	// Offering to the current owner cancels the offer
+	_, err = ms.TransferEntityOwnership(f.ctx, &types.MsgTransferEntityOwnership{Creator: owner, EntityId: entityID, NewOwner: owner})
+	require.NoError(t, err)
+	_, err = ms.AcceptEntityOwnership(f.ctx, &types.MsgAcceptEntityOwnership{Creator: newOwner, EntityId: entityID})
+	require.ErrorIs(t, err, types.ErrNoPendingTransfer)
+
+	_, err = ms.TransferEntityOwnership(f.ctx, &types.MsgTransferEntityOwnership{Creator: owner, EntityId: entityID, NewOwner: newOwner})
+	require.NoError(t, err)
+	_, err = ms.AcceptEntityOwnership(f.ctx, &types.MsgAcceptEntityOwnership{Creator: newOwner, EntityId: entityID})
+	require.NoError(t, err)
+
+	entity, err = f.keeper.GetEntity(f.ctx, entityID)
+	require.NoError(t, err)
+	require.Equal(t, newOwner, entity.OwnerAddress)
+	require.Empty(t, entity.PendingOwner)
+	for _, addr := range []string{newOwner, owner} {
+		member, err := f.keeper.GetEntityMember(f.ctx, entityID, addr)
+		require.NoError(t, err)
+		require.Equal(t, "admin", member.Role)
+	}
+	require.Empty(t, ownedBy(owner))
+	require.Equal(t, []string{entityID}, ownedBy(newOwner))
+
+	// The previous owner is now an ordinary admin who can be removed
+	_, err = ms.RemoveEntityMember(f.ctx, &types.MsgRemoveEntityMember{Creator: newOwner, EntityId: entityID, MemberAddress: owner})
+	require.NoError(t, err)
+}
+
+func TestEntityMembers(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	owner, member := sample.AccAddress(), sample.AccAddress()
+	entityRes, err := ms.CreateEntity(ctx, &types.MsgCreateEntity{Creator: owner, Name: "Acme", EntityType: "firm"})
+	require.NoError(t, err)
+	entityID := entityRes.EntityId
+
+	// Members are added once, with their join height
+	f.addMember(t, ctx.WithBlockHeight(20), owner, entityID, member, "viewer")
+	_, err = ms.InviteMember(ctx, &types.MsgInviteMember{Creator: owner, EntityId: entityID, Invitee: member, Role: "editor"})
+	require.ErrorIs(t, err, types.ErrMemberExists)
+	res, err := qs.EntityMembers(ctx, &types.QueryEntityMembersRequest{EntityId: entityID})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.EntityMember{
+		{EntityId: entityID, Address: owner, Role: "admin", JoinedHeight: 10},
+		{EntityId: entityID, Address: member, Role: "viewer", JoinedHeight: 20},
+	}, res.Members)
+
+	// Only admins change roles; the join height is kept
+	updateRole := func(creator, addr, role string) error {
+		_, err := ms.UpdateMemberRole(ctx.WithBlockHeight(30), &types.MsgUpdateMemberRole{Creator: creator, EntityId: entityID, MemberAddress: addr, Role: role})
+		return err
+	}
+	require.ErrorIs(t, updateRole(member, member, "admin"), types.ErrUnauthorized)
+	require.ErrorIs(t, updateRole(owner, sample.AccAddress(), "editor"), types.ErrMemberNotFound)
+	require.NoError(t, updateRole(owner, member, "editor"))
+	got, err := f.keeper.GetEntityMember(ctx, entityID, member)
+	require.NoError(t, err)
+	require.Equal(t, types.EntityMember{EntityId: entityID, Address: member, Role: "editor", JoinedHeight: 20}, got)
+
+	// Other admins can neither demote nor remove the owner
+	require.ErrorIs(t, updateRole(owner, owner, "editor"), types.ErrUnauthorized)
+	require.NoError(t, updateRole(owner, member, "admin"))
+	require.ErrorIs(t, updateRole(member, owner, "viewer"), types.ErrUnauthorized)
+	_, err = ms.RemoveEntityMember(ctx, &types.MsgRemoveEntityMember{Creator: member, EntityId: entityID, MemberAddress: owner})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	got, err = f.keeper.GetEntityMember(ctx, entityID, owner)
+	require.NoError(t, err)
+	require.Equal(t, "admin", got.Role)
+
+	// Removed members lose their role
+	_, err = ms.RemoveEntityMember(ctx, &types.MsgRemoveEntityMember{Creator: owner, EntityId: entityID, MemberAddress: member})
+	require.NoError(t, err)
+	_, err = f.keeper.GetEntityMember(ctx, entityID, member)
+	require.ErrorIs(t, err, types.ErrMemberNotFound)
+	_, err = ms.RemoveEntityMember(ctx, &types.MsgRemoveEntityMember{Creator: owner, EntityId: entityID, MemberAddress: member})
+	require.ErrorIs(t, err, types.ErrMemberNotFound)
+}
+
+func TestLeaveEntity(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, admin, viewer := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	entityRes, err := ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: owner, Name: "Acme", EntityType: "firm"})
+	require.NoError(t, err)
+	entityID := entityRes.EntityId
+	f.addMember(t, f.ctx, owner, entityID, admin, "admin")
+	f.addMember(t, f.ctx, owner, entityID, viewer, "viewer")
+	leave := func(creator string) error {
+		_, err := ms.LeaveEntity(f.ctx, &types.MsgLeaveEntity{Creator: creator, EntityId: entityID})
+		return err
+	}
+
+	// The owner stays until ownership is transferred
+	require.ErrorIs(t, leave(owner), types.ErrUnauthorized)
+	require.NoError(t, leave(viewer))
+	require.ErrorIs(t, leave(viewer), types.ErrMemberNotFound)
+	memberships, _, err := f.keeper.GetEntitiesByMember(f.ctx, viewer, nil)
+	require.NoError(t, err)
+	require.Empty(t, memberships)
+
+	// The owner stays an admin, so other admins may leave
+	_, err = ms.UpdateMemberRole(f.ctx, &types.MsgUpdateMemberRole{Creator: admin, EntityId: entityID, MemberAddress: owner, Role: "editor"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.NoError(t, leave(admin))
+}
+
+func TestDeactivateEntity(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, admin, member := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	entityRes, err := ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: owner, Name: "Acme", EntityType: "firm"})
+	require.NoError(t, err)
+	entityID := entityRes.EntityId
+	f.addMember(t, f.ctx, owner, entityID, admin, "admin")
+	_, err = ms.SetEntityFeeCap(f.ctx, &types.MsgSetEntityFeeCap{
+		Creator:          owner,
+		EntityId:         entityID,
+		MemberMonthlyCap: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	})
+	require.NoError(t, err)
+	sponsor := func() error {
+		return f.keeper.SponsorFee(f.ctx, types.EntityTreasuryAddress(entityID), sdk.MustAccAddressFromBech32(admin),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	}
+	require.NoError(t, sponsor())
+
+	// Only the owner can deactivate
+	_, err = ms.DeactivateEntity(f.ctx, &types.MsgDeactivateEntity{Creator: admin, EntityId: entityID})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.DeactivateEntity(f.ctx, &types.MsgDeactivateEntity{Creator: owner, EntityId: entityID, Reason: "firm dissolved"})
+	require.NoError(t, err)
+	_, err = ms.DeactivateEntity(f.ctx, &types.MsgDeactivateEntity{Creator: owner, EntityId: entityID})
+	require.ErrorIs(t, err, types.ErrEntityInactive)
+
+	// An inactive entity cannot sponsor fees, be funded or add members
+	require.ErrorIs(t, sponsor(), types.ErrFeeNotSponsored)
+	_, err = ms.FundEntity(f.ctx, &types.MsgFundEntity{
+		Creator:  owner,
+		EntityId: entityID,
+		Amount:   sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+	})
+	require.ErrorIs(t, err, types.ErrEntityInactive)
+	_, err = ms.InviteMember(f.ctx, &types.MsgInviteMember{Creator: owner, EntityId: entityID, Invitee: member, Role: "viewer"})
+	require.ErrorIs(t, err, types.ErrEntityInactive)
+
+	// Reactivation restores it
+	_, err = ms.ReactivateEntity(f.ctx, &types.MsgReactivateEntity{Creator: admin, EntityId: entityID})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.ReactivateEntity(f.ctx, &types.MsgReactivateEntity{Creator: owner, EntityId: entityID})
+	require.NoError(t, err)
+	_, err = ms.ReactivateEntity(f.ctx, &types.MsgReactivateEntity{Creator: owner, EntityId: entityID})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.NoError(t, sponsor())
+	f.addMember(t, f.ctx, owner, entityID, member, "viewer")
+}
+
+func TestEntitiesByMember(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, drafter := sample.AccAddress(), sample.AccAddress()
+	var entityIDs []string
+	for _, name := range []string{"Acme", "Globex", "Initech"} {
+		res, err := ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: owner, Name: name, EntityType: "firm"})
+		require.NoError(t, err)
+		entityIDs = append(entityIDs, res.EntityId)
+	}
+	for _, entityID := range entityIDs[:2] {
+		f.addMember(t, f.ctx, owner, entityID, drafter, "viewer")
+	}
+	_, err := ms.UpdateMemberRole(f.ctx, &types.MsgUpdateMemberRole{Creator: owner, EntityId: entityIDs[1], MemberAddress: drafter, Role: "editor"})
+	require.NoError(t, err)
+
+	roles := func(addr string, pagination *query.PageRequest) map[string]string {
+		res, err := qs.EntitiesByMember(f.ctx, &types.QueryEntitiesByMemberRequest{MemberAddress: addr, Pagination: pagination})
+		require.NoError(t, err)
+		roles := make(map[string]string)
+		for _, membership := range res.Memberships {
+			roles[membership.Entity.Id] = membership.Role
+		}
+		return roles
+	}
+	require.Equal(t, map[string]string{entityIDs[0]: "viewer", entityIDs[1]: "editor"}, roles(drafter, nil))
+	require.Len(t, roles(owner, nil), 3)
+	require.Len(t, roles(owner, &query.PageRequest{Limit: 2}), 2)
+
+	// Removal drops the entity from the member's list
+	_, err = ms.RemoveEntityMember(f.ctx, &types.MsgRemoveEntityMember{Creator: owner, EntityId: entityIDs[0], MemberAddress: drafter})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{entityIDs[1]: "editor"}, roles(drafter, nil))
+
+	// Ownership transfer adds the new owner to the index
+	newOwner := sample.AccAddress()
+	_, err = ms.TransferEntityOwnership(f.ctx, &types.MsgTransferEntityOwnership{Creator: owner, EntityId: entityIDs[2], NewOwner: newOwner})
+	require.NoError(t, err)
+	_, err = ms.AcceptEntityOwnership(f.ctx, &types.MsgAcceptEntityOwnership{Creator: newOwner, EntityId: entityIDs[2]})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{entityIDs[2]: "admin"}, roles(newOwner, nil))
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// InviteMember invites an address to join an entity with the given role and
+// returns the height the invite expires at. Inviting an address again
+// replaces its open invite.
+func (k Keeper) InviteMember(
+	ctx context.Context,
+	creator string,
+	entityID string,
+	invitee string,
+	role string,
+) (int64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Validate role
+	if !types.ValidRoles[role] {
+		return 0, types.ErrInvalidRole.Wrapf("got '%s'", role)
+	}
+
+	// 2. Get entity, which must be active
+	entity, err := k.GetEntity(ctx, entityID)
+	if err != nil {
+		return 0, err
+	}
+	if !entity.Active {
+		return 0, types.ErrEntityInactive.Wrapf("entity ID: %s", entityID)
+	}
+
+	// 3. Verify creator is admin
+	if err := k.requireEntityAdmin(ctx, entityID, creator, "invite members"); err != nil {
+		return 0, err
+	}
+
+	// 4. Reject existing members; role changes go through UpdateMemberRole
+	exists, err := k.EntityMembers.Has(ctx, collections.Join(entityID, invitee))
+	if err != nil {
+		return 0, err
+	}
+	if exists {
+		return 0, types.ErrMemberExists.Wrapf("member: %s", invitee)
+	}
+
+	// 5. Replace any open invite
+	if err := k.removeEntityInvite(ctx, invitee, entityID); err != nil {
+		return 0, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	invite := types.EntityInvite{
+		EntityId:      entityID,
+		Invitee:       invitee,
+		Role:          role,
+		InvitedBy:     creator,
+		CreatedHeight: sdkCtx.BlockHeight(),
+		ExpiresHeight: sdkCtx.BlockHeight() + params.InviteDuration,
+	}
+	if err := k.setEntityInvite(ctx, invite); err != nil {
+		return 0, err
+	}
+
+	// 6. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"entity_member_invited",
+			sdk.NewAttribute("entity_id", entityID),
+			sdk.NewAttribute("invitee", invitee),
+			sdk.NewAttribute("role", role),
+			sdk.NewAttribute("invited_by", creator),
+			sdk.NewAttribute("expires_height", strconv.FormatInt(invite.ExpiresHeight, 10)),
+		),
+	)
+
+	return invite.ExpiresHeight, nil
+}
+
+// AcceptInvite makes the creator a member of the entity with the role it was
+// invited with, and returns that role
+func (k Keeper) AcceptInvite(ctx context.Context, creator string, entityID string) (string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Find an unexpired invite for the creator
+	invite, err := k.GetEntityInvite(ctx, creator, entityID)
+	if err != nil {
+		return "", err
+	}
+	if sdkCtx.BlockHeight() >= invite.ExpiresHeight {
+		return "", types.ErrInvalidInvite.Wrapf("invite expired at height %d", invite.ExpiresHeight)
+	}
+
+	// 2. Get entity, which must be active
+	entity, err := k.GetEntity(ctx, entityID)
+	if err != nil {
+		return "", err
+	}
+	if !entity.Active {
+		return "", types.ErrEntityInactive.Wrapf("entity ID: %s", entityID)
+	}
+
+	// 3. Add member, unless it joined some other way since being invited
+	exists, err := k.EntityMembers.Has(ctx, collections.Join(entityID, creator))
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "", types.ErrMemberExists.Wrapf("member: %s", creator)
+	}
+	if err := k.setEntityMember(ctx, newEntityMember(ctx, entityID, creator, invite.Role)); err != nil {
+		return "", err
+	}
+
+	// 4. Close the invite
+	if err := k.removeEntityInvite(ctx, creator, entityID); err != nil {
+		return "", err
+	}
+
+	// 5. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"entity_member_added",
+			sdk.NewAttribute("entity_id", entityID),
+			sdk.NewAttribute("member_address", creator),
+			sdk.NewAttribute("role", invite.Role),
+			sdk.NewAttribute("invited_by", invite.InvitedBy),
+		),
+	)
+
+	return invite.Role, nil
+}
+
+// DeclineInvite closes the creator's open invite to the entity
+func (k Keeper) DeclineInvite(ctx context.Context, creator string, entityID string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Find the invite
+	invite, err := k.GetEntityInvite(ctx, creator, entityID)
+	if err != nil {
+		return err
+	}
+
+	// 2. Close it
+	if err := k.removeEntityInvite(ctx, creator, entityID); err != nil {
+		return err
+	}
+
+	// 3. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"entity_invite_declined",
+			sdk.NewAttribute("entity_id", entityID),
+			sdk.NewAttribute("invitee", creator),
+			sdk.NewAttribute("invited_by", invite.InvitedBy),
+		),
+	)
+
+	return nil
+}
+
+// GetEntityInvite retrieves the open invite for invitee to the entity
+func (k Keeper) GetEntityInvite(ctx context.Context, invitee string, entityID string) (types.EntityInvite, error) {
+	invite, err := k.EntityInvites.Get(ctx, collections.Join(invitee, entityID))
+	if err != nil {
+		return types.EntityInvite{}, types.ErrInviteNotFound.Wrapf("invitee %s, entity ID: %s", invitee, entityID)
+	}
+	return invite, nil
+}
+
+// GetPendingInvites returns a page of the open invites for an address.
+// Invites expired in the current block are listed until they are pruned.
+func (k Keeper) GetPendingInvites(ctx context.Context, invitee string, pagination *query.PageRequest) ([]types.EntityInvite, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.EntityInvites, pagination,
+		func(_ collections.Pair[string, string], invite types.EntityInvite) (types.EntityInvite, error) {
+			return invite, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](invitee),
+	)
+}
+
+// setEntityInvite stores an invite and queues it for expiry
+func (k Keeper) setEntityInvite(ctx context.Context, invite types.EntityInvite) error {
+	if err := k.EntityInvites.Set(ctx, collections.Join(invite.Invitee, invite.EntityId), invite); err != nil {
+		return err
+	}
+	return k.InviteExpiry.Set(ctx, collections.Join3(invite.ExpiresHeight, invite.Invitee, invite.EntityId), []byte{})
+}
+
+// removeEntityInvite deletes an invite, if any, and its expiry queue entry
+func (k Keeper) removeEntityInvite(ctx context.Context, invitee string, entityID string) error {
+	invite, err := k.EntityInvites.Get(ctx, collections.Join(invitee, entityID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.EntityInvites.Remove(ctx, collections.Join(invitee, entityID)); err != nil {
+		return err
+	}
+	return k.InviteExpiry.Remove(ctx, collections.Join3(invite.ExpiresHeight, invitee, entityID))
+}
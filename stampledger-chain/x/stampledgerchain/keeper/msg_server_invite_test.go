@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+func TestInviteMember(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.InviteDuration = 100
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	owner, invitee, stranger := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	entityIDs := make([]string, 2)
+	for i := range entityIDs {
+		res, err := ms.CreateEntity(ctx, &types.MsgCreateEntity{Creator: owner, Name: "Acme", EntityType: "firm"})
+		require.NoError(t, err)
+		entityIDs[i] = res.EntityId
+	}
+	invite := func(creator, entityID, role string) (int64, error) {
+		res, err := ms.InviteMember(ctx, &types.MsgInviteMember{Creator: creator, EntityId: entityID, Invitee: invitee, Role: role})
+		if err != nil {
+			return 0, err
+		}
+		return res.ExpiresHeight, nil
+	}
+	pending := func() []types.EntityInvite {
+		res, err := qs.PendingInvites(ctx, &types.QueryPendingInvitesRequest{Invitee: invitee})
+		require.NoError(t, err)
+		return res.Invites
+	}
+
+	// Members cannot be added without an invite
+	_, err = ms.AddEntityMember(ctx, &types.MsgAddEntityMember{Creator: owner, EntityId: entityIDs[0], MemberAddress: invitee, Role: "viewer"})
+	require.ErrorIs(t, err, types.ErrDirectAdd)
+
+	// Only admins invite, and an invite does not make a member
+	_, err = invite(stranger, entityIDs[0], "viewer")
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	expires, err := invite(owner, entityIDs[0], "viewer")
+	require.NoError(t, err)
+	require.Equal(t, int64(110), expires)
+	_, err = f.keeper.GetEntityMember(ctx, entityIDs[0], invitee)
+	require.ErrorIs(t, err, types.ErrMemberNotFound)
+
+	// Inviting again replaces the open invite
+	_, err = invite(owner, entityIDs[0], "editor")
+	require.NoError(t, err)
+	_, err = invite(owner, entityIDs[1], "viewer")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.EntityInvite{
+		{EntityId: entityIDs[0], Invitee: invitee, Role: "editor", InvitedBy: owner, CreatedHeight: 10, ExpiresHeight: 110},
+		{EntityId: entityIDs[1], Invitee: invitee, Role: "viewer", InvitedBy: owner, CreatedHeight: 10, ExpiresHeight: 110},
+	}, pending())
+
+	// Only the invitee can accept, with the invited role
+	_, err = ms.AcceptInvite(ctx, &types.MsgAcceptInvite{Creator: stranger, EntityId: entityIDs[0]})
+	require.ErrorIs(t, err, types.ErrInviteNotFound)
+	acceptRes, err := ms.AcceptInvite(ctx.WithBlockHeight(20), &types.MsgAcceptInvite{Creator: invitee, EntityId: entityIDs[0]})
+	require.NoError(t, err)
+	require.Equal(t, "editor", acceptRes.Role)
+	member, err := f.keeper.GetEntityMember(ctx, entityIDs[0], invitee)
+	require.NoError(t, err)
+	require.Equal(t, types.EntityMember{EntityId: entityIDs[0], Address: invitee, Role: "editor", JoinedHeight: 20}, member)
+	_, err = invite(owner, entityIDs[0], "admin")
+	require.ErrorIs(t, err, types.ErrMemberExists)
+
+	// Declining closes the invite without joining
+	_, err = ms.DeclineInvite(ctx, &types.MsgDeclineInvite{Creator: invitee, EntityId: entityIDs[1]})
+	require.NoError(t, err)
+	_, err = ms.AcceptInvite(ctx, &types.MsgAcceptInvite{Creator: invitee, EntityId: entityIDs[1]})
+	require.ErrorIs(t, err, types.ErrInviteNotFound)
+	require.Empty(t, pending())
+}
+
+func TestInviteExpiry(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.InviteDuration = 100
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	owner, invitee := sample.AccAddress(), sample.AccAddress()
+	entityRes, err := ms.CreateEntity(ctx, &types.MsgCreateEntity{Creator: owner, Name: "Acme", EntityType: "firm"})
+	require.NoError(t, err)
+	entityID := entityRes.EntityId
+	_, err = ms.InviteMember(ctx, &types.MsgInviteMember{Creator: owner, EntityId: entityID, Invitee: invitee, Role: "viewer"})
+	require.NoError(t, err)
+
+	// Re-inviting moves the expiry, so the first one prunes nothing
+	_, err = ms.InviteMember(ctx.WithBlockHeight(50), &types.MsgInviteMember{Creator: owner, EntityId: entityID, Invitee: invitee, Role: "viewer"})
+	require.NoError(t, err)
+	endBlock := func(height int64) sdk.Events {
+		ctx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, f.keeper.EndBlocker(ctx))
+		return ctx.EventManager().Events()
+	}
+	require.Empty(t, endBlock(110))
+
+	// An invite cannot be accepted at its expiry height, and is pruned then
+	_, err = ms.AcceptInvite(ctx.WithBlockHeight(150), &types.MsgAcceptInvite{Creator: invitee, EntityId: entityID})
+	require.ErrorIs(t, err, types.ErrInvalidInvite)
+	events := endBlock(150)
+	require.Len(t, events, 1)
+	require.Equal(t, "entity_invite_expired", events[0].Type)
+
+	res, err := qs.PendingInvites(ctx, &types.QueryPendingInvitesRequest{Invitee: invitee})
+	require.NoError(t, err)
+	require.Empty(t, res.Invites)
+	_, err = ms.AcceptInvite(ctx, &types.MsgAcceptInvite{Creator: invitee, EntityId: entityID})
+	require.ErrorIs(t, err, types.ErrInviteNotFound)
+}
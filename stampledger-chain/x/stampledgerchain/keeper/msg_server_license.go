@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// AttestLicense records that a jurisdiction's board has verified a PE license
+// and binds it to the PE's account, so only keys registered by that account
+// can stamp under it
+func (k Keeper) AttestLicense(ctx context.Context, creator, jurisdictionID, licenseNumber, peAccount, reason string) error {
+	return k.setLicenseStatus(
+		ctx, creator, jurisdictionID, licenseNumber, peAccount, reason,
+		[]types.LicenseStatus{types.LicenseUnattested}, types.LicenseActive,
+		"license_attested",
+	)
+}
+
+// SuspendLicense suspends an attested PE license
+func (k Keeper) SuspendLicense(ctx context.Context, creator, jurisdictionID, licenseNumber, reason string) error {
+	return k.setLicenseStatus(
+		ctx, creator, jurisdictionID, licenseNumber, "", reason,
+		[]types.LicenseStatus{types.LicenseActive}, types.LicenseSuspended,
+		"license_suspended",
+	)
+}
+
+// ReinstateLicense reinstates a suspended PE license
+func (k Keeper) ReinstateLicense(ctx context.Context, creator, jurisdictionID, licenseNumber, reason string) error {
+	return k.setLicenseStatus(
+		ctx, creator, jurisdictionID, licenseNumber, "", reason,
+		[]types.LicenseStatus{types.LicenseSuspended}, types.LicenseActive,
+		"license_reinstated",
+	)
+}
+
+// setLicenseStatus moves a license from one of the allowed statuses to a new
+// status on behalf of a jurisdiction board, appending to its history. A
+// non-empty peAccount rebinds the license to that account.
+func (k Keeper) setLicenseStatus(
+	ctx context.Context,
+	creator string,
+	jurisdictionID string,
+	licenseNumber string,
+	peAccount string,
+	reason string,
+	from []types.LicenseStatus,
+	to types.LicenseStatus,
+	eventType string,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Verify creator is a board address for the jurisdiction
+	if err := k.requireBoard(ctx, creator, jurisdictionID); err != nil {
+		return err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := types.CheckLength("reason", reason, params.MaxReasonLength); err != nil {
+		return err
+	}
+
+	// 2. Load the license record, starting a new one if unattested
+	key := collections.Join(jurisdictionID, licenseNumber)
+	license, err := k.Licenses.Get(ctx, key)
+	if err != nil {
+		license = types.License{
+			JurisdictionId: jurisdictionID,
+			LicenseNumber:  licenseNumber,
+			Status:         types.LicenseUnattested,
+		}
+	}
+
+	// 3. Check the transition is allowed
+	if !slices.Contains(from, license.Status) {
+		return types.ErrInvalidLicenseTransition.Wrapf("license is %s", license.Status)
+	}
+
+	// 4. Update status, holder and history
+	license.Status = to
+	if peAccount != "" {
+		license.PeAccount = peAccount
+	}
+	license.History = append(license.History, types.LicenseStatusChange{
+		Status:    to,
+		ChangedAt: sdkCtx.BlockTime().Unix(),
+		ChangedBy: creator,
+		Reason:    reason,
+	})
+	if err := k.Licenses.Set(ctx, key, license); err != nil {
+		return err
+	}
+
+	// 5. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute("jurisdiction", jurisdictionID),
+			sdk.NewAttribute("license_number", licenseNumber),
+			sdk.NewAttribute("pe_account", license.PeAccount),
+			sdk.NewAttribute("reason", reason),
+			sdk.NewAttribute("board", creator),
+		),
+	)
+
+	return nil
+}
+
+// requireBoard verifies that addr is a board address of the jurisdiction
+func (k Keeper) requireBoard(ctx context.Context, addr string, jurisdictionID string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	jurisdiction, found := params.GetJurisdiction(jurisdictionID)
+	if !found {
+		return types.ErrJurisdictionNotFound.Wrapf("jurisdiction: %s", jurisdictionID)
+	}
+	if !jurisdiction.IsBoard(addr) {
+		return types.ErrUnauthorized.Wrapf("not a board address for %s", jurisdictionID)
+	}
+	return nil
+}
+
+// GetLicense retrieves a board's record of a PE license
+func (k Keeper) GetLicense(ctx context.Context, jurisdictionID, licenseNumber string) (types.License, error) {
+	license, err := k.Licenses.Get(ctx, collections.Join(jurisdictionID, licenseNumber))
+	if err != nil {
+		return types.License{}, types.ErrLicenseNotFound.Wrapf("%s license %s", jurisdictionID, licenseNumber)
+	}
+	return license, nil
+}
+
+// getLicenseStatus returns a license's current status for the PE account,
+// treating missing records and licenses attested to another account as
+// unattested
+func (k Keeper) getLicenseStatus(ctx context.Context, jurisdictionID, licenseNumber, peAccount string) types.LicenseStatus {
+	license, err := k.GetLicense(ctx, jurisdictionID, licenseNumber)
+	if err != nil || license.PeAccount != peAccount {
+		return types.LicenseUnattested
+	}
+	return license.Status
+}
+
+// peKeyLicenseStatus returns the status, at timestamp and now, of the license a
+// PE key sealed under, for the key's registered account
+func (k Keeper) peKeyLicenseStatus(ctx context.Context, jurisdictionID, licenseNumber, pePublicKey string, timestamp int64) (types.LicenseStatus, types.LicenseStatus) {
+	pe, err := k.ProfessionalEngineers.Get(ctx, pePublicKey)
+	if err != nil {
+		return types.LicenseUnattested, types.LicenseUnattested
+	}
+	license, err := k.GetLicense(ctx, jurisdictionID, licenseNumber)
+	if err != nil || license.PeAccount != pe.Account {
+		return types.LicenseUnattested, types.LicenseUnattested
+	}
+	return license.StatusAt(timestamp), license.Status
+}
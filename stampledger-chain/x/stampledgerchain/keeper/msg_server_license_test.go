@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+func TestLicenseLifecycle(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+	_, err := ms.RegisterPE(ctx, newRegisterPEMsg(creator, pe))
+	require.NoError(t, err)
+
+	// Unattested licenses cannot stamp
+	_, err = ms.CreateStamp(ctx, newCreateStampMsg(creator, pe, "sheet-A"))
+	require.ErrorIs(t, err, types.ErrLicenseNotActive)
+
+	board, jurisdiction, number := testBoard, "wisconsin", "PE-12345"
+
+	t.Run("only boards may attest", func(t *testing.T) {
+		_, err := ms.AttestLicense(ctx, &types.MsgAttestLicense{Creator: creator, JurisdictionId: jurisdiction, LicenseNumber: number, PeAccount: creator})
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+
+		_, err = ms.AttestLicense(ctx, &types.MsgAttestLicense{Creator: board, JurisdictionId: "california", LicenseNumber: number, PeAccount: creator})
+		require.ErrorIs(t, err, types.ErrJurisdictionNotFound)
+	})
+
+	_, err = ms.SuspendLicense(ctx, &types.MsgSuspendLicense{Creator: board, JurisdictionId: jurisdiction, LicenseNumber: number})
+	require.ErrorIs(t, err, types.ErrInvalidLicenseTransition)
+
+	_, err = ms.AttestLicense(ctx, &types.MsgAttestLicense{Creator: board, JurisdictionId: jurisdiction, LicenseNumber: number, PeAccount: creator})
+	require.NoError(t, err)
+	_, err = ms.AttestLicense(ctx, &types.MsgAttestLicense{Creator: board, JurisdictionId: jurisdiction, LicenseNumber: number, PeAccount: creator})
+	require.ErrorIs(t, err, types.ErrInvalidLicenseTransition)
+
+	res, err := ms.CreateStamp(ctx, newCreateStampMsg(creator, pe, "sheet-A"))
+	require.NoError(t, err)
+
+	// Suspension blocks new stamps and is reported alongside the stamp-time status
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	_, err = ms.SuspendLicense(ctx, &types.MsgSuspendLicense{Creator: board, JurisdictionId: jurisdiction, LicenseNumber: number, Reason: "lapsed"})
+	require.NoError(t, err)
+
+	_, err = ms.CreateStamp(ctx, newCreateStampMsg(creator, pe, "sheet-B"))
+	require.ErrorIs(t, err, types.ErrLicenseNotActive)
+
+	verification, err := f.keeper.VerifyStamp(ctx, res.StampId)
+	require.NoError(t, err)
+	require.True(t, verification.Valid)
+	require.Equal(t, types.LicenseActive, verification.LicenseStatusAtStamp)
+	require.Equal(t, types.LicenseSuspended, verification.LicenseStatusNow)
+
+	// Reinstatement restores stamping
+	_, err = ms.ReinstateLicense(ctx, &types.MsgReinstateLicense{Creator: board, JurisdictionId: jurisdiction, LicenseNumber: number})
+	require.NoError(t, err)
+	_, err = ms.CreateStamp(ctx, newCreateStampMsg(creator, pe, "sheet-B"))
+	require.NoError(t, err)
+
+	record, err := f.keeper.GetLicense(ctx, jurisdiction, number)
+	require.NoError(t, err)
+	require.Equal(t, types.LicenseActive, record.Status)
+	require.Len(t, record.History, 3)
+}
+
+func TestLicenseBoundToPEAccount(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	holder, impostor := sample.AccAddress(), sample.AccAddress()
+	pe, impostorKey := newPEKey("pe-1"), newPEKey("pe-2")
+	f.registerPE(t, ctx, holder, pe)
+
+	// Another account can register a key claiming the same license, but
+	// cannot stamp under the license attested to the holder
+	_, err := ms.RegisterPE(ctx, newRegisterPEMsg(impostor, impostorKey))
+	require.NoError(t, err)
+	_, err = ms.CreateStamp(ctx, newCreateStampMsg(impostor, impostorKey, "sheet-A"))
+	require.ErrorIs(t, err, types.ErrLicenseNotActive)
+
+	res, err := ms.CreateStamp(ctx, newCreateStampMsg(holder, pe, "sheet-A"))
+	require.NoError(t, err)
+	verification, err := f.keeper.VerifyStamp(ctx, res.StampId)
+	require.NoError(t, err)
+	require.Equal(t, types.LicenseActive, verification.LicenseStatusAtStamp)
+
+	record, err := f.keeper.GetLicense(ctx, "wisconsin", "PE-12345")
+	require.NoError(t, err)
+	require.Equal(t, holder, record.PeAccount)
+}
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"stampledger-chain/x/stampledgerchain/merkle"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// CreateMerkleStamp stamps a Merkle root over a set of document hashes. The
+// stamp is stored like any other, with the root as its document hash, so it
+// can be verified, revoked, superseded and expired in the same way.
+func (k Keeper) CreateMerkleStamp(
+	ctx context.Context,
+	creator string,
+	merkleRoot string,
+	leafCount uint64,
+	pePublicKey string,
+	signature string,
+	jurisdictionId string,
+	peLicenseNumber string,
+	peName string,
+	projectName string,
+	manifestIpfsHash string,
+	signatureExpiry int64,
+	nonce uint64,
+	validUntil int64,
+) (string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Validate the Merkle root, leaf count and descriptive fields
+	if rootBytes, err := hex.DecodeString(merkleRoot); err != nil || len(rootBytes) != merkle.HashSize {
+		return "", types.ErrInvalidMerkleStamp.Wrap("merkle root must be 64 hex characters")
+	}
+	if leafCount == 0 {
+		return "", types.ErrInvalidMerkleStamp.Wrap("leaf count must be positive")
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := params.CheckStampFields(peName, projectName, "", 0); err != nil {
+		return "", err
+	}
+
+	// 2. Decode and validate public key and signature
+	pubKeyBytes, err := hex.DecodeString(pePublicKey)
+	if err != nil || len(pubKeyBytes) != ed25519.PublicKeySize {
+		return "", types.ErrInvalidPublicKey.Wrap("invalid hex encoding or length")
+	}
+	sigBytes, err := hex.DecodeString(signature)
+	if err != nil || len(sigBytes) != ed25519.SignatureSize {
+		return "", types.ErrInvalidSignature.Wrap("invalid hex encoding or length")
+	}
+
+	// 3. Verify Ed25519 signature over the Merkle stamp sign bytes
+	if validUntil != 0 && validUntil <= sdkCtx.BlockTime().Unix() {
+		return "", types.ErrInvalidValidUntil.Wrapf("valid_until %d is not after block time", validUntil)
+	}
+	if signatureExpiry != 0 && sdkCtx.BlockTime().Unix() > signatureExpiry {
+		return "", types.ErrSignatureExpired.Wrapf("expired at %d", signatureExpiry)
+	}
+	signBytes := types.MerkleStampSignBytes(sdkCtx.ChainID(), jurisdictionId, peLicenseNumber, merkleRoot, leafCount, signatureExpiry, nonce)
+	if !ed25519.Verify(pubKeyBytes, signBytes, sigBytes) {
+		return "", types.ErrInvalidSignature.Wrap("signature verification failed")
+	}
+
+	// 4. Verify the key is registered to the creator, or delegated to it, and
+	// matches the PE metadata
+	pe, err := k.checkStampAgainstRegistry(ctx, creator, pePublicKey, peName, peLicenseNumber, jurisdictionId, projectName)
+	if err != nil {
+		return "", err
+	}
+
+	// 5. Verify the jurisdiction's board has attested the license and not suspended it
+	if status := k.getLicenseStatus(ctx, jurisdictionId, peLicenseNumber, pe.Account); status != types.LicenseActive {
+		return "", types.ErrLicenseNotActive.Wrapf("%s license %s is %s", jurisdictionId, peLicenseNumber, status)
+	}
+
+	// 6. Reject a root already stamped by this PE, or a replayed signature
+	if err := k.checkDuplicateStamp(ctx, merkleRoot, pePublicKey); err != nil {
+		return "", err
+	}
+	if err := k.checkSignatureUnused(ctx, pePublicKey, sigBytes); err != nil {
+		return "", err
+	}
+
+	// 7. Generate unique stamp ID
+	stampID, err := k.nextID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// 8. Charge the stamp fee, then store and index the stamp
+	stamp := types.Stamp{
+		Id:               stampID,
+		DocumentHash:     merkleRoot,
+		PePublicKey:      pePublicKey,
+		Signature:        signature,
+		JurisdictionId:   jurisdictionId,
+		CreatedAt:        sdkCtx.BlockTime().Unix(),
+		CreatedHeight:    sdkCtx.BlockHeight(),
+		Creator:          creator,
+		PeLicenseNumber:  peLicenseNumber,
+		PeName:           peName,
+		ProjectName:      projectName,
+		DocumentIpfsHash: manifestIpfsHash,
+		SignBytesVersion: types.StampSignBytesVersion,
+		SignatureExpiry:  signatureExpiry,
+		Nonce:            nonce,
+		ValidUntil:       validUntil,
+		MerkleLeafCount:  leafCount,
+		PeAccount:        pe.Account,
+		Delegate:         delegateOf(pe, creator),
+	}
+	if err := k.storeNewStamp(ctx, stamp); err != nil {
+		return "", err
+	}
+
+	// 9. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"merkle_stamp_created",
+			sdk.NewAttribute("stamp_id", stampID),
+			sdk.NewAttribute("merkle_root", merkleRoot),
+			sdk.NewAttribute("leaf_count", strconv.FormatUint(leafCount, 10)),
+			sdk.NewAttribute("pe_public_key", pePublicKey),
+			sdk.NewAttribute("jurisdiction", jurisdictionId),
+			sdk.NewAttribute("creator", creator),
+		),
+	)
+
+	return stampID, nil
+}
+
+// VerifyMerkleInclusion checks that leafHash is covered by a Merkle stamp on
+// merkleRoot that is in force
+func (k Keeper) VerifyMerkleInclusion(
+	ctx context.Context,
+	merkleRoot string,
+	leafHash string,
+	leafIndex uint64,
+	proof []string,
+) (types.MerkleInclusionVerification, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Decode the root, leaf and proof
+	rootBytes, err := hex.DecodeString(merkleRoot)
+	if err != nil || len(rootBytes) != merkle.HashSize {
+		return types.MerkleInclusionVerification{}, types.ErrInvalidMerkleStamp.Wrap("merkle root must be 64 hex characters")
+	}
+	leafBytes, err := hex.DecodeString(leafHash)
+	if err != nil || len(leafBytes) != merkle.HashSize {
+		return types.MerkleInclusionVerification{}, types.ErrInvalidDocumentHash.Wrap("leaf hash must be 64 hex characters")
+	}
+	proofBytes := make([][]byte, len(proof))
+	for i, node := range proof {
+		proofBytes[i], err = hex.DecodeString(node)
+		if err != nil || len(proofBytes[i]) != merkle.HashSize {
+			return types.MerkleInclusionVerification{}, types.ErrInvalidMerkleStamp.Wrapf("proof node %d must be 64 hex characters", i)
+		}
+	}
+
+	verification := types.MerkleInclusionVerification{
+		MerkleRoot: merkleRoot,
+		LeafHash:   leafHash,
+		Reason:     "no Merkle stamp on this root",
+	}
+
+	// 2. Check the proof against each Merkle stamp on the root, preferring one
+	// that is still in force
+	iter, err := k.StampsByDocumentHash.Iterate(ctx, collections.NewPrefixedPairRange[string, string](merkleRoot))
+	if err != nil {
+		return types.MerkleInclusionVerification{}, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return types.MerkleInclusionVerification{}, err
+		}
+		stamp, err := k.GetStamp(ctx, key.K2())
+		if err != nil {
+			return types.MerkleInclusionVerification{}, err
+		}
+		if stamp.MerkleLeafCount == 0 {
+			continue
+		}
+		if !merkle.Verify(rootBytes, leafBytes, leafIndex, stamp.MerkleLeafCount, proofBytes) {
+			verification.Reason = "inclusion proof does not match the stamped root"
+			continue
+		}
+
+		verification.StampId = stamp.Id
+		if status := stamp.StatusAt(sdkCtx.BlockTime().Unix()); status != types.StampValid {
+			verification.Reason = fmt.Sprintf("stamp %s is %s", stamp.Id, status)
+			continue
+		}
+		verification.Valid = true
+		verification.Reason = "valid"
+		break
+	}
+
+	return verification, nil
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/merkle"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+func TestMerkleStamp(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+
+	leaves := make([][]byte, 2500)
+	for i := range leaves {
+		h := sha256.Sum256([]byte(fmt.Sprintf("as-built/%04d.pdf", i)))
+		leaves[i] = h[:]
+	}
+	rootBytes, err := merkle.Root(leaves)
+	require.NoError(t, err)
+	root := hex.EncodeToString(rootBytes)
+
+	msg := &types.MsgCreateMerkleStamp{
+		Creator:         creator,
+		MerkleRoot:      root,
+		LeafCount:       uint64(len(leaves)),
+		PePublicKey:     hex.EncodeToString(pe.Public().(ed25519.PublicKey)),
+		JurisdictionId:  "wisconsin",
+		PeLicenseNumber: "PE-12345",
+		PeName:          "Jane Doe",
+		Nonce:           1,
+	}
+
+	// A single-document signature over the root is not accepted
+	msg.Signature = hex.EncodeToString(ed25519.Sign(pe, types.StampSignBytes(testChainID, "wisconsin", "PE-12345", root, 0, 1)))
+	_, err = ms.CreateMerkleStamp(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	msg.Signature = hex.EncodeToString(ed25519.Sign(pe, types.MerkleStampSignBytes(testChainID, "wisconsin", "PE-12345", root, msg.LeafCount, 0, 1)))
+	res, err := ms.CreateMerkleStamp(f.ctx, msg)
+	require.NoError(t, err)
+
+	verify, err := qs.VerifyStamp(f.ctx, &types.QueryVerifyStampRequest{Id: res.StampId})
+	require.NoError(t, err)
+	require.True(t, verify.Verification.Valid)
+
+	inclusion := func(leaf []byte, index uint64, proof [][]byte) types.MerkleInclusionVerification {
+		t.Helper()
+		req := &types.QueryVerifyMerkleInclusionRequest{MerkleRoot: root, LeafHash: hex.EncodeToString(leaf), LeafIndex: index}
+		for _, node := range proof {
+			req.Proof = append(req.Proof, hex.EncodeToString(node))
+		}
+		res, err := qs.VerifyMerkleInclusion(f.ctx, req)
+		require.NoError(t, err)
+		return res.Verification
+	}
+
+	index, proof, err := merkle.Proof(leaves, leaves[1234])
+	require.NoError(t, err)
+	result := inclusion(leaves[1234], index, proof)
+	require.True(t, result.Valid, result.Reason)
+	require.Equal(t, res.StampId, result.StampId)
+
+	// A document outside the set does not verify
+	outside := sha256.Sum256([]byte("not-in-set.pdf"))
+	require.False(t, inclusion(outside[:], index, proof).Valid)
+
+	// Once the stamp is revoked, inclusion is no longer valid
+	_, err = ms.RevokeStamp(f.ctx, &types.MsgRevokeStamp{Creator: creator, StampId: res.StampId, ReasonCode: types.RevocationErrorInDesign})
+	require.NoError(t, err)
+	result = inclusion(leaves[1234], index, proof)
+	require.False(t, result.Valid)
+	require.Equal(t, res.StampId, result.StampId)
+
+	// Nor can the revoked root be stamped again by replaying the message
+	_, err = ms.CreateMerkleStamp(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrSignatureReused)
+}
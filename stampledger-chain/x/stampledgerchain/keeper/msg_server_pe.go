@@ -0,0 +1,333 @@
+package keeper
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// RegisterPE binds an Ed25519 stamp key to a licensed engineer and the
+// account that registers it
+func (k Keeper) RegisterPE(
+	ctx context.Context,
+	creator string,
+	publicKey string,
+	name string,
+	licenseNumber string,
+	jurisdictions []string,
+	popSignature string,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Decode and validate public key (Ed25519 = 32 bytes = 64 hex chars)
+	pubKeyBytes, err := hex.DecodeString(publicKey)
+	if err != nil || len(pubKeyBytes) != ed25519.PublicKeySize {
+		return types.ErrInvalidPublicKey.Wrap("invalid hex encoding or length")
+	}
+
+	// 2. Validate license number and name
+	if licenseNumber == "" {
+		return types.ErrInvalidLicenseNumber.Wrap("license number cannot be empty")
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := types.CheckLength("name", name, params.MaxNameLength); err != nil {
+		return err
+	}
+
+	// 3. Reject keys that are already registered
+	has, err := k.ProfessionalEngineers.Has(ctx, publicKey)
+	if err != nil {
+		return err
+	}
+	if has {
+		return types.ErrPEAlreadyRegistered.Wrapf("public key: %s", publicKey)
+	}
+
+	// 4. Verify proof of possession of the stamp key
+	sigBytes, err := hex.DecodeString(popSignature)
+	if err != nil || len(sigBytes) != ed25519.SignatureSize {
+		return types.ErrInvalidSignature.Wrap("invalid hex encoding or length")
+	}
+	signBytes := types.PERegistrationSignBytes(sdkCtx.ChainID(), creator, licenseNumber)
+	if !ed25519.Verify(pubKeyBytes, signBytes, sigBytes) {
+		return types.ErrInvalidSignature.Wrap("proof of possession verification failed")
+	}
+
+	// 5. Store the registration
+	pe := types.ProfessionalEngineer{
+		PublicKey:     publicKey,
+		Account:       creator,
+		Name:          name,
+		LicenseNumber: licenseNumber,
+		Jurisdictions: jurisdictions,
+		RegisteredAt:  sdkCtx.BlockTime().Unix(),
+	}
+	if err := k.ProfessionalEngineers.Set(ctx, publicKey, pe); err != nil {
+		return err
+	}
+
+	// 6. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"pe_registered",
+			sdk.NewAttribute("pe_public_key", publicKey),
+			sdk.NewAttribute("account", creator),
+			sdk.NewAttribute("license_number", licenseNumber),
+			sdk.NewAttribute("jurisdictions", strings.Join(jurisdictions, ",")),
+		),
+	)
+
+	return nil
+}
+
+// compromiseBatchSize bounds how many stamps a single MsgReportKeyCompromise
+// visits, keeping its gas cost predictable for PEs with large stamp histories
+const compromiseBatchSize = 200
+
+// RotatePEKey moves a PE's registration from an old stamp key to a new one.
+// Both keys sign the rotation so that neither can be hijacked.
+func (k Keeper) RotatePEKey(
+	ctx context.Context,
+	creator string,
+	oldPublicKey string,
+	newPublicKey string,
+	oldKeySignature string,
+	newKeySignature string,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Load the old key and verify creator owns it
+	oldPE, err := k.GetProfessionalEngineer(ctx, oldPublicKey)
+	if err != nil {
+		return err
+	}
+	if oldPE.Account != creator {
+		return types.ErrUnauthorized.Wrap("PE key is registered to a different account")
+	}
+	if oldPE.RetiredAt != 0 || oldPE.CompromisedSinceHeight != 0 {
+		return types.ErrPEKeyInactive.Wrapf("public key: %s", oldPublicKey)
+	}
+
+	// 2. Validate the new key and reject keys that are already registered
+	newPubKeyBytes, err := hex.DecodeString(newPublicKey)
+	if err != nil || len(newPubKeyBytes) != ed25519.PublicKeySize {
+		return types.ErrInvalidPublicKey.Wrap("invalid hex encoding or length")
+	}
+	has, err := k.ProfessionalEngineers.Has(ctx, newPublicKey)
+	if err != nil {
+		return err
+	}
+	if has {
+		return types.ErrPEAlreadyRegistered.Wrapf("public key: %s", newPublicKey)
+	}
+
+	// 3. Verify both keys signed the rotation
+	oldPubKeyBytes, _ := hex.DecodeString(oldPublicKey)
+	signBytes := types.KeyRotationSignBytes(sdkCtx.ChainID(), oldPublicKey, newPublicKey)
+	for _, check := range []struct {
+		pubKey    []byte
+		signature string
+	}{
+		{oldPubKeyBytes, oldKeySignature},
+		{newPubKeyBytes, newKeySignature},
+	} {
+		sigBytes, err := hex.DecodeString(check.signature)
+		if err != nil || len(sigBytes) != ed25519.SignatureSize {
+			return types.ErrInvalidSignature.Wrap("invalid hex encoding or length")
+		}
+		if !ed25519.Verify(check.pubKey, signBytes, sigBytes) {
+			return types.ErrInvalidSignature.Wrap("key rotation signature verification failed")
+		}
+	}
+
+	// 4. Retire the old key and link it to its successor
+	oldPE.SuccessorPublicKey = newPublicKey
+	oldPE.RetiredAt = sdkCtx.BlockTime().Unix()
+	if err := k.ProfessionalEngineers.Set(ctx, oldPublicKey, oldPE); err != nil {
+		return err
+	}
+
+	// 5. Register the new key with the same PE metadata
+	newPE := types.ProfessionalEngineer{
+		PublicKey:         newPublicKey,
+		Account:           oldPE.Account,
+		Name:              oldPE.Name,
+		LicenseNumber:     oldPE.LicenseNumber,
+		Jurisdictions:     oldPE.Jurisdictions,
+		RegisteredAt:      sdkCtx.BlockTime().Unix(),
+		PreviousPublicKey: oldPublicKey,
+	}
+	if err := k.ProfessionalEngineers.Set(ctx, newPublicKey, newPE); err != nil {
+		return err
+	}
+
+	// 6. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"pe_key_rotated",
+			sdk.NewAttribute("old_public_key", oldPublicKey),
+			sdk.NewAttribute("new_public_key", newPublicKey),
+			sdk.NewAttribute("account", creator),
+		),
+	)
+
+	return nil
+}
+
+// ReportKeyCompromise retires a leaked stamp key and revokes every stamp made
+// with it from compromisedSinceHeight on. At most compromiseBatchSize stamps
+// are visited per call; the returned flag reports whether any remain.
+func (k Keeper) ReportKeyCompromise(
+	ctx context.Context,
+	creator string,
+	publicKey string,
+	compromisedSinceHeight int64,
+) (uint64, bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Load the key and verify creator owns it
+	pe, err := k.GetProfessionalEngineer(ctx, publicKey)
+	if err != nil {
+		return 0, false, err
+	}
+	if pe.Account != creator {
+		return 0, false, types.ErrUnauthorized.Wrap("PE key is registered to a different account")
+	}
+
+	// 2. Record the compromise, or continue a previous report
+	if compromisedSinceHeight <= 0 {
+		return 0, false, types.ErrInvalidCompromise.Wrap("compromised_since_height must be positive")
+	}
+	if pe.CompromisedSinceHeight == 0 {
+		pe.CompromisedSinceHeight = compromisedSinceHeight
+		if pe.RetiredAt == 0 {
+			pe.RetiredAt = sdkCtx.BlockTime().Unix()
+		}
+	} else if pe.CompromisedSinceHeight != compromisedSinceHeight {
+		return 0, false, types.ErrInvalidCompromise.Wrapf("already reported from height %d", pe.CompromisedSinceHeight)
+	}
+
+	// 3. Collect the next batch of stamps under the key
+	rng := collections.NewPrefixedPairRange[string, string](publicKey)
+	if pe.CompromiseCursor != "" {
+		rng = rng.StartExclusive(pe.CompromiseCursor)
+	}
+	iter, err := k.StampsByPE.Iterate(ctx, rng)
+	if err != nil {
+		return 0, false, err
+	}
+	var stampIDs []string
+	for ; iter.Valid() && len(stampIDs) < compromiseBatchSize; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return 0, false, err
+		}
+		stampIDs = append(stampIDs, key.K2())
+	}
+	complete := !iter.Valid()
+	iter.Close()
+
+	// 4. Revoke the affected stamps in the batch
+	var revoked uint64
+	for _, stampID := range stampIDs {
+		stamp, err := k.Stamps.Get(ctx, stampID)
+		if err != nil {
+			return 0, false, err
+		}
+		if stamp.Revoked || stamp.CreatedHeight < compromisedSinceHeight {
+			continue
+		}
+
+		stamp.Revoked = true
+		stamp.RevokedAt = sdkCtx.BlockTime().Unix()
+		stamp.RevokedReason = "key compromised"
+		stamp.RevocationReason = types.RevocationKeyCompromise
+		stamp.RevokedBy = creator
+		if err := k.Stamps.Set(ctx, stampID, stamp); err != nil {
+			return 0, false, err
+		}
+		revoked++
+	}
+
+	// 5. Save progress
+	if len(stampIDs) > 0 {
+		pe.CompromiseCursor = stampIDs[len(stampIDs)-1]
+	}
+	pe.CompromiseComplete = complete
+	if err := k.ProfessionalEngineers.Set(ctx, publicKey, pe); err != nil {
+		return 0, false, err
+	}
+
+	// 6. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"pe_key_compromised",
+			sdk.NewAttribute("pe_public_key", publicKey),
+			sdk.NewAttribute("compromised_since_height", strconv.FormatInt(compromisedSinceHeight, 10)),
+			sdk.NewAttribute("revoked_count", strconv.FormatUint(revoked, 10)),
+			sdk.NewAttribute("complete", strconv.FormatBool(complete)),
+			sdk.NewAttribute("reported_by", creator),
+		),
+	)
+
+	return revoked, complete, nil
+}
+
+// GetProfessionalEngineer retrieves a registered PE by stamp public key
+func (k Keeper) GetProfessionalEngineer(ctx context.Context, publicKey string) (types.ProfessionalEngineer, error) {
+	pe, err := k.ProfessionalEngineers.Get(ctx, publicKey)
+	if err != nil {
+		return types.ProfessionalEngineer{}, types.ErrPENotRegistered.Wrapf("public key: %s", publicKey)
+	}
+	return pe, nil
+}
+
+// checkStampAgainstRegistry verifies that a stamp's key is registered to the
+// submitting account, or to a PE that delegated stamping to it, and that its
+// PE metadata matches the registry
+func (k Keeper) checkStampAgainstRegistry(
+	ctx context.Context,
+	creator string,
+	pePublicKey string,
+	peName string,
+	peLicenseNumber string,
+	jurisdictionId string,
+	projectName string,
+) (types.ProfessionalEngineer, error) {
+	pe, err := k.GetProfessionalEngineer(ctx, pePublicKey)
+	if err != nil {
+		return types.ProfessionalEngineer{}, err
+	}
+
+	if pe.Account != creator {
+		if err := k.checkStampingDelegation(ctx, pe.Account, creator, jurisdictionId, projectName); err != nil {
+			return types.ProfessionalEngineer{}, err
+		}
+	}
+	if pe.RetiredAt != 0 || pe.CompromisedSinceHeight != 0 {
+		return types.ProfessionalEngineer{}, types.ErrPEKeyInactive.Wrapf("public key: %s", pePublicKey)
+	}
+	if pe.LicenseNumber != peLicenseNumber {
+		return types.ProfessionalEngineer{}, types.ErrPEMismatch.Wrapf("license number %q does not match registry", peLicenseNumber)
+	}
+	if pe.Name != peName {
+		return types.ProfessionalEngineer{}, types.ErrPEMismatch.Wrapf("name %q does not match registry", peName)
+	}
+	if jurisdictionId != "" && !slices.Contains(pe.Jurisdictions, jurisdictionId) {
+		return types.ProfessionalEngineer{}, types.ErrPEMismatch.Wrapf("PE is not registered in jurisdiction %s", jurisdictionId)
+	}
+
+	return pe, nil
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+func TestRotatePEKey(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator := sample.AccAddress()
+	oldKey, newKey := newPEKey("pe-old"), newPEKey("pe-new")
+	f.registerPE(t, f.ctx, creator, oldKey)
+
+	oldHex := hex.EncodeToString(oldKey.Public().(ed25519.PublicKey))
+	newHex := hex.EncodeToString(newKey.Public().(ed25519.PublicKey))
+	signBytes := types.KeyRotationSignBytes(testChainID, oldHex, newHex)
+	msg := &types.MsgRotatePEKey{
+		Creator:         creator,
+		OldPublicKey:    oldHex,
+		NewPublicKey:    newHex,
+		OldKeySignature: hex.EncodeToString(ed25519.Sign(oldKey, signBytes)),
+		NewKeySignature: hex.EncodeToString(ed25519.Sign(newKey, signBytes)),
+	}
+
+	t.Run("other account", func(t *testing.T) {
+		bad := *msg
+		bad.Creator = sample.AccAddress()
+		_, err := ms.RotatePEKey(f.ctx, &bad)
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("new key must sign", func(t *testing.T) {
+		bad := *msg
+		bad.NewKeySignature = bad.OldKeySignature
+		_, err := ms.RotatePEKey(f.ctx, &bad)
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+	})
+
+	_, err := ms.RotatePEKey(f.ctx, msg)
+	require.NoError(t, err)
+
+	oldPE, err := f.keeper.GetProfessionalEngineer(f.ctx, oldHex)
+	require.NoError(t, err)
+	require.Equal(t, newHex, oldPE.SuccessorPublicKey)
+	newPE, err := f.keeper.GetProfessionalEngineer(f.ctx, newHex)
+	require.NoError(t, err)
+	require.Equal(t, oldHex, newPE.PreviousPublicKey)
+	require.Equal(t, oldPE.LicenseNumber, newPE.LicenseNumber)
+
+	// Only the new key may stamp
+	_, err = ms.CreateStamp(f.ctx, newCreateStampMsg(creator, oldKey, "sheet-A"))
+	require.ErrorIs(t, err, types.ErrPEKeyInactive)
+	_, err = ms.CreateStamp(f.ctx, newCreateStampMsg(creator, newKey, "sheet-A"))
+	require.NoError(t, err)
+
+	_, err = ms.RotatePEKey(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrPEKeyInactive)
+}
+
+func TestReportKeyCompromise(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+	peHex := hex.EncodeToString(pe.Public().(ed25519.PublicKey))
+
+	// 300 stamps at heights 1..300; the key leaked at height 51
+	const total, since = 300, 51
+	for i := 1; i <= total; i++ {
+		stamp := types.Stamp{Id: fmt.Sprintf("stamp-%03d", i), PePublicKey: peHex, Creator: creator, CreatedHeight: int64(i)}
+		require.NoError(t, f.keeper.Stamps.Set(f.ctx, stamp.Id, stamp))
+		require.NoError(t, f.keeper.StampsByPE.Set(f.ctx, collections.Join(peHex, stamp.Id), []byte{}))
+	}
+
+	msg := &types.MsgReportKeyCompromise{Creator: creator, PublicKey: peHex, CompromisedSinceHeight: since}
+
+	_, err := ms.ReportKeyCompromise(f.ctx, &types.MsgReportKeyCompromise{Creator: sample.AccAddress(), PublicKey: peHex, CompromisedSinceHeight: since})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	var revoked uint64
+	var calls int
+	for {
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+		res, err := ms.ReportKeyCompromise(ctx, msg)
+		require.NoError(t, err)
+		revoked += res.RevokedCount
+		calls++
+
+		compromiseEvents := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "pe_key_compromised" {
+				compromiseEvents++
+			}
+		}
+		require.Equal(t, 1, compromiseEvents)
+
+		if res.Complete {
+			break
+		}
+	}
+	require.Equal(t, 2, calls)
+	require.Equal(t, uint64(total-since+1), revoked)
+
+	before, err := f.keeper.GetStamp(f.ctx, "stamp-050")
+	require.NoError(t, err)
+	require.False(t, before.Revoked)
+	after, err := f.keeper.GetStamp(f.ctx, "stamp-051")
+	require.NoError(t, err)
+	require.True(t, after.Revoked)
+
+	// The key can no longer stamp and the report cannot be moved
+	_, err = ms.CreateStamp(f.ctx, newCreateStampMsg(creator, pe, "sheet-A"))
+	require.ErrorIs(t, err, types.ErrPEKeyInactive)
+	_, err = ms.ReportKeyCompromise(f.ctx, &types.MsgReportKeyCompromise{Creator: creator, PublicKey: peHex, CompromisedSinceHeight: 1})
+	require.ErrorIs(t, err, types.ErrInvalidCompromise)
+}
@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// RenewPin extends a document's pin by the pin duration from its current
+// expiry, or from now if it has lapsed, or pins it forever. Anyone may renew
+// a pin; the renewal pays the document's storage fee again.
+func (k Keeper) RenewPin(ctx context.Context, creator string, documentID string, pinForever bool) (int64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Load the document
+	doc, err := k.GetDocument(ctx, documentID)
+	if err != nil {
+		return 0, err
+	}
+	if doc.Pinned && doc.PinExpiresHeight == 0 {
+		return 0, sdkerrors.ErrInvalidRequest.Wrap("document is already pinned forever")
+	}
+
+	// 2. Work out the new expiry
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var expiresHeight int64
+	if !pinForever {
+		from := sdkCtx.BlockHeight()
+		if doc.Pinned && doc.PinExpiresHeight > from {
+			from = doc.PinExpiresHeight
+		}
+		expiresHeight = from + params.PinDuration
+	}
+
+	// 3. Charge the storage fee for the document's size bucket
+	if err := k.chargeModuleFee(ctx, creator, params.DocumentFee(doc.Size_), "pin renewal"); err != nil {
+		return 0, err
+	}
+
+	// 4. Store the pin and schedule its expiry
+	doc.Pinned = true
+	doc.PinExpiresHeight = expiresHeight
+	if err := k.Documents.Set(ctx, documentID, doc); err != nil {
+		return 0, err
+	}
+	if err := k.schedulePin(ctx, doc, params); err != nil {
+		return 0, err
+	}
+
+	// 5. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"document_pin_renewed",
+			sdk.NewAttribute("document_id", documentID),
+			sdk.NewAttribute("renewed_by", creator),
+			sdk.NewAttribute("pin_expires_height", strconv.FormatInt(expiresHeight, 10)),
+		),
+	)
+
+	return expiresHeight, nil
+}
+
+// Unpin releases a document's pin on behalf of the stamp's submitter or PE
+func (k Keeper) Unpin(ctx context.Context, creator string, documentID string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Load the document and verify creator manages the stamp's documents
+	doc, err := k.GetDocument(ctx, documentID)
+	if err != nil {
+		return err
+	}
+	stamp, err := k.Stamps.Get(ctx, doc.StampId)
+	if err != nil {
+		return types.ErrStampNotFound.Wrapf("stamp ID: %s", doc.StampId)
+	}
+	if !canManageDocuments(stamp, creator) {
+		return types.ErrUnauthorized.Wrap("only the stamp creator or its PE can unpin documents")
+	}
+	if !doc.Pinned {
+		return types.ErrDocumentNotPinned.Wrapf("document ID: %s", documentID)
+	}
+
+	// 2. Release the pin; its schedule entries go stale
+	doc.Pinned = false
+	doc.PinExpiresHeight = 0
+	if err := k.Documents.Set(ctx, documentID, doc); err != nil {
+		return err
+	}
+
+	// 3. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"document_unpinned",
+			sdk.NewAttribute("document_id", documentID),
+			sdk.NewAttribute("unpinned_by", creator),
+		),
+	)
+
+	return nil
+}
+
+// AttestPinned records a registered pinning provider's signed attestation that
+// it holds replicaCount replicas of a pinned document until pinnedUntilHeight.
+// A new attestation replaces the provider's previous one for the document.
+func (k Keeper) AttestPinned(
+	ctx context.Context,
+	creator string,
+	documentID string,
+	replicaCount uint32,
+	pinnedUntilHeight int64,
+	signature string,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Verify creator is a registered pinning provider
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	provider, ok := params.GetPinningProvider(creator)
+	if !ok {
+		return types.ErrNotPinningProvider.Wrapf("address: %s", creator)
+	}
+
+	// 2. Load the document, which must be pinned
+	doc, err := k.GetDocument(ctx, documentID)
+	if err != nil {
+		return err
+	}
+	if !doc.Pinned {
+		return types.ErrDocumentNotPinned.Wrapf("document ID: %s", documentID)
+	}
+
+	// 3. Validate the replica count and commitment
+	if replicaCount == 0 {
+		return types.ErrInvalidPinAttestation.Wrap("replica count must be positive")
+	}
+	if pinnedUntilHeight <= sdkCtx.BlockHeight() {
+		return types.ErrInvalidPinAttestation.Wrapf("pinned_until_height %d is not after the current height", pinnedUntilHeight)
+	}
+
+	// 4. Verify the provider's Ed25519 signature over the attestation
+	pubKeyBytes, err := hex.DecodeString(provider.PublicKey)
+	if err != nil || len(pubKeyBytes) != ed25519.PublicKeySize {
+		return types.ErrInvalidPublicKey.Wrap("invalid hex encoding or length")
+	}
+	sigBytes, err := hex.DecodeString(signature)
+	if err != nil || len(sigBytes) != ed25519.SignatureSize {
+		return types.ErrInvalidSignature.Wrap("invalid hex encoding or length")
+	}
+	signBytes := types.PinAttestationSignBytes(sdkCtx.ChainID(), creator, documentID, doc.IpfsHash, replicaCount, pinnedUntilHeight)
+	if !ed25519.Verify(pubKeyBytes, signBytes, sigBytes) {
+		return types.ErrInvalidSignature.Wrap("pin attestation signature verification failed")
+	}
+
+	// 5. Store the attestation
+	attestation := types.PinAttestation{
+		DocumentId:        documentID,
+		Provider:          creator,
+		ReplicaCount:      replicaCount,
+		PinnedUntilHeight: pinnedUntilHeight,
+		AttestedHeight:    sdkCtx.BlockHeight(),
+		Signature:         signature,
+	}
+	if err := k.PinAttestations.Set(ctx, collections.Join(documentID, creator), attestation); err != nil {
+		return err
+	}
+
+	// 6. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"document_pin_attested",
+			sdk.NewAttribute("document_id", documentID),
+			sdk.NewAttribute("provider", creator),
+			sdk.NewAttribute("replica_count", strconv.FormatUint(uint64(replicaCount), 10)),
+			sdk.NewAttribute("pinned_until_height", strconv.FormatInt(pinnedUntilHeight, 10)),
+		),
+	)
+
+	return nil
+}
+
+// GetPinAttestations returns a page of pinning provider attestations for a
+// document
+func (k Keeper) GetPinAttestations(ctx context.Context, documentID string, pagination *query.PageRequest) ([]types.PinAttestation, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.PinAttestations, pagination,
+		func(_ collections.Pair[string, string], attestation types.PinAttestation) (types.PinAttestation, error) {
+			return attestation, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](documentID),
+	)
+}
+
+// schedulePin queues the expiry warning and the expiry of a document's pin.
+// Entries left behind by a renewal or unpin are not removed; they go stale
+// and are skipped when they come due, as they no longer match the document's
+// expiry.
+func (k Keeper) schedulePin(ctx context.Context, doc types.DocumentStorage, params types.Params) error {
+	if !doc.Pinned || doc.PinExpiresHeight == 0 {
+		return nil
+	}
+	if params.PinExpiryWarning > 0 {
+		warnHeight := max(doc.PinExpiresHeight-params.PinExpiryWarning, sdk.UnwrapSDKContext(ctx).BlockHeight())
+		if err := k.PinSchedule.Set(ctx, collections.Join(warnHeight, doc.Id), doc.PinExpiresHeight); err != nil {
+			return err
+		}
+	}
+	return k.PinSchedule.Set(ctx, collections.Join(doc.PinExpiresHeight, doc.Id), doc.PinExpiresHeight)
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// pinEvents runs the EndBlocker at height and returns the IDs of the documents
+// in each pin event it emits, by event type.
+func pinEvents(t *testing.T, f *fixture, height int64) map[string][]string {
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	events := make(map[string][]string)
+	for _, event := range ctx.EventManager().Events() {
+		for _, attr := range event.Attributes {
+			if attr.Key == "document_id" {
+				events[event.Type] = append(events[event.Type], attr.Value)
+			}
+		}
+	}
+	return events
+}
+
+func TestPinLifecycle(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.PinDuration = 1000
+	params.PinExpiryWarning = 100
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	creator, stranger := sample.AccAddress(), sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, ctx, creator, pe)
+	stampRes, err := ms.CreateStamp(ctx, newCreateStampMsg(creator, pe, "sheet-A"))
+	require.NoError(t, err)
+	store := func(pinForever bool) string {
+		res, err := ms.StoreDocument(ctx, &types.MsgStoreDocument{
+			Creator:    creator,
+			StampId:    stampRes.StampId,
+			IpfsHash:   "QmbWqxBEKC3P8tqsKc98xmWNzrzDtRLMiMPL8wBuTGsMnR",
+			PinForever: pinForever,
+		})
+		require.NoError(t, err)
+		return res.DocumentId
+	}
+
+	// New documents are pinned for the pin duration, or forever
+	lapsing, renewed, forever := store(false), store(false), store(true)
+	doc, err := f.keeper.GetDocument(ctx, lapsing)
+	require.NoError(t, err)
+	require.True(t, doc.Pinned)
+	require.Equal(t, int64(1100), doc.PinExpiresHeight)
+	doc, err = f.keeper.GetDocument(ctx, forever)
+	require.NoError(t, err)
+	require.True(t, doc.Pinned)
+	require.Zero(t, doc.PinExpiresHeight)
+
+	_, err = ms.RenewPin(ctx, &types.MsgRenewPin{Creator: creator, DocumentId: forever})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Anyone can renew, extending from the current expiry
+	renewRes, err := ms.RenewPin(ctx.WithBlockHeight(500), &types.MsgRenewPin{Creator: stranger, DocumentId: renewed})
+	require.NoError(t, err)
+	require.Equal(t, int64(2100), renewRes.PinExpiresHeight)
+
+	// Warned ahead of expiry; the renewed pin's old schedule is skipped
+	require.Empty(t, pinEvents(t, f, 999))
+	require.Equal(t, map[string][]string{"document_pin_expiring": {lapsing}}, pinEvents(t, f, 1000))
+	require.Empty(t, pinEvents(t, f, 1001))
+
+	// Lapsed at expiry
+	require.Equal(t, map[string][]string{"document_pin_expired": {lapsing}}, pinEvents(t, f, 1100))
+	doc, err = f.keeper.GetDocument(ctx, lapsing)
+	require.NoError(t, err)
+	require.False(t, doc.Pinned)
+	require.Equal(t, map[string][]string{"document_pin_expiring": {renewed}}, pinEvents(t, f, 2000))
+
+	// A lapsed pin can be renewed from the current height
+	renewRes, err = ms.RenewPin(ctx.WithBlockHeight(1200), &types.MsgRenewPin{Creator: creator, DocumentId: lapsing})
+	require.NoError(t, err)
+	require.Equal(t, int64(2200), renewRes.PinExpiresHeight)
+
+	// Only the stamp's submitter or PE can unpin
+	_, err = ms.Unpin(ctx, &types.MsgUnpin{Creator: stranger, DocumentId: renewed})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.Unpin(ctx, &types.MsgUnpin{Creator: creator, DocumentId: renewed})
+	require.NoError(t, err)
+	_, err = ms.Unpin(ctx, &types.MsgUnpin{Creator: creator, DocumentId: renewed})
+	require.ErrorIs(t, err, types.ErrDocumentNotPinned)
+	doc, err = f.keeper.GetDocument(ctx, renewed)
+	require.NoError(t, err)
+	require.False(t, doc.Pinned)
+	require.Zero(t, doc.PinExpiresHeight)
+
+	// An unpinned document's schedule is skipped
+	require.Equal(t, map[string][]string{"document_pin_expiring": {lapsing}}, pinEvents(t, f, 2100))
+	require.Equal(t, map[string][]string{"document_pin_expired": {lapsing}}, pinEvents(t, f, 2200))
+}
+
+func TestAttestPinned(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+
+	provider := sample.AccAddress()
+	providerKey := newPEKey("provider")
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.PinningProviders = []types.PinningProvider{{
+		Address:   provider,
+		Name:      "Pinning Co",
+		PublicKey: hex.EncodeToString(providerKey.Public().(ed25519.PublicKey)),
+	}}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, ctx, creator, pe)
+	stampRes, err := ms.CreateStamp(ctx, newCreateStampMsg(creator, pe, "sheet-A"))
+	require.NoError(t, err)
+	docRes, err := ms.StoreDocument(ctx, &types.MsgStoreDocument{
+		Creator:  creator,
+		StampId:  stampRes.StampId,
+		IpfsHash: "QmbWqxBEKC3P8tqsKc98xmWNzrzDtRLMiMPL8wBuTGsMnR",
+	})
+	require.NoError(t, err)
+
+	attest := func(from string, key ed25519.PrivateKey, replicas uint32, until int64) error {
+		signBytes := types.PinAttestationSignBytes(testChainID, from, docRes.DocumentId,
+			"bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", replicas, until)
+		_, err := ms.AttestPinned(ctx, &types.MsgAttestPinned{
+			Creator:           from,
+			DocumentId:        docRes.DocumentId,
+			ReplicaCount:      replicas,
+			PinnedUntilHeight: until,
+			Signature:         hex.EncodeToString(ed25519.Sign(key, signBytes)),
+		})
+		return err
+	}
+
+	require.ErrorIs(t, attest(creator, providerKey, 3, 5000), types.ErrNotPinningProvider)
+	require.ErrorIs(t, attest(provider, newPEKey("someone-else"), 3, 5000), types.ErrInvalidSignature)
+	require.ErrorIs(t, attest(provider, providerKey, 3, 100), types.ErrInvalidPinAttestation)
+	require.NoError(t, attest(provider, providerKey, 3, 5000))
+
+	// A new attestation replaces the provider's previous one
+	require.NoError(t, attest(provider, providerKey, 5, 6000))
+	res, err := qs.PinAttestations(ctx, &types.QueryPinAttestationsRequest{DocumentId: docRes.DocumentId})
+	require.NoError(t, err)
+	require.Len(t, res.Attestations, 1)
+	require.Equal(t, uint32(5), res.Attestations[0].ReplicaCount)
+	require.Equal(t, int64(6000), res.Attestations[0].PinnedUntilHeight)
+	require.Equal(t, int64(100), res.Attestations[0].AttestedHeight)
+
+	// Unpinned documents cannot be attested
+	_, err = ms.Unpin(ctx, &types.MsgUnpin{Creator: creator, DocumentId: docRes.DocumentId})
+	require.NoError(t, err)
+	require.ErrorIs(t, attest(provider, providerKey, 5, 6000), types.ErrDocumentNotPinned)
+}
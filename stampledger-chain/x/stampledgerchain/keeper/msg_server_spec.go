@@ -2,11 +2,10 @@ package keeper
 
 import (
 	"context"
-	"time"
 
 	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/google/uuid"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"stampledger-chain/x/stampledgerchain/types"
 )
@@ -21,6 +20,7 @@ func (k Keeper) CreateSpecVersion(
 	specIpfs string,
 	changelog string,
 	parentVersionID string,
+	entityID string,
 ) (string, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -32,39 +32,58 @@ func (k Keeper) CreateSpecVersion(
 		}
 	}
 
-	// 2. Validate version format (basic check)
+	// 2. Validate version format (basic check) and changelog length
 	if len(version) == 0 {
 		return "", types.ErrInvalidVersion.Wrap("version cannot be empty")
 	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := types.CheckLength("changelog", changelog, params.MaxChangelogLength); err != nil {
+		return "", err
+	}
+
+	// 3. Verify the creator's role in the entity, if creating under one,
+	// permits it
+	if entityID != "" {
+		if err := k.requireEntityPermission(ctx, entityID, creator, types.EntityActionCreateSpecVersion); err != nil {
+			return "", err
+		}
+	}
 
-	// 3. Generate version ID
-	versionID := uuid.New().String()
+	// 4. Generate version ID
+	versionID, err := k.nextID(ctx)
+	if err != nil {
+		return "", err
+	}
 
-	// 4. Create version record
+	// 5. Create version record
 	spec := types.SpecVersion{
 		Id:              versionID,
 		ProjectId:       projectID,
 		Version:         version,
 		SpecHash:        specHash,
 		SpecIpfs:        specIpfs,
-		CreatedAt:       time.Now().Unix(),
+		CreatedAt:       sdkCtx.BlockTime().Unix(),
 		CreatedBy:       creator,
 		Changelog:       changelog,
 		ParentVersionId: parentVersionID,
+		EntityId:        entityID,
 	}
 
-	// 5. Store spec version
+	// 6. Store spec version
 	if err := k.SpecVersions.Set(ctx, versionID, spec); err != nil {
 		return "", err
 	}
 
-	// 6. Index by project
+	// 7. Index by project
 	projectVersionKey := collections.Join(projectID, versionID)
 	if err := k.SpecVersionsByProject.Set(ctx, projectVersionKey, []byte{}); err != nil {
 		return "", err
 	}
 
-	// 7. Emit event
+	// 8. Emit event
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"spec_version_created",
@@ -87,32 +106,15 @@ func (k Keeper) GetSpecVersion(ctx context.Context, versionID string) (types.Spe
 	return spec, nil
 }
 
-// GetSpecVersionsByProject returns all versions for a project
-func (k Keeper) GetSpecVersionsByProject(ctx context.Context, projectID string) ([]types.SpecVersion, error) {
-	var versions []types.SpecVersion
-
-	rng := collections.NewPrefixedPairRange[string, string](projectID)
-	iter, err := k.SpecVersionsByProject.Iterate(ctx, rng)
-	if err != nil {
-		return nil, err
-	}
-	defer iter.Close()
-
-	for ; iter.Valid(); iter.Next() {
-		key, err := iter.Key()
-		if err != nil {
-			return nil, err
-		}
-		versionID := key.K2()
-
-		spec, err := k.SpecVersions.Get(ctx, versionID)
-		if err != nil {
-			continue
-		}
-		versions = append(versions, spec)
-	}
-
-	return versions, nil
+// GetSpecVersionsByProject returns a page of versions for a project
+func (k Keeper) GetSpecVersionsByProject(ctx context.Context, projectID string, pagination *query.PageRequest) ([]types.SpecVersion, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.SpecVersionsByProject, pagination,
+		func(key collections.Pair[string, string], _ []byte) (types.SpecVersion, error) {
+			return k.GetSpecVersion(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](projectID),
+	)
 }
 
 // GetSpecHistory returns the version history starting from a version and tracing back
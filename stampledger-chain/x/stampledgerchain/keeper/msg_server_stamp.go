@@ -3,13 +3,13 @@ package keeper
 import (
 	"context"
 	"crypto/ed25519"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
-	"time"
 
 	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/google/uuid"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"stampledger-chain/x/stampledgerchain/types"
 )
@@ -28,6 +28,10 @@ func (k Keeper) CreateStamp(
 	documentIpfsHash string,
 	documentSize int64,
 	documentFilename string,
+	signatureExpiry int64,
+	nonce uint64,
+	validUntil int64,
+	entityID string,
 ) (string, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -57,23 +61,77 @@ func (k Keeper) CreateStamp(
 		return "", types.ErrInvalidSignature.Wrap("invalid hex encoding or length")
 	}
 
-	// 4. Verify Ed25519 signature
-	hashBytes, _ := hex.DecodeString(documentHash)
-	if !ed25519.Verify(pubKeyBytes, hashBytes, sigBytes) {
-		return "", types.ErrInvalidSignature.Wrap("signature verification failed")
+	// 4. Check the descriptive fields against the governance-set limits
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := params.CheckStampFields(peName, projectName, documentFilename, documentSize); err != nil {
+		return "", err
+	}
+
+	// 5. Verify Ed25519 signature over the versioned sign bytes, falling back to
+	// the raw document hash only while governance allows legacy signatures
+	if validUntil != 0 && validUntil <= sdkCtx.BlockTime().Unix() {
+		return "", types.ErrInvalidValidUntil.Wrapf("valid_until %d is not after block time", validUntil)
+	}
+	if signatureExpiry != 0 && sdkCtx.BlockTime().Unix() > signatureExpiry {
+		return "", types.ErrSignatureExpired.Wrapf("expired at %d", signatureExpiry)
+	}
+	signBytes := types.StampSignBytes(sdkCtx.ChainID(), jurisdictionId, peLicenseNumber, documentHash, signatureExpiry, nonce)
+	signBytesVersion := types.StampSignBytesVersion
+	if !ed25519.Verify(pubKeyBytes, signBytes, sigBytes) {
+		hashBytes, _ := hex.DecodeString(documentHash)
+		if !params.AllowLegacySignatures || !ed25519.Verify(pubKeyBytes, hashBytes, sigBytes) {
+			return "", types.ErrInvalidSignature.Wrap("signature verification failed")
+		}
+		signBytesVersion = 0
+	}
+
+	// 6. Verify the key is registered to the creator, or delegated to it, and
+	// matches the PE metadata
+	pe, err := k.checkStampAgainstRegistry(ctx, creator, pePublicKey, peName, peLicenseNumber, jurisdictionId, projectName)
+	if err != nil {
+		return "", err
 	}
 
-	// 5. Generate unique stamp ID
-	stampID := uuid.New().String()
+	// 7. Verify the jurisdiction's board has attested the license and not suspended it
+	if status := k.getLicenseStatus(ctx, jurisdictionId, peLicenseNumber, pe.Account); status != types.LicenseActive {
+		return "", types.ErrLicenseNotActive.Wrapf("%s license %s is %s", jurisdictionId, peLicenseNumber, status)
+	}
+
+	// 8. Verify the creator's role in the entity, if issuing under one,
+	// permits stamping
+	if entityID != "" {
+		if err := k.requireEntityPermission(ctx, entityID, creator, types.EntityActionStamp); err != nil {
+			return "", err
+		}
+	}
+
+	// 9. Reject an exact duplicate: same document already stamped by this PE
+	// with a stamp that is still in force, or a replayed signature
+	if err := k.checkDuplicateStamp(ctx, documentHash, pePublicKey); err != nil {
+		return "", err
+	}
+	if err := k.checkSignatureUnused(ctx, pePublicKey, sigBytes); err != nil {
+		return "", err
+	}
+
+	// 10. Generate unique stamp ID
+	stampID, err := k.nextID(ctx)
+	if err != nil {
+		return "", err
+	}
 
-	// 6. Create stamp record
+	// 11. Create stamp record
 	stamp := types.Stamp{
 		Id:               stampID,
 		DocumentHash:     documentHash,
 		PePublicKey:      pePublicKey,
 		Signature:        signature,
 		JurisdictionId:   jurisdictionId,
-		CreatedAt:        time.Now().Unix(),
+		CreatedAt:        sdkCtx.BlockTime().Unix(),
+		CreatedHeight:    sdkCtx.BlockHeight(),
 		Creator:          creator,
 		Revoked:          false,
 		PeLicenseNumber:  peLicenseNumber,
@@ -82,28 +140,21 @@ func (k Keeper) CreateStamp(
 		DocumentIpfsHash: documentIpfsHash,
 		DocumentSize:     documentSize,
 		DocumentFilename: documentFilename,
+		SignBytesVersion: signBytesVersion,
+		SignatureExpiry:  signatureExpiry,
+		Nonce:            nonce,
+		ValidUntil:       validUntil,
+		PeAccount:        pe.Account,
+		Delegate:         delegateOf(pe, creator),
+		EntityId:         entityID,
 	}
 
-	// 7. Store the stamp
-	if err := k.Stamps.Set(ctx, stampID, stamp); err != nil {
+	// 12. Charge the stamp fee, then store and index the stamp
+	if err := k.storeNewStamp(ctx, stamp); err != nil {
 		return "", err
 	}
 
-	// 8. Index by PE public key
-	peStampKey := collections.Join(pePublicKey, stampID)
-	if err := k.StampsByPE.Set(ctx, peStampKey, []byte{}); err != nil {
-		return "", err
-	}
-
-	// 9. Index by jurisdiction
-	if jurisdictionId != "" {
-		jurisdictionStampKey := collections.Join(jurisdictionId, stampID)
-		if err := k.StampsByJurisdiction.Set(ctx, jurisdictionStampKey, []byte{}); err != nil {
-			return "", err
-		}
-	}
-
-	// 10. Emit event
+	// 13. Emit event
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"stamp_created",
@@ -111,60 +162,344 @@ func (k Keeper) CreateStamp(
 			sdk.NewAttribute("pe_public_key", pePublicKey),
 			sdk.NewAttribute("jurisdiction", jurisdictionId),
 			sdk.NewAttribute("creator", creator),
+			sdk.NewAttribute("entity_id", entityID),
+		),
+	)
+
+	return stampID, nil
+}
+
+// delegateOf returns the submitting account if it is not the PE's own
+func delegateOf(pe types.ProfessionalEngineer, creator string) string {
+	if pe.Account == creator {
+		return ""
+	}
+	return creator
+}
+
+// checkDuplicateStamp rejects a stamp on a document hash that the PE key
+// already has a stamp in force on
+func (k Keeper) checkDuplicateStamp(ctx context.Context, documentHash string, pePublicKey string) error {
+	rng := collections.NewPrefixedPairRange[string, string](documentHash)
+	iter, err := k.StampsByDocumentHash.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return err
+		}
+		existing, err := k.Stamps.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if existing.HasSigner(pePublicKey) && !existing.Revoked && existing.SupersededBy == "" {
+			return types.ErrDuplicateStamp.Wrapf("existing stamp ID: %s", existing.Id)
+		}
+	}
+	return nil
+}
+
+// checkSignatureUnused rejects a signature the PE key has already stamped
+// with. The signature covers the signer-chosen nonce, so an engineer restamps
+// the same document under a fresh nonce, while a resubmitted message, even of
+// a revoked or superseded stamp, is refused.
+func (k Keeper) checkSignatureUnused(ctx context.Context, pePublicKey string, sigBytes []byte) error {
+	used, err := k.UsedSignatures.Has(ctx, collections.Join(pePublicKey, signatureDigest(sigBytes)))
+	if err != nil {
+		return err
+	}
+	if used {
+		return types.ErrSignatureReused.Wrapf("PE public key %s", pePublicKey)
+	}
+	return nil
+}
+
+// recordStampSignature marks the stamp's signature as used by its PE key.
+// Co-sealed stamps carry no signature of their own and are skipped.
+func (k Keeper) recordStampSignature(ctx context.Context, stamp types.Stamp) error {
+	if stamp.Signature == "" {
+		return nil
+	}
+	sigBytes, err := hex.DecodeString(stamp.Signature)
+	if err != nil {
+		return types.ErrInvalidSignature.Wrapf("stamp %s: invalid hex encoding", stamp.Id)
+	}
+	return k.UsedSignatures.Set(ctx, collections.Join(stamp.PePublicKey, signatureDigest(sigBytes)), []byte{})
+}
+
+// signatureDigest returns the hex SHA-256 of a signature, which keys the used
+// signature set independently of the hex case it was submitted in
+func signatureDigest(sigBytes []byte) string {
+	digest := sha256.Sum256(sigBytes)
+	return hex.EncodeToString(digest[:])
+}
+
+// storeNewStamp charges the creator the stamp fee, stores a new stamp and
+// indexes it by each signer's PE public key, jurisdiction, document hash and
+// entity, queueing it for expiry if it has a valid_until and recording its
+// signature as used
+func (k Keeper) storeNewStamp(ctx context.Context, stamp types.Stamp) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.chargeModuleFee(ctx, stamp.Creator, params.StampFee, "stamp"); err != nil {
+		return err
+	}
+
+	if err := k.Stamps.Set(ctx, stamp.Id, stamp); err != nil {
+		return err
+	}
+	for _, pePublicKey := range stamp.SignerKeys() {
+		if err := k.StampsByPE.Set(ctx, collections.Join(pePublicKey, stamp.Id), []byte{}); err != nil {
+			return err
+		}
+	}
+	if stamp.JurisdictionId != "" {
+		if err := k.StampsByJurisdiction.Set(ctx, collections.Join(stamp.JurisdictionId, stamp.Id), []byte{}); err != nil {
+			return err
+		}
+	}
+	if err := k.StampsByDocumentHash.Set(ctx, collections.Join(stamp.DocumentHash, stamp.Id), []byte{}); err != nil {
+		return err
+	}
+	if stamp.ValidUntil != 0 {
+		if err := k.StampsByExpiry.Set(ctx, collections.Join(stamp.ValidUntil, stamp.Id), []byte{}); err != nil {
+			return err
+		}
+	}
+	if stamp.EntityId != "" {
+		if err := k.StampsByEntity.Set(ctx, collections.Join(stamp.EntityId, stamp.Id), []byte{}); err != nil {
+			return err
+		}
+	}
+	return k.recordStampSignature(ctx, stamp)
+}
+
+// SupersedeStamp creates a new stamp that replaces an existing one. The old
+// stamp is marked superseded rather than revoked: it was valid when made, but
+// the new stamp is now the one in force.
+func (k Keeper) SupersedeStamp(
+	ctx context.Context,
+	creator string,
+	supersededStampID string,
+	documentHash string,
+	pePublicKey string,
+	signature string,
+	jurisdictionId string,
+	peLicenseNumber string,
+	peName string,
+	projectName string,
+	documentIpfsHash string,
+	documentSize int64,
+	documentFilename string,
+	signatureExpiry int64,
+	nonce uint64,
+	validUntil int64,
+) (string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Get the stamp being replaced
+	old, err := k.GetStamp(ctx, supersededStampID)
+	if err != nil {
+		return "", err
+	}
+
+	// 2. Only a stamp still in force can be superseded
+	if old.Revoked {
+		return "", types.ErrStampAlreadyRevoked.Wrapf("stamp ID: %s", supersededStampID)
+	}
+	if old.SupersededBy != "" {
+		return "", types.ErrStampAlreadySuperseded.Wrapf("stamp ID: %s, superseded by %s", supersededStampID, old.SupersededBy)
+	}
+
+	// 3. Verify creator is authorized (must be the original creator)
+	if old.Creator != creator {
+		return "", types.ErrUnauthorized.Wrap("only the stamp creator can supersede")
+	}
+
+	// 4. Create the replacement stamp, under the same entity as the old one
+	stampID, err := k.CreateStamp(
+		ctx,
+		creator,
+		documentHash,
+		pePublicKey,
+		signature,
+		jurisdictionId,
+		peLicenseNumber,
+		peName,
+		projectName,
+		documentIpfsHash,
+		documentSize,
+		documentFilename,
+		signatureExpiry,
+		nonce,
+		validUntil,
+		old.EntityId,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	// 5. Link the two stamps
+	stamp, err := k.GetStamp(ctx, stampID)
+	if err != nil {
+		return "", err
+	}
+	stamp.Supersedes = supersededStampID
+	if err := k.Stamps.Set(ctx, stampID, stamp); err != nil {
+		return "", err
+	}
+	old.SupersededBy = stampID
+	if err := k.Stamps.Set(ctx, supersededStampID, old); err != nil {
+		return "", err
+	}
+
+	// 6. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"stamp_superseded",
+			sdk.NewAttribute("stamp_id", supersededStampID),
+			sdk.NewAttribute("successor_id", stampID),
+			sdk.NewAttribute("superseded_by", creator),
 		),
 	)
 
 	return stampID, nil
 }
 
-// RevokeStamp revokes an existing stamp
+// RevokeStamp revokes an existing stamp. The sender must be the stamp
+// creator, an admin of the entity the stamp was issued under, or a board
+// address of the stamp's jurisdiction, and that authority must be allowed to
+// use reasonCode according to types.RevocationMatrix.
 func (k Keeper) RevokeStamp(
 	ctx context.Context,
 	creator string,
 	stampID string,
+	reasonCode types.RevocationReason,
 	reason string,
+	evidenceHash string,
+	entityID string,
 ) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	// 1. Get the stamp
+	// 1. Validate reason and evidence hash (SHA-256 = 64 hex chars)
+	if !reasonCode.IsValid() {
+		return types.ErrInvalidRevocation.Wrapf("unknown reason %s", reasonCode)
+	}
+	if evidenceHash != "" {
+		if len(evidenceHash) != 64 {
+			return types.ErrInvalidRevocation.Wrapf("evidence hash: got %d chars, expected 64", len(evidenceHash))
+		}
+		if _, err := hex.DecodeString(evidenceHash); err != nil {
+			return types.ErrInvalidRevocation.Wrap("evidence hash is not valid hex encoding")
+		}
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := types.CheckLength("reason", reason, params.MaxReasonLength); err != nil {
+		return err
+	}
+
+	// 2. Get the stamp
 	stamp, err := k.Stamps.Get(ctx, stampID)
 	if err != nil {
 		return types.ErrStampNotFound.Wrapf("stamp ID: %s", stampID)
 	}
 
-	// 2. Check if already revoked
+	// 3. Check if already revoked
 	if stamp.Revoked {
 		return types.ErrStampAlreadyRevoked.Wrapf("stamp ID: %s", stampID)
 	}
 
-	// 3. Verify creator is authorized (must be the original creator or an admin)
-	if stamp.Creator != creator {
-		return types.ErrUnauthorized.Wrap("only the stamp creator can revoke")
+	// 4. Verify creator holds an authority that may revoke for this reason
+	authority, err := k.revocationAuthority(ctx, creator, stamp, reasonCode, entityID)
+	if err != nil {
+		return err
 	}
 
-	// 4. Update stamp
+	// 5. Update stamp
 	stamp.Revoked = true
-	stamp.RevokedAt = time.Now().Unix()
+	stamp.RevokedAt = sdkCtx.BlockTime().Unix()
 	stamp.RevokedReason = reason
+	stamp.RevocationReason = reasonCode
+	stamp.RevocationEvidenceHash = evidenceHash
+	stamp.RevokedBy = creator
 
-	// 5. Save updated stamp
+	// 6. Save updated stamp
 	if err := k.Stamps.Set(ctx, stampID, stamp); err != nil {
 		return err
 	}
 
-	// 6. Emit event
+	// 7. Emit event
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"stamp_revoked",
 			sdk.NewAttribute("stamp_id", stampID),
+			sdk.NewAttribute("reason_code", reasonCode.String()),
 			sdk.NewAttribute("reason", reason),
+			sdk.NewAttribute("evidence_hash", evidenceHash),
 			sdk.NewAttribute("revoked_by", creator),
+			sdk.NewAttribute("authority", string(authority)),
 		),
 	)
 
 	return nil
 }
 
+// revocationAuthority returns the first authority addr holds over the stamp
+// that may revoke it for reason
+func (k Keeper) revocationAuthority(
+	ctx context.Context,
+	addr string,
+	stamp types.Stamp,
+	reason types.RevocationReason,
+	entityID string,
+) (types.RevocationAuthority, error) {
+	var held []types.RevocationAuthority
+
+	// 1. The stamp creator, or the PE it was stamped on behalf of
+	if stamp.Creator == addr || (stamp.PeAccount != "" && stamp.PeAccount == addr) {
+		held = append(held, types.RevokerCreator)
+	}
+
+	// 2. An admin of the entity the stamp was issued under, whatever the
+	// creator's current membership
+	if entityID != "" && entityID != stamp.EntityId {
+		return "", types.ErrUnauthorized.Wrapf("stamp was not issued under entity %s", entityID)
+	}
+	if stamp.EntityId != "" {
+		role, err := k.memberRole(ctx, stamp.EntityId, addr)
+		if err != nil {
+			return "", err
+		}
+		if role == "admin" {
+			held = append(held, types.RevokerEntityAdmin)
+		}
+	}
+
+	// 3. A board address of the stamp's jurisdiction
+	if stamp.JurisdictionId != "" && k.requireBoard(ctx, addr, stamp.JurisdictionId) == nil {
+		held = append(held, types.RevokerJurisdictionBoard)
+	}
+
+	if len(held) == 0 {
+		return "", types.ErrUnauthorized.Wrap("only the stamp creator, an entity admin or the jurisdiction board can revoke")
+	}
+	for _, authority := range held {
+		if authority.CanRevoke(reason) {
+			return authority, nil
+		}
+	}
+	return "", types.ErrUnauthorized.Wrapf("%s may not revoke for reason %s", held[0], reason)
+}
+
 // GetStamp retrieves a stamp by ID
 func (k Keeper) GetStamp(ctx context.Context, stampID string) (types.Stamp, error) {
 	stamp, err := k.Stamps.Get(ctx, stampID)
@@ -174,82 +509,169 @@ func (k Keeper) GetStamp(ctx context.Context, stampID string) (types.Stamp, erro
 	return stamp, nil
 }
 
-// GetStampsByPE returns all stamps created by a PE
-func (k Keeper) GetStampsByPE(ctx context.Context, pePublicKey string) ([]types.Stamp, error) {
-	var stamps []types.Stamp
-
-	// Iterate over stamps by PE index
-	rng := collections.NewPrefixedPairRange[string, string](pePublicKey)
-	iter, err := k.StampsByPE.Iterate(ctx, rng)
-	if err != nil {
-		return nil, err
-	}
-	defer iter.Close()
+// GetStampsByPE returns a page of stamps created by a PE
+func (k Keeper) GetStampsByPE(ctx context.Context, pePublicKey string, pagination *query.PageRequest) ([]types.Stamp, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.StampsByPE, pagination,
+		func(key collections.Pair[string, string], _ []byte) (types.Stamp, error) {
+			return k.GetStamp(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](pePublicKey),
+	)
+}
 
-	for ; iter.Valid(); iter.Next() {
-		key, err := iter.Key()
-		if err != nil {
-			return nil, err
-		}
-		stampID := key.K2()
+// GetStampsByJurisdiction returns a page of stamps for a jurisdiction
+func (k Keeper) GetStampsByJurisdiction(ctx context.Context, jurisdictionId string, pagination *query.PageRequest) ([]types.Stamp, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.StampsByJurisdiction, pagination,
+		func(key collections.Pair[string, string], _ []byte) (types.Stamp, error) {
+			return k.GetStamp(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](jurisdictionId),
+	)
+}
 
-		stamp, err := k.Stamps.Get(ctx, stampID)
-		if err != nil {
-			continue // Skip if stamp not found (shouldn't happen)
-		}
-		stamps = append(stamps, stamp)
-	}
+// GetStampsByEntity returns a page of stamps issued under an entity
+func (k Keeper) GetStampsByEntity(ctx context.Context, entityID string, pagination *query.PageRequest) ([]types.Stamp, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.StampsByEntity, pagination,
+		func(key collections.Pair[string, string], _ []byte) (types.Stamp, error) {
+			return k.GetStamp(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](entityID),
+	)
+}
 
-	return stamps, nil
+// GetStampsByDocumentHash returns a page of stamps on a document hash
+func (k Keeper) GetStampsByDocumentHash(ctx context.Context, documentHash string, pagination *query.PageRequest) ([]types.Stamp, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.StampsByDocumentHash, pagination,
+		func(key collections.Pair[string, string], _ []byte) (types.Stamp, error) {
+			return k.GetStamp(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](documentHash),
+	)
 }
 
-// GetStampsByJurisdiction returns all stamps for a jurisdiction
-func (k Keeper) GetStampsByJurisdiction(ctx context.Context, jurisdictionId string) ([]types.Stamp, error) {
-	var stamps []types.Stamp
+// VerifyStamp verifies a stamp's authenticity and lifecycle status, and
+// reports the PE's license status when the stamp was created and now
+func (k Keeper) VerifyStamp(ctx context.Context, stampID string) (types.StampVerification, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	rng := collections.NewPrefixedPairRange[string, string](jurisdictionId)
-	iter, err := k.StampsByJurisdiction.Iterate(ctx, rng)
+	stamp, err := k.Stamps.Get(ctx, stampID)
 	if err != nil {
-		return nil, err
+		return types.StampVerification{}, types.ErrStampNotFound.Wrapf("stamp ID: %s", stampID)
 	}
-	defer iter.Close()
 
-	for ; iter.Valid(); iter.Next() {
-		key, err := iter.Key()
-		if err != nil {
-			return nil, err
-		}
-		stampID := key.K2()
+	verification := types.StampVerification{
+		StampId:              stampID,
+		LicenseStatusAtStamp: types.LicenseUnattested,
+		LicenseStatusNow:     types.LicenseUnattested,
+	}
+	if len(stamp.CoSigners) == 0 {
+		verification.LicenseStatusAtStamp, verification.LicenseStatusNow = k.peKeyLicenseStatus(
+			ctx, stamp.JurisdictionId, stamp.PeLicenseNumber, stamp.PePublicKey, stamp.CreatedAt,
+		)
+	}
 
-		stamp, err := k.Stamps.Get(ctx, stampID)
-		if err != nil {
-			continue
+	// Co-sealed stamps report each signer, re-verifying their signatures and
+	// licenses, and are only as licensed as their weakest signer
+	var validCoSignatures, signedCount uint32
+	for _, signer := range stamp.CoSigners {
+		result := types.CoSignerVerification{
+			PePublicKey:            signer.PePublicKey,
+			Discipline:             signer.Discipline,
+			PeName:                 signer.PeName,
+			Signed:                 signer.Signature != "",
+			LicenseStatusNow:       types.LicenseUnattested,
+			LicenseStatusAtSigning: types.LicenseUnattested,
+		}
+		if result.Signed {
+			pubKeyBytes, _ := hex.DecodeString(signer.PePublicKey)
+			sigBytes, _ := hex.DecodeString(signer.Signature)
+			result.SignatureValid = ed25519.Verify(pubKeyBytes, coSignBytes(sdkCtx.ChainID(), stamp, signer), sigBytes)
+			result.LicenseStatusAtSigning, result.LicenseStatusNow = k.peKeyLicenseStatus(
+				ctx, stamp.JurisdictionId, signer.PeLicenseNumber, signer.PePublicKey, signer.SignedAt,
+			)
+			if signedCount == 0 || verification.LicenseStatusAtStamp == types.LicenseActive {
+				verification.LicenseStatusAtStamp = result.LicenseStatusAtSigning
+			}
+			if signedCount == 0 || verification.LicenseStatusNow == types.LicenseActive {
+				verification.LicenseStatusNow = result.LicenseStatusNow
+			}
+			signedCount++
 		}
-		stamps = append(stamps, stamp)
+		if result.SignatureValid {
+			validCoSignatures++
+		}
+		verification.CoSigners = append(verification.CoSigners, result)
 	}
 
-	return stamps, nil
-}
-
-// VerifyStamp verifies a stamp's authenticity
-func (k Keeper) VerifyStamp(ctx context.Context, stampID string) (bool, string, error) {
-	stamp, err := k.Stamps.Get(ctx, stampID)
-	if err != nil {
-		return false, "stamp not found", types.ErrStampNotFound
+	verification.Status = stamp.StatusAt(sdkCtx.BlockTime().Unix())
+	switch verification.Status {
+	case types.StampRevoked:
+		verification.Reason = fmt.Sprintf("stamp revoked: %s", stamp.RevokedReason)
+		return verification, nil
+	case types.StampSuperseded:
+		verification.SuccessorId = stamp.SupersededBy
+		verification.Reason = fmt.Sprintf("stamp superseded by %s", stamp.SupersededBy)
+		return verification, nil
+	case types.StampExpired:
+		verification.Reason = fmt.Sprintf("stamp expired at %d", stamp.ValidUntil)
+		return verification, nil
+	case types.StampPending:
+		verification.Reason = fmt.Sprintf("awaiting co-signatures: %d of %d", stamp.CoSignatureCount(), stamp.CoSignThreshold)
+		return verification, nil
 	}
 
-	if stamp.Revoked {
-		return false, fmt.Sprintf("stamp revoked: %s", stamp.RevokedReason), nil
+	if len(stamp.CoSigners) > 0 {
+		if validCoSignatures < stamp.CoSignThreshold {
+			verification.Reason = "co-signature verification failed"
+			return verification, nil
+		}
+		verification.Valid = true
+		verification.Reason = "valid"
+		return verification, nil
 	}
 
 	// Verify signature again
 	pubKeyBytes, _ := hex.DecodeString(stamp.PePublicKey)
 	sigBytes, _ := hex.DecodeString(stamp.Signature)
-	hashBytes, _ := hex.DecodeString(stamp.DocumentHash)
 
-	if !ed25519.Verify(pubKeyBytes, hashBytes, sigBytes) {
-		return false, "signature verification failed", nil
+	if !ed25519.Verify(pubKeyBytes, stampSignBytes(sdkCtx.ChainID(), stamp), sigBytes) {
+		verification.Reason = "signature verification failed"
+		return verification, nil
 	}
 
-	return true, "valid", nil
+	verification.Valid = true
+	verification.Reason = "valid"
+	return verification, nil
+}
+
+// stampSignBytes returns the payload a stamp's signature was made over,
+// according to the sign bytes version recorded when it was created.
+func stampSignBytes(chainID string, stamp types.Stamp) []byte {
+	if stamp.MerkleLeafCount != 0 {
+		return types.MerkleStampSignBytes(
+			chainID,
+			stamp.JurisdictionId,
+			stamp.PeLicenseNumber,
+			stamp.DocumentHash,
+			stamp.MerkleLeafCount,
+			stamp.SignatureExpiry,
+			stamp.Nonce,
+		)
+	}
+	if stamp.SignBytesVersion == 0 {
+		hashBytes, _ := hex.DecodeString(stamp.DocumentHash)
+		return hashBytes
+	}
+	return types.StampSignBytes(
+		chainID,
+		stamp.JurisdictionId,
+		stamp.PeLicenseNumber,
+		stamp.DocumentHash,
+		stamp.SignatureExpiry,
+		stamp.Nonce,
+	)
 }
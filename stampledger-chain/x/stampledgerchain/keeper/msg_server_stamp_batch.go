@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// CreateStampBatch stamps a drawing set under one PE key, and under an entity
+// if entityID is set. Every entry is verified and stored exactly as
+// CreateStamp would; any failure aborts the whole batch.
+func (k Keeper) CreateStampBatch(
+	ctx context.Context,
+	creator string,
+	pePublicKey string,
+	jurisdictionId string,
+	peLicenseNumber string,
+	peName string,
+	projectName string,
+	signatureExpiry int64,
+	nonce uint64,
+	validUntil int64,
+	entries []types.StampBatchEntry,
+	entityID string,
+) (string, []string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Validate batch size
+	if len(entries) == 0 {
+		return "", nil, types.ErrInvalidStampBatch.Wrap("batch has no entries")
+	}
+	if len(entries) > types.MaxStampBatchSize {
+		return "", nil, types.ErrInvalidStampBatch.Wrapf("got %d entries, max %d", len(entries), types.MaxStampBatchSize)
+	}
+
+	// 2. Generate unique batch ID
+	batchID, err := k.nextID(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// 3. Create each stamp and link it to the batch
+	stampIDs := make([]string, 0, len(entries))
+	for i, entry := range entries {
+		stampID, err := k.CreateStamp(
+			ctx,
+			creator,
+			entry.DocumentHash,
+			pePublicKey,
+			entry.Signature,
+			jurisdictionId,
+			peLicenseNumber,
+			peName,
+			projectName,
+			entry.DocumentIpfsHash,
+			entry.DocumentSize,
+			entry.DocumentFilename,
+			signatureExpiry,
+			nonce,
+			validUntil,
+			entityID,
+		)
+		if err != nil {
+			return "", nil, errorsmod.Wrapf(err, "batch entry %d", i)
+		}
+
+		stamp, err := k.GetStamp(ctx, stampID)
+		if err != nil {
+			return "", nil, err
+		}
+		stamp.BatchId = batchID
+		if err := k.Stamps.Set(ctx, stampID, stamp); err != nil {
+			return "", nil, err
+		}
+		if err := k.StampsByBatch.Set(ctx, collections.Join(batchID, stampID), []byte{}); err != nil {
+			return "", nil, err
+		}
+		stampIDs = append(stampIDs, stampID)
+	}
+
+	// 4. Store the batch
+	batch := types.StampBatch{
+		Id:             batchID,
+		Creator:        creator,
+		PePublicKey:    pePublicKey,
+		JurisdictionId: jurisdictionId,
+		ProjectName:    projectName,
+		CreatedAt:      sdkCtx.BlockTime().Unix(),
+		StampCount:     uint32(len(stampIDs)),
+	}
+	if err := k.StampBatches.Set(ctx, batchID, batch); err != nil {
+		return "", nil, err
+	}
+
+	// 5. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"stamp_batch_created",
+			sdk.NewAttribute("batch_id", batchID),
+			sdk.NewAttribute("pe_public_key", pePublicKey),
+			sdk.NewAttribute("stamp_count", strconv.Itoa(len(stampIDs))),
+			sdk.NewAttribute("creator", creator),
+		),
+	)
+
+	return batchID, stampIDs, nil
+}
+
+// RevokeStampBatch revokes every stamp in a batch that is not yet revoked.
+// Each stamp is authorized as in RevokeStamp.
+func (k Keeper) RevokeStampBatch(
+	ctx context.Context,
+	creator string,
+	batchID string,
+	reasonCode types.RevocationReason,
+	reason string,
+	evidenceHash string,
+	entityID string,
+) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Verify the batch exists
+	if _, err := k.GetStampBatch(ctx, batchID); err != nil {
+		return 0, err
+	}
+
+	// 2. Collect the batch's stamps
+	iter, err := k.StampsByBatch.Iterate(ctx, collections.NewPrefixedPairRange[string, string](batchID))
+	if err != nil {
+		return 0, err
+	}
+	stampIDs, err := iter.Keys()
+	if err != nil {
+		return 0, err
+	}
+
+	// 3. Revoke the ones still in force
+	var revoked uint64
+	for _, key := range stampIDs {
+		stamp, err := k.GetStamp(ctx, key.K2())
+		if err != nil {
+			return 0, err
+		}
+		if stamp.Revoked {
+			continue
+		}
+		if err := k.RevokeStamp(ctx, creator, stamp.Id, reasonCode, reason, evidenceHash, entityID); err != nil {
+			return 0, err
+		}
+		revoked++
+	}
+
+	// 4. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"stamp_batch_revoked",
+			sdk.NewAttribute("batch_id", batchID),
+			sdk.NewAttribute("revoked_count", strconv.FormatUint(revoked, 10)),
+			sdk.NewAttribute("revoked_by", creator),
+		),
+	)
+
+	return revoked, nil
+}
+
+// GetStampBatch retrieves a stamp batch by ID
+func (k Keeper) GetStampBatch(ctx context.Context, batchID string) (types.StampBatch, error) {
+	batch, err := k.StampBatches.Get(ctx, batchID)
+	if err != nil {
+		return types.StampBatch{}, types.ErrStampBatchNotFound.Wrapf("batch ID: %s", batchID)
+	}
+	return batch, nil
+}
+
+// GetStampsByBatch returns a page of the stamps in a batch
+func (k Keeper) GetStampsByBatch(ctx context.Context, batchID string, pagination *query.PageRequest) ([]types.Stamp, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx, k.StampsByBatch, pagination,
+		func(key collections.Pair[string, string], _ []byte) (types.Stamp, error) {
+			return k.GetStamp(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](batchID),
+	)
+}
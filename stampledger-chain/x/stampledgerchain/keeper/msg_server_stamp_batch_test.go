@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// newCreateStampBatchMsg returns a MsgCreateStampBatch with one signed entry
+// per sheet, using the PE metadata of newCreateStampMsg.
+func newCreateStampBatchMsg(creator string, pe ed25519.PrivateKey, sheets ...string) *types.MsgCreateStampBatch {
+	msg := &types.MsgCreateStampBatch{
+		Creator:         creator,
+		PePublicKey:     hex.EncodeToString(pe.Public().(ed25519.PublicKey)),
+		JurisdictionId:  "wisconsin",
+		PeLicenseNumber: "PE-12345",
+		PeName:          "Jane Doe",
+		ProjectName:     "Main Street Bridge",
+		Nonce:           1,
+	}
+	for _, sheet := range sheets {
+		docHash := sha256.Sum256([]byte(sheet))
+		hash := hex.EncodeToString(docHash[:])
+		signBytes := types.StampSignBytes(testChainID, msg.JurisdictionId, msg.PeLicenseNumber, hash, msg.SignatureExpiry, msg.Nonce)
+		msg.Entries = append(msg.Entries, types.StampBatchEntry{
+			DocumentHash:     hash,
+			Signature:        hex.EncodeToString(ed25519.Sign(pe, signBytes)),
+			DocumentFilename: sheet,
+		})
+	}
+	return msg
+}
+
+func TestCreateStampBatch(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+
+	sheets := make([]string, 80)
+	for i := range sheets {
+		sheets[i] = fmt.Sprintf("S-%03d.pdf", i+1)
+	}
+	res, err := ms.CreateStampBatch(f.ctx, newCreateStampBatchMsg(creator, pe, sheets...))
+	require.NoError(t, err)
+	require.Len(t, res.StampIds, len(sheets))
+
+	batch, err := qs.StampBatch(f.ctx, &types.QueryStampBatchRequest{Id: res.BatchId})
+	require.NoError(t, err)
+	require.Equal(t, uint32(len(sheets)), batch.Batch.StampCount)
+	require.Equal(t, "Main Street Bridge", batch.Batch.ProjectName)
+	require.Len(t, batch.Stamps, len(sheets))
+	for _, stamp := range batch.Stamps {
+		require.Equal(t, res.BatchId, stamp.BatchId)
+	}
+
+	// Every batched stamp verifies on its own
+	verify, err := qs.VerifyStamp(f.ctx, &types.QueryVerifyStampRequest{Id: res.StampIds[0]})
+	require.NoError(t, err)
+	require.True(t, verify.Verification.Valid)
+
+	// The whole set is revoked together
+	revokeRes, err := ms.RevokeStampBatch(f.ctx, &types.MsgRevokeStampBatch{
+		Creator:    creator,
+		BatchId:    res.BatchId,
+		ReasonCode: types.RevocationErrorInDesign,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(sheets)), revokeRes.RevokedCount)
+
+	for _, stampID := range res.StampIds {
+		stamp, err := f.keeper.GetStamp(f.ctx, stampID)
+		require.NoError(t, err)
+		require.True(t, stamp.Revoked)
+	}
+}
+
+func TestCreateStampBatchUnderEntity(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	owner, creator := sample.AccAddress(), sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+
+	firm, err := ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: owner, Name: "Acme Engineering", EntityType: "firm"})
+	require.NoError(t, err)
+	f.addMember(t, f.ctx, owner, firm.EntityId, creator, "viewer")
+
+	// The creator's role must permit stamping under the entity
+	msg := newCreateStampBatchMsg(creator, pe, "S-001.pdf", "S-002.pdf")
+	msg.EntityId = firm.EntityId
+	_, err = ms.CreateStampBatch(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = ms.UpdateMemberRole(f.ctx, &types.MsgUpdateMemberRole{Creator: owner, EntityId: firm.EntityId, MemberAddress: creator, Role: "editor"})
+	require.NoError(t, err)
+	res, err := ms.CreateStampBatch(f.ctx, msg)
+	require.NoError(t, err)
+
+	byEntity, err := qs.StampsByEntity(f.ctx, &types.QueryStampsByEntityRequest{EntityId: firm.EntityId})
+	require.NoError(t, err)
+	require.Len(t, byEntity.Stamps, len(res.StampIds))
+	for _, stamp := range byEntity.Stamps {
+		require.Equal(t, firm.EntityId, stamp.EntityId)
+		require.Equal(t, res.BatchId, stamp.BatchId)
+	}
+}
+
+func TestCreateStampBatchAtomic(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+
+	t.Run("empty batch", func(t *testing.T) {
+		_, err := ms.CreateStampBatch(f.ctx, newCreateStampBatchMsg(creator, pe))
+		require.ErrorIs(t, err, types.ErrInvalidStampBatch)
+	})
+
+	t.Run("duplicate sheet", func(t *testing.T) {
+		_, err := ms.CreateStampBatch(f.ctx, newCreateStampBatchMsg(creator, pe, "S-001.pdf", "S-001.pdf"))
+		require.ErrorIs(t, err, types.ErrDuplicateStamp)
+	})
+
+	t.Run("one bad signature fails the batch", func(t *testing.T) {
+		msg := newCreateStampBatchMsg(creator, pe, "S-101.pdf", "S-102.pdf", "S-103.pdf")
+		msg.Entries[2].Signature = msg.Entries[0].Signature
+
+		// Run in a cached context, as the tx would, and discard it on failure
+		cacheCtx, _ := sdk.UnwrapSDKContext(f.ctx).CacheContext()
+		_, err := ms.CreateStampBatch(cacheCtx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+		// The valid sheets can still be stamped afterwards
+		_, err = ms.CreateStampBatch(f.ctx, newCreateStampBatchMsg(creator, pe, "S-101.pdf", "S-102.pdf"))
+		require.NoError(t, err)
+	})
+}
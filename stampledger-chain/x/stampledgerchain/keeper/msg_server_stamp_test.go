@@ -0,0 +1,562 @@
+package keeper_test
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+func TestCreateStampDuplicate(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := sample.AccAddress()
+
+	pe1, pe2 := newPEKey("pe-1"), newPEKey("pe-2")
+	f.registerPE(t, f.ctx, creator, pe1)
+	f.registerPE(t, f.ctx, creator, pe2)
+
+	msg := newCreateStampMsg(creator, pe1, "sheet-S101.pdf")
+	first, err := ms.CreateStamp(f.ctx, msg)
+	require.NoError(t, err)
+
+	// Same document and PE key is rejected
+	_, err = ms.CreateStamp(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrDuplicateStamp)
+
+	// A second PE may seal the same document
+	_, err = ms.CreateStamp(f.ctx, newCreateStampMsg(creator, pe2, "sheet-S101.pdf"))
+	require.NoError(t, err)
+
+	res, err := qs.StampsByDocumentHash(f.ctx, &types.QueryStampsByDocumentHashRequest{DocumentHash: msg.DocumentHash})
+	require.NoError(t, err)
+	require.Len(t, res.Stamps, 2)
+
+	// Once revoked, the PE may stamp the document again under a fresh nonce
+	_, err = ms.RevokeStamp(f.ctx, &types.MsgRevokeStamp{Creator: creator, StampId: first.StampId, ReasonCode: types.RevocationSuperseded, Reason: "reissued"})
+	require.NoError(t, err)
+	msg.Nonce = 2
+	signCreateStampMsg(pe1, msg)
+	_, err = ms.CreateStamp(f.ctx, msg)
+	require.NoError(t, err)
+}
+
+func TestCreateStampReplay(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+
+	msg := newCreateStampMsg(creator, pe, "sheet-S101.pdf")
+	first, err := ms.CreateStamp(f.ctx, msg)
+	require.NoError(t, err)
+	_, err = ms.RevokeStamp(f.ctx, &types.MsgRevokeStamp{Creator: testBoard, StampId: first.StampId, ReasonCode: types.RevocationDisciplinary})
+	require.NoError(t, err)
+
+	// The board's revocation cannot be undone by resubmitting the message,
+	// in any hex case
+	_, err = ms.CreateStamp(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrSignatureReused)
+	replay := *msg
+	replay.Signature = strings.ToUpper(msg.Signature)
+	_, err = ms.CreateStamp(f.ctx, &replay)
+	require.ErrorIs(t, err, types.ErrSignatureReused)
+
+	// Nor by resubmitting it inside a batch
+	_, err = ms.CreateStampBatch(f.ctx, &types.MsgCreateStampBatch{
+		Creator:         creator,
+		PePublicKey:     msg.PePublicKey,
+		JurisdictionId:  msg.JurisdictionId,
+		PeLicenseNumber: msg.PeLicenseNumber,
+		PeName:          msg.PeName,
+		Nonce:           msg.Nonce,
+		Entries:         []types.StampBatchEntry{{DocumentHash: msg.DocumentHash, Signature: msg.Signature}},
+	})
+	require.ErrorIs(t, err, types.ErrSignatureReused)
+}
+
+func TestCreateStampSignBytes(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+
+	t.Run("signature binds jurisdiction", func(t *testing.T) {
+		msg := newCreateStampMsg(creator, pe, "sheet-A")
+		msg.JurisdictionId = "california"
+		_, err := ms.CreateStamp(f.ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+	})
+
+	t.Run("signature binds chain ID", func(t *testing.T) {
+		msg := newCreateStampMsg(creator, pe, "sheet-B")
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithChainID("other-chain")
+		_, err := ms.CreateStamp(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+	})
+
+	t.Run("expired signature", func(t *testing.T) {
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(2000, 0))
+		msg := newCreateStampMsg(creator, pe, "sheet-C")
+		msg.SignatureExpiry = 1000
+		signCreateStampMsg(pe, msg)
+		_, err := ms.CreateStamp(ctx, msg)
+		require.ErrorIs(t, err, types.ErrSignatureExpired)
+	})
+
+	t.Run("structured signature verifies", func(t *testing.T) {
+		res, err := ms.CreateStamp(f.ctx, newCreateStampMsg(creator, pe, "sheet-D"))
+		require.NoError(t, err)
+
+		stamp, err := f.keeper.GetStamp(f.ctx, res.StampId)
+		require.NoError(t, err)
+		require.Equal(t, types.StampSignBytesVersion, stamp.SignBytesVersion)
+
+		verification, err := f.keeper.VerifyStamp(f.ctx, res.StampId)
+		require.NoError(t, err)
+		require.True(t, verification.Valid)
+	})
+
+	t.Run("legacy signature requires param", func(t *testing.T) {
+		msg := newCreateStampMsg(creator, pe, "sheet-E")
+		hashBytes, err := hex.DecodeString(msg.DocumentHash)
+		require.NoError(t, err)
+		msg.Signature = hex.EncodeToString(ed25519.Sign(pe, hashBytes))
+
+		_, err = ms.CreateStamp(f.ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+		params, err := f.keeper.Params.Get(f.ctx)
+		require.NoError(t, err)
+		params.AllowLegacySignatures = true
+		require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+		res, err := ms.CreateStamp(f.ctx, msg)
+		require.NoError(t, err)
+
+		stamp, err := f.keeper.GetStamp(f.ctx, res.StampId)
+		require.NoError(t, err)
+		require.Zero(t, stamp.SignBytesVersion)
+
+		verification, err := f.keeper.VerifyStamp(f.ctx, res.StampId)
+		require.NoError(t, err)
+		require.True(t, verification.Valid)
+	})
+}
+
+func TestCreateStampPERegistry(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+
+	// Unregistered keys cannot stamp
+	_, err := ms.CreateStamp(f.ctx, newCreateStampMsg(creator, pe, "sheet-A"))
+	require.ErrorIs(t, err, types.ErrPENotRegistered)
+
+	// Registration requires proof of possession for this account
+	reg := newRegisterPEMsg(creator, pe)
+	reg.Creator = sample.AccAddress()
+	_, err = ms.RegisterPE(f.ctx, reg)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	f.registerPE(t, f.ctx, creator, pe)
+	_, err = ms.RegisterPE(f.ctx, newRegisterPEMsg(creator, pe))
+	require.ErrorIs(t, err, types.ErrPEAlreadyRegistered)
+
+	t.Run("other account", func(t *testing.T) {
+		_, err := ms.CreateStamp(f.ctx, newCreateStampMsg(sample.AccAddress(), pe, "sheet-B"))
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("license mismatch", func(t *testing.T) {
+		msg := newCreateStampMsg(creator, pe, "sheet-C")
+		msg.PeLicenseNumber = "PE-99999"
+		signCreateStampMsg(pe, msg)
+		_, err := ms.CreateStamp(f.ctx, msg)
+		require.ErrorIs(t, err, types.ErrPEMismatch)
+	})
+
+	t.Run("name mismatch", func(t *testing.T) {
+		msg := newCreateStampMsg(creator, pe, "sheet-D")
+		msg.PeName = "John Roe"
+		_, err := ms.CreateStamp(f.ctx, msg)
+		require.ErrorIs(t, err, types.ErrPEMismatch)
+	})
+
+	t.Run("unregistered jurisdiction", func(t *testing.T) {
+		msg := newCreateStampMsg(creator, pe, "sheet-E")
+		msg.JurisdictionId = "california"
+		signCreateStampMsg(pe, msg)
+		_, err := ms.CreateStamp(f.ctx, msg)
+		require.ErrorIs(t, err, types.ErrPEMismatch)
+	})
+
+	t.Run("matching registry", func(t *testing.T) {
+		_, err := ms.CreateStamp(f.ctx, newCreateStampMsg(creator, pe, "sheet-F"))
+		require.NoError(t, err)
+	})
+}
+
+func TestSupersedeStamp(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+
+	original, err := ms.CreateStamp(f.ctx, newCreateStampMsg(creator, pe, "sheet-A rev 1"))
+	require.NoError(t, err)
+
+	supersede := func(sender, stampID, content string) (*types.MsgSupersedeStampResponse, error) {
+		msg := newCreateStampMsg(creator, pe, content)
+		return ms.SupersedeStamp(f.ctx, &types.MsgSupersedeStamp{
+			Creator:           sender,
+			SupersededStampId: stampID,
+			DocumentHash:      msg.DocumentHash,
+			PePublicKey:       msg.PePublicKey,
+			Signature:         msg.Signature,
+			JurisdictionId:    msg.JurisdictionId,
+			PeLicenseNumber:   msg.PeLicenseNumber,
+			PeName:            msg.PeName,
+			Nonce:             msg.Nonce,
+		})
+	}
+
+	_, err = supersede(sample.AccAddress(), original.StampId, "sheet-A rev 2")
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := supersede(creator, original.StampId, "sheet-A rev 2")
+	require.NoError(t, err)
+
+	old, err := f.keeper.GetStamp(f.ctx, original.StampId)
+	require.NoError(t, err)
+	require.False(t, old.Revoked)
+	require.Equal(t, res.StampId, old.SupersededBy)
+
+	successor, err := f.keeper.GetStamp(f.ctx, res.StampId)
+	require.NoError(t, err)
+	require.Equal(t, original.StampId, successor.Supersedes)
+
+	verify, err := qs.VerifyStamp(f.ctx, &types.QueryVerifyStampRequest{Id: original.StampId})
+	require.NoError(t, err)
+	require.False(t, verify.Verification.Valid)
+	require.Equal(t, types.StampSuperseded, verify.Verification.Status)
+	require.Equal(t, res.StampId, verify.Verification.SuccessorId)
+
+	verify, err = qs.VerifyStamp(f.ctx, &types.QueryVerifyStampRequest{Id: res.StampId})
+	require.NoError(t, err)
+	require.True(t, verify.Verification.Valid)
+	require.Equal(t, types.StampValid, verify.Verification.Status)
+
+	// A stamp can only be superseded once
+	_, err = supersede(creator, original.StampId, "sheet-A rev 3")
+	require.ErrorIs(t, err, types.ErrStampAlreadySuperseded)
+
+	// Revocation takes precedence over supersession
+	_, err = ms.RevokeStamp(f.ctx, &types.MsgRevokeStamp{Creator: creator, StampId: original.StampId, ReasonCode: types.RevocationErrorInDesign})
+	require.NoError(t, err)
+	verify, err = qs.VerifyStamp(f.ctx, &types.QueryVerifyStampRequest{Id: original.StampId})
+	require.NoError(t, err)
+	require.Equal(t, types.StampRevoked, verify.Verification.Status)
+}
+
+func TestStampExpiry(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+	f.registerPE(t, ctx, creator, pe)
+
+	msg := newCreateStampMsg(creator, pe, "sheet-A")
+	msg.ValidUntil = 1000
+	_, err := ms.CreateStamp(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidValidUntil)
+
+	msg.ValidUntil = 2000
+	res, err := ms.CreateStamp(ctx, msg)
+	require.NoError(t, err)
+	permanent, err := ms.CreateStamp(ctx, newCreateStampMsg(creator, pe, "sheet-B"))
+	require.NoError(t, err)
+
+	// Still valid at valid_until
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	verify, err := qs.VerifyStamp(ctx, &types.QueryVerifyStampRequest{Id: res.StampId})
+	require.NoError(t, err)
+	require.True(t, verify.Verification.Valid)
+	require.Equal(t, types.StampValid, verify.Verification.Status)
+
+	// Reported expired once past valid_until, even before the EndBlocker runs
+	ctx = ctx.WithBlockTime(time.Unix(2001, 0))
+	verify, err = qs.VerifyStamp(ctx, &types.QueryVerifyStampRequest{Id: res.StampId})
+	require.NoError(t, err)
+	require.False(t, verify.Verification.Valid)
+	require.Equal(t, types.StampExpired, verify.Verification.Status)
+
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	stamp, err := f.keeper.GetStamp(ctx, res.StampId)
+	require.NoError(t, err)
+	require.True(t, stamp.Expired)
+	has, err := f.keeper.StampsByExpiry.Has(ctx, collections.Join(int64(2000), res.StampId))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// Stamps without valid_until never expire
+	stamp, err = f.keeper.GetStamp(ctx, permanent.StampId)
+	require.NoError(t, err)
+	require.False(t, stamp.Expired)
+	require.Equal(t, types.StampValid, stamp.StatusAt(ctx.BlockTime().Unix()))
+}
+
+func TestRevokeStampAuthorization(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator, firmAdmin := sample.AccAddress(), sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+
+	firm, err := ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: firmAdmin, Name: "Acme Engineering", EntityType: "firm"})
+	require.NoError(t, err)
+	other, err := ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: firmAdmin, Name: "Other Engineering", EntityType: "firm"})
+	require.NoError(t, err)
+	f.addMember(t, f.ctx, firmAdmin, firm.EntityId, creator, "editor")
+
+	newStamp := func(content string) string {
+		msg := newCreateStampMsg(creator, pe, content)
+		msg.EntityId = firm.EntityId
+		res, err := ms.CreateStamp(f.ctx, msg)
+		require.NoError(t, err)
+		return res.StampId
+	}
+	evidence := hex.EncodeToString(make([]byte, 32))
+
+	testCases := []struct {
+		name   string
+		msg    types.MsgRevokeStamp
+		expErr error
+	}{
+		{
+			name:   "unspecified reason",
+			msg:    types.MsgRevokeStamp{Creator: creator},
+			expErr: types.ErrInvalidRevocation,
+		},
+		{
+			name:   "malformed evidence hash",
+			msg:    types.MsgRevokeStamp{Creator: creator, ReasonCode: types.RevocationErrorInDesign, EvidenceHash: "abc"},
+			expErr: types.ErrInvalidRevocation,
+		},
+		{
+			name:   "stranger",
+			msg:    types.MsgRevokeStamp{Creator: sample.AccAddress(), ReasonCode: types.RevocationAdministrative},
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			name:   "creator cannot revoke for disciplinary reasons",
+			msg:    types.MsgRevokeStamp{Creator: creator, ReasonCode: types.RevocationDisciplinary},
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			name: "creator",
+			msg:  types.MsgRevokeStamp{Creator: creator, ReasonCode: types.RevocationErrorInDesign, EvidenceHash: evidence},
+		},
+		{
+			name:   "entity other than the issuing one",
+			msg:    types.MsgRevokeStamp{Creator: firmAdmin, ReasonCode: types.RevocationAdministrative, EntityId: other.EntityId},
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			name:   "entity admin cannot revoke for key compromise",
+			msg:    types.MsgRevokeStamp{Creator: firmAdmin, ReasonCode: types.RevocationKeyCompromise, EntityId: firm.EntityId},
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			name: "entity admin",
+			msg:  types.MsgRevokeStamp{Creator: firmAdmin, ReasonCode: types.RevocationAdministrative, EntityId: firm.EntityId},
+		},
+		{
+			name:   "board cannot revoke for design errors",
+			msg:    types.MsgRevokeStamp{Creator: testBoard, ReasonCode: types.RevocationErrorInDesign},
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			name: "jurisdiction board",
+			msg:  types.MsgRevokeStamp{Creator: testBoard, ReasonCode: types.RevocationDisciplinary, EvidenceHash: evidence},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.msg
+			msg.StampId = newStamp(fmt.Sprintf("sheet-%d", i))
+			_, err := ms.RevokeStamp(f.ctx, &msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			stamp, err := f.keeper.GetStamp(f.ctx, msg.StampId)
+			require.NoError(t, err)
+			require.True(t, stamp.Revoked)
+			require.Equal(t, msg.ReasonCode, stamp.RevocationReason)
+			require.Equal(t, msg.EvidenceHash, stamp.RevocationEvidenceHash)
+			require.Equal(t, msg.Creator, stamp.RevokedBy)
+		})
+	}
+}
+
+func TestRevokeStampIssuingEntity(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator, firmAdmin, otherAdmin := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+
+	firm, err := ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: firmAdmin, Name: "Acme Engineering", EntityType: "firm"})
+	require.NoError(t, err)
+	other, err := ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: otherAdmin, Name: "Other Engineering", EntityType: "firm"})
+	require.NoError(t, err)
+	f.addMember(t, f.ctx, firmAdmin, firm.EntityId, creator, "editor")
+	f.addMember(t, f.ctx, otherAdmin, other.EntityId, creator, "viewer")
+
+	msg := newCreateStampMsg(creator, pe, "sheet-A")
+	msg.EntityId = firm.EntityId
+	res, err := ms.CreateStamp(f.ctx, msg)
+	require.NoError(t, err)
+	revoke := func(admin, entityID string) error {
+		_, err := ms.RevokeStamp(f.ctx, &types.MsgRevokeStamp{Creator: admin, StampId: res.StampId, ReasonCode: types.RevocationAdministrative, EntityId: entityID})
+		return err
+	}
+
+	// Admins of another entity the creator belongs to have no authority
+	require.ErrorIs(t, revoke(otherAdmin, other.EntityId), types.ErrUnauthorized)
+	require.ErrorIs(t, revoke(otherAdmin, ""), types.ErrUnauthorized)
+
+	// The issuing entity's admin keeps authority after the creator leaves
+	_, err = ms.LeaveEntity(f.ctx, &types.MsgLeaveEntity{Creator: creator, EntityId: firm.EntityId})
+	require.NoError(t, err)
+	require.NoError(t, revoke(firmAdmin, firm.EntityId))
+}
+
+func TestStampUnderEntity(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	owner, creator := sample.AccAddress(), sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+
+	firm, err := ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: owner, Name: "Acme Engineering", EntityType: "firm"})
+	require.NoError(t, err)
+	other, err := ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: owner, Name: "Other Engineering", EntityType: "firm"})
+	require.NoError(t, err)
+	f.addMember(t, f.ctx, owner, firm.EntityId, creator, "editor")
+	stamp := func(content, entityID string) (string, error) {
+		msg := newCreateStampMsg(creator, pe, content)
+		msg.EntityId = entityID
+		res, err := ms.CreateStamp(f.ctx, msg)
+		if err != nil {
+			return "", err
+		}
+		return res.StampId, nil
+	}
+	setRole := func(role string) {
+		_, err := ms.UpdateMemberRole(f.ctx, &types.MsgUpdateMemberRole{Creator: owner, EntityId: firm.EntityId, MemberAddress: creator, Role: role})
+		require.NoError(t, err)
+	}
+
+	// Stamping under an entity needs a role in it that permits stamping
+	_, err = stamp("sheet-A", other.EntityId)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	firmStampID, err := stamp("sheet-A", firm.EntityId)
+	require.NoError(t, err)
+	got, err := f.keeper.GetStamp(f.ctx, firmStampID)
+	require.NoError(t, err)
+	require.Equal(t, firm.EntityId, got.EntityId)
+	_, err = stamp("sheet-B", "")
+	require.NoError(t, err)
+
+	// A successor stays under the superseded stamp's entity
+	msg := newCreateStampMsg(creator, pe, "sheet-A rev 2")
+	successor, err := ms.SupersedeStamp(f.ctx, &types.MsgSupersedeStamp{
+		Creator:           creator,
+		SupersededStampId: firmStampID,
+		DocumentHash:      msg.DocumentHash,
+		PePublicKey:       msg.PePublicKey,
+		Signature:         msg.Signature,
+		JurisdictionId:    msg.JurisdictionId,
+		PeLicenseNumber:   msg.PeLicenseNumber,
+		PeName:            msg.PeName,
+		Nonce:             msg.Nonce,
+	})
+	require.NoError(t, err)
+
+	res, err := qs.StampsByEntity(f.ctx, &types.QueryStampsByEntityRequest{EntityId: firm.EntityId})
+	require.NoError(t, err)
+	stampIDs := make([]string, 0, len(res.Stamps))
+	for _, s := range res.Stamps {
+		stampIDs = append(stampIDs, s.Id)
+	}
+	require.ElementsMatch(t, []string{firmStampID, successor.StampId}, stampIDs)
+	_, err = qs.StampsByEntity(f.ctx, &types.QueryStampsByEntityRequest{EntityId: "missing"})
+	require.ErrorIs(t, err, types.ErrEntityNotFound)
+
+	// Viewers can neither store documents nor create spec versions under it
+	storeDoc := func() (*types.MsgStoreDocumentResponse, error) {
+		return ms.StoreDocument(f.ctx, &types.MsgStoreDocument{
+			Creator:  creator,
+			StampId:  successor.StampId,
+			IpfsHash: "QmbWqxBEKC3P8tqsKc98xmWNzrzDtRLMiMPL8wBuTGsMnR",
+			EntityId: firm.EntityId,
+		})
+	}
+	createSpec := func() (*types.MsgCreateSpecVersionResponse, error) {
+		return ms.CreateSpecVersion(f.ctx, &types.MsgCreateSpecVersion{
+			Creator:   creator,
+			ProjectId: "project-1",
+			Version:   "1.0.0",
+			EntityId:  firm.EntityId,
+		})
+	}
+	setRole("viewer")
+	_, err = storeDoc()
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = createSpec()
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	setRole("editor")
+	docRes, err := storeDoc()
+	require.NoError(t, err)
+	doc, err := f.keeper.GetDocument(f.ctx, docRes.DocumentId)
+	require.NoError(t, err)
+	require.Equal(t, firm.EntityId, doc.EntityId)
+	specRes, err := createSpec()
+	require.NoError(t, err)
+	spec, err := f.keeper.GetSpecVersion(f.ctx, specRes.VersionId)
+	require.NoError(t, err)
+	require.Equal(t, firm.EntityId, spec.EntityId)
+
+	// Nothing is issued under an inactive entity
+	_, err = ms.DeactivateEntity(f.ctx, &types.MsgDeactivateEntity{Creator: owner, EntityId: firm.EntityId})
+	require.NoError(t, err)
+	_, err = stamp("sheet-C", firm.EntityId)
+	require.ErrorIs(t, err, types.ErrEntityInactive)
+}
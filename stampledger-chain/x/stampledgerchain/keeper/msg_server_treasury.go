@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+// FundEntity transfers coins from the creator into the entity's treasury
+// account and returns the treasury address. Anyone may fund an active entity.
+func (k Keeper) FundEntity(
+	ctx context.Context,
+	creator string,
+	entityID string,
+	amount sdk.Coins,
+) (string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Validate amount
+	if !amount.IsValid() || amount.IsZero() {
+		return "", types.ErrInvalidFunding.Wrapf("amount %s must be positive", amount)
+	}
+
+	// 2. Get entity
+	entity, err := k.GetEntity(ctx, entityID)
+	if err != nil {
+		return "", err
+	}
+	if !entity.Active {
+		return "", types.ErrEntityInactive.Wrapf("entity ID: %s", entityID)
+	}
+
+	// 3. Transfer to the treasury
+	from, err := k.addressCodec.StringToBytes(creator)
+	if err != nil {
+		return "", err
+	}
+	treasury, err := k.addressCodec.StringToBytes(entity.TreasuryAddress)
+	if err != nil {
+		return "", err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, from, treasury, amount); err != nil {
+		return "", err
+	}
+
+	// 4. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"entity_funded",
+			sdk.NewAttribute("entity_id", entityID),
+			sdk.NewAttribute("treasury_address", entity.TreasuryAddress),
+			sdk.NewAttribute("amount", amount.String()),
+			sdk.NewAttribute("funded_by", creator),
+		),
+	)
+
+	return entity.TreasuryAddress, nil
+}
+
+// SetEntityFeeCap sets the fees the entity treasury sponsors for each member
+// per calendar month. An empty cap disables sponsorship.
+func (k Keeper) SetEntityFeeCap(
+	ctx context.Context,
+	creator string,
+	entityID string,
+	memberMonthlyCap sdk.Coins,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Validate cap
+	if !memberMonthlyCap.IsValid() {
+		return types.ErrInvalidFunding.Wrapf("invalid member monthly cap %s", memberMonthlyCap)
+	}
+
+	// 2. Get entity
+	entity, err := k.GetEntity(ctx, entityID)
+	if err != nil {
+		return err
+	}
+
+	// 3. Verify creator is admin
+	if err := k.requireEntityAdmin(ctx, entityID, creator, "set the fee cap"); err != nil {
+		return err
+	}
+
+	// 4. Update entity
+	entity.MemberMonthlyCap = memberMonthlyCap
+	if err := k.Entities.Set(ctx, entityID, entity); err != nil {
+		return err
+	}
+
+	// 5. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"entity_fee_cap_set",
+			sdk.NewAttribute("entity_id", entityID),
+			sdk.NewAttribute("member_monthly_cap", memberMonthlyCap.String()),
+			sdk.NewAttribute("set_by", creator),
+		),
+	)
+
+	return nil
+}
+
+// IsEntityTreasury reports whether addr is the treasury account of an entity.
+func (k Keeper) IsEntityTreasury(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	treasury, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return false, err
+	}
+	return k.EntitiesByTreasury.Has(ctx, treasury)
+}
+
+// SponsorFee records fee against member's monthly allowance in the entity
+// owning the treasury account. The ante handler calls it before deducting
+// the fee from the treasury; it fails if the member may not be sponsored or
+// the fee would exceed the entity's member monthly cap.
+func (k Keeper) SponsorFee(ctx context.Context, treasuryAddr, memberAddr sdk.AccAddress, fee sdk.Coins) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// 1. Resolve the entity from its treasury
+	treasury, err := k.addressCodec.BytesToString(treasuryAddr)
+	if err != nil {
+		return err
+	}
+	entityID, err := k.EntitiesByTreasury.Get(ctx, treasury)
+	if err != nil {
+		return types.ErrFeeNotSponsored.Wrapf("%s is not an entity treasury", treasury)
+	}
+	entity, err := k.GetEntity(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	if !entity.Active {
+		return types.ErrFeeNotSponsored.Wrap("entity is not active")
+	}
+
+	// 2. Check the member's role
+	member, err := k.addressCodec.BytesToString(memberAddr)
+	if err != nil {
+		return err
+	}
+	role, err := k.memberRole(ctx, entityID, member)
+	if err != nil {
+		return err
+	}
+	if !types.SponsoredRoles[role] {
+		return types.ErrFeeNotSponsored.Wrapf("member %s has role '%s', need 'editor' or 'admin'", member, role)
+	}
+
+	// 3. Check the monthly cap
+	if entity.MemberMonthlyCap.IsZero() {
+		return types.ErrFeeNotSponsored.Wrap("entity has no member fee cap set")
+	}
+	usage, err := k.GetMemberFeeUsage(ctx, entityID, member)
+	if err != nil {
+		return err
+	}
+	spent := usage.Spent.Add(fee...)
+	if !spent.IsAllLTE(entity.MemberMonthlyCap) {
+		return types.ErrFeeCapExceeded.Wrapf("%s spent %s of %s in %s, fee %s", member, usage.Spent, entity.MemberMonthlyCap, usage.Period, fee)
+	}
+
+	// 4. Record usage
+	usage.Spent = spent
+	if err := k.MemberFeeUsage.Set(ctx, collections.Join(entityID, member), usage); err != nil {
+		return err
+	}
+
+	// 5. Emit event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"entity_fee_sponsored",
+			sdk.NewAttribute("entity_id", entityID),
+			sdk.NewAttribute("member", member),
+			sdk.NewAttribute("fee", fee.String()),
+			sdk.NewAttribute("period_spent", spent.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetMemberFeeUsage returns the fees the entity has sponsored for member in
+// the current calendar month. Usage from an earlier month reads as zero.
+func (k Keeper) GetMemberFeeUsage(ctx context.Context, entityID string, member string) (types.MemberFeeUsage, error) {
+	if _, err := k.GetEntity(ctx, entityID); err != nil {
+		return types.MemberFeeUsage{}, err
+	}
+
+	period := types.FeePeriod(sdk.UnwrapSDKContext(ctx).BlockTime())
+	usage, err := k.MemberFeeUsage.Get(ctx, collections.Join(entityID, member))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.MemberFeeUsage{}, err
+	}
+	if err != nil || usage.Period != period {
+		usage = types.MemberFeeUsage{EntityId: entityID, Member: member, Period: period, Spent: sdk.Coins{}}
+	}
+	return usage, nil
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/testutil/sample"
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+func TestFundEntity(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, funder := sample.AccAddress(), sample.AccAddress()
+	funderAddr := sdk.MustAccAddressFromBech32(funder)
+	f.bankKeeper.balances[string(funderAddr)] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	entityRes, err := ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: owner, Name: "Acme", EntityType: "firm"})
+	require.NoError(t, err)
+	entity, err := f.keeper.GetEntity(f.ctx, entityRes.EntityId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(types.EntityTreasuryAddress(entity.Id)).String(), entity.TreasuryAddress)
+
+	// Anyone can fund the treasury
+	res, err := ms.FundEntity(f.ctx, &types.MsgFundEntity{
+		Creator:  funder,
+		EntityId: entity.Id,
+		Amount:   sdk.NewCoins(sdk.NewInt64Coin("stake", 400)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, entity.TreasuryAddress, res.TreasuryAddress)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 400)), f.bankKeeper.balances[string(types.EntityTreasuryAddress(entity.Id))])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 600)), f.bankKeeper.balances[string(funderAddr)])
+
+	// Overdrafts fail in the bank keeper
+	_, err = ms.FundEntity(f.ctx, &types.MsgFundEntity{
+		Creator:  funder,
+		EntityId: entity.Id,
+		Amount:   sdk.NewCoins(sdk.NewInt64Coin("stake", 4000)),
+	})
+	require.Error(t, err)
+
+	_, err = ms.FundEntity(f.ctx, &types.MsgFundEntity{
+		Creator:  funder,
+		EntityId: "missing",
+		Amount:   sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+	})
+	require.ErrorIs(t, err, types.ErrEntityNotFound)
+}
+
+func TestSponsorFee(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC))
+
+	owner, editor, viewer := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	entityRes, err := ms.CreateEntity(ctx, &types.MsgCreateEntity{Creator: owner, Name: "Acme", EntityType: "firm"})
+	require.NoError(t, err)
+	entityID := entityRes.EntityId
+	for member, role := range map[string]string{editor: "editor", viewer: "viewer"} {
+		f.addMember(t, ctx, owner, entityID, member, role)
+	}
+
+	treasury := types.EntityTreasuryAddress(entityID)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 40))
+	sponsor := func(ctx sdk.Context, member string) error {
+		return f.keeper.SponsorFee(ctx, treasury, sdk.MustAccAddressFromBech32(member), fee)
+	}
+
+	isTreasury, err := f.keeper.IsEntityTreasury(ctx, treasury)
+	require.NoError(t, err)
+	require.True(t, isTreasury)
+	isTreasury, err = f.keeper.IsEntityTreasury(ctx, sdk.MustAccAddressFromBech32(owner))
+	require.NoError(t, err)
+	require.False(t, isTreasury)
+
+	// Sponsorship is off until an admin sets a cap
+	require.ErrorIs(t, sponsor(ctx, editor), types.ErrFeeNotSponsored)
+
+	_, err = ms.SetEntityFeeCap(ctx, &types.MsgSetEntityFeeCap{
+		Creator:          editor,
+		EntityId:         entityID,
+		MemberMonthlyCap: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.SetEntityFeeCap(ctx, &types.MsgSetEntityFeeCap{
+		Creator:          owner,
+		EntityId:         entityID,
+		MemberMonthlyCap: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	})
+	require.NoError(t, err)
+
+	// Editors and admins are sponsored up to the cap; viewers are not
+	require.NoError(t, sponsor(ctx, editor))
+	require.NoError(t, sponsor(ctx, editor))
+	require.ErrorIs(t, sponsor(ctx, editor), types.ErrFeeCapExceeded)
+	require.NoError(t, sponsor(ctx, owner))
+	require.ErrorIs(t, sponsor(ctx, viewer), types.ErrFeeNotSponsored)
+
+	usage, err := qs.MemberFeeUsage(ctx, &types.QueryMemberFeeUsageRequest{EntityId: entityID, Member: editor})
+	require.NoError(t, err)
+	require.Equal(t, "2026-03", usage.Usage.Period)
+	require.Equal(t, sdkmath.NewInt(80), usage.Usage.Spent.AmountOf("stake"))
+
+	// Fees in a denom outside the cap are never sponsored
+	err = f.keeper.SponsorFee(ctx, treasury, sdk.MustAccAddressFromBech32(owner), sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+	require.ErrorIs(t, err, types.ErrFeeCapExceeded)
+
+	// The allowance resets with the calendar month
+	nextMonth := ctx.WithBlockTime(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+	usage, err = qs.MemberFeeUsage(nextMonth, &types.QueryMemberFeeUsageRequest{EntityId: entityID, Member: editor})
+	require.NoError(t, err)
+	require.Equal(t, "2026-04", usage.Usage.Period)
+	require.True(t, usage.Usage.Spent.IsZero())
+	require.NoError(t, sponsor(nextMonth, editor))
+
+	// Addresses that are not entity treasuries cannot sponsor
+	err = f.keeper.SponsorFee(ctx, sdk.MustAccAddressFromBech32(owner), sdk.MustAccAddressFromBech32(editor), fee)
+	require.ErrorIs(t, err, types.ErrFeeNotSponsored)
+}
@@ -1,11 +1,14 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+    "stampledger-chain/testutil/sample"
     "stampledger-chain/x/stampledgerchain/keeper"
     "stampledger-chain/x/stampledgerchain/types"
 )
@@ -20,6 +23,13 @@ func TestMsgUpdateParams(t *testing.T) {
 	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
 	require.NoError(t, err)
 
+	// withParams returns the default params with modify applied
+	withParams := func(modify func(p *types.Params)) types.Params {
+		p := types.DefaultParams()
+		modify(&p)
+		return p
+	}
+
 	// default params
 	testCases := []struct {
 		name      string
@@ -42,6 +52,199 @@ func TestMsgUpdateParams(t *testing.T) {
 				Authority: authorityStr,
 				Params:    types.Params{},
 			},
+			expErr:    true,
+			expErrMsg: "max_name_length",
+		},
+		{
+			name: "duplicate jurisdiction",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: withParams(func(p *types.Params) {
+					p.Jurisdictions = []types.Jurisdiction{
+						{Id: "wisconsin", BoardAddresses: []string{authorityStr}},
+						{Id: "wisconsin", BoardAddresses: []string{authorityStr}},
+					}
+				}),
+			},
+			expErr:    true,
+			expErrMsg: "duplicate jurisdiction",
+		},
+		{
+			name: "invalid board address",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: withParams(func(p *types.Params) {
+					p.Jurisdictions = []types.Jurisdiction{{Id: "wisconsin", BoardAddresses: []string{"invalid"}}}
+				}),
+			},
+			expErr:    true,
+			expErrMsg: "invalid board address",
+		},
+		{
+			name: "invalid stamp fee",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: withParams(func(p *types.Params) {
+					p.StampFee = sdk.Coins{{Denom: "stake", Amount: math.NewInt(-1)}}
+				}),
+			},
+			expErr:    true,
+			expErrMsg: "invalid stamp fee",
+		},
+		{
+			name: "document fee buckets out of order",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: withParams(func(p *types.Params) {
+					p.DocumentFeeBuckets = []types.DocumentFeeBucket{
+						{MaxSize: 1 << 20, Fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+						{MaxSize: 1 << 10, Fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 2))},
+					}
+				}),
+			},
+			expErr:    true,
+			expErrMsg: "greater than the previous bucket",
+		},
+		{
+			name: "duplicate fee exempt address",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: withParams(func(p *types.Params) {
+					p.FeeExemptAddresses = []string{authorityStr, authorityStr}
+				}),
+			},
+			expErr:    true,
+			expErrMsg: "duplicate fee exempt address",
+		},
+		{
+			name: "fee schedule",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: withParams(func(p *types.Params) {
+					p.StampFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+					p.DocumentFeeBuckets = []types.DocumentFeeBucket{{MaxSize: 1 << 20, Fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 5))}}
+					p.EntityCreationFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+					p.FeeExemptAddresses = []string{authorityStr}
+				}),
+			},
+			expErr: false,
+		},
+		{
+			name: "zero length limit",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withParams(func(p *types.Params) { p.MaxReasonLength = 0 }),
+			},
+			expErr:    true,
+			expErrMsg: "max_reason_length must be between 1 and 4096",
+		},
+		{
+			name: "length limit above ceiling",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withParams(func(p *types.Params) { p.MaxNameLength = types.MaxNameLengthCeiling + 1 }),
+			},
+			expErr:    true,
+			expErrMsg: "max_name_length must be between 1 and 256",
+		},
+		{
+			name: "document size above ceiling",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withParams(func(p *types.Params) { p.MaxDocumentSize = types.MaxDocumentSizeCeiling + 1 }),
+			},
+			expErr:    true,
+			expErrMsg: "max_document_size",
+		},
+		{
+			name: "no entity types",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withParams(func(p *types.Params) { p.AllowedEntityTypes = nil }),
+			},
+			expErr:    true,
+			expErrMsg: "allowed_entity_types cannot be empty",
+		},
+		{
+			name: "duplicate mime type",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withParams(func(p *types.Params) { p.AllowedMimeTypes = []string{"application/pdf", "application/pdf"} }),
+			},
+			expErr:    true,
+			expErrMsg: "duplicate allowed_mime_types value",
+		},
+		{
+			name: "empty cid prefix",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withParams(func(p *types.Params) { p.IpfsCidPrefixes = []string{"Qm", ""} }),
+			},
+			expErr:    true,
+			expErrMsg: "ipfs_cid_prefixes cannot contain an empty value",
+		},
+		{
+			name: "zero pin duration",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withParams(func(p *types.Params) { p.PinDuration = 0 }),
+			},
+			expErr:    true,
+			expErrMsg: "pin_duration must be positive",
+		},
+		{
+			name: "zero invite duration",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withParams(func(p *types.Params) { p.InviteDuration = 0 }),
+			},
+			expErr:    true,
+			expErrMsg: "invite_duration must be positive",
+		},
+		{
+			name: "pin warning not before expiry",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    withParams(func(p *types.Params) { p.PinExpiryWarning = p.PinDuration }),
+			},
+			expErr:    true,
+			expErrMsg: "pin_expiry_warning must be between 0 and pin_duration",
+		},
+		{
+			name: "invalid pinning provider key",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: withParams(func(p *types.Params) {
+					p.PinningProviders = []types.PinningProvider{{Address: sample.AccAddress(), PublicKey: "abcd"}}
+				}),
+			},
+			expErr:    true,
+			expErrMsg: "public key must be 64 hex characters",
+		},
+		{
+			name: "duplicate pinning provider",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: withParams(func(p *types.Params) {
+					provider := types.PinningProvider{Address: sample.AccAddress(), PublicKey: strings.Repeat("ab", 32)}
+					p.PinningProviders = []types.PinningProvider{provider, provider}
+				}),
+			},
+			expErr:    true,
+			expErrMsg: "duplicate pinning provider",
+		},
+		{
+			name: "validation limits",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: withParams(func(p *types.Params) {
+					p.MaxNameLength = 64
+					p.MaxDocumentSize = 1 << 20
+					p.AllowedMimeTypes = []string{"application/pdf"}
+					p.AllowedEntityTypes = []string{"firm", "agency"}
+					p.IpfsCidPrefixes = []string{"bafy"}
+				}),
+			},
 			expErr: false,
 		},
 		{
@@ -67,3 +270,75 @@ func TestMsgUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestParamChangeLimits(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	updateParams := func(modify func(p *types.Params)) {
+		params, err := f.keeper.Params.Get(f.ctx)
+		require.NoError(t, err)
+		modify(&params)
+		_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authorityStr, Params: params})
+		require.NoError(t, err)
+	}
+
+	creator := sample.AccAddress()
+	pe := newPEKey("pe-1")
+	f.registerPE(t, f.ctx, creator, pe)
+	stampRes, err := ms.CreateStamp(f.ctx, newCreateStampMsg(creator, pe, "sheet-A"))
+	require.NoError(t, err)
+
+	// Tighten the limits through governance
+	updateParams(func(p *types.Params) {
+		p.MaxNameLength = 8
+		p.MaxReasonLength = 16
+		p.MaxDocumentSize = 1 << 20
+		p.AllowedMimeTypes = []string{"application/pdf"}
+		p.AllowedEntityTypes = []string{"agency"}
+		p.IpfsCidPrefixes = []string{"bafy"}
+	})
+
+	_, err = ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: creator, Name: "Acme Engineering", EntityType: "agency"})
+	require.ErrorIs(t, err, types.ErrFieldTooLong)
+	_, err = ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: creator, Name: "Acme", EntityType: "firm"})
+	require.ErrorIs(t, err, types.ErrInvalidEntityType)
+	_, err = ms.CreateEntity(f.ctx, &types.MsgCreateEntity{Creator: creator, Name: "Acme", EntityType: "agency"})
+	require.NoError(t, err)
+
+	doc := &types.MsgStoreDocument{
+		Creator:  creator,
+		StampId:  stampRes.StampId,
+		IpfsHash: "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+		Size_:    2 << 20,
+		MimeType: "application/pdf",
+	}
+	_, err = ms.StoreDocument(f.ctx, doc)
+	require.ErrorIs(t, err, types.ErrDocumentTooLarge)
+	doc.Size_ = 1 << 10
+	doc.MimeType = "image/png"
+	_, err = ms.StoreDocument(f.ctx, doc)
+	require.ErrorIs(t, err, types.ErrMimeTypeNotAllowed)
+	doc.MimeType = "application/pdf"
+	doc.IpfsHash = "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+	_, err = ms.StoreDocument(f.ctx, doc)
+	require.ErrorIs(t, err, types.ErrInvalidIpfsHash)
+
+	revoke := &types.MsgRevokeStamp{Creator: creator, StampId: stampRes.StampId, Reason: "superseded by revision B", ReasonCode: types.RevocationSuperseded}
+	_, err = ms.RevokeStamp(f.ctx, revoke)
+	require.ErrorIs(t, err, types.ErrFieldTooLong)
+
+	// Relaxing them again lets the same messages through
+	updateParams(func(p *types.Params) {
+		p.MaxReasonLength = types.DefaultMaxReasonLength
+		p.AllowedMimeTypes = nil
+		p.IpfsCidPrefixes = []string{"Qm", "bafy"}
+	})
+	doc.MimeType = "image/png"
+	_, err = ms.StoreDocument(f.ctx, doc)
+	require.NoError(t, err)
+	_, err = ms.RevokeStamp(f.ctx, revoke)
+	require.NoError(t, err)
+}
@@ -3,6 +3,10 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"stampledger-chain/x/stampledgerchain/types"
 )
 
@@ -27,43 +31,159 @@ func (q queryServer) Stamp(ctx context.Context, req *types.QueryStampRequest) (*
 	return &types.QueryStampResponse{Stamp: stamp}, nil
 }
 
-// StampsByPE returns all stamps by a PE
+// StampsByPE returns a page of stamps by a PE
 func (q queryServer) StampsByPE(ctx context.Context, req *types.QueryStampsByPERequest) (*types.QueryStampsByPEResponse, error) {
-	stamps, err := q.k.GetStampsByPE(ctx, req.PePublicKey)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	stamps, pageRes, err := q.k.GetStampsByPE(ctx, req.PePublicKey, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
-	return &types.QueryStampsByPEResponse{Stamps: stamps}, nil
+	return &types.QueryStampsByPEResponse{Stamps: stamps, Pagination: pageRes}, nil
 }
 
-// StampsByJurisdiction returns all stamps for a jurisdiction
+// StampsByJurisdiction returns a page of stamps for a jurisdiction
 func (q queryServer) StampsByJurisdiction(ctx context.Context, req *types.QueryStampsByJurisdictionRequest) (*types.QueryStampsByJurisdictionResponse, error) {
-	stamps, err := q.k.GetStampsByJurisdiction(ctx, req.JurisdictionId)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	stamps, pageRes, err := q.k.GetStampsByJurisdiction(ctx, req.JurisdictionId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryStampsByJurisdictionResponse{Stamps: stamps, Pagination: pageRes}, nil
+}
+
+// StampsByDocumentHash returns a page of stamps on a document hash
+func (q queryServer) StampsByDocumentHash(ctx context.Context, req *types.QueryStampsByDocumentHashRequest) (*types.QueryStampsByDocumentHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	stamps, pageRes, err := q.k.GetStampsByDocumentHash(ctx, req.DocumentHash, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryStampsByDocumentHashResponse{Stamps: stamps, Pagination: pageRes}, nil
+}
+
+// StampsByEntity returns a page of stamps issued under an entity
+func (q queryServer) StampsByEntity(ctx context.Context, req *types.QueryStampsByEntityRequest) (*types.QueryStampsByEntityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := q.k.GetEntity(ctx, req.EntityId); err != nil {
+		return nil, err
+	}
+	stamps, pageRes, err := q.k.GetStampsByEntity(ctx, req.EntityId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryStampsByEntityResponse{Stamps: stamps, Pagination: pageRes}, nil
+}
+
+// StampBatch returns a stamp batch and a page of its stamps
+func (q queryServer) StampBatch(ctx context.Context, req *types.QueryStampBatchRequest) (*types.QueryStampBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	batch, err := q.k.GetStampBatch(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	stamps, pageRes, err := q.k.GetStampsByBatch(ctx, req.Id, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryStampBatchResponse{Batch: batch, Stamps: stamps, Pagination: pageRes}, nil
+}
+
+// VerifyMerkleInclusion checks a document hash against a Merkle stamp
+func (q queryServer) VerifyMerkleInclusion(ctx context.Context, req *types.QueryVerifyMerkleInclusionRequest) (*types.QueryVerifyMerkleInclusionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	verification, err := q.k.VerifyMerkleInclusion(ctx, req.MerkleRoot, req.LeafHash, req.LeafIndex, req.Proof)
 	if err != nil {
 		return nil, err
 	}
-	return &types.QueryStampsByJurisdictionResponse{Stamps: stamps}, nil
+	return &types.QueryVerifyMerkleInclusionResponse{Verification: verification}, nil
 }
 
-// AllStamps returns all stamps
+// VerifyStamp re-verifies a stamp and reports the PE's license status
+func (q queryServer) VerifyStamp(ctx context.Context, req *types.QueryVerifyStampRequest) (*types.QueryVerifyStampResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	verification, err := q.k.VerifyStamp(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryVerifyStampResponse{Verification: verification}, nil
+}
+
+// AllStamps returns a page of all stamps
 func (q queryServer) AllStamps(ctx context.Context, req *types.QueryAllStampsRequest) (*types.QueryAllStampsResponse, error) {
-	var stamps []types.Stamp
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
 
-	iter, err := q.k.Stamps.Iterate(ctx, nil)
+	stamps, pageRes, err := query.CollectionPaginate(
+		ctx, q.k.Stamps, req.Pagination,
+		func(_ string, stamp types.Stamp) (types.Stamp, error) {
+			return stamp, nil
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
-	defer iter.Close()
+	return &types.QueryAllStampsResponse{Stamps: stamps, Pagination: pageRes}, nil
+}
 
-	for ; iter.Valid(); iter.Next() {
-		stamp, err := iter.Value()
-		if err != nil {
-			return nil, err
-		}
-		stamps = append(stamps, stamp)
+// ProfessionalEngineer returns a registered PE by stamp public key
+func (q queryServer) ProfessionalEngineer(ctx context.Context, req *types.QueryProfessionalEngineerRequest) (*types.QueryProfessionalEngineerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	return &types.QueryAllStampsResponse{Stamps: stamps}, nil
+	pe, err := q.k.GetProfessionalEngineer(ctx, req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryProfessionalEngineerResponse{ProfessionalEngineer: pe}, nil
+}
+
+// StampingDelegations returns a page of the delegations granted by a PE account
+func (q queryServer) StampingDelegations(ctx context.Context, req *types.QueryStampingDelegationsRequest) (*types.QueryStampingDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delegations, pageRes, err := q.k.GetStampingDelegations(ctx, req.Granter, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryStampingDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+// License returns a board's record of a PE license
+func (q queryServer) License(ctx context.Context, req *types.QueryLicenseRequest) (*types.QueryLicenseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	license, err := q.k.GetLicense(ctx, req.JurisdictionId, req.LicenseNumber)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryLicenseResponse{License: license}, nil
 }
 
 // Document returns a document by ID
@@ -75,13 +195,30 @@ func (q queryServer) Document(ctx context.Context, req *types.QueryDocumentReque
 	return &types.QueryDocumentResponse{Document: doc}, nil
 }
 
-// DocumentsByStamp returns all documents for a stamp
+// DocumentsByStamp returns a page of documents for a stamp
 func (q queryServer) DocumentsByStamp(ctx context.Context, req *types.QueryDocumentsByStampRequest) (*types.QueryDocumentsByStampResponse, error) {
-	docs, err := q.k.GetDocumentsByStamp(ctx, req.StampId)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	docs, pageRes, err := q.k.GetDocumentsByStamp(ctx, req.StampId, req.Role, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
-	return &types.QueryDocumentsByStampResponse{Documents: docs}, nil
+	return &types.QueryDocumentsByStampResponse{Documents: docs, Pagination: pageRes}, nil
+}
+
+// PinAttestations returns a page of pinning provider attestations for a document
+func (q queryServer) PinAttestations(ctx context.Context, req *types.QueryPinAttestationsRequest) (*types.QueryPinAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	attestations, pageRes, err := q.k.GetPinAttestations(ctx, req.DocumentId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPinAttestationsResponse{Attestations: attestations, Pagination: pageRes}, nil
 }
 
 // Entity returns an entity by ID
@@ -93,13 +230,72 @@ func (q queryServer) Entity(ctx context.Context, req *types.QueryEntityRequest)
 	return &types.QueryEntityResponse{Entity: entity}, nil
 }
 
-// EntitiesByOwner returns all entities owned by an address
+// EntitiesByOwner returns a page of entities owned by an address
 func (q queryServer) EntitiesByOwner(ctx context.Context, req *types.QueryEntitiesByOwnerRequest) (*types.QueryEntitiesByOwnerResponse, error) {
-	entities, err := q.k.GetEntitiesByOwner(ctx, req.OwnerAddress)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	entities, pageRes, err := q.k.GetEntitiesByOwner(ctx, req.OwnerAddress, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
-	return &types.QueryEntitiesByOwnerResponse{Entities: entities}, nil
+	return &types.QueryEntitiesByOwnerResponse{Entities: entities, Pagination: pageRes}, nil
+}
+
+// EntitiesByMember returns a page of the entities an address is a member of
+func (q queryServer) EntitiesByMember(ctx context.Context, req *types.QueryEntitiesByMemberRequest) (*types.QueryEntitiesByMemberResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	memberships, pageRes, err := q.k.GetEntitiesByMember(ctx, req.MemberAddress, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEntitiesByMemberResponse{Memberships: memberships, Pagination: pageRes}, nil
+}
+
+// PendingInvites returns a page of the open entity invitations for an address
+func (q queryServer) PendingInvites(ctx context.Context, req *types.QueryPendingInvitesRequest) (*types.QueryPendingInvitesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	invites, pageRes, err := q.k.GetPendingInvites(ctx, req.Invitee, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPendingInvitesResponse{Invites: invites, Pagination: pageRes}, nil
+}
+
+// EntityMembers returns a page of an entity's members with their roles
+func (q queryServer) EntityMembers(ctx context.Context, req *types.QueryEntityMembersRequest) (*types.QueryEntityMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := q.k.GetEntity(ctx, req.EntityId); err != nil {
+		return nil, err
+	}
+	members, pageRes, err := q.k.GetEntityMembers(ctx, req.EntityId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEntityMembersResponse{Members: members, Pagination: pageRes}, nil
+}
+
+// MemberFeeUsage returns the fees an entity has sponsored for a member this month
+func (q queryServer) MemberFeeUsage(ctx context.Context, req *types.QueryMemberFeeUsageRequest) (*types.QueryMemberFeeUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	usage, err := q.k.GetMemberFeeUsage(ctx, req.EntityId, req.Member)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMemberFeeUsageResponse{Usage: usage}, nil
 }
 
 // SpecVersion returns a spec version by ID
@@ -111,13 +307,17 @@ func (q queryServer) SpecVersion(ctx context.Context, req *types.QuerySpecVersio
 	return &types.QuerySpecVersionResponse{Version: version}, nil
 }
 
-// SpecVersionsByProject returns all versions for a project
+// SpecVersionsByProject returns a page of versions for a project
 func (q queryServer) SpecVersionsByProject(ctx context.Context, req *types.QuerySpecVersionsByProjectRequest) (*types.QuerySpecVersionsByProjectResponse, error) {
-	versions, err := q.k.GetSpecVersionsByProject(ctx, req.ProjectId)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	versions, pageRes, err := q.k.GetSpecVersionsByProject(ctx, req.ProjectId, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
-	return &types.QuerySpecVersionsByProjectResponse{Versions: versions}, nil
+	return &types.QuerySpecVersionsByProjectResponse{Versions: versions, Pagination: pageRes}, nil
 }
 
 // SpecHistory returns the version history starting from a version
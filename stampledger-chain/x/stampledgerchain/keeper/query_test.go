@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/x/stampledgerchain/keeper"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+func TestStampsByJurisdictionPagination(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	genesisState := types.GenesisState{Params: types.DefaultParams()}
+	for i := 0; i < 5; i++ {
+		genesisState.Stamps = append(genesisState.Stamps, types.Stamp{
+			Id:             fmt.Sprintf("stamp-%d", i),
+			PePublicKey:    "pe-key",
+			JurisdictionId: "wisconsin",
+		})
+	}
+	genesisState.Stamps = append(genesisState.Stamps, types.Stamp{Id: "stamp-other", JurisdictionId: "california"})
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, genesisState))
+
+	request := func(pagination *query.PageRequest) *types.QueryStampsByJurisdictionRequest {
+		return &types.QueryStampsByJurisdictionRequest{JurisdictionId: "wisconsin", Pagination: pagination}
+	}
+
+	t.Run("by key", func(t *testing.T) {
+		var next []byte
+		var ids []string
+		for {
+			res, err := qs.StampsByJurisdiction(f.ctx, request(&query.PageRequest{Key: next, Limit: 2}))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.Stamps), 2)
+			for _, stamp := range res.Stamps {
+				ids = append(ids, stamp.Id)
+			}
+			next = res.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		require.Equal(t, []string{"stamp-0", "stamp-1", "stamp-2", "stamp-3", "stamp-4"}, ids)
+	})
+
+	t.Run("by offset with total", func(t *testing.T) {
+		res, err := qs.StampsByJurisdiction(f.ctx, request(&query.PageRequest{Offset: 3, Limit: 10, CountTotal: true}))
+		require.NoError(t, err)
+		require.Len(t, res.Stamps, 2)
+		require.Equal(t, "stamp-3", res.Stamps[0].Id)
+		require.Equal(t, uint64(5), res.Pagination.Total)
+	})
+
+	t.Run("reverse", func(t *testing.T) {
+		res, err := qs.StampsByJurisdiction(f.ctx, request(&query.PageRequest{Limit: 1, Reverse: true}))
+		require.NoError(t, err)
+		require.Len(t, res.Stamps, 1)
+		require.Equal(t, "stamp-4", res.Stamps[0].Id)
+		require.NotNil(t, res.Pagination.NextKey)
+	})
+
+	t.Run("all stamps", func(t *testing.T) {
+		res, err := qs.AllStamps(f.ctx, &types.QueryAllStampsRequest{Pagination: &query.PageRequest{Limit: 4, CountTotal: true}})
+		require.NoError(t, err)
+		require.Len(t, res.Stamps, 4)
+		require.Equal(t, uint64(6), res.Pagination.Total)
+	})
+
+	t.Run("nil request", func(t *testing.T) {
+		_, err := qs.StampsByJurisdiction(f.ctx, nil)
+		require.Error(t, err)
+	})
+}
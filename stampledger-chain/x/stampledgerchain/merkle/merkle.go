@@ -0,0 +1,155 @@
+// Package merkle builds and verifies the Merkle trees used by Merkle-root
+// stamps, so that off-chain tooling and the chain agree on the tree format.
+//
+// Leaves are SHA-256 document hashes, sorted in ascending byte order. Leaf
+// and interior nodes are domain separated as in RFC 6962:
+//
+//	leaf node     = SHA-256(0x00 || document hash)
+//	interior node = SHA-256(0x01 || left || right)
+//
+// When a level has an odd number of nodes, the last node is promoted to the
+// next level unchanged rather than paired with itself.
+package merkle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// HashSize is the size of a leaf and of every node in the tree
+const HashSize = sha256.Size
+
+const (
+	leafPrefix     byte = 0x00
+	interiorPrefix byte = 0x01
+)
+
+var (
+	// ErrNoLeaves is returned when building a tree over no documents
+	ErrNoLeaves = errors.New("merkle: no leaves")
+	// ErrDuplicateLeaf is returned when the same document hash appears twice
+	ErrDuplicateLeaf = errors.New("merkle: duplicate leaf")
+	// ErrLeafNotFound is returned when proving a leaf that is not in the tree
+	ErrLeafNotFound = errors.New("merkle: leaf not found")
+)
+
+// SortLeaves returns a sorted copy of leaves after checking that each is a
+// SHA-256 hash and that none repeats.
+func SortLeaves(leaves [][]byte) ([][]byte, error) {
+	if len(leaves) == 0 {
+		return nil, ErrNoLeaves
+	}
+	sorted := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		if len(leaf) != HashSize {
+			return nil, fmt.Errorf("merkle: leaf %d is %d bytes, expected %d", i, len(leaf), HashSize)
+		}
+		sorted[i] = leaf
+	}
+	slices.SortFunc(sorted, bytes.Compare)
+	for i := 1; i < len(sorted); i++ {
+		if bytes.Equal(sorted[i-1], sorted[i]) {
+			return nil, fmt.Errorf("%w: %x", ErrDuplicateLeaf, sorted[i])
+		}
+	}
+	return sorted, nil
+}
+
+// Root returns the Merkle root over the given document hashes, in any order.
+func Root(leaves [][]byte) ([]byte, error) {
+	sorted, err := SortLeaves(leaves)
+	if err != nil {
+		return nil, err
+	}
+	level := make([][]byte, len(sorted))
+	for i, leaf := range sorted {
+		level[i] = hashLeaf(leaf)
+	}
+	for len(level) > 1 {
+		level = nextLevel(level)
+	}
+	return level[0], nil
+}
+
+// Proof returns the inclusion proof for leaf: its index in the sorted leaves
+// and the sibling hashes from the leaf level up to the root.
+func Proof(leaves [][]byte, leaf []byte) (uint64, [][]byte, error) {
+	sorted, err := SortLeaves(leaves)
+	if err != nil {
+		return 0, nil, err
+	}
+	index, found := slices.BinarySearchFunc(sorted, leaf, bytes.Compare)
+	if !found {
+		return 0, nil, ErrLeafNotFound
+	}
+
+	level := make([][]byte, len(sorted))
+	for i, l := range sorted {
+		level[i] = hashLeaf(l)
+	}
+	var proof [][]byte
+	for i := index; len(level) > 1; i /= 2 {
+		if sibling := i ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = nextLevel(level)
+	}
+	return uint64(index), proof, nil
+}
+
+// Verify reports whether proof shows that leaf is the leaf at index of a tree
+// of leafCount leaves with the given root.
+func Verify(root []byte, leaf []byte, index uint64, leafCount uint64, proof [][]byte) bool {
+	if len(root) != HashSize || len(leaf) != HashSize || index >= leafCount {
+		return false
+	}
+
+	node := hashLeaf(leaf)
+	for width := leafCount; width > 1; width = (width + 1) / 2 {
+		sibling := index ^ 1
+		if sibling < width {
+			if len(proof) == 0 || len(proof[0]) != HashSize {
+				return false
+			}
+			if index%2 == 0 {
+				node = hashInterior(node, proof[0])
+			} else {
+				node = hashInterior(proof[0], node)
+			}
+			proof = proof[1:]
+		}
+		index /= 2
+	}
+	return len(proof) == 0 && bytes.Equal(node, root)
+}
+
+// nextLevel hashes adjacent pairs of nodes, promoting an odd last node
+func nextLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+			continue
+		}
+		next = append(next, hashInterior(level[i], level[i+1]))
+	}
+	return next
+}
+
+func hashLeaf(leaf []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{leafPrefix})
+	h.Write(leaf)
+	return h.Sum(nil)
+}
+
+func hashInterior(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{interiorPrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
@@ -0,0 +1,81 @@
+package merkle_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"stampledger-chain/x/stampledgerchain/merkle"
+)
+
+func documentHashes(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		h := sha256.Sum256([]byte(fmt.Sprintf("as-built-%d.pdf", i)))
+		leaves[i] = h[:]
+	}
+	return leaves
+}
+
+func TestRootAndProof(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 4, 5, 7, 8, 33, 1000} {
+		t.Run(fmt.Sprintf("%d leaves", n), func(t *testing.T) {
+			leaves := documentHashes(n)
+			root, err := merkle.Root(leaves)
+			require.NoError(t, err)
+			require.Len(t, root, merkle.HashSize)
+
+			for _, leaf := range leaves {
+				index, proof, err := merkle.Proof(leaves, leaf)
+				require.NoError(t, err)
+				require.True(t, merkle.Verify(root, leaf, index, uint64(n), proof))
+
+				// The proof is bound to the leaf's position
+				require.False(t, merkle.Verify(root, leaf, uint64(n), uint64(n), proof))
+				if n > 1 {
+					require.False(t, merkle.Verify(root, leaf, (index+1)%uint64(n), uint64(n), proof))
+				}
+			}
+		})
+	}
+}
+
+func TestRootIsOrderIndependent(t *testing.T) {
+	leaves := documentHashes(5)
+	root, err := merkle.Root(leaves)
+	require.NoError(t, err)
+
+	reversed := [][]byte{leaves[4], leaves[3], leaves[2], leaves[1], leaves[0]}
+	reversedRoot, err := merkle.Root(reversed)
+	require.NoError(t, err)
+	require.Equal(t, root, reversedRoot)
+}
+
+func TestRootInvalidLeaves(t *testing.T) {
+	_, err := merkle.Root(nil)
+	require.ErrorIs(t, err, merkle.ErrNoLeaves)
+
+	leaves := documentHashes(2)
+	_, err = merkle.Root(append(leaves, leaves[0]))
+	require.ErrorIs(t, err, merkle.ErrDuplicateLeaf)
+
+	_, err = merkle.Root([][]byte{[]byte("not a hash")})
+	require.Error(t, err)
+
+	_, _, err = merkle.Proof(leaves, documentHashes(3)[2])
+	require.ErrorIs(t, err, merkle.ErrLeafNotFound)
+}
+
+func TestVerifyRejectsInteriorNodeAsLeaf(t *testing.T) {
+	leaves := documentHashes(4)
+	root, err := merkle.Root(leaves)
+	require.NoError(t, err)
+
+	// An interior node presented as a leaf of a smaller tree must not verify
+	_, proof, err := merkle.Proof(leaves, leaves[0])
+	require.NoError(t, err)
+	require.False(t, merkle.Verify(root, proof[len(proof)-1], 1, 2, nil))
+	require.False(t, merkle.Verify(root, proof[len(proof)-1], 1, 2, proof[:1]))
+}
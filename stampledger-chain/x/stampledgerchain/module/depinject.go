@@ -57,6 +57,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	    in.Cdc,
 		in.AddressCodec,
 	    authority, 
+		in.BankKeeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
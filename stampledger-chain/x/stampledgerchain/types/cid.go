@@ -0,0 +1,159 @@
+package types
+
+import (
+	"bytes"
+	"encoding/base32"
+	"encoding/binary"
+	"strings"
+
+	"github.com/cosmos/btcutil/base58"
+)
+
+// Multicodec and multihash codes used by IPFS document references
+const (
+	CodecRaw        uint64 = 0x55
+	CodecDagPB      uint64 = 0x70
+	MultihashSHA256 uint64 = 0x12
+)
+
+// cidV0Length is the length of a base58btc CIDv0, a bare SHA-256 multihash
+const cidV0Length = 46
+
+var base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// CID is a decoded IPFS content identifier
+type CID struct {
+	Version  uint64
+	Codec    uint64
+	HashCode uint64
+	Digest   []byte
+}
+
+// ParseCID decodes a CIDv0 ("Qm...") or a CIDv1 in base32 ("b...") or
+// base58btc ("z...") multibase. Non-canonical encodings are rejected so that
+// each CID has exactly one string form per multibase.
+func ParseCID(s string) (CID, error) {
+	if len(s) == cidV0Length && strings.HasPrefix(s, "Qm") {
+		mh := base58.Decode(s)
+		if len(mh) == 0 || base58.Encode(mh) != s {
+			return CID{}, ErrInvalidCIDEncoding.Wrap("malformed base58btc CIDv0")
+		}
+		hashCode, digest, err := decodeMultihash(mh)
+		if err != nil {
+			return CID{}, err
+		}
+		if hashCode != MultihashSHA256 || len(digest) != 32 {
+			return CID{}, ErrInvalidMultihash.Wrap("CIDv0 must be a SHA-256 multihash")
+		}
+		return CID{Version: 0, Codec: CodecDagPB, HashCode: hashCode, Digest: digest}, nil
+	}
+
+	if s == "" {
+		return CID{}, ErrInvalidCIDEncoding.Wrap("empty CID")
+	}
+	var data []byte
+	switch s[0] {
+	case 'b':
+		decoded, err := base32Lower.DecodeString(s[1:])
+		if err != nil || base32Lower.EncodeToString(decoded) != s[1:] {
+			return CID{}, ErrInvalidCIDEncoding.Wrap("malformed base32 CID")
+		}
+		data = decoded
+	case 'z':
+		data = base58.Decode(s[1:])
+		if len(data) == 0 || base58.Encode(data) != s[1:] {
+			return CID{}, ErrInvalidCIDEncoding.Wrap("malformed base58btc CID")
+		}
+	default:
+		return CID{}, ErrInvalidCIDEncoding.Wrapf("unsupported multibase prefix '%c'", s[0])
+	}
+
+	version, data, err := readUvarint(data)
+	if err != nil {
+		return CID{}, ErrInvalidCIDEncoding.Wrap("malformed CID version")
+	}
+	if version != 1 {
+		return CID{}, ErrUnsupportedCIDVersion.Wrapf("version %d", version)
+	}
+	codec, data, err := readUvarint(data)
+	if err != nil {
+		return CID{}, ErrInvalidCIDEncoding.Wrap("malformed CID codec")
+	}
+	hashCode, digest, err := decodeMultihash(data)
+	if err != nil {
+		return CID{}, err
+	}
+	return CID{Version: 1, Codec: codec, HashCode: hashCode, Digest: digest}, nil
+}
+
+// NormalizeCID parses a CID and returns its canonical CIDv1 base32 form.
+func NormalizeCID(s string) (string, error) {
+	cid, err := ParseCID(s)
+	if err != nil {
+		return "", err
+	}
+	return cid.V1().String(), nil
+}
+
+// V1 returns the CID as a CIDv1. A CIDv0 is implicitly dag-pb, so only the
+// version changes.
+func (c CID) V1() CID {
+	c.Version = 1
+	return c
+}
+
+// IsRawSHA256 reports whether the CID addresses raw bytes by their SHA-256
+// digest, in which case the digest is the file's document hash.
+func (c CID) IsRawSHA256() bool {
+	return c.Codec == CodecRaw && c.HashCode == MultihashSHA256 && len(c.Digest) == 32
+}
+
+// Bytes returns the binary CID: the bare multihash for a CIDv0, or the
+// version, codec and multihash for a CIDv1.
+func (c CID) Bytes() []byte {
+	mh := binary.AppendUvarint(nil, c.HashCode)
+	mh = binary.AppendUvarint(mh, uint64(len(c.Digest)))
+	mh = append(mh, c.Digest...)
+	if c.Version == 0 {
+		return mh
+	}
+	bz := binary.AppendUvarint(nil, c.Version)
+	bz = binary.AppendUvarint(bz, c.Codec)
+	return append(bz, mh...)
+}
+
+// String encodes a CIDv0 in base58btc and a CIDv1 in base32, the canonical
+// string forms.
+func (c CID) String() string {
+	if c.Version == 0 {
+		return base58.Encode(c.Bytes())
+	}
+	return "b" + base32Lower.EncodeToString(c.Bytes())
+}
+
+// decodeMultihash splits a multihash into its hash code and digest. The
+// digest length must match the declared length exactly.
+func decodeMultihash(data []byte) (uint64, []byte, error) {
+	hashCode, data, err := readUvarint(data)
+	if err != nil {
+		return 0, nil, ErrInvalidMultihash.Wrap("malformed hash code")
+	}
+	length, data, err := readUvarint(data)
+	if err != nil {
+		return 0, nil, ErrInvalidMultihash.Wrap("malformed digest length")
+	}
+	if length == 0 || length != uint64(len(data)) {
+		return 0, nil, ErrInvalidMultihash.Wrapf("digest is %d bytes, declared %d", len(data), length)
+	}
+	return hashCode, bytes.Clone(data), nil
+}
+
+// readUvarint reads a minimally encoded unsigned varint, as multiformats
+// requires, and returns the rest of the buffer.
+func readUvarint(data []byte) (uint64, []byte, error) {
+	v, n := binary.Uvarint(data)
+	if n <= 0 || n != len(binary.AppendUvarint(nil, v)) {
+		return 0, nil, ErrInvalidCIDEncoding
+	}
+	return v, data[n:], nil
+}
@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+func TestParseCID(t *testing.T) {
+	emptySHA256 := sha256.Sum256(nil)
+
+	tests := []struct {
+		desc       string
+		cid        string
+		err        error
+		version    uint64
+		codec      uint64
+		normalized string
+	}{
+		{
+			desc:       "CIDv0",
+			cid:        "QmbWqxBEKC3P8tqsKc98xmWNzrzDtRLMiMPL8wBuTGsMnR",
+			version:    0,
+			codec:      types.CodecDagPB,
+			normalized: "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+		},
+		{
+			desc:       "CIDv1 dag-pb",
+			cid:        "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+			version:    1,
+			codec:      types.CodecDagPB,
+			normalized: "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+		},
+		{
+			desc:       "CIDv1 raw",
+			cid:        "bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku",
+			version:    1,
+			codec:      types.CodecRaw,
+			normalized: "bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku",
+		},
+		{
+			desc: "CIDv0 with a bad base58 character",
+			cid:  "QmbWqxBEKC3P8tqsKc98xmWNzrzDtRLMiMPL8wBuTGsMn0",
+			err:  types.ErrInvalidCIDEncoding,
+		},
+		{
+			desc: "Qm prefix but not a CIDv0",
+			cid:  "Qmgarbage",
+			err:  types.ErrInvalidCIDEncoding,
+		},
+		{
+			desc: "unsupported multibase",
+			cid:  "mAXASIOOwxEKY/BwUmvv0yJlvuSQnrkHkZJuTTKSVmRt4UrhV",
+			err:  types.ErrInvalidCIDEncoding,
+		},
+		{
+			desc: "base32 with uppercase characters",
+			cid:  "bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyKU",
+			err:  types.ErrInvalidCIDEncoding,
+		},
+		{
+			desc: "CIDv2",
+			cid:  types.CID{Version: 2, Codec: types.CodecRaw, HashCode: types.MultihashSHA256, Digest: emptySHA256[:]}.String(),
+			err:  types.ErrUnsupportedCIDVersion,
+		},
+		{
+			desc: "truncated digest",
+			cid:  "bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvy",
+			err:  types.ErrInvalidMultihash,
+		},
+		{
+			desc: "empty",
+			cid:  "",
+			err:  types.ErrInvalidCIDEncoding,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			cid, err := types.ParseCID(tc.cid)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.version, cid.Version)
+			require.Equal(t, tc.codec, cid.Codec)
+			require.Equal(t, types.MultihashSHA256, cid.HashCode)
+			require.Equal(t, tc.cid, cid.String())
+
+			normalized, err := types.NormalizeCID(tc.cid)
+			require.NoError(t, err)
+			require.Equal(t, tc.normalized, normalized)
+		})
+	}
+
+	// A raw SHA-256 CID carries the file's document hash
+	cid, err := types.ParseCID("bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku")
+	require.NoError(t, err)
+	require.True(t, cid.IsRawSHA256())
+	require.Equal(t, hex.EncodeToString(emptySHA256[:]), hex.EncodeToString(cid.Digest))
+}
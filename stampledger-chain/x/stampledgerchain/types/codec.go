@@ -10,10 +10,39 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgCreateStamp{},
 		&MsgRevokeStamp{},
+		&MsgSupersedeStamp{},
+		&MsgCreateStampBatch{},
+		&MsgRevokeStampBatch{},
+		&MsgCreateMerkleStamp{},
+		&MsgProposeCoStamp{},
+		&MsgAddCoSignature{},
+		&MsgRegisterPE{},
+		&MsgRotatePEKey{},
+		&MsgReportKeyCompromise{},
+		&MsgGrantStampingDelegation{},
+		&MsgRevokeStampingDelegation{},
+		&MsgAttestLicense{},
+		&MsgSuspendLicense{},
+		&MsgReinstateLicense{},
 		&MsgStoreDocument{},
+		&MsgRenewPin{},
+		&MsgUnpin{},
+		&MsgAttestPinned{},
 		&MsgCreateEntity{},
 		&MsgAddEntityMember{},
+		&MsgInviteMember{},
+		&MsgAcceptInvite{},
+		&MsgDeclineInvite{},
+		&MsgLeaveEntity{},
 		&MsgRemoveEntityMember{},
+		&MsgUpdateMemberRole{},
+		&MsgFundEntity{},
+		&MsgSetEntityFeeCap{},
+		&MsgUpdateEntity{},
+		&MsgTransferEntityOwnership{},
+		&MsgAcceptEntityOwnership{},
+		&MsgDeactivateEntity{},
+		&MsgReactivateEntity{},
 		&MsgCreateSpecVersion{},
 	)
 }
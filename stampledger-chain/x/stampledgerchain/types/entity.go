@@ -0,0 +1,59 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// SponsoredRoles are the entity roles whose stamping fees the entity
+// treasury pays.
+var SponsoredRoles = map[string]bool{
+	"editor": true,
+	"admin":  true,
+}
+
+// EntityAction is something a member does under an entity's name
+type EntityAction string
+
+const (
+	// EntityActionStamp issues a stamp under the entity
+	EntityActionStamp EntityAction = "issue stamps"
+	// EntityActionStoreDocument stores a document under the entity
+	EntityActionStoreDocument EntityAction = "store documents"
+	// EntityActionCreateSpecVersion creates a spec version under the entity
+	EntityActionCreateSpecVersion EntityAction = "create spec versions"
+)
+
+// RolePermissions lists the actions each entity role permits. Viewers can
+// see the entity's records but act under its name in no way.
+var RolePermissions = map[string]map[EntityAction]bool{
+	"editor": {
+		EntityActionStamp:             true,
+		EntityActionStoreDocument:     true,
+		EntityActionCreateSpecVersion: true,
+	},
+	"admin": {
+		EntityActionStamp:             true,
+		EntityActionStoreDocument:     true,
+		EntityActionCreateSpecVersion: true,
+	},
+}
+
+// RolePermits reports whether the entity role permits action.
+func RolePermits(role string, action EntityAction) bool {
+	return RolePermissions[role][action]
+}
+
+// EntityTreasuryAddress derives the keyless account that holds an entity's
+// fee sponsorship funds.
+func EntityTreasuryAddress(entityID string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("treasury"), []byte(entityID))
+}
+
+// FeePeriod returns the calendar month, in UTC, that sponsored fee usage at
+// blockTime counts against.
+func FeePeriod(blockTime time.Time) string {
+	return blockTime.UTC().Format("2006-01")
+}
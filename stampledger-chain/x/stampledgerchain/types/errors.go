@@ -11,23 +11,88 @@ var (
 	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 
 	// Stamp errors
-	ErrInvalidDocumentHash  = errors.Register(ModuleName, 1101, "invalid document hash: must be 64 hex characters (SHA-256)")
-	ErrInvalidPublicKey     = errors.Register(ModuleName, 1102, "invalid PE public key: must be 64 hex characters (Ed25519)")
-	ErrInvalidSignature     = errors.Register(ModuleName, 1103, "signature verification failed")
-	ErrStampNotFound        = errors.Register(ModuleName, 1104, "stamp not found")
-	ErrStampAlreadyRevoked  = errors.Register(ModuleName, 1105, "stamp is already revoked")
-	ErrUnauthorized         = errors.Register(ModuleName, 1106, "unauthorized: sender is not authorized for this action")
-	ErrDuplicateStamp       = errors.Register(ModuleName, 1107, "stamp already exists for this document and PE")
+	ErrInvalidDocumentHash = errors.Register(ModuleName, 1101, "invalid document hash: must be 64 hex characters (SHA-256)")
+	ErrInvalidPublicKey    = errors.Register(ModuleName, 1102, "invalid PE public key: must be 64 hex characters (Ed25519)")
+	ErrInvalidSignature    = errors.Register(ModuleName, 1103, "signature verification failed")
+	ErrStampNotFound       = errors.Register(ModuleName, 1104, "stamp not found")
+	ErrStampAlreadyRevoked = errors.Register(ModuleName, 1105, "stamp is already revoked")
+	ErrUnauthorized        = errors.Register(ModuleName, 1106, "unauthorized: sender is not authorized for this action")
+	ErrDuplicateStamp      = errors.Register(ModuleName, 1107, "stamp already exists for this document and PE")
+	ErrSignatureExpired    = errors.Register(ModuleName, 1108, "stamp signature has expired")
+	ErrSignatureReused     = errors.Register(ModuleName, 1109, "stamp signature has already been used")
+
+	// PE registry errors
+	ErrPENotRegistered      = errors.Register(ModuleName, 1140, "PE public key is not registered")
+	ErrPEAlreadyRegistered  = errors.Register(ModuleName, 1141, "PE public key is already registered")
+	ErrPEMismatch           = errors.Register(ModuleName, 1142, "stamp does not match the PE registry")
+	ErrInvalidLicenseNumber = errors.Register(ModuleName, 1143, "invalid PE license number")
+	ErrPEKeyInactive        = errors.Register(ModuleName, 1144, "PE key has been rotated or reported compromised")
+	ErrInvalidCompromise    = errors.Register(ModuleName, 1145, "invalid key compromise report")
+
+	// Jurisdiction errors
+	ErrInvalidJurisdiction      = errors.Register(ModuleName, 1150, "invalid jurisdiction")
+	ErrJurisdictionNotFound     = errors.Register(ModuleName, 1151, "jurisdiction not found")
+	ErrLicenseNotFound          = errors.Register(ModuleName, 1152, "license not found")
+	ErrLicenseNotActive         = errors.Register(ModuleName, 1153, "PE license is not active in this jurisdiction")
+	ErrInvalidLicenseTransition = errors.Register(ModuleName, 1154, "invalid license status transition")
+
+	// Stamp lifecycle errors
+	ErrInvalidValidUntil      = errors.Register(ModuleName, 1160, "invalid stamp validity: valid_until must be in the future")
+	ErrStampAlreadySuperseded = errors.Register(ModuleName, 1161, "stamp is already superseded")
+	ErrInvalidRevocation      = errors.Register(ModuleName, 1162, "invalid revocation: unknown reason or malformed evidence hash")
+	ErrInvalidStampBatch      = errors.Register(ModuleName, 1163, "invalid stamp batch")
+	ErrStampBatchNotFound     = errors.Register(ModuleName, 1164, "stamp batch not found")
+	ErrInvalidMerkleStamp     = errors.Register(ModuleName, 1165, "invalid Merkle stamp or inclusion proof")
+	ErrInvalidCoStamp         = errors.Register(ModuleName, 1166, "invalid co-sealed stamp proposal")
+	ErrInvalidCoSignature     = errors.Register(ModuleName, 1167, "invalid co-signature")
+
+	// Stamping delegation errors
+	ErrInvalidDelegation  = errors.Register(ModuleName, 1170, "invalid stamping delegation")
+	ErrDelegationNotFound = errors.Register(ModuleName, 1171, "stamping delegation not found")
+
+	// Module fee errors
+	ErrInvalidFeeSchedule = errors.Register(ModuleName, 1180, "invalid module fee schedule")
+
+	// Document pinning errors
+	ErrInvalidPinParams      = errors.Register(ModuleName, 1200, "invalid document pinning parameters")
+	ErrDocumentNotPinned     = errors.Register(ModuleName, 1201, "document is not pinned")
+	ErrNotPinningProvider    = errors.Register(ModuleName, 1202, "sender is not a registered pinning provider")
+	ErrInvalidPinAttestation = errors.Register(ModuleName, 1203, "invalid pin attestation")
+
+	// Entity membership errors
+	ErrMemberExists   = errors.Register(ModuleName, 1210, "address is already a member of the entity")
+	ErrLastAdmin      = errors.Register(ModuleName, 1211, "entity must keep at least one admin")
+	ErrInviteNotFound = errors.Register(ModuleName, 1212, "entity invite not found")
+	ErrInvalidInvite  = errors.Register(ModuleName, 1213, "invalid entity invite")
+	ErrDirectAdd      = errors.Register(ModuleName, 1214, "members can no longer be added directly: use MsgInviteMember")
+
+	// Validation limit errors
+	ErrInvalidLimits      = errors.Register(ModuleName, 1190, "invalid validation limits")
+	ErrFieldTooLong       = errors.Register(ModuleName, 1191, "field exceeds maximum length")
+	ErrDocumentTooLarge   = errors.Register(ModuleName, 1192, "document size out of range")
+	ErrMimeTypeNotAllowed = errors.Register(ModuleName, 1193, "MIME type not allowed")
 
 	// Document errors
-	ErrInvalidIpfsHash  = errors.Register(ModuleName, 1110, "invalid IPFS hash format")
-	ErrDocumentNotFound = errors.Register(ModuleName, 1111, "document not found")
+	ErrInvalidIpfsHash       = errors.Register(ModuleName, 1110, "invalid IPFS hash format")
+	ErrDocumentNotFound      = errors.Register(ModuleName, 1111, "document not found")
+	ErrInvalidCIDEncoding    = errors.Register(ModuleName, 1112, "invalid CID multibase encoding")
+	ErrUnsupportedCIDVersion = errors.Register(ModuleName, 1113, "unsupported CID version")
+	ErrInvalidMultihash      = errors.Register(ModuleName, 1114, "invalid CID multihash")
+	ErrCIDDigestMismatch     = errors.Register(ModuleName, 1115, "CID digest does not match the stamp's document hash")
+	ErrDocumentHashMismatch  = errors.Register(ModuleName, 1116, "sealed original does not match the stamp's document hash")
+	ErrInvalidDocumentRole   = errors.Register(ModuleName, 1117, "invalid document role")
+	ErrContentHashMismatch   = errors.Register(ModuleName, 1118, "CID digest does not match the declared content hash")
 
 	// Entity errors
-	ErrEntityNotFound   = errors.Register(ModuleName, 1120, "entity not found")
-	ErrInvalidEntityType = errors.Register(ModuleName, 1121, "invalid entity type: must be 'company', 'municipality', or 'firm'")
-	ErrMemberNotFound   = errors.Register(ModuleName, 1122, "member not found in entity")
-	ErrInvalidRole      = errors.Register(ModuleName, 1123, "invalid role: must be 'viewer', 'editor', or 'admin'")
+	ErrEntityNotFound    = errors.Register(ModuleName, 1120, "entity not found")
+	ErrInvalidEntityType = errors.Register(ModuleName, 1121, "invalid entity type")
+	ErrMemberNotFound    = errors.Register(ModuleName, 1122, "member not found in entity")
+	ErrInvalidRole       = errors.Register(ModuleName, 1123, "invalid role: must be 'viewer', 'editor', or 'admin'")
+	ErrInvalidFunding    = errors.Register(ModuleName, 1124, "invalid entity funding or fee cap amount")
+	ErrFeeNotSponsored   = errors.Register(ModuleName, 1125, "transaction fee cannot be sponsored by this entity")
+	ErrFeeCapExceeded    = errors.Register(ModuleName, 1126, "member monthly fee cap exceeded")
+	ErrEntityInactive    = errors.Register(ModuleName, 1127, "entity is not active")
+	ErrNoPendingTransfer = errors.Register(ModuleName, 1128, "no pending ownership transfer to the sender")
 
 	// Spec version errors
 	ErrSpecVersionNotFound   = errors.Register(ModuleName, 1130, "spec version not found")
@@ -1,14 +1,223 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-	    Params:	DefaultParams(),
+		Params: DefaultParams(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// 1. Stamps must have unique, non-empty IDs and supersession links must resolve
+	stampIDs := make(map[string]bool, len(gs.Stamps))
+	for _, stamp := range gs.Stamps {
+		if stamp.Id == "" {
+			return fmt.Errorf("stamp with empty id")
+		}
+		if stampIDs[stamp.Id] {
+			return fmt.Errorf("duplicate stamp id: %s", stamp.Id)
+		}
+		stampIDs[stamp.Id] = true
+	}
+	for _, stamp := range gs.Stamps {
+		if stamp.Supersedes != "" && !stampIDs[stamp.Supersedes] {
+			return fmt.Errorf("stamp %s supersedes unknown stamp %s", stamp.Id, stamp.Supersedes)
+		}
+		if stamp.SupersededBy != "" && !stampIDs[stamp.SupersededBy] {
+			return fmt.Errorf("stamp %s is superseded by unknown stamp %s", stamp.Id, stamp.SupersededBy)
+		}
+	}
+
+	// 2. Stamp batches must be unique and every batched stamp must point at one
+	batchIDs := make(map[string]bool, len(gs.StampBatches))
+	for _, batch := range gs.StampBatches {
+		if batch.Id == "" {
+			return fmt.Errorf("stamp batch with empty id")
+		}
+		if batchIDs[batch.Id] {
+			return fmt.Errorf("duplicate stamp batch id: %s", batch.Id)
+		}
+		batchIDs[batch.Id] = true
+	}
+	for _, stamp := range gs.Stamps {
+		if stamp.BatchId != "" && !batchIDs[stamp.BatchId] {
+			return fmt.Errorf("stamp %s references unknown batch %s", stamp.Id, stamp.BatchId)
+		}
+	}
+
+	// 3. PEs must be registered once per public key
+	peKeys := make(map[string]bool, len(gs.ProfessionalEngineers))
+	for _, pe := range gs.ProfessionalEngineers {
+		if pe.PublicKey == "" {
+			return fmt.Errorf("professional engineer with empty public key")
+		}
+		if peKeys[pe.PublicKey] {
+			return fmt.Errorf("duplicate professional engineer public key: %s", pe.PublicKey)
+		}
+		peKeys[pe.PublicKey] = true
+	}
+
+	// 4. Stamping delegations must be unique per granter and grantee
+	delegationKeys := make(map[[2]string]bool, len(gs.StampingDelegations))
+	for _, delegation := range gs.StampingDelegations {
+		key := [2]string{delegation.Granter, delegation.Grantee}
+		if delegationKeys[key] {
+			return fmt.Errorf("duplicate stamping delegation from %s to %s", delegation.Granter, delegation.Grantee)
+		}
+		if delegation.Granter == delegation.Grantee {
+			return fmt.Errorf("stamping delegation from %s to itself", delegation.Granter)
+		}
+		delegationKeys[key] = true
+	}
+
+	// 5. Licenses must be unique per jurisdiction, and attested ones must name
+	// the PE account they were attested to
+	licenseKeys := make(map[[2]string]bool, len(gs.Licenses))
+	for _, license := range gs.Licenses {
+		key := [2]string{license.JurisdictionId, license.LicenseNumber}
+		if licenseKeys[key] {
+			return fmt.Errorf("duplicate license %s in jurisdiction %s", license.LicenseNumber, license.JurisdictionId)
+		}
+		if license.Status != LicenseUnattested && license.PeAccount == "" {
+			return fmt.Errorf("license %s in jurisdiction %s has no PE account", license.LicenseNumber, license.JurisdictionId)
+		}
+		licenseKeys[key] = true
+	}
+
+	// 6. Documents must be unique, have a known role and point at an existing
+	// stamp, and pin attestations must point at a document
+	docIDs := make(map[string]bool, len(gs.Documents))
+	for _, doc := range gs.Documents {
+		if doc.Id == "" {
+			return fmt.Errorf("document with empty id")
+		}
+		if docIDs[doc.Id] {
+			return fmt.Errorf("duplicate document id: %s", doc.Id)
+		}
+		docIDs[doc.Id] = true
+
+		if !stampIDs[doc.StampId] {
+			return fmt.Errorf("document %s references unknown stamp %s", doc.Id, doc.StampId)
+		}
+		if _, ok := DocumentRole_name[int32(doc.Role)]; !ok {
+			return fmt.Errorf("document %s has unknown role %d", doc.Id, doc.Role)
+		}
+	}
+	attestationKeys := make(map[[2]string]bool, len(gs.PinAttestations))
+	for _, attestation := range gs.PinAttestations {
+		if !docIDs[attestation.DocumentId] {
+			return fmt.Errorf("pin attestation by %s references unknown document %s", attestation.Provider, attestation.DocumentId)
+		}
+		key := [2]string{attestation.DocumentId, attestation.Provider}
+		if attestationKeys[key] {
+			return fmt.Errorf("duplicate pin attestation by %s for document %s", attestation.Provider, attestation.DocumentId)
+		}
+		attestationKeys[key] = true
+	}
+
+	// 7. Entities must have unique, non-empty IDs, and members, invites and
+	// fee usage must point at one
+	entityIDs := make(map[string]bool, len(gs.Entities))
+	for _, entity := range gs.Entities {
+		if entity.Id == "" {
+			return fmt.Errorf("entity with empty id")
+		}
+		if entityIDs[entity.Id] {
+			return fmt.Errorf("duplicate entity id: %s", entity.Id)
+		}
+		entityIDs[entity.Id] = true
+		if !entity.MemberMonthlyCap.IsValid() {
+			return fmt.Errorf("entity %s has invalid member monthly cap %s", entity.Id, entity.MemberMonthlyCap)
+		}
+		if entity.PendingOwner != "" && entity.PendingOwner == entity.OwnerAddress {
+			return fmt.Errorf("entity %s is pending transfer to its own owner", entity.Id)
+		}
+	}
+	memberKeys := make(map[[2]string]bool, len(gs.EntityMembers))
+	for _, member := range gs.EntityMembers {
+		if !entityIDs[member.EntityId] {
+			return fmt.Errorf("member %s references unknown entity %s", member.Address, member.EntityId)
+		}
+		key := [2]string{member.EntityId, member.Address}
+		if memberKeys[key] {
+			return fmt.Errorf("duplicate member %s in entity %s", member.Address, member.EntityId)
+		}
+		memberKeys[key] = true
+		if !ValidRoles[member.Role] {
+			return fmt.Errorf("member %s in entity %s has invalid role '%s'", member.Address, member.EntityId, member.Role)
+		}
+	}
+	inviteKeys := make(map[[2]string]bool, len(gs.EntityInvites))
+	for _, invite := range gs.EntityInvites {
+		if !entityIDs[invite.EntityId] {
+			return fmt.Errorf("invite for %s references unknown entity %s", invite.Invitee, invite.EntityId)
+		}
+		key := [2]string{invite.EntityId, invite.Invitee}
+		if inviteKeys[key] {
+			return fmt.Errorf("duplicate invite for %s to entity %s", invite.Invitee, invite.EntityId)
+		}
+		inviteKeys[key] = true
+		if !ValidRoles[invite.Role] {
+			return fmt.Errorf("invite for %s to entity %s has invalid role '%s'", invite.Invitee, invite.EntityId, invite.Role)
+		}
+		if memberKeys[key] {
+			return fmt.Errorf("invite for %s to entity %s, which it is already a member of", invite.Invitee, invite.EntityId)
+		}
+	}
+	usageKeys := make(map[[2]string]bool, len(gs.MemberFeeUsages))
+	for _, usage := range gs.MemberFeeUsages {
+		if !entityIDs[usage.EntityId] {
+			return fmt.Errorf("fee usage for %s references unknown entity %s", usage.Member, usage.EntityId)
+		}
+		key := [2]string{usage.EntityId, usage.Member}
+		if usageKeys[key] {
+			return fmt.Errorf("duplicate fee usage for %s in entity %s", usage.Member, usage.EntityId)
+		}
+		usageKeys[key] = true
+	}
+
+	// 8. Spec versions must be unique and their parents must exist
+	versionIDs := make(map[string]bool, len(gs.SpecVersions))
+	for _, spec := range gs.SpecVersions {
+		if spec.Id == "" {
+			return fmt.Errorf("spec version with empty id")
+		}
+		if versionIDs[spec.Id] {
+			return fmt.Errorf("duplicate spec version id: %s", spec.Id)
+		}
+		versionIDs[spec.Id] = true
+	}
+	for _, spec := range gs.SpecVersions {
+		if spec.ParentVersionId != "" && !versionIDs[spec.ParentVersionId] {
+			return fmt.Errorf("spec version %s references unknown parent %s", spec.Id, spec.ParentVersionId)
+		}
+	}
+
+	// 9. Stamps, documents and spec versions issued under an entity must point
+	// at an existing one
+	for _, stamp := range gs.Stamps {
+		if stamp.EntityId != "" && !entityIDs[stamp.EntityId] {
+			return fmt.Errorf("stamp %s references unknown entity %s", stamp.Id, stamp.EntityId)
+		}
+	}
+	for _, doc := range gs.Documents {
+		if doc.EntityId != "" && !entityIDs[doc.EntityId] {
+			return fmt.Errorf("document %s references unknown entity %s", doc.Id, doc.EntityId)
+		}
+	}
+	for _, spec := range gs.SpecVersions {
+		if spec.EntityId != "" && !entityIDs[spec.EntityId] {
+			return fmt.Errorf("spec version %s references unknown entity %s", spec.Id, spec.EntityId)
+		}
+	}
+
+	return nil
 }
@@ -36,6 +36,24 @@ type GenesisState struct {
 	Entities []EntityAccount `protobuf:"bytes,4,rep,name=entities,proto3" json:"entities"`
 	// spec_versions is the list of all spec versions
 	SpecVersions []SpecVersion `protobuf:"bytes,5,rep,name=spec_versions,json=specVersions,proto3" json:"spec_versions"`
+	// id_sequence is the next value of the module's record ID sequence
+	IdSequence uint64 `protobuf:"varint,6,opt,name=id_sequence,json=idSequence,proto3" json:"id_sequence,omitempty"`
+	// professional_engineers is the PE registry
+	ProfessionalEngineers []ProfessionalEngineer `protobuf:"bytes,7,rep,name=professional_engineers,json=professionalEngineers,proto3" json:"professional_engineers"`
+	// licenses is the list of board license records
+	Licenses []License `protobuf:"bytes,8,rep,name=licenses,proto3" json:"licenses"`
+	// stamp_batches is the list of stamp batches
+	StampBatches []StampBatch `protobuf:"bytes,9,rep,name=stamp_batches,json=stampBatches,proto3" json:"stamp_batches"`
+	// stamping_delegations is the list of PE stamping delegations
+	StampingDelegations []StampingDelegation `protobuf:"bytes,10,rep,name=stamping_delegations,json=stampingDelegations,proto3" json:"stamping_delegations"`
+	// member_fee_usages is the list of per-member sponsored fee usage
+	MemberFeeUsages []MemberFeeUsage `protobuf:"bytes,11,rep,name=member_fee_usages,json=memberFeeUsages,proto3" json:"member_fee_usages"`
+	// pin_attestations is the list of pinning provider attestations
+	PinAttestations []PinAttestation `protobuf:"bytes,12,rep,name=pin_attestations,json=pinAttestations,proto3" json:"pin_attestations"`
+	// entity_members is the membership of all entities
+	EntityMembers []EntityMember `protobuf:"bytes,13,rep,name=entity_members,json=entityMembers,proto3" json:"entity_members"`
+	// entity_invites is the list of open entity invitations
+	EntityInvites []EntityInvite `protobuf:"bytes,14,rep,name=entity_invites,json=entityInvites,proto3" json:"entity_invites"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +124,69 @@ func (m *GenesisState) GetSpecVersions() []SpecVersion {
 	return nil
 }
 
+func (m *GenesisState) GetIdSequence() uint64 {
+	if m != nil {
+		return m.IdSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetProfessionalEngineers() []ProfessionalEngineer {
+	if m != nil {
+		return m.ProfessionalEngineers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLicenses() []License {
+	if m != nil {
+		return m.Licenses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStampBatches() []StampBatch {
+	if m != nil {
+		return m.StampBatches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStampingDelegations() []StampingDelegation {
+	if m != nil {
+		return m.StampingDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMemberFeeUsages() []MemberFeeUsage {
+	if m != nil {
+		return m.MemberFeeUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPinAttestations() []PinAttestation {
+	if m != nil {
+		return m.PinAttestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEntityMembers() []EntityMember {
+	if m != nil {
+		return m.EntityMembers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEntityInvites() []EntityInvite {
+	if m != nil {
+		return m.EntityInvites
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stampledgerchain.stampledgerchain.v1.GenesisState")
 }
@@ -115,29 +196,45 @@ func init() {
 }
 
 var fileDescriptor_2a8ccb74ac876602 = []byte{
-	// 347 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0xd2, 0xb1, 0x4a, 0xc3, 0x40,
-	0x18, 0x07, 0xf0, 0xc4, 0xd6, 0x62, 0xaf, 0x75, 0x30, 0x38, 0x84, 0x0e, 0xb1, 0x88, 0x43, 0xa9,
-	0x9a, 0x98, 0x16, 0x1f, 0xc0, 0x52, 0x11, 0x27, 0xa5, 0x45, 0x41, 0x11, 0xe4, 0x4c, 0x3f, 0x62,
-	0xc0, 0xdc, 0x85, 0x7c, 0xd7, 0x62, 0xdf, 0xc2, 0xc7, 0x70, 0x74, 0xf6, 0x09, 0x3a, 0x76, 0x74,
-	0x12, 0x69, 0x07, 0x5f, 0x43, 0x72, 0x39, 0xac, 0x98, 0xe5, 0x96, 0x70, 0xfc, 0xc9, 0xff, 0xc7,
-	0x7d, 0xc9, 0x47, 0x3a, 0x28, 0x68, 0x9c, 0x3c, 0xc1, 0x28, 0x84, 0x34, 0x78, 0xa4, 0x11, 0xf3,
-	0x0a, 0xc1, 0xc4, 0xf7, 0x42, 0x60, 0x80, 0x11, 0xba, 0x49, 0xca, 0x05, 0xb7, 0xf6, 0xfe, 0xbf,
-	0xe2, 0x16, 0x82, 0x89, 0xdf, 0xd8, 0xa2, 0x71, 0xc4, 0xb8, 0x27, 0x9f, 0x79, 0xb1, 0xb1, 0x1d,
-	0xf2, 0x90, 0xcb, 0xa3, 0x97, 0x9d, 0x54, 0xea, 0x6b, 0x5d, 0x21, 0xa1, 0x29, 0x8d, 0xd5, 0x0d,
-	0x1a, 0x47, 0x5a, 0x15, 0x99, 0xe5, 0x8d, 0xdd, 0xf7, 0x12, 0xa9, 0x9f, 0xe5, 0x53, 0x0c, 0x05,
-	0x15, 0x60, 0x5d, 0x90, 0x4a, 0x4e, 0xda, 0x66, 0xd3, 0x6c, 0xd5, 0x3a, 0x07, 0xae, 0xce, 0x54,
-	0xee, 0xa5, 0xec, 0xf4, 0xaa, 0xb3, 0xcf, 0x1d, 0xe3, 0xf5, 0xfb, 0xad, 0x6d, 0x0e, 0x14, 0x63,
-	0x9d, 0x93, 0x8a, 0x2c, 0xa0, 0xbd, 0xd6, 0x2c, 0xb5, 0x6a, 0x9d, 0x7d, 0x3d, 0x70, 0x98, 0x65,
-	0xbd, 0x72, 0xe6, 0x0d, 0x14, 0x60, 0xdd, 0x90, 0xea, 0x88, 0x07, 0xe3, 0x18, 0x98, 0x40, 0xbb,
-	0x24, 0xb5, 0x63, 0x3d, 0xad, 0xaf, 0x6a, 0x43, 0xc1, 0x53, 0x1a, 0x82, 0x72, 0x57, 0x9a, 0x75,
-	0x45, 0x36, 0x80, 0x89, 0x48, 0x44, 0x80, 0x76, 0x59, 0xca, 0x5d, 0x3d, 0xf9, 0x34, 0x6b, 0x4d,
-	0x4f, 0x82, 0x80, 0x8f, 0x99, 0x50, 0xee, 0x2f, 0x65, 0xdd, 0x91, 0x4d, 0x4c, 0x20, 0xb8, 0x9f,
-	0x40, 0x8a, 0x11, 0x67, 0x68, 0xaf, 0x4b, 0xdb, 0xd7, 0xfc, 0x06, 0x09, 0x04, 0xd7, 0x79, 0x53,
-	0xc9, 0x75, 0x5c, 0x45, 0xd8, 0xeb, 0xcf, 0x16, 0x8e, 0x39, 0x5f, 0x38, 0xe6, 0xd7, 0xc2, 0x31,
-	0x5f, 0x96, 0x8e, 0x31, 0x5f, 0x3a, 0xc6, 0xc7, 0xd2, 0x31, 0x6e, 0xdb, 0x7f, 0xb8, 0xc3, 0xfc,
-	0xc7, 0x3f, 0x17, 0x77, 0x41, 0x4c, 0x13, 0xc0, 0x87, 0x8a, 0xdc, 0x84, 0xee, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x21, 0x54, 0xd2, 0xa2, 0xf3, 0x02, 0x00, 0x00,
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0x13, 0x31,
+	0x14, 0x85, 0x33, 0xb4, 0x84, 0xd6, 0x69, 0x0a, 0x35, 0x05, 0x59, 0x59, 0x4c, 0x23, 0xc4, 0x22,
+	0x2a, 0x34, 0x69, 0x52, 0x90, 0x10, 0xbb, 0x46, 0x29, 0xa8, 0x12, 0xa8, 0x55, 0xa2, 0x22, 0xf1,
+	0x23, 0x8d, 0x9c, 0xc9, 0xcd, 0xd4, 0x52, 0xc6, 0x33, 0x9d, 0xeb, 0x04, 0xf2, 0x02, 0xac, 0x79,
+	0x0c, 0x96, 0x3c, 0x46, 0x97, 0x5d, 0xb2, 0x42, 0x28, 0x59, 0xf0, 0x1a, 0x68, 0x3c, 0xce, 0x1f,
+	0x61, 0x31, 0xd9, 0x44, 0xce, 0xb1, 0xcf, 0x77, 0xee, 0xb5, 0x3d, 0x26, 0x35, 0x54, 0xdc, 0x0f,
+	0x7b, 0xd0, 0xf1, 0x20, 0x72, 0x2f, 0xb9, 0x90, 0x95, 0x25, 0x61, 0x50, 0xad, 0x78, 0x20, 0x01,
+	0x05, 0x96, 0xc3, 0x28, 0x50, 0x01, 0x7d, 0xfc, 0xef, 0x92, 0xf2, 0x92, 0x30, 0xa8, 0x16, 0x76,
+	0xb8, 0x2f, 0x64, 0x50, 0xd1, 0xbf, 0x89, 0xb1, 0xb0, 0xeb, 0x05, 0x5e, 0xa0, 0x87, 0x95, 0x78,
+	0x64, 0xd4, 0x6a, 0xaa, 0x12, 0x42, 0x1e, 0x71, 0xdf, 0x54, 0x50, 0x38, 0x4c, 0x65, 0xd1, 0x5a,
+	0xe2, 0x78, 0xf4, 0x95, 0x90, 0xad, 0xd7, 0x49, 0x17, 0x2d, 0xc5, 0x15, 0xd0, 0x33, 0x92, 0x4d,
+	0x90, 0xcc, 0x2a, 0x5a, 0xa5, 0x5c, 0xed, 0x69, 0x39, 0x4d, 0x57, 0xe5, 0x73, 0xed, 0xa9, 0x6f,
+	0x5e, 0xff, 0xda, 0xcb, 0x7c, 0xff, 0xf3, 0x63, 0xdf, 0x6a, 0x1a, 0x0c, 0x3d, 0x25, 0x59, 0x6d,
+	0x40, 0x76, 0xab, 0xb8, 0x56, 0xca, 0xd5, 0x9e, 0xa4, 0x03, 0xb6, 0x62, 0xad, 0xbe, 0x1e, 0xf3,
+	0x9a, 0x06, 0x40, 0xdf, 0x93, 0xcd, 0x4e, 0xe0, 0xf6, 0x7d, 0x90, 0x0a, 0xd9, 0x9a, 0xa6, 0x3d,
+	0x4f, 0x47, 0x6b, 0x18, 0x5b, 0x4b, 0x05, 0x11, 0xf7, 0xc0, 0x70, 0x67, 0x34, 0x7a, 0x41, 0x36,
+	0x40, 0x2a, 0xa1, 0x04, 0x20, 0x5b, 0xd7, 0xe4, 0xa3, 0x74, 0xe4, 0x93, 0xd8, 0x35, 0x3c, 0x76,
+	0xdd, 0xa0, 0x2f, 0x95, 0xe1, 0x4e, 0x51, 0xf4, 0x13, 0xc9, 0x63, 0x08, 0xae, 0x33, 0x80, 0x08,
+	0x45, 0x20, 0x91, 0xdd, 0xd6, 0xec, 0x6a, 0xca, 0x3d, 0x08, 0xc1, 0x7d, 0x97, 0x38, 0x0d, 0x79,
+	0x0b, 0x67, 0x12, 0xd2, 0x3d, 0x92, 0x13, 0x1d, 0x07, 0xe1, 0xaa, 0x0f, 0xd2, 0x05, 0x96, 0x2d,
+	0x5a, 0xa5, 0xf5, 0x26, 0x11, 0x9d, 0x96, 0x51, 0xe8, 0x67, 0xf2, 0x30, 0x8c, 0x82, 0x2e, 0x60,
+	0xbc, 0x9e, 0xf7, 0x1c, 0x90, 0x9e, 0x90, 0x00, 0x11, 0xb2, 0x3b, 0xba, 0x8e, 0x97, 0x29, 0x0f,
+	0x77, 0x8e, 0x71, 0x62, 0x10, 0xa6, 0xa0, 0x07, 0xe1, 0x7f, 0xe6, 0x90, 0x9e, 0x91, 0x8d, 0x9e,
+	0x70, 0x41, 0x22, 0x20, 0xdb, 0xd0, 0x51, 0x07, 0xe9, 0xa2, 0xde, 0x24, 0xae, 0xc9, 0x46, 0x4e,
+	0x20, 0xf4, 0x23, 0xc9, 0xeb, 0xe5, 0x4e, 0x9b, 0x2b, 0xf7, 0x12, 0x90, 0x6d, 0x6a, 0xea, 0xe1,
+	0x2a, 0x97, 0x29, 0x76, 0x4e, 0xf7, 0x71, 0xaa, 0x00, 0xd2, 0x2b, 0xb2, 0xab, 0xff, 0x0b, 0xe9,
+	0x39, 0x1d, 0xe8, 0x81, 0xc7, 0x95, 0x3e, 0x2c, 0xa2, 0x33, 0x5e, 0xac, 0x90, 0x21, 0xa4, 0xd7,
+	0x98, 0x02, 0x4c, 0xd6, 0x7d, 0x5c, 0x9a, 0x41, 0xda, 0x25, 0x3b, 0x3e, 0xf8, 0x6d, 0x88, 0x9c,
+	0x2e, 0x80, 0xd3, 0x47, 0xee, 0x01, 0xb2, 0x9c, 0xce, 0x7b, 0x96, 0x2e, 0xef, 0xad, 0xb6, 0xbf,
+	0x02, 0xb8, 0xc0, 0xd9, 0x8d, 0xbe, 0xeb, 0x2f, 0xa8, 0x48, 0x81, 0xdc, 0x0b, 0x85, 0x74, 0xb8,
+	0x52, 0x80, 0xca, 0xb4, 0xb5, 0xb5, 0x4a, 0xcc, 0xb9, 0x90, 0xc7, 0x33, 0xf3, 0x24, 0x26, 0x5c,
+	0x50, 0x91, 0x3a, 0x64, 0x5b, 0xdf, 0xf9, 0xa1, 0x93, 0x14, 0x80, 0x2c, 0xaf, 0x43, 0x6a, 0xab,
+	0x7c, 0x44, 0x49, 0x47, 0x26, 0x22, 0x0f, 0x73, 0xda, 0x7c, 0x80, 0x90, 0x03, 0xa1, 0x00, 0xd9,
+	0xf6, 0xea, 0x01, 0xa7, 0xda, 0xba, 0x18, 0x90, 0x68, 0x58, 0x6f, 0x5c, 0x8f, 0x6c, 0xeb, 0x66,
+	0x64, 0x5b, 0xbf, 0x47, 0xb6, 0xf5, 0x6d, 0x6c, 0x67, 0x6e, 0xc6, 0x76, 0xe6, 0xe7, 0xd8, 0xce,
+	0x7c, 0xd8, 0x9f, 0x03, 0x1e, 0x24, 0x8f, 0xe8, 0x97, 0xe5, 0x77, 0x55, 0x0d, 0x43, 0xc0, 0x76,
+	0x56, 0xbf, 0xaa, 0x47, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xb6, 0x63, 0xb0, 0x3f, 0x06,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -160,6 +257,123 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EntityInvites) > 0 {
+		for iNdEx := len(m.EntityInvites) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntityInvites[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.EntityMembers) > 0 {
+		for iNdEx := len(m.EntityMembers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntityMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PinAttestations) > 0 {
+		for iNdEx := len(m.PinAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PinAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.MemberFeeUsages) > 0 {
+		for iNdEx := len(m.MemberFeeUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberFeeUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.StampingDelegations) > 0 {
+		for iNdEx := len(m.StampingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StampingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.StampBatches) > 0 {
+		for iNdEx := len(m.StampBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StampBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Licenses) > 0 {
+		for iNdEx := len(m.Licenses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Licenses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ProfessionalEngineers) > 0 {
+		for iNdEx := len(m.ProfessionalEngineers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfessionalEngineers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.IdSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IdSequence))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.SpecVersions) > 0 {
 		for iNdEx := len(m.SpecVersions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -272,6 +486,57 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.IdSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.IdSequence))
+	}
+	if len(m.ProfessionalEngineers) > 0 {
+		for _, e := range m.ProfessionalEngineers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Licenses) > 0 {
+		for _, e := range m.Licenses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StampBatches) > 0 {
+		for _, e := range m.StampBatches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StampingDelegations) > 0 {
+		for _, e := range m.StampingDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MemberFeeUsages) > 0 {
+		for _, e := range m.MemberFeeUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PinAttestations) > 0 {
+		for _, e := range m.PinAttestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EntityMembers) > 0 {
+		for _, e := range m.EntityMembers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EntityInvites) > 0 {
+		for _, e := range m.EntityInvites {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -479,6 +744,297 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdSequence", wireType)
+			}
+			m.IdSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfessionalEngineers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfessionalEngineers = append(m.ProfessionalEngineers, ProfessionalEngineer{})
+			if err := m.ProfessionalEngineers[len(m.ProfessionalEngineers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Licenses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Licenses = append(m.Licenses, License{})
+			if err := m.Licenses[len(m.Licenses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StampBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StampBatches = append(m.StampBatches, StampBatch{})
+			if err := m.StampBatches[len(m.StampBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StampingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StampingDelegations = append(m.StampingDelegations, StampingDelegation{})
+			if err := m.StampingDelegations[len(m.StampingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberFeeUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberFeeUsages = append(m.MemberFeeUsages, MemberFeeUsage{})
+			if err := m.MemberFeeUsages[len(m.MemberFeeUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinAttestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PinAttestations = append(m.PinAttestations, PinAttestation{})
+			if err := m.PinAttestations[len(m.PinAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityMembers = append(m.EntityMembers, EntityMember{})
+			if err := m.EntityMembers[len(m.EntityMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityInvites", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityInvites = append(m.EntityInvites, EntityInvite{})
+			if err := m.EntityInvites[len(m.EntityInvites)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
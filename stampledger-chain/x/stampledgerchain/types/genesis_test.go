@@ -8,32 +8,110 @@ import (
 )
 
 func TestGenesisState_Validate(t *testing.T) {
-    tests := []struct {
-    		desc          string
-    		genState      *types.GenesisState
-    		valid bool
-    } {
-        {
-            desc:     "default is valid",
-            genState: types.DefaultGenesis(),
-            valid:    true,
-        },
-        {
-            desc:     "valid genesis state",
-            genState: &types.GenesisState{
-            	
-            },
-            valid:    true,
-        },
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				Stamps:       []types.Stamp{{Id: "stamp-1"}},
+				Documents:    []types.DocumentStorage{{Id: "doc-1", StampId: "stamp-1"}},
+				Entities:     []types.EntityAccount{{Id: "entity-1"}},
+				SpecVersions: []types.SpecVersion{{Id: "spec-2", ParentVersionId: "spec-1"}, {Id: "spec-1"}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate stamp",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Stamps: []types.Stamp{{Id: "stamp-1"}, {Id: "stamp-1"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate document",
+			genState: &types.GenesisState{
+				Params:    types.DefaultParams(),
+				Stamps:    []types.Stamp{{Id: "stamp-1"}},
+				Documents: []types.DocumentStorage{{Id: "doc-1", StampId: "stamp-1"}, {Id: "doc-1", StampId: "stamp-1"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "document references missing stamp",
+			genState: &types.GenesisState{
+				Params:    types.DefaultParams(),
+				Documents: []types.DocumentStorage{{Id: "doc-1", StampId: "stamp-1"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate entity",
+			genState: &types.GenesisState{
+				Params:   types.DefaultParams(),
+				Entities: []types.EntityAccount{{Id: "entity-1"}, {Id: "entity-1"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invite references missing entity",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				Entities:      []types.EntityAccount{{Id: "entity-1"}},
+				EntityInvites: []types.EntityInvite{{EntityId: "entity-2", Invitee: "invitee", Role: "viewer"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "stamp references missing entity",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Stamps: []types.Stamp{{Id: "stamp-1", EntityId: "entity-1"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "attested license without PE account",
+			genState: &types.GenesisState{
+				Params:   types.DefaultParams(),
+				Licenses: []types.License{{JurisdictionId: "wisconsin", LicenseNumber: "PE-12345", Status: types.LicenseActive}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate spec version",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				SpecVersions: []types.SpecVersion{{Id: "spec-1"}, {Id: "spec-1"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "spec version references missing parent",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				SpecVersions: []types.SpecVersion{{Id: "spec-2", ParentVersionId: "spec-1"}},
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-            err := tc.genState.Validate()
-            if tc.valid {
-                require.NoError(t, err)
-            } else {
-                require.Error(t, err)
-            }
-        })
-    }
-}
\ No newline at end of file
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
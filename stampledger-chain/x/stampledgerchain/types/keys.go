@@ -11,24 +11,56 @@ const (
 
 	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
 	GovModuleName = "gov"
+
+	// FeeCollectorName duplicates the auth fee collector module account's name.
+	// Module fees are paid into it so that x/distribution pays them out.
+	FeeCollectorName = "fee_collector"
 )
 
 var (
 	// ParamsKey is the prefix to retrieve all Params
 	ParamsKey = collections.NewPrefix("p_stampledgerchain")
 
+	// IDSequenceKey is the prefix of the sequence used to derive record IDs
+	IDSequenceKey = collections.NewPrefix("seq/id")
+
 	// Stamp storage keys - use short unique prefixes to avoid collisions
 	StampsKey               = collections.NewPrefix("st/id")
 	StampsByPEKey           = collections.NewPrefix("st/pe")
 	StampsByJurisdictionKey = collections.NewPrefix("st/jur")
+	StampsByDocumentHashKey = collections.NewPrefix("st/doc")
+	StampsByExpiryKey       = collections.NewPrefix("st/exp")
+	StampsByBatchKey        = collections.NewPrefix("st/batch")
+	StampsByEntityKey       = collections.NewPrefix("st/ent")
+	UsedSignaturesKey       = collections.NewPrefix("st/sig")
+
+	// Stamp batch storage
+	StampBatchesKey = collections.NewPrefix("batch/id")
+
+	// Stamping delegations
+	StampingDelegationsKey = collections.NewPrefix("deleg/pe")
+
+	// PE registry keys
+	ProfessionalEngineersKey = collections.NewPrefix("pe/key")
+
+	// Jurisdiction license keys
+	LicensesKey = collections.NewPrefix("lic/jur")
 
 	// Document storage keys
 	DocumentsKey        = collections.NewPrefix("doc/id")
 	DocumentsByStampKey = collections.NewPrefix("doc/stamp")
+	PinScheduleKey      = collections.NewPrefix("doc/pin")
+	PinAttestationsKey  = collections.NewPrefix("doc/patt")
 
 	// Entity storage keys
-	EntitiesKey        = collections.NewPrefix("ent/id")
-	EntitiesByOwnerKey = collections.NewPrefix("ent/own")
+	EntitiesKey           = collections.NewPrefix("ent/id")
+	EntitiesByOwnerKey    = collections.NewPrefix("ent/own")
+	EntitiesByTreasuryKey = collections.NewPrefix("ent/tsy")
+	MemberFeeUsageKey     = collections.NewPrefix("ent/fee")
+	EntityMembersKey      = collections.NewPrefix("ent/mem")
+	EntitiesByMemberKey   = collections.NewPrefix("ent/bymem")
+	EntityInvitesKey      = collections.NewPrefix("ent/invite")
+	InviteExpiryKey       = collections.NewPrefix("ent/invexp")
 
 	// Spec version storage keys
 	SpecVersionsKey          = collections.NewPrefix("spec/id")
@@ -0,0 +1,20 @@
+package types
+
+import "slices"
+
+// IsBoard reports whether addr is one of the jurisdiction's board addresses.
+func (j Jurisdiction) IsBoard(addr string) bool {
+	return slices.Contains(j.BoardAddresses, addr)
+}
+
+// StatusAt returns the license status in effect at the given Unix timestamp.
+func (l License) StatusAt(timestamp int64) LicenseStatus {
+	status := LicenseUnattested
+	for _, change := range l.History {
+		if change.ChangedAt > timestamp {
+			break
+		}
+		status = change.Status
+	}
+	return status
+}
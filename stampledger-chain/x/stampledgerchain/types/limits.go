@@ -0,0 +1,87 @@
+package types
+
+import (
+	"slices"
+	"strings"
+)
+
+// Ceilings on user-supplied fields. ValidateBasic enforces them statelessly;
+// the governance-set limits in Params are enforced by the keeper and may not
+// exceed them.
+const (
+	MaxNameLengthCeiling        = 256
+	MaxProjectNameLengthCeiling = 512
+	MaxFilenameLengthCeiling    = 1024
+	MaxReasonLengthCeiling      = 4096
+	MaxChangelogLengthCeiling   = 65536
+	MaxMetadataLengthCeiling    = 65536
+	MaxDocumentSizeCeiling      = 1 << 40 // 1 TiB
+)
+
+// ceilingLimits holds the ceilings as Params so ValidateBasic can run the
+// same checks as the keeper.
+var ceilingLimits = Params{
+	MaxNameLength:        MaxNameLengthCeiling,
+	MaxProjectNameLength: MaxProjectNameLengthCeiling,
+	MaxFilenameLength:    MaxFilenameLengthCeiling,
+	MaxReasonLength:      MaxReasonLengthCeiling,
+	MaxChangelogLength:   MaxChangelogLengthCeiling,
+	MaxMetadataLength:    MaxMetadataLengthCeiling,
+	MaxDocumentSize:      MaxDocumentSizeCeiling,
+}
+
+// CheckLength returns ErrFieldTooLong if value is longer than limit bytes.
+func CheckLength(field string, value string, limit uint32) error {
+	if uint64(len(value)) > uint64(limit) {
+		return ErrFieldTooLong.Wrapf("%s is %d bytes, limit is %d", field, len(value), limit)
+	}
+	return nil
+}
+
+// CheckStampFields checks a stamp's descriptive fields against the limits.
+func (p Params) CheckStampFields(peName, projectName, documentFilename string, documentSize int64) error {
+	if err := CheckLength("pe_name", peName, p.MaxNameLength); err != nil {
+		return err
+	}
+	if err := CheckLength("project_name", projectName, p.MaxProjectNameLength); err != nil {
+		return err
+	}
+	if err := CheckLength("document_filename", documentFilename, p.MaxFilenameLength); err != nil {
+		return err
+	}
+	return p.CheckDocumentSize(documentSize)
+}
+
+// CheckDocumentSize rejects negative sizes and sizes above the limit.
+func (p Params) CheckDocumentSize(size int64) error {
+	if size < 0 || size > p.MaxDocumentSize {
+		return ErrDocumentTooLarge.Wrapf("size %d, limit is %d bytes", size, p.MaxDocumentSize)
+	}
+	return nil
+}
+
+// CheckMimeType rejects MIME types outside the allow list, if one is set.
+func (p Params) CheckMimeType(mimeType string) error {
+	if len(p.AllowedMimeTypes) > 0 && !slices.Contains(p.AllowedMimeTypes, mimeType) {
+		return ErrMimeTypeNotAllowed.Wrapf("got '%s'", mimeType)
+	}
+	return nil
+}
+
+// CheckEntityType rejects entity types outside the allow list.
+func (p Params) CheckEntityType(entityType string) error {
+	if !slices.Contains(p.AllowedEntityTypes, entityType) {
+		return ErrInvalidEntityType.Wrapf("got '%s', allowed: %s", entityType, strings.Join(p.AllowedEntityTypes, ", "))
+	}
+	return nil
+}
+
+// CheckIpfsCID rejects CIDs that do not start with an accepted prefix.
+func (p Params) CheckIpfsCID(cid string) error {
+	for _, prefix := range p.IpfsCidPrefixes {
+		if strings.HasPrefix(cid, prefix) {
+			return nil
+		}
+	}
+	return ErrInvalidIpfsHash.Wrapf("must start with one of: %s", strings.Join(p.IpfsCidPrefixes, ", "))
+}
@@ -4,12 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// ValidEntityTypes defines valid entity types
-var ValidEntityTypes = map[string]bool{
-	"company":      true,
-	"municipality": true,
-	"firm":         true,
-}
+// MaxStampBatchSize is the maximum number of entries in a MsgCreateStampBatch
+const MaxStampBatchSize = 200
 
 // ValidRoles defines valid member roles
 var ValidRoles = map[string]bool{
@@ -56,7 +52,7 @@ func (m MsgCreateStamp) ValidateBasic() error {
 	if len(m.Signature) != 128 {
 		return ErrInvalidSignature
 	}
-	return nil
+	return ceilingLimits.CheckStampFields(m.PeName, m.ProjectName, m.DocumentFilename, m.DocumentSize)
 }
 
 func (m MsgRevokeStamp) GetSigners() []sdk.AccAddress {
@@ -71,9 +67,317 @@ func (m MsgRevokeStamp) ValidateBasic() error {
 	if m.StampId == "" {
 		return ErrStampNotFound
 	}
+	if !m.ReasonCode.IsValid() {
+		return ErrInvalidRevocation
+	}
+	if m.EvidenceHash != "" && len(m.EvidenceHash) != 64 {
+		return ErrInvalidRevocation
+	}
+	return CheckLength("reason", m.Reason, MaxReasonLengthCeiling)
+}
+
+func (m MsgSupersedeStamp) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgSupersedeStamp) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.SupersededStampId == "" {
+		return ErrStampNotFound
+	}
+	if len(m.DocumentHash) != 64 {
+		return ErrInvalidDocumentHash
+	}
+	if len(m.PePublicKey) != 64 {
+		return ErrInvalidPublicKey
+	}
+	if len(m.Signature) != 128 {
+		return ErrInvalidSignature
+	}
+	return ceilingLimits.CheckStampFields(m.PeName, m.ProjectName, m.DocumentFilename, m.DocumentSize)
+}
+
+func (m MsgCreateStampBatch) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgCreateStampBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if len(m.PePublicKey) != 64 {
+		return ErrInvalidPublicKey
+	}
+	if len(m.Entries) == 0 || len(m.Entries) > MaxStampBatchSize {
+		return ErrInvalidStampBatch
+	}
+	for _, entry := range m.Entries {
+		if len(entry.DocumentHash) != 64 {
+			return ErrInvalidDocumentHash
+		}
+		if len(entry.Signature) != 128 {
+			return ErrInvalidSignature
+		}
+		if err := ceilingLimits.CheckStampFields(m.PeName, m.ProjectName, entry.DocumentFilename, entry.DocumentSize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m MsgRevokeStampBatch) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgRevokeStampBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.BatchId == "" {
+		return ErrStampBatchNotFound
+	}
+	if !m.ReasonCode.IsValid() {
+		return ErrInvalidRevocation
+	}
+	if m.EvidenceHash != "" && len(m.EvidenceHash) != 64 {
+		return ErrInvalidRevocation
+	}
+	return CheckLength("reason", m.Reason, MaxReasonLengthCeiling)
+}
+
+func (m MsgCreateMerkleStamp) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgCreateMerkleStamp) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if len(m.MerkleRoot) != 64 || m.LeafCount == 0 {
+		return ErrInvalidMerkleStamp
+	}
+	if len(m.PePublicKey) != 64 {
+		return ErrInvalidPublicKey
+	}
+	if len(m.Signature) != 128 {
+		return ErrInvalidSignature
+	}
+	return ceilingLimits.CheckStampFields(m.PeName, m.ProjectName, "", 0)
+}
+
+func (m MsgProposeCoStamp) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgProposeCoStamp) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if len(m.DocumentHash) != 64 {
+		return ErrInvalidDocumentHash
+	}
+	if len(m.CoSigners) < 2 || m.Threshold == 0 || int(m.Threshold) > len(m.CoSigners) {
+		return ErrInvalidCoStamp
+	}
+	for _, signer := range m.CoSigners {
+		if len(signer.PePublicKey) != 64 {
+			return ErrInvalidPublicKey
+		}
+	}
+	return ceilingLimits.CheckStampFields("", m.ProjectName, m.DocumentFilename, m.DocumentSize)
+}
+
+func (m MsgAddCoSignature) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgAddCoSignature) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.StampId == "" {
+		return ErrStampNotFound
+	}
+	if len(m.PePublicKey) != 64 {
+		return ErrInvalidPublicKey
+	}
+	if len(m.Signature) != 128 {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// ============================================================================
+// PE REGISTRY MESSAGE VALIDATION
+// ============================================================================
+
+func (m MsgRegisterPE) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgRegisterPE) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if len(m.PublicKey) != 64 {
+		return ErrInvalidPublicKey
+	}
+	if m.LicenseNumber == "" {
+		return ErrInvalidLicenseNumber
+	}
+	if len(m.PopSignature) != 128 {
+		return ErrInvalidSignature
+	}
+	return CheckLength("name", m.Name, MaxNameLengthCeiling)
+}
+
+func (m MsgRotatePEKey) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgRotatePEKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if len(m.OldPublicKey) != 64 || len(m.NewPublicKey) != 64 {
+		return ErrInvalidPublicKey
+	}
+	if m.OldPublicKey == m.NewPublicKey {
+		return ErrInvalidPublicKey.Wrap("new key must differ from old key")
+	}
+	if len(m.OldKeySignature) != 128 || len(m.NewKeySignature) != 128 {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func (m MsgReportKeyCompromise) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgReportKeyCompromise) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if len(m.PublicKey) != 64 {
+		return ErrInvalidPublicKey
+	}
+	if m.CompromisedSinceHeight <= 0 {
+		return ErrInvalidCompromise.Wrap("compromised_since_height must be positive")
+	}
+	return nil
+}
+
+func (m MsgGrantStampingDelegation) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgGrantStampingDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Grantee); err != nil {
+		return err
+	}
+	if m.Grantee == m.Creator {
+		return ErrInvalidDelegation
+	}
+	for _, projectName := range m.ProjectNames {
+		if err := CheckLength("project_name", projectName, MaxProjectNameLengthCeiling); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m MsgRevokeStampingDelegation) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgRevokeStampingDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Grantee); err != nil {
+		return err
+	}
 	return nil
 }
 
+// ============================================================================
+// JURISDICTION BOARD MESSAGE VALIDATION
+// ============================================================================
+
+func (m MsgAttestLicense) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgAttestLicense) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.JurisdictionId == "" {
+		return ErrJurisdictionNotFound
+	}
+	if m.LicenseNumber == "" {
+		return ErrInvalidLicenseNumber
+	}
+	if _, err := sdk.AccAddressFromBech32(m.PeAccount); err != nil {
+		return err
+	}
+	return CheckLength("reason", m.Reason, MaxReasonLengthCeiling)
+}
+
+func (m MsgSuspendLicense) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgSuspendLicense) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.JurisdictionId == "" {
+		return ErrJurisdictionNotFound
+	}
+	if m.LicenseNumber == "" {
+		return ErrInvalidLicenseNumber
+	}
+	return CheckLength("reason", m.Reason, MaxReasonLengthCeiling)
+}
+
+func (m MsgReinstateLicense) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgReinstateLicense) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.JurisdictionId == "" {
+		return ErrJurisdictionNotFound
+	}
+	if m.LicenseNumber == "" {
+		return ErrInvalidLicenseNumber
+	}
+	return CheckLength("reason", m.Reason, MaxReasonLengthCeiling)
+}
+
 // ============================================================================
 // DOCUMENT MESSAGE VALIDATION
 // ============================================================================
@@ -90,8 +394,68 @@ func (m MsgStoreDocument) ValidateBasic() error {
 	if m.StampId == "" {
 		return ErrStampNotFound
 	}
-	if len(m.IpfsHash) < 46 {
-		return ErrInvalidIpfsHash
+	if _, err := ParseCID(m.IpfsHash); err != nil {
+		return err
+	}
+	if m.ContentSha256 != "" && len(m.ContentSha256) != 64 {
+		return ErrInvalidDocumentHash
+	}
+	if _, ok := DocumentRole_name[int32(m.Role)]; !ok {
+		return ErrInvalidDocumentRole
+	}
+	if err := CheckLength("filename", m.Filename, MaxFilenameLengthCeiling); err != nil {
+		return err
+	}
+	return ceilingLimits.CheckDocumentSize(m.Size_)
+}
+
+func (m MsgRenewPin) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgRenewPin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.DocumentId == "" {
+		return ErrDocumentNotFound
+	}
+	return nil
+}
+
+func (m MsgUnpin) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgUnpin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.DocumentId == "" {
+		return ErrDocumentNotFound
+	}
+	return nil
+}
+
+func (m MsgAttestPinned) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgAttestPinned) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.DocumentId == "" {
+		return ErrDocumentNotFound
+	}
+	if m.ReplicaCount == 0 || m.PinnedUntilHeight <= 0 {
+		return ErrInvalidPinAttestation
+	}
+	if len(m.Signature) != 128 {
+		return ErrInvalidSignature
 	}
 	return nil
 }
@@ -112,10 +476,10 @@ func (m MsgCreateEntity) ValidateBasic() error {
 	if m.Name == "" {
 		return ErrInvalidEntityType.Wrap("name cannot be empty")
 	}
-	if !ValidEntityTypes[m.EntityType] {
-		return ErrInvalidEntityType
+	if m.EntityType == "" {
+		return ErrInvalidEntityType.Wrap("entity type cannot be empty")
 	}
-	return nil
+	return CheckLength("name", m.Name, MaxNameLengthCeiling)
 }
 
 func (m MsgAddEntityMember) GetSigners() []sdk.AccAddress {
@@ -136,6 +500,72 @@ func (m MsgAddEntityMember) ValidateBasic() error {
 	return nil
 }
 
+func (m MsgInviteMember) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgInviteMember) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.EntityId == "" {
+		return ErrEntityNotFound
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Invitee); err != nil {
+		return err
+	}
+	if !ValidRoles[m.Role] {
+		return ErrInvalidRole
+	}
+	return nil
+}
+
+func (m MsgAcceptInvite) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgAcceptInvite) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.EntityId == "" {
+		return ErrEntityNotFound
+	}
+	return nil
+}
+
+func (m MsgDeclineInvite) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgDeclineInvite) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.EntityId == "" {
+		return ErrEntityNotFound
+	}
+	return nil
+}
+
+func (m MsgLeaveEntity) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgLeaveEntity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.EntityId == "" {
+		return ErrEntityNotFound
+	}
+	return nil
+}
+
 func (m MsgRemoveEntityMember) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Creator)
 	return []sdk.AccAddress{addr}
@@ -151,6 +581,147 @@ func (m MsgRemoveEntityMember) ValidateBasic() error {
 	return nil
 }
 
+func (m MsgUpdateMemberRole) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgUpdateMemberRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.EntityId == "" {
+		return ErrEntityNotFound
+	}
+	if !ValidRoles[m.Role] {
+		return ErrInvalidRole
+	}
+	return nil
+}
+
+func (m MsgFundEntity) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgFundEntity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.EntityId == "" {
+		return ErrEntityNotFound
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return ErrInvalidFunding.Wrapf("amount %s must be positive", m.Amount)
+	}
+	return nil
+}
+
+func (m MsgSetEntityFeeCap) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgSetEntityFeeCap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.EntityId == "" {
+		return ErrEntityNotFound
+	}
+	if !m.MemberMonthlyCap.IsValid() {
+		return ErrInvalidFunding.Wrapf("invalid member monthly cap %s", m.MemberMonthlyCap)
+	}
+	return nil
+}
+
+func (m MsgUpdateEntity) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgUpdateEntity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.EntityId == "" {
+		return ErrEntityNotFound
+	}
+	if m.Name == "" {
+		return ErrInvalidEntityType.Wrap("name cannot be empty")
+	}
+	if m.EntityType == "" {
+		return ErrInvalidEntityType.Wrap("entity type cannot be empty")
+	}
+	if err := CheckLength("name", m.Name, MaxNameLengthCeiling); err != nil {
+		return err
+	}
+	return CheckLength("metadata", m.Metadata, MaxMetadataLengthCeiling)
+}
+
+func (m MsgTransferEntityOwnership) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgTransferEntityOwnership) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.EntityId == "" {
+		return ErrEntityNotFound
+	}
+	if _, err := sdk.AccAddressFromBech32(m.NewOwner); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgAcceptEntityOwnership) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgAcceptEntityOwnership) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.EntityId == "" {
+		return ErrEntityNotFound
+	}
+	return nil
+}
+
+func (m MsgDeactivateEntity) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgDeactivateEntity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.EntityId == "" {
+		return ErrEntityNotFound
+	}
+	return CheckLength("reason", m.Reason, MaxReasonLengthCeiling)
+}
+
+func (m MsgReactivateEntity) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgReactivateEntity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return err
+	}
+	if m.EntityId == "" {
+		return ErrEntityNotFound
+	}
+	return nil
+}
+
 // ============================================================================
 // SPEC VERSION MESSAGE VALIDATION
 // ============================================================================
@@ -170,5 +741,5 @@ func (m MsgCreateSpecVersion) ValidateBasic() error {
 	if m.Version == "" {
 		return ErrInvalidVersion
 	}
-	return nil
+	return CheckLength("changelog", m.Changelog, MaxChangelogLengthCeiling)
 }
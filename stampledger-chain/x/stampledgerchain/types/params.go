@@ -1,24 +1,275 @@
 package types
 
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"slices"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Default validation limits.
+var (
+	DefaultMaxNameLength        uint32 = 128
+	DefaultMaxProjectNameLength uint32 = 256
+	DefaultMaxFilenameLength    uint32 = 255
+	DefaultMaxReasonLength      uint32 = 1024
+	DefaultMaxChangelogLength   uint32 = 8192
+	DefaultMaxDocumentSize      int64  = 1 << 30 // 1 GiB
+	DefaultMaxMetadataLength    uint32 = 4096
+	DefaultAllowedEntityTypes          = []string{"company", "municipality", "firm"}
+	DefaultIpfsCidPrefixes             = []string{"Qm", "bafy", "bafk", "z"}
+)
+
+// Default pinning and invitation parameters, in blocks of about six seconds.
+var (
+	DefaultPinDuration      int64 = 5_256_000 // About a year
+	DefaultPinExpiryWarning int64 = 100_800   // About a week
+	DefaultInviteDuration   int64 = 201_600   // About two weeks
+)
 
 // NewParams creates a new Params instance.
 func NewParams(
+	allowLegacySignatures bool,
+	jurisdictions []Jurisdiction,
+	stampFee sdk.Coins,
+	documentFeeBuckets []DocumentFeeBucket,
+	entityCreationFee sdk.Coins,
+	feeExemptAddresses []string,
+	maxNameLength uint32,
+	maxProjectNameLength uint32,
+	maxFilenameLength uint32,
+	maxReasonLength uint32,
+	maxChangelogLength uint32,
+	maxDocumentSize int64,
+	allowedMimeTypes []string,
+	allowedEntityTypes []string,
+	ipfsCidPrefixes []string,
+	pinDuration int64,
+	pinExpiryWarning int64,
+	pinningProviders []PinningProvider,
+	maxMetadataLength uint32,
+	inviteDuration int64,
 ) Params {
 	return Params{
+		AllowLegacySignatures: allowLegacySignatures,
+		Jurisdictions:         jurisdictions,
+		StampFee:              stampFee,
+		DocumentFeeBuckets:    documentFeeBuckets,
+		EntityCreationFee:     entityCreationFee,
+		FeeExemptAddresses:    feeExemptAddresses,
+		MaxNameLength:         maxNameLength,
+		MaxProjectNameLength:  maxProjectNameLength,
+		MaxFilenameLength:     maxFilenameLength,
+		MaxReasonLength:       maxReasonLength,
+		MaxChangelogLength:    maxChangelogLength,
+		MaxDocumentSize:       maxDocumentSize,
+		AllowedMimeTypes:      allowedMimeTypes,
+		AllowedEntityTypes:    allowedEntityTypes,
+		IpfsCidPrefixes:       ipfsCidPrefixes,
+		PinDuration:           pinDuration,
+		PinExpiryWarning:      pinExpiryWarning,
+		PinningProviders:      pinningProviders,
+		MaxMetadataLength:     maxMetadataLength,
+		InviteDuration:        inviteDuration,
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return NewParams(
+		false,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		DefaultMaxNameLength,
+		DefaultMaxProjectNameLength,
+		DefaultMaxFilenameLength,
+		DefaultMaxReasonLength,
+		DefaultMaxChangelogLength,
+		DefaultMaxDocumentSize,
+		nil,
+		slices.Clone(DefaultAllowedEntityTypes),
+		slices.Clone(DefaultIpfsCidPrefixes),
+		DefaultPinDuration,
+		DefaultPinExpiryWarning,
+		nil,
+		DefaultMaxMetadataLength,
+		DefaultInviteDuration,
 	)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Jurisdictions))
+	for _, j := range p.Jurisdictions {
+		if j.Id == "" {
+			return ErrInvalidJurisdiction.Wrap("jurisdiction id cannot be empty")
+		}
+		if seen[j.Id] {
+			return ErrInvalidJurisdiction.Wrapf("duplicate jurisdiction id: %s", j.Id)
+		}
+		seen[j.Id] = true
+
+		for _, board := range j.BoardAddresses {
+			if _, err := sdk.AccAddressFromBech32(board); err != nil {
+				return ErrInvalidJurisdiction.Wrapf("invalid board address %q for %s: %s", board, j.Id, err)
+			}
+		}
+	}
+
+	if !p.StampFee.IsValid() {
+		return ErrInvalidFeeSchedule.Wrapf("invalid stamp fee %s", p.StampFee)
+	}
+	if !p.EntityCreationFee.IsValid() {
+		return ErrInvalidFeeSchedule.Wrapf("invalid entity creation fee %s", p.EntityCreationFee)
+	}
+	for i, bucket := range p.DocumentFeeBuckets {
+		if bucket.MaxSize <= 0 {
+			return ErrInvalidFeeSchedule.Wrapf("document fee bucket %d: max_size must be positive", i)
+		}
+		if i > 0 && bucket.MaxSize <= p.DocumentFeeBuckets[i-1].MaxSize {
+			return ErrInvalidFeeSchedule.Wrapf("document fee bucket %d: max_size must be greater than the previous bucket's", i)
+		}
+		if !bucket.Fee.IsValid() {
+			return ErrInvalidFeeSchedule.Wrapf("document fee bucket %d: invalid fee %s", i, bucket.Fee)
+		}
+	}
+
+	exempt := make(map[string]bool, len(p.FeeExemptAddresses))
+	for _, addr := range p.FeeExemptAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return ErrInvalidFeeSchedule.Wrapf("invalid fee exempt address %q: %s", addr, err)
+		}
+		if exempt[addr] {
+			return ErrInvalidFeeSchedule.Wrapf("duplicate fee exempt address: %s", addr)
+		}
+		exempt[addr] = true
+	}
+
+	if err := p.validateLimits(); err != nil {
+		return err
+	}
+	if p.InviteDuration <= 0 {
+		return ErrInvalidInvite.Wrapf("invite_duration must be positive, got %d", p.InviteDuration)
+	}
+
+	return p.validatePinning()
+}
+
+// validatePinning checks the pin lifecycle and the pinning provider registry.
+func (p Params) validatePinning() error {
+	if p.PinDuration <= 0 {
+		return ErrInvalidPinParams.Wrapf("pin_duration must be positive, got %d", p.PinDuration)
+	}
+	if p.PinExpiryWarning < 0 || p.PinExpiryWarning >= p.PinDuration {
+		return ErrInvalidPinParams.Wrapf("pin_expiry_warning must be between 0 and pin_duration, got %d", p.PinExpiryWarning)
+	}
+
+	seen := make(map[string]bool, len(p.PinningProviders))
+	for _, provider := range p.PinningProviders {
+		if _, err := sdk.AccAddressFromBech32(provider.Address); err != nil {
+			return ErrInvalidPinParams.Wrapf("invalid pinning provider address %q: %s", provider.Address, err)
+		}
+		if seen[provider.Address] {
+			return ErrInvalidPinParams.Wrapf("duplicate pinning provider: %s", provider.Address)
+		}
+		seen[provider.Address] = true
 
+		if key, err := hex.DecodeString(provider.PublicKey); err != nil || len(key) != ed25519.PublicKeySize {
+			return ErrInvalidPinParams.Wrapf("pinning provider %s: public key must be 64 hex characters", provider.Address)
+		}
+	}
 	return nil
 }
 
+// GetPinningProvider returns the pinning provider with the given address, if
+// registered.
+func (p Params) GetPinningProvider(address string) (PinningProvider, bool) {
+	for _, provider := range p.PinningProviders {
+		if provider.Address == address {
+			return provider, true
+		}
+	}
+	return PinningProvider{}, false
+}
+
+// validateLimits checks the validation limits against their ceilings.
+func (p Params) validateLimits() error {
+	for _, limit := range []struct {
+		name    string
+		value   uint32
+		ceiling uint32
+	}{
+		{"max_name_length", p.MaxNameLength, MaxNameLengthCeiling},
+		{"max_project_name_length", p.MaxProjectNameLength, MaxProjectNameLengthCeiling},
+		{"max_filename_length", p.MaxFilenameLength, MaxFilenameLengthCeiling},
+		{"max_reason_length", p.MaxReasonLength, MaxReasonLengthCeiling},
+		{"max_changelog_length", p.MaxChangelogLength, MaxChangelogLengthCeiling},
+		{"max_metadata_length", p.MaxMetadataLength, MaxMetadataLengthCeiling},
+	} {
+		if limit.value == 0 || limit.value > limit.ceiling {
+			return ErrInvalidLimits.Wrapf("%s must be between 1 and %d, got %d", limit.name, limit.ceiling, limit.value)
+		}
+	}
+	if p.MaxDocumentSize <= 0 || p.MaxDocumentSize > MaxDocumentSizeCeiling {
+		return ErrInvalidLimits.Wrapf("max_document_size must be between 1 and %d, got %d", int64(MaxDocumentSizeCeiling), p.MaxDocumentSize)
+	}
+
+	for _, list := range []struct {
+		name     string
+		values   []string
+		nonEmpty bool
+	}{
+		{"allowed_mime_types", p.AllowedMimeTypes, false},
+		{"allowed_entity_types", p.AllowedEntityTypes, true},
+		{"ipfs_cid_prefixes", p.IpfsCidPrefixes, true},
+	} {
+		if list.nonEmpty && len(list.values) == 0 {
+			return ErrInvalidLimits.Wrapf("%s cannot be empty", list.name)
+		}
+		seen := make(map[string]bool, len(list.values))
+		for _, v := range list.values {
+			if v == "" {
+				return ErrInvalidLimits.Wrapf("%s cannot contain an empty value", list.name)
+			}
+			if seen[v] {
+				return ErrInvalidLimits.Wrapf("duplicate %s value: %s", list.name, v)
+			}
+			seen[v] = true
+		}
+	}
 
+	return nil
+}
+
+// GetJurisdiction returns the jurisdiction with the given ID, if configured.
+func (p Params) GetJurisdiction(id string) (Jurisdiction, bool) {
+	for _, j := range p.Jurisdictions {
+		if j.Id == id {
+			return j, true
+		}
+	}
+	return Jurisdiction{}, false
+}
+
+// DocumentFee returns the storage fee for a document of the given size: the
+// fee of the first bucket that fits it, or of the last bucket if none does.
+func (p Params) DocumentFee(size int64) sdk.Coins {
+	if len(p.DocumentFeeBuckets) == 0 {
+		return nil
+	}
+	for _, bucket := range p.DocumentFeeBuckets {
+		if size <= bucket.MaxSize {
+			return bucket.Fee
+		}
+	}
+	return p.DocumentFeeBuckets[len(p.DocumentFeeBuckets)-1].Fee
+}
+
+// IsFeeExempt reports whether addr pays no module fees.
+func (p Params) IsFeeExempt(addr string) bool {
+	return slices.Contains(p.FeeExemptAddresses, addr)
+}
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +29,41 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// allow_legacy_signatures accepts stamp signatures over the raw document
+	// hash instead of the versioned StampSignDoc sign bytes.
+	AllowLegacySignatures bool `protobuf:"varint,1,opt,name=allow_legacy_signatures,json=allowLegacySignatures,proto3" json:"allow_legacy_signatures,omitempty"`
+	// jurisdictions lists the licensing boards recognized by the chain
+	Jurisdictions []Jurisdiction `protobuf:"bytes,2,rep,name=jurisdictions,proto3" json:"jurisdictions"`
+	// stamp_fee is charged to the creator of every stamp
+	StampFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=stamp_fee,json=stampFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"stamp_fee"`
+	// document_fee_buckets price document storage by size, in ascending
+	// max_size order; documents larger than every bucket pay the last one
+	DocumentFeeBuckets []DocumentFeeBucket `protobuf:"bytes,4,rep,name=document_fee_buckets,json=documentFeeBuckets,proto3" json:"document_fee_buckets"`
+	// entity_creation_fee is charged to the creator of every entity
+	EntityCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=entity_creation_fee,json=entityCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"entity_creation_fee"`
+	// fee_exempt_addresses are jurisdiction authority accounts that pay no
+	// module fees
+	FeeExemptAddresses []string `protobuf:"bytes,6,rep,name=fee_exempt_addresses,json=feeExemptAddresses,proto3" json:"fee_exempt_addresses,omitempty"`
+	// Validation limits on user-supplied fields, enforced by the keeper. None
+	// may exceed the ceilings ValidateBasic enforces statelessly.
+	MaxNameLength        uint32   `protobuf:"varint,7,opt,name=max_name_length,json=maxNameLength,proto3" json:"max_name_length,omitempty"`
+	MaxProjectNameLength uint32   `protobuf:"varint,8,opt,name=max_project_name_length,json=maxProjectNameLength,proto3" json:"max_project_name_length,omitempty"`
+	MaxFilenameLength    uint32   `protobuf:"varint,9,opt,name=max_filename_length,json=maxFilenameLength,proto3" json:"max_filename_length,omitempty"`
+	MaxReasonLength      uint32   `protobuf:"varint,10,opt,name=max_reason_length,json=maxReasonLength,proto3" json:"max_reason_length,omitempty"`
+	MaxChangelogLength   uint32   `protobuf:"varint,11,opt,name=max_changelog_length,json=maxChangelogLength,proto3" json:"max_changelog_length,omitempty"`
+	MaxDocumentSize      int64    `protobuf:"varint,12,opt,name=max_document_size,json=maxDocumentSize,proto3" json:"max_document_size,omitempty"`
+	AllowedMimeTypes     []string `protobuf:"bytes,13,rep,name=allowed_mime_types,json=allowedMimeTypes,proto3" json:"allowed_mime_types,omitempty"`
+	AllowedEntityTypes   []string `protobuf:"bytes,14,rep,name=allowed_entity_types,json=allowedEntityTypes,proto3" json:"allowed_entity_types,omitempty"`
+	IpfsCidPrefixes      []string `protobuf:"bytes,15,rep,name=ipfs_cid_prefixes,json=ipfsCidPrefixes,proto3" json:"ipfs_cid_prefixes,omitempty"`
+	MaxMetadataLength    uint32   `protobuf:"varint,19,opt,name=max_metadata_length,json=maxMetadataLength,proto3" json:"max_metadata_length,omitempty"`
+	// Document pinning. Pins that are not forever lapse after pin_duration
+	// blocks unless renewed; document_pin_expiring is emitted
+	// pin_expiry_warning blocks beforehand.
+	PinDuration      int64             `protobuf:"varint,16,opt,name=pin_duration,json=pinDuration,proto3" json:"pin_duration,omitempty"`
+	PinExpiryWarning int64             `protobuf:"varint,17,opt,name=pin_expiry_warning,json=pinExpiryWarning,proto3" json:"pin_expiry_warning,omitempty"`
+	PinningProviders []PinningProvider `protobuf:"bytes,18,rep,name=pinning_providers,json=pinningProviders,proto3" json:"pinning_providers"`
+	// invite_duration is how many blocks an entity invitation stays open
+	InviteDuration int64 `protobuf:"varint,20,opt,name=invite_duration,json=inviteDuration,proto3" json:"invite_duration,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,8 +99,328 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAllowLegacySignatures() bool {
+	if m != nil {
+		return m.AllowLegacySignatures
+	}
+	return false
+}
+
+func (m *Params) GetJurisdictions() []Jurisdiction {
+	if m != nil {
+		return m.Jurisdictions
+	}
+	return nil
+}
+
+func (m *Params) GetStampFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StampFee
+	}
+	return nil
+}
+
+func (m *Params) GetDocumentFeeBuckets() []DocumentFeeBucket {
+	if m != nil {
+		return m.DocumentFeeBuckets
+	}
+	return nil
+}
+
+func (m *Params) GetEntityCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EntityCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetFeeExemptAddresses() []string {
+	if m != nil {
+		return m.FeeExemptAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetMaxNameLength() uint32 {
+	if m != nil {
+		return m.MaxNameLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxProjectNameLength() uint32 {
+	if m != nil {
+		return m.MaxProjectNameLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFilenameLength() uint32 {
+	if m != nil {
+		return m.MaxFilenameLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxReasonLength() uint32 {
+	if m != nil {
+		return m.MaxReasonLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxChangelogLength() uint32 {
+	if m != nil {
+		return m.MaxChangelogLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDocumentSize() int64 {
+	if m != nil {
+		return m.MaxDocumentSize
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedMimeTypes() []string {
+	if m != nil {
+		return m.AllowedMimeTypes
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedEntityTypes() []string {
+	if m != nil {
+		return m.AllowedEntityTypes
+	}
+	return nil
+}
+
+func (m *Params) GetIpfsCidPrefixes() []string {
+	if m != nil {
+		return m.IpfsCidPrefixes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxMetadataLength() uint32 {
+	if m != nil {
+		return m.MaxMetadataLength
+	}
+	return 0
+}
+
+func (m *Params) GetPinDuration() int64 {
+	if m != nil {
+		return m.PinDuration
+	}
+	return 0
+}
+
+func (m *Params) GetPinExpiryWarning() int64 {
+	if m != nil {
+		return m.PinExpiryWarning
+	}
+	return 0
+}
+
+func (m *Params) GetPinningProviders() []PinningProvider {
+	if m != nil {
+		return m.PinningProviders
+	}
+	return nil
+}
+
+func (m *Params) GetInviteDuration() int64 {
+	if m != nil {
+		return m.InviteDuration
+	}
+	return 0
+}
+
+// PinningProvider is a pinning service allowed to attest that it holds
+// documents
+type PinningProvider struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PublicKey string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (m *PinningProvider) Reset()         { *m = PinningProvider{} }
+func (m *PinningProvider) String() string { return proto.CompactTextString(m) }
+func (*PinningProvider) ProtoMessage()    {}
+func (*PinningProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cce6612868ea557, []int{1}
+}
+func (m *PinningProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PinningProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PinningProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PinningProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinningProvider.Merge(m, src)
+}
+func (m *PinningProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *PinningProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinningProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PinningProvider proto.InternalMessageInfo
+
+func (m *PinningProvider) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PinningProvider) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PinningProvider) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+// DocumentFeeBucket is the storage fee for documents up to max_size bytes
+type DocumentFeeBucket struct {
+	MaxSize int64                                    `protobuf:"varint,1,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	Fee     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *DocumentFeeBucket) Reset()         { *m = DocumentFeeBucket{} }
+func (m *DocumentFeeBucket) String() string { return proto.CompactTextString(m) }
+func (*DocumentFeeBucket) ProtoMessage()    {}
+func (*DocumentFeeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cce6612868ea557, []int{2}
+}
+func (m *DocumentFeeBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DocumentFeeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DocumentFeeBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DocumentFeeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentFeeBucket.Merge(m, src)
+}
+func (m *DocumentFeeBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *DocumentFeeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentFeeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentFeeBucket proto.InternalMessageInfo
+
+func (m *DocumentFeeBucket) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *DocumentFeeBucket) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// Jurisdiction designates the board addresses that speak for a licensing
+// jurisdiction
+type Jurisdiction struct {
+	Id             string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BoardAddresses []string `protobuf:"bytes,3,rep,name=board_addresses,json=boardAddresses,proto3" json:"board_addresses,omitempty"`
+}
+
+func (m *Jurisdiction) Reset()         { *m = Jurisdiction{} }
+func (m *Jurisdiction) String() string { return proto.CompactTextString(m) }
+func (*Jurisdiction) ProtoMessage()    {}
+func (*Jurisdiction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cce6612868ea557, []int{3}
+}
+func (m *Jurisdiction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Jurisdiction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Jurisdiction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Jurisdiction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Jurisdiction.Merge(m, src)
+}
+func (m *Jurisdiction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Jurisdiction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Jurisdiction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Jurisdiction proto.InternalMessageInfo
+
+func (m *Jurisdiction) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Jurisdiction) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Jurisdiction) GetBoardAddresses() []string {
+	if m != nil {
+		return m.BoardAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "stampledgerchain.stampledgerchain.v1.Params")
+	proto.RegisterType((*PinningProvider)(nil), "stampledgerchain.stampledgerchain.v1.PinningProvider")
+	proto.RegisterType((*DocumentFeeBucket)(nil), "stampledgerchain.stampledgerchain.v1.DocumentFeeBucket")
+	proto.RegisterType((*Jurisdiction)(nil), "stampledgerchain.stampledgerchain.v1.Jurisdiction")
 }
 
 func init() {
@@ -70,18 +428,66 @@ func init() {
 }
 
 var fileDescriptor_8cce6612868ea557 = []byte{
-	// 164 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x2c, 0x2e, 0x49, 0xcc,
-	0x2d, 0xc8, 0x49, 0x4d, 0x49, 0x4f, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xc7, 0x10, 0x28,
-	0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52,
-	0x41, 0x57, 0xa1, 0x87, 0x21, 0x50, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f,
-	0x26, 0x21, 0x1a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa,
-	0x64, 0xcb, 0xc5, 0x16, 0x00, 0x36, 0xde, 0xca, 0xf8, 0xc5, 0x02, 0x79, 0xc6, 0xae, 0xe7, 0x1b,
-	0xb4, 0xb4, 0x30, 0xdc, 0x50, 0x81, 0xe9, 0x2c, 0x88, 0x26, 0x27, 0x97, 0x13, 0x8f, 0xe4, 0x18,
-	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x42, 0x36, 0x45, 0x17, 0xa7, 0x31, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0x60, 0xb7, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4d, 0xdd, 0x4e, 0x4a,
-	0x0f, 0x01, 0x00, 0x00,
+	// 940 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4b, 0x6f, 0x23, 0x45,
+	0x10, 0xce, 0xd8, 0x21, 0x8f, 0xce, 0xc3, 0x71, 0xc7, 0x68, 0x27, 0x2b, 0xe1, 0x98, 0x08, 0x81,
+	0x65, 0x11, 0x1b, 0x67, 0xb5, 0x20, 0xed, 0x2d, 0xce, 0xe3, 0xb0, 0xec, 0x22, 0x6b, 0x82, 0x84,
+	0xc4, 0x81, 0x51, 0x7b, 0xa6, 0x3c, 0xee, 0x8d, 0xbb, 0x67, 0x34, 0x3d, 0xf6, 0x8e, 0x17, 0x69,
+	0x7f, 0x00, 0x27, 0xb8, 0x72, 0xe2, 0x08, 0x9c, 0x72, 0xe0, 0x47, 0xec, 0x71, 0xc5, 0x89, 0x13,
+	0x8b, 0x92, 0x43, 0xf8, 0x19, 0xa8, 0x6b, 0x7a, 0x82, 0x13, 0xf3, 0xc8, 0x29, 0x17, 0x7b, 0xa6,
+	0xbe, 0xef, 0xeb, 0xae, 0xaf, 0xba, 0xba, 0x86, 0xb4, 0x55, 0xc2, 0x44, 0x34, 0x04, 0x3f, 0x80,
+	0xd8, 0x1b, 0x30, 0x2e, 0x5b, 0x33, 0x81, 0x71, 0xbb, 0x15, 0xb1, 0x98, 0x09, 0xd5, 0x8c, 0xe2,
+	0x30, 0x09, 0xe9, 0x7b, 0x37, 0x19, 0xcd, 0x99, 0xc0, 0xb8, 0x7d, 0xbf, 0xcc, 0x04, 0x97, 0x61,
+	0x0b, 0x7f, 0x33, 0xe1, 0xfd, 0xaa, 0x17, 0x2a, 0x11, 0xaa, 0x56, 0x8f, 0x29, 0x68, 0x8d, 0xdb,
+	0x3d, 0x48, 0x58, 0xbb, 0xe5, 0x85, 0x5c, 0x1a, 0x7c, 0x2b, 0xc3, 0x5d, 0x7c, 0x6b, 0x65, 0x2f,
+	0x06, 0xaa, 0x04, 0x61, 0x10, 0x66, 0x71, 0xfd, 0x94, 0x45, 0x77, 0xde, 0x10, 0xb2, 0xd0, 0xc5,
+	0xd4, 0xe8, 0xc7, 0xe4, 0x1e, 0x1b, 0x0e, 0xc3, 0xe7, 0xee, 0x10, 0x02, 0xe6, 0x4d, 0x5c, 0xc5,
+	0x03, 0xc9, 0x92, 0x51, 0x0c, 0xca, 0xb6, 0x6a, 0x56, 0x7d, 0xc9, 0x79, 0x1b, 0xe1, 0x27, 0x88,
+	0x9e, 0x5c, 0x81, 0xf4, 0x2b, 0xb2, 0xf6, 0x6c, 0x14, 0x73, 0xe5, 0x73, 0x2f, 0xe1, 0xa1, 0x54,
+	0x76, 0xa1, 0x56, 0xac, 0xaf, 0xec, 0xed, 0x35, 0x6f, 0x63, 0xb2, 0xf9, 0x78, 0x4a, 0xda, 0x99,
+	0x7f, 0xf5, 0xfb, 0xf6, 0x9c, 0x73, 0x7d, 0x39, 0xfa, 0x92, 0x2c, 0xa3, 0xd0, 0xed, 0x03, 0xd8,
+	0x45, 0x5c, 0x7b, 0xab, 0x69, 0xac, 0xe9, 0x3a, 0x34, 0x4d, 0x1d, 0x9a, 0x07, 0x21, 0x97, 0x9d,
+	0x63, 0xbd, 0xc4, 0xcf, 0x6f, 0xb6, 0xeb, 0x01, 0x4f, 0x06, 0xa3, 0x5e, 0xd3, 0x0b, 0x85, 0xa9,
+	0x83, 0xf9, 0xdb, 0x55, 0xfe, 0x69, 0x2b, 0x99, 0x44, 0xa0, 0x50, 0xa0, 0xbe, 0xbf, 0x3c, 0x6b,
+	0xac, 0x1a, 0xcb, 0xba, 0x92, 0xea, 0xc7, 0xcb, 0xb3, 0x86, 0xe5, 0x2c, 0xe1, 0x9e, 0xc7, 0x00,
+	0x34, 0x24, 0x15, 0x3f, 0xf4, 0x46, 0x02, 0x64, 0xa2, 0x53, 0x70, 0x7b, 0x23, 0xef, 0x14, 0x12,
+	0x65, 0xcf, 0x63, 0x2a, 0x9f, 0xdc, 0xce, 0xe6, 0xa1, 0x59, 0xe1, 0x18, 0xa0, 0x83, 0x7a, 0xe3,
+	0x95, 0xfa, 0x37, 0x01, 0x45, 0xbf, 0xb3, 0xc8, 0x26, 0xc8, 0x84, 0x27, 0x13, 0xd7, 0x8b, 0x81,
+	0xe9, 0x2a, 0xa0, 0xf7, 0xb7, 0xee, 0xca, 0x7b, 0x39, 0xdb, 0xfd, 0xc0, 0x6c, 0xae, 0x8b, 0xf0,
+	0x98, 0x54, 0xb4, 0x77, 0x48, 0x41, 0x44, 0x89, 0xcb, 0x7c, 0x3f, 0x06, 0xa5, 0x40, 0xd9, 0x0b,
+	0xb5, 0x62, 0x7d, 0xb9, 0x63, 0xff, 0xfa, 0xcb, 0x6e, 0xc5, 0xa4, 0xb5, 0x9f, 0x61, 0x27, 0x49,
+	0xcc, 0x65, 0xe0, 0xd0, 0x3e, 0xc0, 0x11, 0x8a, 0xf6, 0x73, 0x0d, 0x7d, 0x9f, 0x94, 0x04, 0x4b,
+	0x5d, 0xc9, 0x04, 0xb8, 0x43, 0x90, 0x41, 0x32, 0xb0, 0x17, 0x6b, 0x56, 0x7d, 0xcd, 0x59, 0x13,
+	0x2c, 0xfd, 0x8c, 0x09, 0x78, 0x82, 0x41, 0xfa, 0x90, 0xdc, 0xd3, 0xbc, 0x28, 0x0e, 0x9f, 0x81,
+	0x97, 0x5c, 0xe3, 0x2f, 0x21, 0xbf, 0x22, 0x58, 0xda, 0xcd, 0xd0, 0x29, 0x59, 0x93, 0x6c, 0x6a,
+	0x59, 0x9f, 0x0f, 0x61, 0x5a, 0xb2, 0x8c, 0x92, 0xb2, 0x60, 0xe9, 0xb1, 0x41, 0x0c, 0xbf, 0x41,
+	0x74, 0xd0, 0x8d, 0x81, 0xa9, 0x50, 0xe6, 0x6c, 0x82, 0x6c, 0x9d, 0xa7, 0x83, 0x71, 0xc3, 0xfd,
+	0x88, 0xe8, 0x3d, 0x5d, 0x6f, 0xc0, 0x64, 0x00, 0xc3, 0x30, 0xc8, 0xe9, 0x2b, 0x48, 0xa7, 0x82,
+	0xa5, 0x07, 0x39, 0x74, 0x7d, 0xf5, 0xab, 0x0e, 0x52, 0xfc, 0x05, 0xd8, 0xab, 0x35, 0xab, 0x5e,
+	0xc4, 0xd5, 0xf3, 0xbe, 0x38, 0xe1, 0x2f, 0x80, 0x7e, 0x48, 0x28, 0x5e, 0x31, 0xf0, 0x5d, 0xc1,
+	0x05, 0xb8, 0x78, 0x52, 0xf6, 0x9a, 0x2e, 0xb1, 0xb3, 0x61, 0x90, 0xa7, 0x5c, 0xc0, 0xe7, 0x3a,
+	0xae, 0x73, 0xc9, 0xd9, 0xa6, 0x5b, 0x32, 0xfe, 0x3a, 0xf2, 0xf3, 0x95, 0x8e, 0x10, 0xca, 0x14,
+	0x0d, 0x52, 0xe6, 0x51, 0x5f, 0xb9, 0x1e, 0xf7, 0xdd, 0x28, 0x86, 0x3e, 0x4f, 0x41, 0xd9, 0x25,
+	0xa4, 0x97, 0x34, 0x70, 0xc0, 0xfd, 0xae, 0x09, 0xe7, 0x55, 0x14, 0x90, 0x30, 0x9f, 0x25, 0x2c,
+	0x37, 0xba, 0x79, 0x55, 0xc5, 0xa7, 0x06, 0x31, 0x3e, 0xdf, 0x25, 0xab, 0x11, 0x97, 0xae, 0x3f,
+	0x8a, 0xb1, 0x67, 0xec, 0x0d, 0xb4, 0xb8, 0x12, 0x71, 0x79, 0x68, 0x42, 0xda, 0x9e, 0xa6, 0x40,
+	0x1a, 0xf1, 0x78, 0xe2, 0x3e, 0x67, 0xb1, 0xe4, 0x32, 0xb0, 0xcb, 0x48, 0xdc, 0x88, 0xb8, 0x3c,
+	0x42, 0xe0, 0x8b, 0x2c, 0x4e, 0x07, 0xa4, 0x1c, 0x71, 0xa9, 0x1f, 0x75, 0x07, 0x8c, 0xb9, 0x0f,
+	0xb1, 0xb2, 0x29, 0x5e, 0x81, 0x87, 0xb7, 0xbb, 0x73, 0xdd, 0x4c, 0xde, 0x35, 0x6a, 0x73, 0xe3,
+	0x36, 0xa2, 0xeb, 0x61, 0x45, 0x3f, 0x20, 0x25, 0x2e, 0xc7, 0x3c, 0x81, 0xbf, 0xb3, 0xaf, 0x60,
+	0x52, 0xeb, 0x59, 0x38, 0x37, 0xf0, 0xe8, 0xc1, 0x9f, 0x3f, 0x6c, 0x5b, 0xdf, 0x5c, 0x9e, 0x35,
+	0x1a, 0x33, 0x13, 0x3e, 0x9d, 0x1d, 0xfa, 0xd9, 0x58, 0xdd, 0x79, 0x49, 0x4a, 0x37, 0x12, 0xa1,
+	0x7b, 0x64, 0xd1, 0xdc, 0x20, 0x9c, 0xac, 0xff, 0x75, 0x7f, 0x72, 0x22, 0xa5, 0x64, 0x5e, 0xf7,
+	0xac, 0x5d, 0xd0, 0x02, 0x07, 0x9f, 0xe9, 0x3b, 0x84, 0x44, 0xa3, 0xde, 0x90, 0x7b, 0xee, 0x29,
+	0x4c, 0xec, 0x22, 0x22, 0xcb, 0x59, 0xe4, 0x53, 0x98, 0x3c, 0x9a, 0xd7, 0xe9, 0xee, 0xfc, 0x64,
+	0x91, 0xf2, 0xcc, 0xf4, 0xa1, 0x5b, 0x64, 0x49, 0x1f, 0x2f, 0x76, 0xa3, 0x85, 0x66, 0x17, 0x05,
+	0x4b, 0xb1, 0x0b, 0x15, 0x29, 0xea, 0x69, 0x53, 0xb8, 0xab, 0x69, 0xa3, 0x77, 0x33, 0xb9, 0x7e,
+	0x4d, 0x56, 0xa7, 0xbf, 0x07, 0x74, 0x9d, 0x14, 0xb8, 0x9f, 0xd5, 0xc8, 0x29, 0x70, 0xff, 0x1f,
+	0x8b, 0xb0, 0x4f, 0x4a, 0xbd, 0x90, 0xc5, 0xfe, 0xd4, 0x50, 0x2a, 0xfe, 0xcf, 0x50, 0x5a, 0x47,
+	0xc1, 0xd5, 0x40, 0xca, 0x36, 0xef, 0x1c, 0xbe, 0x3a, 0xaf, 0x5a, 0xaf, 0xcf, 0xab, 0xd6, 0x1f,
+	0xe7, 0x55, 0xeb, 0xdb, 0x8b, 0xea, 0xdc, 0xeb, 0x8b, 0xea, 0xdc, 0x6f, 0x17, 0xd5, 0xb9, 0x2f,
+	0xa7, 0x8f, 0x7b, 0xf7, 0x5f, 0xcf, 0x1b, 0x9d, 0xf6, 0x16, 0xf0, 0xbb, 0xfa, 0xe0, 0xaf, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x4c, 0x39, 0xe1, 0xed, 0x16, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -103,86 +509,1648 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.AllowLegacySignatures != that1.AllowLegacySignatures {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(this.Jurisdictions) != len(that1.Jurisdictions) {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	for i := range this.Jurisdictions {
+		if !this.Jurisdictions[i].Equal(&that1.Jurisdictions[i]) {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+	if len(this.StampFee) != len(that1.StampFee) {
+		return false
+	}
+	for i := range this.StampFee {
+		if !this.StampFee[i].Equal(&that1.StampFee[i]) {
+			return false
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+	}
+	if len(this.DocumentFeeBuckets) != len(that1.DocumentFeeBuckets) {
+		return false
+	}
+	for i := range this.DocumentFeeBuckets {
+		if !this.DocumentFeeBuckets[i].Equal(&that1.DocumentFeeBuckets[i]) {
+			return false
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if len(this.EntityCreationFee) != len(that1.EntityCreationFee) {
+		return false
+	}
+	for i := range this.EntityCreationFee {
+		if !this.EntityCreationFee[i].Equal(&that1.EntityCreationFee[i]) {
+			return false
 		}
-		switch fieldNum {
+	}
+	if len(this.FeeExemptAddresses) != len(that1.FeeExemptAddresses) {
+		return false
+	}
+	for i := range this.FeeExemptAddresses {
+		if this.FeeExemptAddresses[i] != that1.FeeExemptAddresses[i] {
+			return false
+		}
+	}
+	if this.MaxNameLength != that1.MaxNameLength {
+		return false
+	}
+	if this.MaxProjectNameLength != that1.MaxProjectNameLength {
+		return false
+	}
+	if this.MaxFilenameLength != that1.MaxFilenameLength {
+		return false
+	}
+	if this.MaxReasonLength != that1.MaxReasonLength {
+		return false
+	}
+	if this.MaxChangelogLength != that1.MaxChangelogLength {
+		return false
+	}
+	if this.MaxDocumentSize != that1.MaxDocumentSize {
+		return false
+	}
+	if len(this.AllowedMimeTypes) != len(that1.AllowedMimeTypes) {
+		return false
+	}
+	for i := range this.AllowedMimeTypes {
+		if this.AllowedMimeTypes[i] != that1.AllowedMimeTypes[i] {
+			return false
+		}
+	}
+	if len(this.AllowedEntityTypes) != len(that1.AllowedEntityTypes) {
+		return false
+	}
+	for i := range this.AllowedEntityTypes {
+		if this.AllowedEntityTypes[i] != that1.AllowedEntityTypes[i] {
+			return false
+		}
+	}
+	if len(this.IpfsCidPrefixes) != len(that1.IpfsCidPrefixes) {
+		return false
+	}
+	for i := range this.IpfsCidPrefixes {
+		if this.IpfsCidPrefixes[i] != that1.IpfsCidPrefixes[i] {
+			return false
+		}
+	}
+	if this.MaxMetadataLength != that1.MaxMetadataLength {
+		return false
+	}
+	if this.PinDuration != that1.PinDuration {
+		return false
+	}
+	if this.PinExpiryWarning != that1.PinExpiryWarning {
+		return false
+	}
+	if len(this.PinningProviders) != len(that1.PinningProviders) {
+		return false
+	}
+	for i := range this.PinningProviders {
+		if !this.PinningProviders[i].Equal(&that1.PinningProviders[i]) {
+			return false
+		}
+	}
+	if this.InviteDuration != that1.InviteDuration {
+		return false
+	}
+	return true
+}
+func (this *PinningProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PinningProvider)
+	if !ok {
+		that2, ok := that.(PinningProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.PublicKey != that1.PublicKey {
+		return false
+	}
+	return true
+}
+func (this *DocumentFeeBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DocumentFeeBucket)
+	if !ok {
+		that2, ok := that.(DocumentFeeBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxSize != that1.MaxSize {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Jurisdiction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Jurisdiction)
+	if !ok {
+		that2, ok := that.(Jurisdiction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.BoardAddresses) != len(that1.BoardAddresses) {
+		return false
+	}
+	for i := range this.BoardAddresses {
+		if this.BoardAddresses[i] != that1.BoardAddresses[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InviteDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InviteDuration))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaxMetadataLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMetadataLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.PinningProviders) > 0 {
+		for iNdEx := len(m.PinningProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PinningProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.PinExpiryWarning != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PinExpiryWarning))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.PinDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PinDuration))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.IpfsCidPrefixes) > 0 {
+		for iNdEx := len(m.IpfsCidPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IpfsCidPrefixes[iNdEx])
+			copy(dAtA[i:], m.IpfsCidPrefixes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.IpfsCidPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.AllowedEntityTypes) > 0 {
+		for iNdEx := len(m.AllowedEntityTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedEntityTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedEntityTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedEntityTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AllowedMimeTypes) > 0 {
+		for iNdEx := len(m.AllowedMimeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMimeTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMimeTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedMimeTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.MaxDocumentSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDocumentSize))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxChangelogLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxChangelogLength))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxReasonLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxReasonLength))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxFilenameLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFilenameLength))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxProjectNameLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxProjectNameLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxNameLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxNameLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FeeExemptAddresses) > 0 {
+		for iNdEx := len(m.FeeExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.FeeExemptAddresses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.FeeExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.EntityCreationFee) > 0 {
+		for iNdEx := len(m.EntityCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntityCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DocumentFeeBuckets) > 0 {
+		for iNdEx := len(m.DocumentFeeBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DocumentFeeBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.StampFee) > 0 {
+		for iNdEx := len(m.StampFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StampFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Jurisdictions) > 0 {
+		for iNdEx := len(m.Jurisdictions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jurisdictions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AllowLegacySignatures {
+		i--
+		if m.AllowLegacySignatures {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PinningProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PinningProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PinningProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocumentFeeBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentFeeBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentFeeBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Jurisdiction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Jurisdiction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Jurisdiction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BoardAddresses) > 0 {
+		for iNdEx := len(m.BoardAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BoardAddresses[iNdEx])
+			copy(dAtA[i:], m.BoardAddresses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BoardAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowLegacySignatures {
+		n += 2
+	}
+	if len(m.Jurisdictions) > 0 {
+		for _, e := range m.Jurisdictions {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.StampFee) > 0 {
+		for _, e := range m.StampFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DocumentFeeBuckets) > 0 {
+		for _, e := range m.DocumentFeeBuckets {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.EntityCreationFee) > 0 {
+		for _, e := range m.EntityCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.FeeExemptAddresses) > 0 {
+		for _, s := range m.FeeExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxNameLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxNameLength))
+	}
+	if m.MaxProjectNameLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxProjectNameLength))
+	}
+	if m.MaxFilenameLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxFilenameLength))
+	}
+	if m.MaxReasonLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxReasonLength))
+	}
+	if m.MaxChangelogLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxChangelogLength))
+	}
+	if m.MaxDocumentSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxDocumentSize))
+	}
+	if len(m.AllowedMimeTypes) > 0 {
+		for _, s := range m.AllowedMimeTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedEntityTypes) > 0 {
+		for _, s := range m.AllowedEntityTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.IpfsCidPrefixes) > 0 {
+		for _, s := range m.IpfsCidPrefixes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.PinDuration != 0 {
+		n += 2 + sovParams(uint64(m.PinDuration))
+	}
+	if m.PinExpiryWarning != 0 {
+		n += 2 + sovParams(uint64(m.PinExpiryWarning))
+	}
+	if len(m.PinningProviders) > 0 {
+		for _, e := range m.PinningProviders {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxMetadataLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxMetadataLength))
+	}
+	if m.InviteDuration != 0 {
+		n += 2 + sovParams(uint64(m.InviteDuration))
+	}
+	return n
+}
+
+func (m *PinningProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *DocumentFeeBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxSize))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Jurisdiction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.BoardAddresses) > 0 {
+		for _, s := range m.BoardAddresses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowLegacySignatures", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowLegacySignatures = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdictions = append(m.Jurisdictions, Jurisdiction{})
+			if err := m.Jurisdictions[len(m.Jurisdictions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StampFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StampFee = append(m.StampFee, types.Coin{})
+			if err := m.StampFee[len(m.StampFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentFeeBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentFeeBuckets = append(m.DocumentFeeBuckets, DocumentFeeBucket{})
+			if err := m.DocumentFeeBuckets[len(m.DocumentFeeBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityCreationFee = append(m.EntityCreationFee, types.Coin{})
+			if err := m.EntityCreationFee[len(m.EntityCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeExemptAddresses = append(m.FeeExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLength", wireType)
+			}
+			m.MaxNameLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNameLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProjectNameLength", wireType)
+			}
+			m.MaxProjectNameLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProjectNameLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFilenameLength", wireType)
+			}
+			m.MaxFilenameLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFilenameLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReasonLength", wireType)
+			}
+			m.MaxReasonLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReasonLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangelogLength", wireType)
+			}
+			m.MaxChangelogLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChangelogLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDocumentSize", wireType)
+			}
+			m.MaxDocumentSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDocumentSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMimeTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMimeTypes = append(m.AllowedMimeTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedEntityTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedEntityTypes = append(m.AllowedEntityTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsCidPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsCidPrefixes = append(m.IpfsCidPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinDuration", wireType)
+			}
+			m.PinDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PinDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinExpiryWarning", wireType)
+			}
+			m.PinExpiryWarning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PinExpiryWarning |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinningProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PinningProviders = append(m.PinningProviders, PinningProvider{})
+			if err := m.PinningProviders[len(m.PinningProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataLength", wireType)
+			}
+			m.MaxMetadataLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMetadataLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InviteDuration", wireType)
+			}
+			m.InviteDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InviteDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PinningProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PinningProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PinningProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DocumentFeeBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DocumentFeeBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DocumentFeeBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Jurisdiction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Jurisdiction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Jurisdiction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoardAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BoardAddresses = append(m.BoardAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -410,22 +410,23 @@ func (m *QueryStampsByJurisdictionResponse) GetPagination() *query.PageResponse
 	return nil
 }
 
-type QueryAllStampsRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryStampsByDocumentHashRequest struct {
+	DocumentHash string             `protobuf:"bytes,1,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllStampsRequest) Reset()         { *m = QueryAllStampsRequest{} }
-func (m *QueryAllStampsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllStampsRequest) ProtoMessage()    {}
-func (*QueryAllStampsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryStampsByDocumentHashRequest) Reset()         { *m = QueryStampsByDocumentHashRequest{} }
+func (m *QueryStampsByDocumentHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStampsByDocumentHashRequest) ProtoMessage()    {}
+func (*QueryStampsByDocumentHashRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{8}
 }
-func (m *QueryAllStampsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStampsByDocumentHashRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllStampsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStampsByDocumentHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllStampsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStampsByDocumentHashRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -435,42 +436,49 @@ func (m *QueryAllStampsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryAllStampsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllStampsRequest.Merge(m, src)
+func (m *QueryStampsByDocumentHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStampsByDocumentHashRequest.Merge(m, src)
 }
-func (m *QueryAllStampsRequest) XXX_Size() int {
+func (m *QueryStampsByDocumentHashRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllStampsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllStampsRequest.DiscardUnknown(m)
+func (m *QueryStampsByDocumentHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStampsByDocumentHashRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllStampsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStampsByDocumentHashRequest proto.InternalMessageInfo
 
-func (m *QueryAllStampsRequest) GetPagination() *query.PageRequest {
+func (m *QueryStampsByDocumentHashRequest) GetDocumentHash() string {
+	if m != nil {
+		return m.DocumentHash
+	}
+	return ""
+}
+
+func (m *QueryStampsByDocumentHashRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryAllStampsResponse struct {
+type QueryStampsByDocumentHashResponse struct {
 	Stamps     []Stamp             `protobuf:"bytes,1,rep,name=stamps,proto3" json:"stamps"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllStampsResponse) Reset()         { *m = QueryAllStampsResponse{} }
-func (m *QueryAllStampsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllStampsResponse) ProtoMessage()    {}
-func (*QueryAllStampsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryStampsByDocumentHashResponse) Reset()         { *m = QueryStampsByDocumentHashResponse{} }
+func (m *QueryStampsByDocumentHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStampsByDocumentHashResponse) ProtoMessage()    {}
+func (*QueryStampsByDocumentHashResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{9}
 }
-func (m *QueryAllStampsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStampsByDocumentHashResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllStampsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStampsByDocumentHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllStampsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStampsByDocumentHashResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -480,48 +488,49 @@ func (m *QueryAllStampsResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryAllStampsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllStampsResponse.Merge(m, src)
+func (m *QueryStampsByDocumentHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStampsByDocumentHashResponse.Merge(m, src)
 }
-func (m *QueryAllStampsResponse) XXX_Size() int {
+func (m *QueryStampsByDocumentHashResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllStampsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllStampsResponse.DiscardUnknown(m)
+func (m *QueryStampsByDocumentHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStampsByDocumentHashResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllStampsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStampsByDocumentHashResponse proto.InternalMessageInfo
 
-func (m *QueryAllStampsResponse) GetStamps() []Stamp {
+func (m *QueryStampsByDocumentHashResponse) GetStamps() []Stamp {
 	if m != nil {
 		return m.Stamps
 	}
 	return nil
 }
 
-func (m *QueryAllStampsResponse) GetPagination() *query.PageResponse {
+func (m *QueryStampsByDocumentHashResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryDocumentRequest struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type QueryStampsByEntityRequest struct {
+	EntityId   string             `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDocumentRequest) Reset()         { *m = QueryDocumentRequest{} }
-func (m *QueryDocumentRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDocumentRequest) ProtoMessage()    {}
-func (*QueryDocumentRequest) Descriptor() ([]byte, []int) {
+func (m *QueryStampsByEntityRequest) Reset()         { *m = QueryStampsByEntityRequest{} }
+func (m *QueryStampsByEntityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStampsByEntityRequest) ProtoMessage()    {}
+func (*QueryStampsByEntityRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{10}
 }
-func (m *QueryDocumentRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStampsByEntityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStampsByEntityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDocumentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStampsByEntityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -531,41 +540,49 @@ func (m *QueryDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryDocumentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDocumentRequest.Merge(m, src)
+func (m *QueryStampsByEntityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStampsByEntityRequest.Merge(m, src)
 }
-func (m *QueryDocumentRequest) XXX_Size() int {
+func (m *QueryStampsByEntityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDocumentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDocumentRequest.DiscardUnknown(m)
+func (m *QueryStampsByEntityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStampsByEntityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDocumentRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStampsByEntityRequest proto.InternalMessageInfo
 
-func (m *QueryDocumentRequest) GetId() string {
+func (m *QueryStampsByEntityRequest) GetEntityId() string {
 	if m != nil {
-		return m.Id
+		return m.EntityId
 	}
 	return ""
 }
 
-type QueryDocumentResponse struct {
-	Document DocumentStorage `protobuf:"bytes,1,opt,name=document,proto3" json:"document"`
+func (m *QueryStampsByEntityRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryDocumentResponse) Reset()         { *m = QueryDocumentResponse{} }
-func (m *QueryDocumentResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDocumentResponse) ProtoMessage()    {}
-func (*QueryDocumentResponse) Descriptor() ([]byte, []int) {
+type QueryStampsByEntityResponse struct {
+	Stamps     []Stamp             `protobuf:"bytes,1,rep,name=stamps,proto3" json:"stamps"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStampsByEntityResponse) Reset()         { *m = QueryStampsByEntityResponse{} }
+func (m *QueryStampsByEntityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStampsByEntityResponse) ProtoMessage()    {}
+func (*QueryStampsByEntityResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{11}
 }
-func (m *QueryDocumentResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStampsByEntityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStampsByEntityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDocumentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStampsByEntityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -575,42 +592,49 @@ func (m *QueryDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryDocumentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDocumentResponse.Merge(m, src)
+func (m *QueryStampsByEntityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStampsByEntityResponse.Merge(m, src)
 }
-func (m *QueryDocumentResponse) XXX_Size() int {
+func (m *QueryStampsByEntityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDocumentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDocumentResponse.DiscardUnknown(m)
+func (m *QueryStampsByEntityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStampsByEntityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDocumentResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStampsByEntityResponse proto.InternalMessageInfo
 
-func (m *QueryDocumentResponse) GetDocument() DocumentStorage {
+func (m *QueryStampsByEntityResponse) GetStamps() []Stamp {
 	if m != nil {
-		return m.Document
+		return m.Stamps
 	}
-	return DocumentStorage{}
+	return nil
 }
 
-type QueryDocumentsByStampRequest struct {
-	StampId    string             `protobuf:"bytes,1,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
+func (m *QueryStampsByEntityResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryStampBatchRequest struct {
+	Id         string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDocumentsByStampRequest) Reset()         { *m = QueryDocumentsByStampRequest{} }
-func (m *QueryDocumentsByStampRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDocumentsByStampRequest) ProtoMessage()    {}
-func (*QueryDocumentsByStampRequest) Descriptor() ([]byte, []int) {
+func (m *QueryStampBatchRequest) Reset()         { *m = QueryStampBatchRequest{} }
+func (m *QueryStampBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStampBatchRequest) ProtoMessage()    {}
+func (*QueryStampBatchRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{12}
 }
-func (m *QueryDocumentsByStampRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStampBatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDocumentsByStampRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStampBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDocumentsByStampRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStampBatchRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -620,49 +644,50 @@ func (m *QueryDocumentsByStampRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryDocumentsByStampRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDocumentsByStampRequest.Merge(m, src)
+func (m *QueryStampBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStampBatchRequest.Merge(m, src)
 }
-func (m *QueryDocumentsByStampRequest) XXX_Size() int {
+func (m *QueryStampBatchRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDocumentsByStampRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDocumentsByStampRequest.DiscardUnknown(m)
+func (m *QueryStampBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStampBatchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDocumentsByStampRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStampBatchRequest proto.InternalMessageInfo
 
-func (m *QueryDocumentsByStampRequest) GetStampId() string {
+func (m *QueryStampBatchRequest) GetId() string {
 	if m != nil {
-		return m.StampId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *QueryDocumentsByStampRequest) GetPagination() *query.PageRequest {
+func (m *QueryStampBatchRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryDocumentsByStampResponse struct {
-	Documents  []DocumentStorage   `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryStampBatchResponse struct {
+	Batch      StampBatch          `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch"`
+	Stamps     []Stamp             `protobuf:"bytes,2,rep,name=stamps,proto3" json:"stamps"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDocumentsByStampResponse) Reset()         { *m = QueryDocumentsByStampResponse{} }
-func (m *QueryDocumentsByStampResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDocumentsByStampResponse) ProtoMessage()    {}
-func (*QueryDocumentsByStampResponse) Descriptor() ([]byte, []int) {
+func (m *QueryStampBatchResponse) Reset()         { *m = QueryStampBatchResponse{} }
+func (m *QueryStampBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStampBatchResponse) ProtoMessage()    {}
+func (*QueryStampBatchResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{13}
 }
-func (m *QueryDocumentsByStampResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStampBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDocumentsByStampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStampBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDocumentsByStampResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStampBatchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -672,48 +697,58 @@ func (m *QueryDocumentsByStampResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryDocumentsByStampResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDocumentsByStampResponse.Merge(m, src)
+func (m *QueryStampBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStampBatchResponse.Merge(m, src)
 }
-func (m *QueryDocumentsByStampResponse) XXX_Size() int {
+func (m *QueryStampBatchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDocumentsByStampResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDocumentsByStampResponse.DiscardUnknown(m)
+func (m *QueryStampBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStampBatchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDocumentsByStampResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStampBatchResponse proto.InternalMessageInfo
 
-func (m *QueryDocumentsByStampResponse) GetDocuments() []DocumentStorage {
+func (m *QueryStampBatchResponse) GetBatch() StampBatch {
 	if m != nil {
-		return m.Documents
+		return m.Batch
+	}
+	return StampBatch{}
+}
+
+func (m *QueryStampBatchResponse) GetStamps() []Stamp {
+	if m != nil {
+		return m.Stamps
 	}
 	return nil
 }
 
-func (m *QueryDocumentsByStampResponse) GetPagination() *query.PageResponse {
+func (m *QueryStampBatchResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryEntityRequest struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type QueryVerifyMerkleInclusionRequest struct {
+	MerkleRoot string   `protobuf:"bytes,1,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	LeafHash   string   `protobuf:"bytes,2,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	LeafIndex  uint64   `protobuf:"varint,3,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	Proof      []string `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (m *QueryEntityRequest) Reset()         { *m = QueryEntityRequest{} }
-func (m *QueryEntityRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEntityRequest) ProtoMessage()    {}
-func (*QueryEntityRequest) Descriptor() ([]byte, []int) {
+func (m *QueryVerifyMerkleInclusionRequest) Reset()         { *m = QueryVerifyMerkleInclusionRequest{} }
+func (m *QueryVerifyMerkleInclusionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyMerkleInclusionRequest) ProtoMessage()    {}
+func (*QueryVerifyMerkleInclusionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{14}
 }
-func (m *QueryEntityRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryVerifyMerkleInclusionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEntityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVerifyMerkleInclusionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEntityRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVerifyMerkleInclusionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -723,41 +758,62 @@ func (m *QueryEntityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryEntityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEntityRequest.Merge(m, src)
+func (m *QueryVerifyMerkleInclusionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyMerkleInclusionRequest.Merge(m, src)
 }
-func (m *QueryEntityRequest) XXX_Size() int {
+func (m *QueryVerifyMerkleInclusionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEntityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEntityRequest.DiscardUnknown(m)
+func (m *QueryVerifyMerkleInclusionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyMerkleInclusionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEntityRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryVerifyMerkleInclusionRequest proto.InternalMessageInfo
 
-func (m *QueryEntityRequest) GetId() string {
+func (m *QueryVerifyMerkleInclusionRequest) GetMerkleRoot() string {
 	if m != nil {
-		return m.Id
+		return m.MerkleRoot
 	}
 	return ""
 }
 
-type QueryEntityResponse struct {
-	Entity EntityAccount `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity"`
+func (m *QueryVerifyMerkleInclusionRequest) GetLeafHash() string {
+	if m != nil {
+		return m.LeafHash
+	}
+	return ""
 }
 
-func (m *QueryEntityResponse) Reset()         { *m = QueryEntityResponse{} }
-func (m *QueryEntityResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEntityResponse) ProtoMessage()    {}
-func (*QueryEntityResponse) Descriptor() ([]byte, []int) {
+func (m *QueryVerifyMerkleInclusionRequest) GetLeafIndex() uint64 {
+	if m != nil {
+		return m.LeafIndex
+	}
+	return 0
+}
+
+func (m *QueryVerifyMerkleInclusionRequest) GetProof() []string {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type QueryVerifyMerkleInclusionResponse struct {
+	Verification MerkleInclusionVerification `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification"`
+}
+
+func (m *QueryVerifyMerkleInclusionResponse) Reset()         { *m = QueryVerifyMerkleInclusionResponse{} }
+func (m *QueryVerifyMerkleInclusionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyMerkleInclusionResponse) ProtoMessage()    {}
+func (*QueryVerifyMerkleInclusionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{15}
 }
-func (m *QueryEntityResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryVerifyMerkleInclusionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEntityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVerifyMerkleInclusionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEntityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVerifyMerkleInclusionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -767,42 +823,41 @@ func (m *QueryEntityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryEntityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEntityResponse.Merge(m, src)
+func (m *QueryVerifyMerkleInclusionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyMerkleInclusionResponse.Merge(m, src)
 }
-func (m *QueryEntityResponse) XXX_Size() int {
+func (m *QueryVerifyMerkleInclusionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEntityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEntityResponse.DiscardUnknown(m)
+func (m *QueryVerifyMerkleInclusionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyMerkleInclusionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEntityResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryVerifyMerkleInclusionResponse proto.InternalMessageInfo
 
-func (m *QueryEntityResponse) GetEntity() EntityAccount {
+func (m *QueryVerifyMerkleInclusionResponse) GetVerification() MerkleInclusionVerification {
 	if m != nil {
-		return m.Entity
+		return m.Verification
 	}
-	return EntityAccount{}
+	return MerkleInclusionVerification{}
 }
 
-type QueryEntitiesByOwnerRequest struct {
-	OwnerAddress string             `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryVerifyStampRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryEntitiesByOwnerRequest) Reset()         { *m = QueryEntitiesByOwnerRequest{} }
-func (m *QueryEntitiesByOwnerRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEntitiesByOwnerRequest) ProtoMessage()    {}
-func (*QueryEntitiesByOwnerRequest) Descriptor() ([]byte, []int) {
+func (m *QueryVerifyStampRequest) Reset()         { *m = QueryVerifyStampRequest{} }
+func (m *QueryVerifyStampRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyStampRequest) ProtoMessage()    {}
+func (*QueryVerifyStampRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{16}
 }
-func (m *QueryEntitiesByOwnerRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryVerifyStampRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEntitiesByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVerifyStampRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEntitiesByOwnerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVerifyStampRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -812,49 +867,41 @@ func (m *QueryEntitiesByOwnerRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryEntitiesByOwnerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEntitiesByOwnerRequest.Merge(m, src)
+func (m *QueryVerifyStampRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyStampRequest.Merge(m, src)
 }
-func (m *QueryEntitiesByOwnerRequest) XXX_Size() int {
+func (m *QueryVerifyStampRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEntitiesByOwnerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEntitiesByOwnerRequest.DiscardUnknown(m)
+func (m *QueryVerifyStampRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyStampRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEntitiesByOwnerRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryVerifyStampRequest proto.InternalMessageInfo
 
-func (m *QueryEntitiesByOwnerRequest) GetOwnerAddress() string {
+func (m *QueryVerifyStampRequest) GetId() string {
 	if m != nil {
-		return m.OwnerAddress
+		return m.Id
 	}
 	return ""
 }
 
-func (m *QueryEntitiesByOwnerRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-type QueryEntitiesByOwnerResponse struct {
-	Entities   []EntityAccount     `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryVerifyStampResponse struct {
+	Verification StampVerification `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification"`
 }
 
-func (m *QueryEntitiesByOwnerResponse) Reset()         { *m = QueryEntitiesByOwnerResponse{} }
-func (m *QueryEntitiesByOwnerResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEntitiesByOwnerResponse) ProtoMessage()    {}
-func (*QueryEntitiesByOwnerResponse) Descriptor() ([]byte, []int) {
+func (m *QueryVerifyStampResponse) Reset()         { *m = QueryVerifyStampResponse{} }
+func (m *QueryVerifyStampResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyStampResponse) ProtoMessage()    {}
+func (*QueryVerifyStampResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{17}
 }
-func (m *QueryEntitiesByOwnerResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryVerifyStampResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEntitiesByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVerifyStampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEntitiesByOwnerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVerifyStampResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -864,48 +911,41 @@ func (m *QueryEntitiesByOwnerResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryEntitiesByOwnerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEntitiesByOwnerResponse.Merge(m, src)
+func (m *QueryVerifyStampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyStampResponse.Merge(m, src)
 }
-func (m *QueryEntitiesByOwnerResponse) XXX_Size() int {
+func (m *QueryVerifyStampResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEntitiesByOwnerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEntitiesByOwnerResponse.DiscardUnknown(m)
+func (m *QueryVerifyStampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyStampResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEntitiesByOwnerResponse proto.InternalMessageInfo
-
-func (m *QueryEntitiesByOwnerResponse) GetEntities() []EntityAccount {
-	if m != nil {
-		return m.Entities
-	}
-	return nil
-}
+var xxx_messageInfo_QueryVerifyStampResponse proto.InternalMessageInfo
 
-func (m *QueryEntitiesByOwnerResponse) GetPagination() *query.PageResponse {
+func (m *QueryVerifyStampResponse) GetVerification() StampVerification {
 	if m != nil {
-		return m.Pagination
+		return m.Verification
 	}
-	return nil
+	return StampVerification{}
 }
 
-type QuerySpecVersionRequest struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type QueryAllStampsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySpecVersionRequest) Reset()         { *m = QuerySpecVersionRequest{} }
-func (m *QuerySpecVersionRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySpecVersionRequest) ProtoMessage()    {}
-func (*QuerySpecVersionRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllStampsRequest) Reset()         { *m = QueryAllStampsRequest{} }
+func (m *QueryAllStampsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllStampsRequest) ProtoMessage()    {}
+func (*QueryAllStampsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{18}
 }
-func (m *QuerySpecVersionRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllStampsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySpecVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllStampsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySpecVersionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllStampsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -915,41 +955,42 @@ func (m *QuerySpecVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QuerySpecVersionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySpecVersionRequest.Merge(m, src)
-}
-func (m *QuerySpecVersionRequest) XXX_Size() int {
+func (m *QueryAllStampsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllStampsRequest.Merge(m, src)
+}
+func (m *QueryAllStampsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySpecVersionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySpecVersionRequest.DiscardUnknown(m)
+func (m *QueryAllStampsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllStampsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySpecVersionRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllStampsRequest proto.InternalMessageInfo
 
-func (m *QuerySpecVersionRequest) GetId() string {
+func (m *QueryAllStampsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Id
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-type QuerySpecVersionResponse struct {
-	Version SpecVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version"`
+type QueryAllStampsResponse struct {
+	Stamps     []Stamp             `protobuf:"bytes,1,rep,name=stamps,proto3" json:"stamps"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySpecVersionResponse) Reset()         { *m = QuerySpecVersionResponse{} }
-func (m *QuerySpecVersionResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySpecVersionResponse) ProtoMessage()    {}
-func (*QuerySpecVersionResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllStampsResponse) Reset()         { *m = QueryAllStampsResponse{} }
+func (m *QueryAllStampsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllStampsResponse) ProtoMessage()    {}
+func (*QueryAllStampsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{19}
 }
-func (m *QuerySpecVersionResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllStampsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySpecVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllStampsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySpecVersionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllStampsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -959,42 +1000,48 @@ func (m *QuerySpecVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QuerySpecVersionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySpecVersionResponse.Merge(m, src)
+func (m *QueryAllStampsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllStampsResponse.Merge(m, src)
 }
-func (m *QuerySpecVersionResponse) XXX_Size() int {
+func (m *QueryAllStampsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySpecVersionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySpecVersionResponse.DiscardUnknown(m)
+func (m *QueryAllStampsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllStampsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySpecVersionResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllStampsResponse proto.InternalMessageInfo
 
-func (m *QuerySpecVersionResponse) GetVersion() SpecVersion {
+func (m *QueryAllStampsResponse) GetStamps() []Stamp {
 	if m != nil {
-		return m.Version
+		return m.Stamps
 	}
-	return SpecVersion{}
+	return nil
 }
 
-type QuerySpecVersionsByProjectRequest struct {
-	ProjectId  string             `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryAllStampsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QuerySpecVersionsByProjectRequest) Reset()         { *m = QuerySpecVersionsByProjectRequest{} }
-func (m *QuerySpecVersionsByProjectRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySpecVersionsByProjectRequest) ProtoMessage()    {}
-func (*QuerySpecVersionsByProjectRequest) Descriptor() ([]byte, []int) {
+type QueryProfessionalEngineerRequest struct {
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (m *QueryProfessionalEngineerRequest) Reset()         { *m = QueryProfessionalEngineerRequest{} }
+func (m *QueryProfessionalEngineerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProfessionalEngineerRequest) ProtoMessage()    {}
+func (*QueryProfessionalEngineerRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{20}
 }
-func (m *QuerySpecVersionsByProjectRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryProfessionalEngineerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySpecVersionsByProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProfessionalEngineerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySpecVersionsByProjectRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProfessionalEngineerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1004,49 +1051,41 @@ func (m *QuerySpecVersionsByProjectRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QuerySpecVersionsByProjectRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySpecVersionsByProjectRequest.Merge(m, src)
+func (m *QueryProfessionalEngineerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProfessionalEngineerRequest.Merge(m, src)
 }
-func (m *QuerySpecVersionsByProjectRequest) XXX_Size() int {
+func (m *QueryProfessionalEngineerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySpecVersionsByProjectRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySpecVersionsByProjectRequest.DiscardUnknown(m)
+func (m *QueryProfessionalEngineerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProfessionalEngineerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySpecVersionsByProjectRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryProfessionalEngineerRequest proto.InternalMessageInfo
 
-func (m *QuerySpecVersionsByProjectRequest) GetProjectId() string {
+func (m *QueryProfessionalEngineerRequest) GetPublicKey() string {
 	if m != nil {
-		return m.ProjectId
+		return m.PublicKey
 	}
 	return ""
 }
 
-func (m *QuerySpecVersionsByProjectRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-type QuerySpecVersionsByProjectResponse struct {
-	Versions   []SpecVersion       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryProfessionalEngineerResponse struct {
+	ProfessionalEngineer ProfessionalEngineer `protobuf:"bytes,1,opt,name=professional_engineer,json=professionalEngineer,proto3" json:"professional_engineer"`
 }
 
-func (m *QuerySpecVersionsByProjectResponse) Reset()         { *m = QuerySpecVersionsByProjectResponse{} }
-func (m *QuerySpecVersionsByProjectResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySpecVersionsByProjectResponse) ProtoMessage()    {}
-func (*QuerySpecVersionsByProjectResponse) Descriptor() ([]byte, []int) {
+func (m *QueryProfessionalEngineerResponse) Reset()         { *m = QueryProfessionalEngineerResponse{} }
+func (m *QueryProfessionalEngineerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProfessionalEngineerResponse) ProtoMessage()    {}
+func (*QueryProfessionalEngineerResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{21}
 }
-func (m *QuerySpecVersionsByProjectResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryProfessionalEngineerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySpecVersionsByProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProfessionalEngineerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySpecVersionsByProjectResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProfessionalEngineerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1056,48 +1095,42 @@ func (m *QuerySpecVersionsByProjectResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QuerySpecVersionsByProjectResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySpecVersionsByProjectResponse.Merge(m, src)
+func (m *QueryProfessionalEngineerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProfessionalEngineerResponse.Merge(m, src)
 }
-func (m *QuerySpecVersionsByProjectResponse) XXX_Size() int {
+func (m *QueryProfessionalEngineerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySpecVersionsByProjectResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySpecVersionsByProjectResponse.DiscardUnknown(m)
+func (m *QueryProfessionalEngineerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProfessionalEngineerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySpecVersionsByProjectResponse proto.InternalMessageInfo
-
-func (m *QuerySpecVersionsByProjectResponse) GetVersions() []SpecVersion {
-	if m != nil {
-		return m.Versions
-	}
-	return nil
-}
+var xxx_messageInfo_QueryProfessionalEngineerResponse proto.InternalMessageInfo
 
-func (m *QuerySpecVersionsByProjectResponse) GetPagination() *query.PageResponse {
+func (m *QueryProfessionalEngineerResponse) GetProfessionalEngineer() ProfessionalEngineer {
 	if m != nil {
-		return m.Pagination
+		return m.ProfessionalEngineer
 	}
-	return nil
+	return ProfessionalEngineer{}
 }
 
-type QuerySpecHistoryRequest struct {
-	StartingVersionId string `protobuf:"bytes,1,opt,name=starting_version_id,json=startingVersionId,proto3" json:"starting_version_id,omitempty"`
+type QueryStampingDelegationsRequest struct {
+	Granter    string             `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySpecHistoryRequest) Reset()         { *m = QuerySpecHistoryRequest{} }
-func (m *QuerySpecHistoryRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySpecHistoryRequest) ProtoMessage()    {}
-func (*QuerySpecHistoryRequest) Descriptor() ([]byte, []int) {
+func (m *QueryStampingDelegationsRequest) Reset()         { *m = QueryStampingDelegationsRequest{} }
+func (m *QueryStampingDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStampingDelegationsRequest) ProtoMessage()    {}
+func (*QueryStampingDelegationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{22}
 }
-func (m *QuerySpecHistoryRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStampingDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySpecHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStampingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySpecHistoryRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStampingDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1107,41 +1140,49 @@ func (m *QuerySpecHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QuerySpecHistoryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySpecHistoryRequest.Merge(m, src)
+func (m *QueryStampingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStampingDelegationsRequest.Merge(m, src)
 }
-func (m *QuerySpecHistoryRequest) XXX_Size() int {
+func (m *QueryStampingDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySpecHistoryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySpecHistoryRequest.DiscardUnknown(m)
+func (m *QueryStampingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStampingDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySpecHistoryRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStampingDelegationsRequest proto.InternalMessageInfo
 
-func (m *QuerySpecHistoryRequest) GetStartingVersionId() string {
+func (m *QueryStampingDelegationsRequest) GetGranter() string {
 	if m != nil {
-		return m.StartingVersionId
+		return m.Granter
 	}
 	return ""
 }
 
-type QuerySpecHistoryResponse struct {
-	History []SpecVersion `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+func (m *QueryStampingDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QuerySpecHistoryResponse) Reset()         { *m = QuerySpecHistoryResponse{} }
-func (m *QuerySpecHistoryResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySpecHistoryResponse) ProtoMessage()    {}
-func (*QuerySpecHistoryResponse) Descriptor() ([]byte, []int) {
+type QueryStampingDelegationsResponse struct {
+	Delegations []StampingDelegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	Pagination  *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStampingDelegationsResponse) Reset()         { *m = QueryStampingDelegationsResponse{} }
+func (m *QueryStampingDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStampingDelegationsResponse) ProtoMessage()    {}
+func (*QueryStampingDelegationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84b15ab839ebe314, []int{23}
 }
-func (m *QuerySpecHistoryResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStampingDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySpecHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStampingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySpecHistoryResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStampingDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1151,1927 +1192,8286 @@ func (m *QuerySpecHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QuerySpecHistoryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySpecHistoryResponse.Merge(m, src)
+func (m *QueryStampingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStampingDelegationsResponse.Merge(m, src)
 }
-func (m *QuerySpecHistoryResponse) XXX_Size() int {
+func (m *QueryStampingDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySpecHistoryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySpecHistoryResponse.DiscardUnknown(m)
+func (m *QueryStampingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStampingDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySpecHistoryResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStampingDelegationsResponse proto.InternalMessageInfo
 
-func (m *QuerySpecHistoryResponse) GetHistory() []SpecVersion {
+func (m *QueryStampingDelegationsResponse) GetDelegations() []StampingDelegation {
 	if m != nil {
-		return m.History
+		return m.Delegations
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryStampRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampRequest")
-	proto.RegisterType((*QueryStampResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampResponse")
-	proto.RegisterType((*QueryStampsByPERequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampsByPERequest")
-	proto.RegisterType((*QueryStampsByPEResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampsByPEResponse")
-	proto.RegisterType((*QueryStampsByJurisdictionRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampsByJurisdictionRequest")
-	proto.RegisterType((*QueryStampsByJurisdictionResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampsByJurisdictionResponse")
-	proto.RegisterType((*QueryAllStampsRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryAllStampsRequest")
-	proto.RegisterType((*QueryAllStampsResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryAllStampsResponse")
-	proto.RegisterType((*QueryDocumentRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryDocumentRequest")
-	proto.RegisterType((*QueryDocumentResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryDocumentResponse")
-	proto.RegisterType((*QueryDocumentsByStampRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryDocumentsByStampRequest")
-	proto.RegisterType((*QueryDocumentsByStampResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryDocumentsByStampResponse")
-	proto.RegisterType((*QueryEntityRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryEntityRequest")
-	proto.RegisterType((*QueryEntityResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryEntityResponse")
-	proto.RegisterType((*QueryEntitiesByOwnerRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryEntitiesByOwnerRequest")
-	proto.RegisterType((*QueryEntitiesByOwnerResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryEntitiesByOwnerResponse")
-	proto.RegisterType((*QuerySpecVersionRequest)(nil), "stampledgerchain.stampledgerchain.v1.QuerySpecVersionRequest")
-	proto.RegisterType((*QuerySpecVersionResponse)(nil), "stampledgerchain.stampledgerchain.v1.QuerySpecVersionResponse")
-	proto.RegisterType((*QuerySpecVersionsByProjectRequest)(nil), "stampledgerchain.stampledgerchain.v1.QuerySpecVersionsByProjectRequest")
-	proto.RegisterType((*QuerySpecVersionsByProjectResponse)(nil), "stampledgerchain.stampledgerchain.v1.QuerySpecVersionsByProjectResponse")
-	proto.RegisterType((*QuerySpecHistoryRequest)(nil), "stampledgerchain.stampledgerchain.v1.QuerySpecHistoryRequest")
-	proto.RegisterType((*QuerySpecHistoryResponse)(nil), "stampledgerchain.stampledgerchain.v1.QuerySpecHistoryResponse")
+func (m *QueryStampingDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("stampledgerchain/stampledgerchain/v1/query.proto", fileDescriptor_84b15ab839ebe314)
+type QueryLicenseRequest struct {
+	JurisdictionId string `protobuf:"bytes,1,opt,name=jurisdiction_id,json=jurisdictionId,proto3" json:"jurisdiction_id,omitempty"`
+	LicenseNumber  string `protobuf:"bytes,2,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
 }
 
-var fileDescriptor_84b15ab839ebe314 = []byte{
-	// 1248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcb, 0x6f, 0x1b, 0xd5,
-	0x17, 0xce, 0xcd, 0xef, 0x17, 0x37, 0x39, 0xa1, 0x2d, 0xb9, 0x4d, 0x21, 0x0c, 0xad, 0x69, 0xa7,
-	0x55, 0x0b, 0x81, 0x7a, 0x70, 0xd2, 0xd2, 0xa6, 0x0d, 0x8f, 0xb8, 0x4d, 0x93, 0x94, 0x47, 0xd3,
-	0x84, 0x87, 0x40, 0x42, 0xd6, 0xc4, 0xbe, 0x38, 0x53, 0xe2, 0x99, 0xe9, 0xdc, 0x71, 0xc0, 0xb2,
-	0xbc, 0x00, 0xb1, 0x62, 0x03, 0x52, 0xff, 0x09, 0x16, 0x20, 0xb1, 0x00, 0x21, 0x16, 0x20, 0x01,
-	0x9b, 0x6e, 0x90, 0x2a, 0x75, 0xc3, 0xaa, 0x42, 0x09, 0xa2, 0xff, 0x04, 0x48, 0xc8, 0xf7, 0x9e,
-	0xf1, 0xbc, 0x92, 0x76, 0x66, 0xec, 0x45, 0x37, 0x91, 0x7d, 0xe6, 0xde, 0xef, 0x7c, 0xdf, 0x39,
-	0x77, 0xce, 0xfd, 0x62, 0x78, 0x9e, 0xbb, 0x7a, 0xdd, 0xde, 0x60, 0xd5, 0x1a, 0x73, 0x2a, 0xeb,
-	0xba, 0x61, 0x6a, 0xb1, 0xc0, 0x66, 0x51, 0xbb, 0xd1, 0x60, 0x4e, 0xb3, 0x60, 0x3b, 0x96, 0x6b,
-	0xd1, 0xe3, 0xd1, 0x05, 0x85, 0x58, 0x60, 0xb3, 0xa8, 0x8c, 0xe9, 0x75, 0xc3, 0xb4, 0x34, 0xf1,
-	0x57, 0x6e, 0x54, 0xc6, 0x6b, 0x56, 0xcd, 0x12, 0x1f, 0xb5, 0xce, 0x27, 0x8c, 0x1e, 0xaa, 0x59,
-	0x56, 0x6d, 0x83, 0x69, 0xba, 0x6d, 0x68, 0xba, 0x69, 0x5a, 0xae, 0xee, 0x1a, 0x96, 0xc9, 0xf1,
-	0xe9, 0x64, 0xc5, 0xe2, 0x75, 0x8b, 0x6b, 0x6b, 0x3a, 0x67, 0x92, 0x85, 0xb6, 0x59, 0x5c, 0x63,
-	0xae, 0x5e, 0xd4, 0x6c, 0xbd, 0x66, 0x98, 0x62, 0x31, 0xae, 0x2d, 0x26, 0x92, 0x62, 0xeb, 0x8e,
-	0x5e, 0xf7, 0xe0, 0x93, 0xa9, 0x17, 0x31, 0xb9, 0x43, 0x1d, 0x07, 0x7a, 0xad, 0x43, 0x63, 0x59,
-	0xc0, 0xac, 0xb0, 0x1b, 0x0d, 0xc6, 0x5d, 0xf5, 0x03, 0x38, 0x10, 0x8a, 0x72, 0xdb, 0x32, 0x39,
-	0xa3, 0x57, 0x21, 0x27, 0xd3, 0x4d, 0x90, 0x23, 0xe4, 0xe9, 0xd1, 0xa9, 0xe7, 0x0a, 0x49, 0x6a,
-	0x57, 0x90, 0x28, 0xa5, 0x91, 0x5b, 0x77, 0x9f, 0x1a, 0xf8, 0xea, 0xde, 0xb7, 0x93, 0x64, 0x05,
-	0x61, 0xd4, 0x63, 0x30, 0x26, 0xf2, 0xac, 0x76, 0x76, 0x61, 0x72, 0xba, 0x0f, 0x06, 0x8d, 0xaa,
-	0xc8, 0x30, 0xb2, 0x32, 0x68, 0x54, 0xd5, 0xf7, 0x91, 0x22, 0x2e, 0x42, 0x2e, 0x0b, 0x30, 0x24,
-	0x72, 0x21, 0x95, 0x67, 0x93, 0x51, 0x11, 0x18, 0xa5, 0xff, 0x77, 0x98, 0xac, 0xc8, 0xfd, 0xea,
-	0x67, 0x04, 0x1e, 0xf3, 0xf1, 0x79, 0xa9, 0xb9, 0x3c, 0xef, 0x31, 0x51, 0x61, 0xaf, 0xcd, 0xca,
-	0x76, 0x63, 0x6d, 0xc3, 0xa8, 0x94, 0x3f, 0x64, 0x4d, 0x24, 0x35, 0x6a, 0xb3, 0x65, 0x11, 0x7b,
-	0x95, 0x35, 0xe9, 0x65, 0x00, 0xbf, 0x73, 0x13, 0x83, 0x82, 0xcc, 0x89, 0x82, 0x6c, 0x73, 0xa1,
-	0xd3, 0xe6, 0x82, 0x3c, 0x6c, 0xd8, 0xe6, 0xc2, 0xb2, 0x5e, 0x63, 0x88, 0xbf, 0x12, 0xd8, 0xa9,
-	0x7e, 0x43, 0xe0, 0xf1, 0x18, 0x0d, 0xd4, 0xba, 0x04, 0x39, 0xc1, 0xb5, 0x53, 0xf7, 0xff, 0x65,
-	0x13, 0x8b, 0x00, 0x74, 0x61, 0x07, 0xba, 0x27, 0x1f, 0x48, 0x57, 0xf2, 0x08, 0xf1, 0xbd, 0x49,
-	0xe0, 0x48, 0x88, 0xef, 0x95, 0x86, 0x63, 0xf0, 0xaa, 0x51, 0xe9, 0x3c, 0xf5, 0x0a, 0x78, 0x12,
-	0xf6, 0x5f, 0x0f, 0x84, 0xcb, 0xdd, 0xbe, 0xee, 0x0b, 0x86, 0x97, 0xaa, 0x7d, 0xab, 0xe2, 0x0f,
-	0x04, 0x8e, 0xde, 0x87, 0xd5, 0x43, 0x5c, 0xcf, 0x32, 0x1c, 0x14, 0xc4, 0xe7, 0x36, 0x36, 0x24,
-	0x77, 0xaf, 0x86, 0xe1, 0xd2, 0x90, 0xcc, 0xa5, 0xf9, 0xda, 0x3b, 0xe7, 0x81, 0x0c, 0x0f, 0x71,
-	0x3d, 0x4e, 0xc0, 0xb8, 0x60, 0x7b, 0xc9, 0xaa, 0x34, 0xea, 0xcc, 0x74, 0x77, 0x9b, 0x0e, 0x36,
-	0xd6, 0xcd, 0x5f, 0x87, 0xa2, 0xde, 0x81, 0xe1, 0x2a, 0xc6, 0xb0, 0x6a, 0x67, 0x92, 0xc9, 0xf2,
-	0x90, 0x56, 0x5d, 0xcb, 0xd1, 0x6b, 0x0c, 0x05, 0x76, 0xc1, 0xd4, 0x4f, 0x08, 0x1c, 0x0a, 0xa5,
-	0xe4, 0xa5, 0xf0, 0x00, 0x7b, 0x02, 0x86, 0x05, 0xae, 0x7f, 0xdc, 0xf7, 0x88, 0xef, 0x7d, 0x3c,
-	0xe7, 0xbf, 0x11, 0x38, 0xbc, 0x0b, 0x07, 0x94, 0xff, 0x2e, 0x8c, 0x78, 0x8c, 0xbd, 0xb6, 0xf6,
-	0xa4, 0xdf, 0x47, 0xeb, 0x5f, 0x8f, 0x8f, 0xe3, 0x64, 0x9f, 0x37, 0x5d, 0xc3, 0x6d, 0xee, 0xd6,
-	0xe1, 0x75, 0xbc, 0x8c, 0xbc, 0x55, 0x28, 0xf0, 0x1a, 0xe4, 0x98, 0x88, 0x60, 0x77, 0xa7, 0x93,
-	0xa9, 0x93, 0x28, 0x73, 0x95, 0x8a, 0xd5, 0x30, 0x5d, 0xef, 0xf0, 0x4a, 0x20, 0xf5, 0x73, 0x02,
-	0x4f, 0xfa, 0xa9, 0x0c, 0xc6, 0x4b, 0xcd, 0xab, 0x1f, 0x99, 0xcc, 0xf1, 0x98, 0x1d, 0x83, 0xbd,
-	0x56, 0xe7, 0x7b, 0x59, 0xaf, 0x56, 0x1d, 0xc6, 0x39, 0x92, 0x7c, 0x44, 0x04, 0xe7, 0x64, 0xac,
-	0x6f, 0x2d, 0xfe, 0xd9, 0x3b, 0x66, 0x31, 0x32, 0x58, 0x80, 0xb7, 0x60, 0x98, 0xe1, 0x23, 0x6c,
-	0x70, 0x0f, 0x25, 0xe8, 0x42, 0xf5, 0xaf, 0xbb, 0xcf, 0x78, 0x17, 0x9a, 0xcd, 0x2a, 0x6f, 0x33,
-	0x87, 0x07, 0xee, 0x85, 0x68, 0x8b, 0xeb, 0x30, 0x11, 0x5f, 0xda, 0xed, 0xf3, 0x9e, 0x4d, 0x19,
-	0xc2, 0x46, 0x17, 0x13, 0x4e, 0x27, 0x1f, 0x0b, 0x35, 0x7a, 0x38, 0x9d, 0x3e, 0x1f, 0x8d, 0xe6,
-	0xeb, 0xdc, 0xb8, 0x8e, 0x75, 0x9d, 0x55, 0xba, 0x93, 0xe6, 0x30, 0x80, 0x2d, 0x23, 0xfe, 0x8b,
-	0x3c, 0x82, 0x91, 0x3e, 0xbe, 0xca, 0xbf, 0x12, 0x50, 0xef, 0x47, 0x06, 0xcb, 0xb0, 0x0a, 0xc3,
-	0x48, 0xdf, 0xeb, 0x76, 0xe6, 0x3a, 0x74, 0x81, 0xfa, 0xd7, 0xeb, 0xa5, 0x40, 0xaf, 0x17, 0x0d,
-	0xee, 0x5a, 0x4e, 0xf7, 0x75, 0x2e, 0xc0, 0x01, 0xee, 0xea, 0x8e, 0x6b, 0x98, 0xb5, 0x32, 0x26,
-	0xf6, 0xeb, 0x39, 0xe6, 0x3d, 0x42, 0x86, 0x4b, 0xe1, 0xb3, 0xd0, 0x85, 0xf2, 0xcf, 0xc2, 0xba,
-	0x0c, 0xf5, 0x5a, 0x03, 0x0f, 0x67, 0xea, 0x8b, 0x71, 0x18, 0x12, 0xf9, 0xe8, 0x77, 0x04, 0x72,
-	0xd2, 0xaa, 0xd2, 0x73, 0xc9, 0x60, 0xe3, 0xce, 0x59, 0x99, 0xc9, 0xb0, 0x53, 0x8a, 0x53, 0xcf,
-	0x7c, 0x7a, 0xe7, 0xaf, 0x9b, 0x83, 0x1a, 0x3d, 0x15, 0x34, 0xed, 0xa7, 0x1e, 0xe4, 0xfc, 0xe9,
-	0xf7, 0x04, 0x86, 0xc4, 0xe8, 0xa7, 0x67, 0x53, 0xe4, 0x0e, 0x5e, 0x58, 0xca, 0xb9, 0xf4, 0x1b,
-	0x91, 0xf3, 0x8c, 0xe0, 0x3c, 0x4d, 0x8b, 0x09, 0x39, 0x8b, 0x98, 0xd6, 0x32, 0xaa, 0x6d, 0x7a,
-	0x87, 0x00, 0xf8, 0x5e, 0x97, 0xce, 0xa6, 0xe5, 0x10, 0x74, 0xea, 0xca, 0x8b, 0x19, 0x77, 0xa3,
-	0x8c, 0x45, 0x21, 0xa3, 0x44, 0x5f, 0x49, 0x23, 0x83, 0x6b, 0x36, 0xd3, 0x5a, 0xa1, 0x7f, 0x10,
-	0xda, 0xf4, 0x5f, 0x02, 0xe3, 0x3b, 0x79, 0x4f, 0x7a, 0x39, 0x03, 0xc3, 0x1d, 0x2c, 0xb5, 0xb2,
-	0xd0, 0x33, 0x0e, 0x6a, 0x7e, 0x53, 0x68, 0x7e, 0x83, 0xbe, 0x96, 0x4e, 0x73, 0xd0, 0xb8, 0x6b,
-	0xad, 0x88, 0xbb, 0x6f, 0xd3, 0x9f, 0x08, 0x8c, 0x74, 0x0d, 0x26, 0xbd, 0x90, 0x82, 0x6c, 0xd4,
-	0xf8, 0x2a, 0xb3, 0xd9, 0x36, 0x67, 0x7c, 0x9b, 0xd0, 0xbf, 0xfe, 0x42, 0x60, 0xd8, 0x33, 0x40,
-	0xf4, 0x7c, 0x0a, 0x06, 0x11, 0x9f, 0xaa, 0x5c, 0xc8, 0xb4, 0x17, 0xc9, 0xcf, 0x0a, 0xf2, 0x2f,
-	0xd0, 0xd3, 0x09, 0xc9, 0x7b, 0xde, 0x4c, 0xbe, 0x59, 0x7f, 0x13, 0x78, 0x34, 0xea, 0x0b, 0x69,
-	0x29, 0x03, 0x9f, 0x88, 0xb1, 0x55, 0x2e, 0xf6, 0x84, 0x81, 0xda, 0x96, 0x84, 0xb6, 0x8b, 0x74,
-	0x2e, 0xa5, 0x36, 0xee, 0x0d, 0x0f, 0xcf, 0x5b, 0xb7, 0xe9, 0x8f, 0x04, 0x72, 0xd2, 0xcc, 0xa4,
-	0x9a, 0xd8, 0x21, 0xbb, 0x99, 0x6a, 0x62, 0x87, 0x2d, 0xa8, 0x7a, 0x5e, 0x48, 0x39, 0x4d, 0xa7,
-	0x12, 0x4a, 0x91, 0x36, 0x53, 0x36, 0xe9, 0x1e, 0x81, 0xfd, 0x11, 0x67, 0x47, 0xe7, 0xd2, 0x52,
-	0x89, 0x59, 0x54, 0xa5, 0xd4, 0x0b, 0x04, 0xca, 0x7a, 0x5d, 0xc8, 0x5a, 0xa0, 0xf3, 0x69, 0x64,
-	0x19, 0x8c, 0x6b, 0xc2, 0x07, 0x6b, 0xad, 0x90, 0x47, 0x6e, 0xd3, 0xdf, 0x09, 0x8c, 0x06, 0x2e,
-	0x60, 0x9a, 0x6a, 0x56, 0xc7, 0xbc, 0xa3, 0xf2, 0x52, 0xd6, 0xed, 0xa8, 0xee, 0x65, 0xa1, 0x6e,
-	0x86, 0x9e, 0x4d, 0x3a, 0x18, 0x6c, 0x56, 0x41, 0xdf, 0x22, 0x3b, 0xf7, 0x0f, 0x81, 0x83, 0x3b,
-	0x7a, 0x35, 0xba, 0x90, 0x8d, 0x5a, 0xcc, 0x7a, 0x2a, 0x8b, 0xbd, 0x03, 0xa1, 0xda, 0x65, 0xa1,
-	0xf6, 0x0a, 0x5d, 0x4c, 0xaf, 0x96, 0x6b, 0x68, 0x76, 0xb5, 0x96, 0xef, 0x83, 0xdb, 0xf4, 0x2e,
-	0xb6, 0x13, 0xbd, 0x59, 0xea, 0x76, 0x86, 0xed, 0x61, 0xea, 0x76, 0x46, 0x2c, 0x61, 0x26, 0x81,
-	0xe8, 0xfd, 0xc4, 0x28, 0x89, 0x1a, 0xd3, 0x76, 0xe9, 0xd2, 0xad, 0xad, 0x3c, 0xb9, 0xbd, 0x95,
-	0x27, 0x7f, 0x6e, 0xe5, 0xc9, 0x97, 0xdb, 0xf9, 0x81, 0xdb, 0xdb, 0xf9, 0x81, 0x3f, 0xb6, 0xf3,
-	0x03, 0xef, 0x4d, 0xc6, 0x53, 0x7c, 0x1c, 0x4f, 0xe2, 0x36, 0x6d, 0xc6, 0xd7, 0x72, 0xe2, 0xf7,
-	0xd5, 0xe9, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x46, 0x4b, 0xbe, 0xfa, 0x91, 0x16, 0x00, 0x00,
+func (m *QueryLicenseRequest) Reset()         { *m = QueryLicenseRequest{} }
+func (m *QueryLicenseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLicenseRequest) ProtoMessage()    {}
+func (*QueryLicenseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{24}
+}
+func (m *QueryLicenseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLicenseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLicenseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLicenseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLicenseRequest.Merge(m, src)
+}
+func (m *QueryLicenseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLicenseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLicenseRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryLicenseRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Stamp returns a stamp by ID
-	Stamp(ctx context.Context, in *QueryStampRequest, opts ...grpc.CallOption) (*QueryStampResponse, error)
-	// StampsByPE returns all stamps by a specific PE
-	StampsByPE(ctx context.Context, in *QueryStampsByPERequest, opts ...grpc.CallOption) (*QueryStampsByPEResponse, error)
-	// StampsByJurisdiction returns all stamps for a jurisdiction
-	StampsByJurisdiction(ctx context.Context, in *QueryStampsByJurisdictionRequest, opts ...grpc.CallOption) (*QueryStampsByJurisdictionResponse, error)
-	// AllStamps returns all stamps with pagination
-	AllStamps(ctx context.Context, in *QueryAllStampsRequest, opts ...grpc.CallOption) (*QueryAllStampsResponse, error)
-	// Document returns a document by ID
-	Document(ctx context.Context, in *QueryDocumentRequest, opts ...grpc.CallOption) (*QueryDocumentResponse, error)
-	// DocumentsByStamp returns all documents for a stamp
-	DocumentsByStamp(ctx context.Context, in *QueryDocumentsByStampRequest, opts ...grpc.CallOption) (*QueryDocumentsByStampResponse, error)
-	// Entity returns an entity by ID
-	Entity(ctx context.Context, in *QueryEntityRequest, opts ...grpc.CallOption) (*QueryEntityResponse, error)
-	// EntitiesByOwner returns all entities owned by an address
-	EntitiesByOwner(ctx context.Context, in *QueryEntitiesByOwnerRequest, opts ...grpc.CallOption) (*QueryEntitiesByOwnerResponse, error)
-	// SpecVersion returns a spec version by ID
-	SpecVersion(ctx context.Context, in *QuerySpecVersionRequest, opts ...grpc.CallOption) (*QuerySpecVersionResponse, error)
-	// SpecVersionsByProject returns all versions for a project
-	SpecVersionsByProject(ctx context.Context, in *QuerySpecVersionsByProjectRequest, opts ...grpc.CallOption) (*QuerySpecVersionsByProjectResponse, error)
-	// SpecHistory returns the version history starting from a version
-	SpecHistory(ctx context.Context, in *QuerySpecHistoryRequest, opts ...grpc.CallOption) (*QuerySpecHistoryResponse, error)
+func (m *QueryLicenseRequest) GetJurisdictionId() string {
+	if m != nil {
+		return m.JurisdictionId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryLicenseRequest) GetLicenseNumber() string {
+	if m != nil {
+		return m.LicenseNumber
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryLicenseResponse struct {
+	License License `protobuf:"bytes,1,opt,name=license,proto3" json:"license"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLicenseResponse) Reset()         { *m = QueryLicenseResponse{} }
+func (m *QueryLicenseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLicenseResponse) ProtoMessage()    {}
+func (*QueryLicenseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{25}
 }
-
-func (c *queryClient) Stamp(ctx context.Context, in *QueryStampRequest, opts ...grpc.CallOption) (*QueryStampResponse, error) {
-	out := new(QueryStampResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/Stamp", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLicenseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) StampsByPE(ctx context.Context, in *QueryStampsByPERequest, opts ...grpc.CallOption) (*QueryStampsByPEResponse, error) {
-	out := new(QueryStampsByPEResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/StampsByPE", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLicenseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLicenseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) StampsByJurisdiction(ctx context.Context, in *QueryStampsByJurisdictionRequest, opts ...grpc.CallOption) (*QueryStampsByJurisdictionResponse, error) {
-	out := new(QueryStampsByJurisdictionResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/StampsByJurisdiction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLicenseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLicenseResponse.Merge(m, src)
 }
-
-func (c *queryClient) AllStamps(ctx context.Context, in *QueryAllStampsRequest, opts ...grpc.CallOption) (*QueryAllStampsResponse, error) {
-	out := new(QueryAllStampsResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/AllStamps", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLicenseResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Document(ctx context.Context, in *QueryDocumentRequest, opts ...grpc.CallOption) (*QueryDocumentResponse, error) {
-	out := new(QueryDocumentResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/Document", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLicenseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLicenseResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DocumentsByStamp(ctx context.Context, in *QueryDocumentsByStampRequest, opts ...grpc.CallOption) (*QueryDocumentsByStampResponse, error) {
-	out := new(QueryDocumentsByStampResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/DocumentsByStamp", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryLicenseResponse proto.InternalMessageInfo
 
-func (c *queryClient) Entity(ctx context.Context, in *QueryEntityRequest, opts ...grpc.CallOption) (*QueryEntityResponse, error) {
-	out := new(QueryEntityResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/Entity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLicenseResponse) GetLicense() License {
+	if m != nil {
+		return m.License
 	}
-	return out, nil
+	return License{}
 }
 
-func (c *queryClient) EntitiesByOwner(ctx context.Context, in *QueryEntitiesByOwnerRequest, opts ...grpc.CallOption) (*QueryEntitiesByOwnerResponse, error) {
-	out := new(QueryEntitiesByOwnerResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/EntitiesByOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryDocumentRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *queryClient) SpecVersion(ctx context.Context, in *QuerySpecVersionRequest, opts ...grpc.CallOption) (*QuerySpecVersionResponse, error) {
-	out := new(QuerySpecVersionResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/SpecVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDocumentRequest) Reset()         { *m = QueryDocumentRequest{} }
+func (m *QueryDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDocumentRequest) ProtoMessage()    {}
+func (*QueryDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{26}
 }
-
-func (c *queryClient) SpecVersionsByProject(ctx context.Context, in *QuerySpecVersionsByProjectRequest, opts ...grpc.CallOption) (*QuerySpecVersionsByProjectResponse, error) {
-	out := new(QuerySpecVersionsByProjectResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/SpecVersionsByProject", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDocumentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDocumentRequest.Merge(m, src)
+}
+func (m *QueryDocumentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDocumentRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) SpecHistory(ctx context.Context, in *QuerySpecHistoryRequest, opts ...grpc.CallOption) (*QuerySpecHistoryResponse, error) {
-	out := new(QuerySpecHistoryResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/SpecHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDocumentRequest proto.InternalMessageInfo
+
+func (m *QueryDocumentRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Stamp returns a stamp by ID
-	Stamp(context.Context, *QueryStampRequest) (*QueryStampResponse, error)
-	// StampsByPE returns all stamps by a specific PE
-	StampsByPE(context.Context, *QueryStampsByPERequest) (*QueryStampsByPEResponse, error)
-	// StampsByJurisdiction returns all stamps for a jurisdiction
-	StampsByJurisdiction(context.Context, *QueryStampsByJurisdictionRequest) (*QueryStampsByJurisdictionResponse, error)
-	// AllStamps returns all stamps with pagination
-	AllStamps(context.Context, *QueryAllStampsRequest) (*QueryAllStampsResponse, error)
-	// Document returns a document by ID
-	Document(context.Context, *QueryDocumentRequest) (*QueryDocumentResponse, error)
-	// DocumentsByStamp returns all documents for a stamp
-	DocumentsByStamp(context.Context, *QueryDocumentsByStampRequest) (*QueryDocumentsByStampResponse, error)
-	// Entity returns an entity by ID
-	Entity(context.Context, *QueryEntityRequest) (*QueryEntityResponse, error)
-	// EntitiesByOwner returns all entities owned by an address
-	EntitiesByOwner(context.Context, *QueryEntitiesByOwnerRequest) (*QueryEntitiesByOwnerResponse, error)
-	// SpecVersion returns a spec version by ID
-	SpecVersion(context.Context, *QuerySpecVersionRequest) (*QuerySpecVersionResponse, error)
-	// SpecVersionsByProject returns all versions for a project
-	SpecVersionsByProject(context.Context, *QuerySpecVersionsByProjectRequest) (*QuerySpecVersionsByProjectResponse, error)
-	// SpecHistory returns the version history starting from a version
-	SpecHistory(context.Context, *QuerySpecHistoryRequest) (*QuerySpecHistoryResponse, error)
+type QueryDocumentResponse struct {
+	Document DocumentStorage `protobuf:"bytes,1,opt,name=document,proto3" json:"document"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryDocumentResponse) Reset()         { *m = QueryDocumentResponse{} }
+func (m *QueryDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDocumentResponse) ProtoMessage()    {}
+func (*QueryDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{27}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Stamp(ctx context.Context, req *QueryStampRequest) (*QueryStampResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stamp not implemented")
+func (m *QueryDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) StampsByPE(ctx context.Context, req *QueryStampsByPERequest) (*QueryStampsByPEResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StampsByPE not implemented")
+func (m *QueryDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDocumentResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) StampsByJurisdiction(ctx context.Context, req *QueryStampsByJurisdictionRequest) (*QueryStampsByJurisdictionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StampsByJurisdiction not implemented")
+func (m *QueryDocumentResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AllStamps(ctx context.Context, req *QueryAllStampsRequest) (*QueryAllStampsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllStamps not implemented")
+func (m *QueryDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDocumentResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Document(ctx context.Context, req *QueryDocumentRequest) (*QueryDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Document not implemented")
+
+var xxx_messageInfo_QueryDocumentResponse proto.InternalMessageInfo
+
+func (m *QueryDocumentResponse) GetDocument() DocumentStorage {
+	if m != nil {
+		return m.Document
+	}
+	return DocumentStorage{}
 }
-func (*UnimplementedQueryServer) DocumentsByStamp(ctx context.Context, req *QueryDocumentsByStampRequest) (*QueryDocumentsByStampResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DocumentsByStamp not implemented")
+
+type QueryDocumentsByStampRequest struct {
+	StampId    string             `protobuf:"bytes,1,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Role       DocumentRole       `protobuf:"varint,3,opt,name=role,proto3,enum=stampledgerchain.stampledgerchain.v1.DocumentRole" json:"role,omitempty"`
 }
-func (*UnimplementedQueryServer) Entity(ctx context.Context, req *QueryEntityRequest) (*QueryEntityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Entity not implemented")
+
+func (m *QueryDocumentsByStampRequest) Reset()         { *m = QueryDocumentsByStampRequest{} }
+func (m *QueryDocumentsByStampRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDocumentsByStampRequest) ProtoMessage()    {}
+func (*QueryDocumentsByStampRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{28}
 }
-func (*UnimplementedQueryServer) EntitiesByOwner(ctx context.Context, req *QueryEntitiesByOwnerRequest) (*QueryEntitiesByOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EntitiesByOwner not implemented")
+func (m *QueryDocumentsByStampRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SpecVersion(ctx context.Context, req *QuerySpecVersionRequest) (*QuerySpecVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpecVersion not implemented")
+func (m *QueryDocumentsByStampRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDocumentsByStampRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) SpecVersionsByProject(ctx context.Context, req *QuerySpecVersionsByProjectRequest) (*QuerySpecVersionsByProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpecVersionsByProject not implemented")
+func (m *QueryDocumentsByStampRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDocumentsByStampRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) SpecHistory(ctx context.Context, req *QuerySpecHistoryRequest) (*QuerySpecHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpecHistory not implemented")
+func (m *QueryDocumentsByStampRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDocumentsByStampRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDocumentsByStampRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryDocumentsByStampRequest proto.InternalMessageInfo
+
+func (m *QueryDocumentsByStampRequest) GetStampId() string {
+	if m != nil {
+		return m.StampId
+	}
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryDocumentsByStampRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Stamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStampRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Stamp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/Stamp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Stamp(ctx, req.(*QueryStampRequest))
+func (m *QueryDocumentsByStampRequest) GetRole() DocumentRole {
+	if m != nil {
+		return m.Role
 	}
-	return interceptor(ctx, in, info, handler)
+	return DocumentRoleUnspecified
 }
 
-func _Query_StampsByPE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStampsByPERequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StampsByPE(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/StampsByPE",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StampsByPE(ctx, req.(*QueryStampsByPERequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryDocumentsByStampResponse struct {
+	Documents  []DocumentStorage   `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_StampsByJurisdiction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStampsByJurisdictionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StampsByJurisdiction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/StampsByJurisdiction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StampsByJurisdiction(ctx, req.(*QueryStampsByJurisdictionRequest))
+func (m *QueryDocumentsByStampResponse) Reset()         { *m = QueryDocumentsByStampResponse{} }
+func (m *QueryDocumentsByStampResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDocumentsByStampResponse) ProtoMessage()    {}
+func (*QueryDocumentsByStampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{29}
+}
+func (m *QueryDocumentsByStampResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDocumentsByStampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDocumentsByStampResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDocumentsByStampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDocumentsByStampResponse.Merge(m, src)
+}
+func (m *QueryDocumentsByStampResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDocumentsByStampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDocumentsByStampResponse.DiscardUnknown(m)
 }
 
-func _Query_AllStamps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllStampsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllStamps(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/AllStamps",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllStamps(ctx, req.(*QueryAllStampsRequest))
+var xxx_messageInfo_QueryDocumentsByStampResponse proto.InternalMessageInfo
+
+func (m *QueryDocumentsByStampResponse) GetDocuments() []DocumentStorage {
+	if m != nil {
+		return m.Documents
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Document_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDocumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Document(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/Document",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Document(ctx, req.(*QueryDocumentRequest))
+func (m *QueryDocumentsByStampResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DocumentsByStamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDocumentsByStampRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DocumentsByStamp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/DocumentsByStamp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DocumentsByStamp(ctx, req.(*QueryDocumentsByStampRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPinAttestationsRequest struct {
+	DocumentId string             `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Entity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEntityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Entity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/Entity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Entity(ctx, req.(*QueryEntityRequest))
+func (m *QueryPinAttestationsRequest) Reset()         { *m = QueryPinAttestationsRequest{} }
+func (m *QueryPinAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPinAttestationsRequest) ProtoMessage()    {}
+func (*QueryPinAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{30}
+}
+func (m *QueryPinAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPinAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPinAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPinAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPinAttestationsRequest.Merge(m, src)
+}
+func (m *QueryPinAttestationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPinAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPinAttestationsRequest.DiscardUnknown(m)
 }
 
-func _Query_EntitiesByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEntitiesByOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EntitiesByOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/EntitiesByOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EntitiesByOwner(ctx, req.(*QueryEntitiesByOwnerRequest))
+var xxx_messageInfo_QueryPinAttestationsRequest proto.InternalMessageInfo
+
+func (m *QueryPinAttestationsRequest) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_SpecVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpecVersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SpecVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/SpecVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SpecVersion(ctx, req.(*QuerySpecVersionRequest))
+func (m *QueryPinAttestationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SpecVersionsByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpecVersionsByProjectRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SpecVersionsByProject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/SpecVersionsByProject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SpecVersionsByProject(ctx, req.(*QuerySpecVersionsByProjectRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPinAttestationsResponse struct {
+	Attestations []PinAttestation    `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_SpecHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpecHistoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SpecHistory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/SpecHistory",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SpecHistory(ctx, req.(*QuerySpecHistoryRequest))
+func (m *QueryPinAttestationsResponse) Reset()         { *m = QueryPinAttestationsResponse{} }
+func (m *QueryPinAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPinAttestationsResponse) ProtoMessage()    {}
+func (*QueryPinAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{31}
+}
+func (m *QueryPinAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPinAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPinAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPinAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPinAttestationsResponse.Merge(m, src)
+}
+func (m *QueryPinAttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPinAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPinAttestationsResponse.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stampledgerchain.stampledgerchain.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Stamp",
-			Handler:    _Query_Stamp_Handler,
-		},
-		{
-			MethodName: "StampsByPE",
-			Handler:    _Query_StampsByPE_Handler,
-		},
-		{
-			MethodName: "StampsByJurisdiction",
-			Handler:    _Query_StampsByJurisdiction_Handler,
-		},
-		{
-			MethodName: "AllStamps",
-			Handler:    _Query_AllStamps_Handler,
-		},
-		{
-			MethodName: "Document",
-			Handler:    _Query_Document_Handler,
-		},
-		{
-			MethodName: "DocumentsByStamp",
-			Handler:    _Query_DocumentsByStamp_Handler,
-		},
-		{
-			MethodName: "Entity",
-			Handler:    _Query_Entity_Handler,
-		},
-		{
-			MethodName: "EntitiesByOwner",
-			Handler:    _Query_EntitiesByOwner_Handler,
-		},
-		{
-			MethodName: "SpecVersion",
-			Handler:    _Query_SpecVersion_Handler,
-		},
-		{
-			MethodName: "SpecVersionsByProject",
-			Handler:    _Query_SpecVersionsByProject_Handler,
-		},
-		{
-			MethodName: "SpecHistory",
-			Handler:    _Query_SpecHistory_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stampledgerchain/stampledgerchain/v1/query.proto",
-}
+var xxx_messageInfo_QueryPinAttestationsResponse proto.InternalMessageInfo
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPinAttestationsResponse) GetAttestations() []PinAttestation {
+	if m != nil {
+		return m.Attestations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPinAttestationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryEntityRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEntityRequest) Reset()         { *m = QueryEntityRequest{} }
+func (m *QueryEntityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEntityRequest) ProtoMessage()    {}
+func (*QueryEntityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{32}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEntityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryEntityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryEntityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntityRequest.Merge(m, src)
+}
+func (m *QueryEntityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStampRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEntityRequest proto.InternalMessageInfo
+
+func (m *QueryEntityRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryStampRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryEntityResponse struct {
+	Entity EntityAccount `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity"`
 }
 
-func (m *QueryStampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryEntityResponse) Reset()         { *m = QueryEntityResponse{} }
+func (m *QueryEntityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEntityResponse) ProtoMessage()    {}
+func (*QueryEntityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{33}
+}
+func (m *QueryEntityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEntityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntityResponse.Merge(m, src)
+}
+func (m *QueryEntityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStampResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEntityResponse proto.InternalMessageInfo
+
+func (m *QueryEntityResponse) GetEntity() EntityAccount {
+	if m != nil {
+		return m.Entity
 	}
-	return dAtA[:n], nil
+	return EntityAccount{}
 }
 
-func (m *QueryStampResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryEntitiesByOwnerRequest struct {
+	OwnerAddress string             `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Stamp.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryEntitiesByOwnerRequest) Reset()         { *m = QueryEntitiesByOwnerRequest{} }
+func (m *QueryEntitiesByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEntitiesByOwnerRequest) ProtoMessage()    {}
+func (*QueryEntitiesByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{34}
+}
+func (m *QueryEntitiesByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntitiesByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntitiesByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryStampsByPERequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEntitiesByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntitiesByOwnerRequest.Merge(m, src)
 }
-
-func (m *QueryStampsByPERequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEntitiesByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntitiesByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntitiesByOwnerRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStampsByPERequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PePublicKey) > 0 {
-		i -= len(m.PePublicKey)
-		copy(dAtA[i:], m.PePublicKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PePublicKey)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryEntitiesByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryEntitiesByOwnerRequest) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryStampsByPEResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEntitiesByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStampsByPEResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryEntitiesByOwnerResponse struct {
+	Entities   []EntityAccount     `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStampsByPEResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEntitiesByOwnerResponse) Reset()         { *m = QueryEntitiesByOwnerResponse{} }
+func (m *QueryEntitiesByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEntitiesByOwnerResponse) ProtoMessage()    {}
+func (*QueryEntitiesByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{35}
+}
+func (m *QueryEntitiesByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntitiesByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntitiesByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Stamps) > 0 {
-		for iNdEx := len(m.Stamps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Stamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryEntitiesByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntitiesByOwnerResponse.Merge(m, src)
+}
+func (m *QueryEntitiesByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntitiesByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntitiesByOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEntitiesByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryEntitiesByOwnerResponse) GetEntities() []EntityAccount {
+	if m != nil {
+		return m.Entities
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryStampsByJurisdictionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEntitiesByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStampsByJurisdictionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryEntitiesByMemberRequest struct {
+	MemberAddress string             `protobuf:"bytes,1,opt,name=member_address,json=memberAddress,proto3" json:"member_address,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStampsByJurisdictionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEntitiesByMemberRequest) Reset()         { *m = QueryEntitiesByMemberRequest{} }
+func (m *QueryEntitiesByMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEntitiesByMemberRequest) ProtoMessage()    {}
+func (*QueryEntitiesByMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{36}
+}
+func (m *QueryEntitiesByMemberRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntitiesByMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntitiesByMemberRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.JurisdictionId) > 0 {
-		i -= len(m.JurisdictionId)
-		copy(dAtA[i:], m.JurisdictionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.JurisdictionId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryEntitiesByMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntitiesByMemberRequest.Merge(m, src)
+}
+func (m *QueryEntitiesByMemberRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntitiesByMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntitiesByMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEntitiesByMemberRequest proto.InternalMessageInfo
+
+func (m *QueryEntitiesByMemberRequest) GetMemberAddress() string {
+	if m != nil {
+		return m.MemberAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryStampsByJurisdictionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEntitiesByMemberRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStampsByJurisdictionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EntityMembership is an entity together with a member's place in it
+type EntityMembership struct {
+	Entity       EntityAccount `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity"`
+	Role         string        `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	JoinedHeight int64         `protobuf:"varint,3,opt,name=joined_height,json=joinedHeight,proto3" json:"joined_height,omitempty"`
 }
 
-func (m *QueryStampsByJurisdictionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Stamps) > 0 {
-		for iNdEx := len(m.Stamps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Stamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *EntityMembership) Reset()         { *m = EntityMembership{} }
+func (m *EntityMembership) String() string { return proto.CompactTextString(m) }
+func (*EntityMembership) ProtoMessage()    {}
+func (*EntityMembership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{37}
+}
+func (m *EntityMembership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntityMembership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntityMembership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EntityMembership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityMembership.Merge(m, src)
+}
+func (m *EntityMembership) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntityMembership) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityMembership.DiscardUnknown(m)
 }
 
-func (m *QueryAllStampsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EntityMembership proto.InternalMessageInfo
+
+func (m *EntityMembership) GetEntity() EntityAccount {
+	if m != nil {
+		return m.Entity
 	}
-	return dAtA[:n], nil
+	return EntityAccount{}
 }
 
-func (m *QueryAllStampsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EntityMembership) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
 }
 
-func (m *QueryAllStampsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *EntityMembership) GetJoinedHeight() int64 {
+	if m != nil {
+		return m.JoinedHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllStampsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryEntitiesByMemberResponse struct {
+	Memberships []EntityMembership  `protobuf:"bytes,1,rep,name=memberships,proto3" json:"memberships"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllStampsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEntitiesByMemberResponse) Reset()         { *m = QueryEntitiesByMemberResponse{} }
+func (m *QueryEntitiesByMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEntitiesByMemberResponse) ProtoMessage()    {}
+func (*QueryEntitiesByMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{38}
 }
-
-func (m *QueryAllStampsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Stamps) > 0 {
-		for iNdEx := len(m.Stamps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Stamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryEntitiesByMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntitiesByMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntitiesByMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEntitiesByMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntitiesByMemberResponse.Merge(m, src)
 }
-
-func (m *QueryDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEntitiesByMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntitiesByMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntitiesByMemberResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryEntitiesByMemberResponse proto.InternalMessageInfo
+
+func (m *QueryEntitiesByMemberResponse) GetMemberships() []EntityMembership {
+	if m != nil {
+		return m.Memberships
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEntitiesByMemberResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingInvitesRequest struct {
+	Invitee    string             `protobuf:"bytes,1,opt,name=invitee,proto3" json:"invitee,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Document.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPendingInvitesRequest) Reset()         { *m = QueryPendingInvitesRequest{} }
+func (m *QueryPendingInvitesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingInvitesRequest) ProtoMessage()    {}
+func (*QueryPendingInvitesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{39}
+}
+func (m *QueryPendingInvitesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingInvitesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingInvitesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingInvitesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingInvitesRequest.Merge(m, src)
+}
+func (m *QueryPendingInvitesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingInvitesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingInvitesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDocumentsByStampRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingInvitesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingInvitesRequest) GetInvitee() string {
+	if m != nil {
+		return m.Invitee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDocumentsByStampRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingInvitesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryDocumentsByStampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryPendingInvitesResponse struct {
+	Invites    []EntityInvite      `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingInvitesResponse) Reset()         { *m = QueryPendingInvitesResponse{} }
+func (m *QueryPendingInvitesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingInvitesResponse) ProtoMessage()    {}
+func (*QueryPendingInvitesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{40}
+}
+func (m *QueryPendingInvitesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingInvitesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingInvitesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.StampId) > 0 {
-		i -= len(m.StampId)
-		copy(dAtA[i:], m.StampId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StampId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryPendingInvitesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingInvitesResponse.Merge(m, src)
+}
+func (m *QueryPendingInvitesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingInvitesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingInvitesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingInvitesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingInvitesResponse) GetInvites() []EntityInvite {
+	if m != nil {
+		return m.Invites
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDocumentsByStampResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingInvitesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDocumentsByStampResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryEntityMembersRequest struct {
+	EntityId   string             `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDocumentsByStampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEntityMembersRequest) Reset()         { *m = QueryEntityMembersRequest{} }
+func (m *QueryEntityMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEntityMembersRequest) ProtoMessage()    {}
+func (*QueryEntityMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{41}
+}
+func (m *QueryEntityMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntityMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntityMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Documents) > 0 {
-		for iNdEx := len(m.Documents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Documents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryEntityMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntityMembersRequest.Merge(m, src)
+}
+func (m *QueryEntityMembersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntityMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntityMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEntityMembersRequest proto.InternalMessageInfo
+
+func (m *QueryEntityMembersRequest) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryEntityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEntityMembersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEntityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryEntityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type QueryEntityMembersResponse struct {
+	Members    []EntityMember      `protobuf:"bytes,1,rep,name=members,proto3" json:"members"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEntityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEntityMembersResponse) Reset()         { *m = QueryEntityMembersResponse{} }
+func (m *QueryEntityMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEntityMembersResponse) ProtoMessage()    {}
+func (*QueryEntityMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{42}
 }
-
-func (m *QueryEntityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEntityMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryEntityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Entity.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryEntityMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntityMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryEntitiesByOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEntityMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntityMembersResponse.Merge(m, src)
 }
-
-func (m *QueryEntitiesByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEntityMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntityMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntityMembersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEntitiesByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryEntityMembersResponse proto.InternalMessageInfo
+
+func (m *QueryEntityMembersResponse) GetMembers() []EntityMember {
+	if m != nil {
+		return m.Members
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryEntitiesByOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEntityMembersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEntitiesByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryMemberFeeUsageRequest struct {
+	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Member   string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
 }
 
-func (m *QueryEntitiesByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Entities) > 0 {
-		for iNdEx := len(m.Entities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMemberFeeUsageRequest) Reset()         { *m = QueryMemberFeeUsageRequest{} }
+func (m *QueryMemberFeeUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMemberFeeUsageRequest) ProtoMessage()    {}
+func (*QueryMemberFeeUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{43}
+}
+func (m *QueryMemberFeeUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemberFeeUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemberFeeUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySpecVersionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMemberFeeUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemberFeeUsageRequest.Merge(m, src)
 }
-
-func (m *QuerySpecVersionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMemberFeeUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemberFeeUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemberFeeUsageRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySpecVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryMemberFeeUsageRequest proto.InternalMessageInfo
+
+func (m *QueryMemberFeeUsageRequest) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QuerySpecVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMemberFeeUsageRequest) GetMember() string {
+	if m != nil {
+		return m.Member
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySpecVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryMemberFeeUsageResponse struct {
+	Usage MemberFeeUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
 }
 
-func (m *QuerySpecVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMemberFeeUsageResponse) Reset()         { *m = QueryMemberFeeUsageResponse{} }
+func (m *QueryMemberFeeUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMemberFeeUsageResponse) ProtoMessage()    {}
+func (*QueryMemberFeeUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{44}
+}
+func (m *QueryMemberFeeUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemberFeeUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemberFeeUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryMemberFeeUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemberFeeUsageResponse.Merge(m, src)
+}
+func (m *QueryMemberFeeUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemberFeeUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemberFeeUsageResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySpecVersionsByProjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMemberFeeUsageResponse proto.InternalMessageInfo
+
+func (m *QueryMemberFeeUsageResponse) GetUsage() MemberFeeUsage {
+	if m != nil {
+		return m.Usage
 	}
-	return dAtA[:n], nil
+	return MemberFeeUsage{}
 }
 
-func (m *QuerySpecVersionsByProjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySpecVersionRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QuerySpecVersionsByProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProjectId) > 0 {
-		i -= len(m.ProjectId)
-		copy(dAtA[i:], m.ProjectId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProjectId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySpecVersionRequest) Reset()         { *m = QuerySpecVersionRequest{} }
+func (m *QuerySpecVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpecVersionRequest) ProtoMessage()    {}
+func (*QuerySpecVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{45}
 }
-
-func (m *QuerySpecVersionsByProjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySpecVersionsByProjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySpecVersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QuerySpecVersionsByProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Versions) > 0 {
-		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySpecVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpecVersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySpecVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpecVersionRequest.Merge(m, src)
+}
+func (m *QuerySpecVersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpecVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpecVersionRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySpecHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpecVersionRequest proto.InternalMessageInfo
+
+func (m *QuerySpecVersionRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySpecHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySpecVersionResponse struct {
+	Version SpecVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version"`
 }
 
-func (m *QuerySpecHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StartingVersionId) > 0 {
-		i -= len(m.StartingVersionId)
-		copy(dAtA[i:], m.StartingVersionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StartingVersionId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySpecVersionResponse) Reset()         { *m = QuerySpecVersionResponse{} }
+func (m *QuerySpecVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpecVersionResponse) ProtoMessage()    {}
+func (*QuerySpecVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{46}
+}
+func (m *QuerySpecVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpecVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpecVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySpecVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpecVersionResponse.Merge(m, src)
+}
+func (m *QuerySpecVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpecVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpecVersionResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySpecHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpecVersionResponse proto.InternalMessageInfo
+
+func (m *QuerySpecVersionResponse) GetVersion() SpecVersion {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return SpecVersion{}
 }
 
-func (m *QuerySpecHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySpecVersionsByProjectRequest struct {
+	ProjectId  string             `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySpecHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.History) > 0 {
-		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySpecVersionsByProjectRequest) Reset()         { *m = QuerySpecVersionsByProjectRequest{} }
+func (m *QuerySpecVersionsByProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpecVersionsByProjectRequest) ProtoMessage()    {}
+func (*QuerySpecVersionsByProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{47}
+}
+func (m *QuerySpecVersionsByProjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpecVersionsByProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpecVersionsByProjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QuerySpecVersionsByProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpecVersionsByProjectRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QuerySpecVersionsByProjectRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QuerySpecVersionsByProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpecVersionsByProjectRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStampRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QuerySpecVersionsByProjectRequest proto.InternalMessageInfo
+
+func (m *QuerySpecVersionsByProjectRequest) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryStampResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySpecVersionsByProjectRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Stamp.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryStampsByPERequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PePublicKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QuerySpecVersionsByProjectResponse struct {
+	Versions   []SpecVersion       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStampsByPEResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Stamps) > 0 {
-		for _, e := range m.Stamps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySpecVersionsByProjectResponse) Reset()         { *m = QuerySpecVersionsByProjectResponse{} }
+func (m *QuerySpecVersionsByProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpecVersionsByProjectResponse) ProtoMessage()    {}
+func (*QuerySpecVersionsByProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{48}
+}
+func (m *QuerySpecVersionsByProjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpecVersionsByProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpecVersionsByProjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QuerySpecVersionsByProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpecVersionsByProjectResponse.Merge(m, src)
+}
+func (m *QuerySpecVersionsByProjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpecVersionsByProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpecVersionsByProjectResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStampsByJurisdictionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JurisdictionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QuerySpecVersionsByProjectResponse proto.InternalMessageInfo
+
+func (m *QuerySpecVersionsByProjectResponse) GetVersions() []SpecVersion {
+	if m != nil {
+		return m.Versions
 	}
-	return n
+	return nil
 }
 
-func (m *QueryStampsByJurisdictionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Stamps) > 0 {
-		for _, e := range m.Stamps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySpecVersionsByProjectResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllStampsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QuerySpecHistoryRequest struct {
+	StartingVersionId string `protobuf:"bytes,1,opt,name=starting_version_id,json=startingVersionId,proto3" json:"starting_version_id,omitempty"`
 }
 
-func (m *QueryAllStampsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Stamps) > 0 {
-		for _, e := range m.Stamps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySpecHistoryRequest) Reset()         { *m = QuerySpecHistoryRequest{} }
+func (m *QuerySpecHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpecHistoryRequest) ProtoMessage()    {}
+func (*QuerySpecHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{49}
+}
+func (m *QuerySpecHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpecHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpecHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
 }
-
-func (m *QueryDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QuerySpecHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpecHistoryRequest.Merge(m, src)
+}
+func (m *QuerySpecHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpecHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpecHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySpecHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySpecHistoryRequest) GetStartingVersionId() string {
+	if m != nil {
+		return m.StartingVersionId
 	}
-	var l int
-	_ = l
-	l = m.Document.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryDocumentsByStampRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StampId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QuerySpecHistoryResponse struct {
+	History []SpecVersion `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
 }
 
-func (m *QueryDocumentsByStampResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Documents) > 0 {
-		for _, e := range m.Documents {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySpecHistoryResponse) Reset()         { *m = QuerySpecHistoryResponse{} }
+func (m *QuerySpecHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpecHistoryResponse) ProtoMessage()    {}
+func (*QuerySpecHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b15ab839ebe314, []int{50}
+}
+func (m *QuerySpecHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpecHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpecHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
 }
-
-func (m *QueryEntityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QuerySpecHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpecHistoryResponse.Merge(m, src)
+}
+func (m *QuerySpecHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpecHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpecHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEntityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySpecHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySpecHistoryResponse) GetHistory() []SpecVersion {
+	if m != nil {
+		return m.History
 	}
-	var l int
-	_ = l
-	l = m.Entity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryEntitiesByOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryStampRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampRequest")
+	proto.RegisterType((*QueryStampResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampResponse")
+	proto.RegisterType((*QueryStampsByPERequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampsByPERequest")
+	proto.RegisterType((*QueryStampsByPEResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampsByPEResponse")
+	proto.RegisterType((*QueryStampsByJurisdictionRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampsByJurisdictionRequest")
+	proto.RegisterType((*QueryStampsByJurisdictionResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampsByJurisdictionResponse")
+	proto.RegisterType((*QueryStampsByDocumentHashRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampsByDocumentHashRequest")
+	proto.RegisterType((*QueryStampsByDocumentHashResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampsByDocumentHashResponse")
+	proto.RegisterType((*QueryStampsByEntityRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampsByEntityRequest")
+	proto.RegisterType((*QueryStampsByEntityResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampsByEntityResponse")
+	proto.RegisterType((*QueryStampBatchRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampBatchRequest")
+	proto.RegisterType((*QueryStampBatchResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampBatchResponse")
+	proto.RegisterType((*QueryVerifyMerkleInclusionRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryVerifyMerkleInclusionRequest")
+	proto.RegisterType((*QueryVerifyMerkleInclusionResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryVerifyMerkleInclusionResponse")
+	proto.RegisterType((*QueryVerifyStampRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryVerifyStampRequest")
+	proto.RegisterType((*QueryVerifyStampResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryVerifyStampResponse")
+	proto.RegisterType((*QueryAllStampsRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryAllStampsRequest")
+	proto.RegisterType((*QueryAllStampsResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryAllStampsResponse")
+	proto.RegisterType((*QueryProfessionalEngineerRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryProfessionalEngineerRequest")
+	proto.RegisterType((*QueryProfessionalEngineerResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryProfessionalEngineerResponse")
+	proto.RegisterType((*QueryStampingDelegationsRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampingDelegationsRequest")
+	proto.RegisterType((*QueryStampingDelegationsResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryStampingDelegationsResponse")
+	proto.RegisterType((*QueryLicenseRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryLicenseRequest")
+	proto.RegisterType((*QueryLicenseResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryLicenseResponse")
+	proto.RegisterType((*QueryDocumentRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryDocumentRequest")
+	proto.RegisterType((*QueryDocumentResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryDocumentResponse")
+	proto.RegisterType((*QueryDocumentsByStampRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryDocumentsByStampRequest")
+	proto.RegisterType((*QueryDocumentsByStampResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryDocumentsByStampResponse")
+	proto.RegisterType((*QueryPinAttestationsRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryPinAttestationsRequest")
+	proto.RegisterType((*QueryPinAttestationsResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryPinAttestationsResponse")
+	proto.RegisterType((*QueryEntityRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryEntityRequest")
+	proto.RegisterType((*QueryEntityResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryEntityResponse")
+	proto.RegisterType((*QueryEntitiesByOwnerRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryEntitiesByOwnerRequest")
+	proto.RegisterType((*QueryEntitiesByOwnerResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryEntitiesByOwnerResponse")
+	proto.RegisterType((*QueryEntitiesByMemberRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryEntitiesByMemberRequest")
+	proto.RegisterType((*EntityMembership)(nil), "stampledgerchain.stampledgerchain.v1.EntityMembership")
+	proto.RegisterType((*QueryEntitiesByMemberResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryEntitiesByMemberResponse")
+	proto.RegisterType((*QueryPendingInvitesRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryPendingInvitesRequest")
+	proto.RegisterType((*QueryPendingInvitesResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryPendingInvitesResponse")
+	proto.RegisterType((*QueryEntityMembersRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryEntityMembersRequest")
+	proto.RegisterType((*QueryEntityMembersResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryEntityMembersResponse")
+	proto.RegisterType((*QueryMemberFeeUsageRequest)(nil), "stampledgerchain.stampledgerchain.v1.QueryMemberFeeUsageRequest")
+	proto.RegisterType((*QueryMemberFeeUsageResponse)(nil), "stampledgerchain.stampledgerchain.v1.QueryMemberFeeUsageResponse")
+	proto.RegisterType((*QuerySpecVersionRequest)(nil), "stampledgerchain.stampledgerchain.v1.QuerySpecVersionRequest")
+	proto.RegisterType((*QuerySpecVersionResponse)(nil), "stampledgerchain.stampledgerchain.v1.QuerySpecVersionResponse")
+	proto.RegisterType((*QuerySpecVersionsByProjectRequest)(nil), "stampledgerchain.stampledgerchain.v1.QuerySpecVersionsByProjectRequest")
+	proto.RegisterType((*QuerySpecVersionsByProjectResponse)(nil), "stampledgerchain.stampledgerchain.v1.QuerySpecVersionsByProjectResponse")
+	proto.RegisterType((*QuerySpecHistoryRequest)(nil), "stampledgerchain.stampledgerchain.v1.QuerySpecHistoryRequest")
+	proto.RegisterType((*QuerySpecHistoryResponse)(nil), "stampledgerchain.stampledgerchain.v1.QuerySpecHistoryResponse")
+}
+
+func init() {
+	proto.RegisterFile("stampledgerchain/stampledgerchain/v1/query.proto", fileDescriptor_84b15ab839ebe314)
+}
+
+var fileDescriptor_84b15ab839ebe314 = []byte{
+	// 2427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x5d, 0x6c, 0x1c, 0x57,
+	0xf5, 0xcf, 0x75, 0xe2, 0xaf, 0xe3, 0xd8, 0x69, 0x6e, 0x9c, 0xfe, 0xdd, 0x6d, 0xe3, 0xa4, 0x93,
+	0x7e, 0xfd, 0x53, 0xec, 0xa9, 0x9d, 0x34, 0x5f, 0x4d, 0xd3, 0x78, 0xeb, 0xcf, 0x92, 0x8f, 0xcd,
+	0x9a, 0x06, 0x15, 0x09, 0x96, 0xf1, 0xee, 0xf5, 0xee, 0x24, 0xbb, 0x33, 0xd3, 0x9d, 0x59, 0x53,
+	0x6b, 0xb5, 0x08, 0x0a, 0xe2, 0x01, 0x09, 0x01, 0xaa, 0xc4, 0x03, 0x0f, 0x3c, 0xf3, 0x00, 0x12,
+	0x48, 0x20, 0x54, 0x24, 0x90, 0x80, 0x07, 0x02, 0x52, 0x50, 0xa5, 0x3e, 0x80, 0x84, 0x28, 0x28,
+	0x41, 0x44, 0x02, 0x1e, 0x78, 0xe3, 0x01, 0x90, 0xd0, 0xdc, 0x7b, 0xee, 0xee, 0xcc, 0xec, 0xd8,
+	0x99, 0x3b, 0xbb, 0x45, 0x79, 0x89, 0xbc, 0x67, 0xe6, 0x9e, 0xfb, 0x3b, 0xbf, 0x73, 0xe7, 0xdc,
+	0xf3, 0xa1, 0xc0, 0x0b, 0xae, 0x67, 0xd4, 0x9c, 0x2a, 0x2b, 0x95, 0x59, 0xbd, 0x58, 0x31, 0x4c,
+	0x4b, 0xef, 0x12, 0x6c, 0xcd, 0xe9, 0x6f, 0x36, 0x58, 0x7d, 0x7b, 0xd6, 0xa9, 0xdb, 0x9e, 0x4d,
+	0x9f, 0x8a, 0xbe, 0x30, 0xdb, 0x25, 0xd8, 0x9a, 0xcb, 0x1c, 0x34, 0x6a, 0xa6, 0x65, 0xeb, 0xfc,
+	0x5f, 0xb1, 0x30, 0x33, 0x59, 0xb6, 0xcb, 0x36, 0xff, 0x53, 0xf7, 0xff, 0x42, 0xe9, 0x13, 0x65,
+	0xdb, 0x2e, 0x57, 0x99, 0x6e, 0x38, 0xa6, 0x6e, 0x58, 0x96, 0xed, 0x19, 0x9e, 0x69, 0x5b, 0x2e,
+	0x3e, 0x3d, 0x51, 0xb4, 0xdd, 0x9a, 0xed, 0xea, 0x1b, 0x86, 0xcb, 0x04, 0x0a, 0x7d, 0x6b, 0x6e,
+	0x83, 0x79, 0xc6, 0x9c, 0xee, 0x18, 0x65, 0xd3, 0xe2, 0x2f, 0xe3, 0xbb, 0x73, 0x89, 0x4c, 0x71,
+	0x8c, 0xba, 0x51, 0x93, 0xea, 0x93, 0x59, 0xcf, 0x65, 0x62, 0x85, 0x36, 0x09, 0xf4, 0xba, 0x0f,
+	0x23, 0xc7, 0xd5, 0xe4, 0xd9, 0x9b, 0x0d, 0xe6, 0x7a, 0xda, 0x26, 0x1c, 0x0a, 0x49, 0x5d, 0xc7,
+	0xb6, 0x5c, 0x46, 0xaf, 0xc1, 0x90, 0xd8, 0x6e, 0x8a, 0x1c, 0x23, 0xcf, 0x8d, 0xcd, 0x7f, 0x64,
+	0x36, 0x09, 0x77, 0xb3, 0x42, 0x4b, 0x76, 0xf4, 0xf6, 0x07, 0x47, 0xf7, 0x7c, 0xfb, 0xfe, 0xf7,
+	0x4e, 0x90, 0x3c, 0xaa, 0xd1, 0x8e, 0xc3, 0x41, 0xbe, 0xcf, 0xba, 0xbf, 0x0a, 0x37, 0xa7, 0x13,
+	0x30, 0x60, 0x96, 0xf8, 0x0e, 0xa3, 0xf9, 0x01, 0xb3, 0xa4, 0x7d, 0x12, 0x21, 0xe2, 0x4b, 0x88,
+	0x65, 0x05, 0x06, 0xf9, 0x5e, 0x08, 0xe5, 0xf9, 0x64, 0x50, 0xb8, 0x8e, 0xec, 0x3e, 0x1f, 0x49,
+	0x5e, 0xac, 0xd7, 0xbe, 0x48, 0xe0, 0xd1, 0x8e, 0x7e, 0x37, 0xbb, 0x9d, 0x5b, 0x92, 0x48, 0x34,
+	0x18, 0x77, 0x58, 0xc1, 0x69, 0x6c, 0x54, 0xcd, 0x62, 0xe1, 0x16, 0xdb, 0x46, 0x50, 0x63, 0x0e,
+	0xcb, 0x71, 0xd9, 0x47, 0xd9, 0x36, 0x5d, 0x06, 0xe8, 0x78, 0x6e, 0x6a, 0x80, 0x83, 0x79, 0x66,
+	0x56, 0xb8, 0x79, 0xd6, 0x77, 0xf3, 0xac, 0x38, 0x6c, 0xe8, 0xe6, 0xd9, 0x9c, 0x51, 0x66, 0xa8,
+	0x3f, 0x1f, 0x58, 0xa9, 0x7d, 0x97, 0xc0, 0xff, 0x75, 0xc1, 0x40, 0x5b, 0xd7, 0x60, 0x88, 0x63,
+	0xf5, 0x79, 0xdf, 0x9b, 0xce, 0x58, 0x54, 0x40, 0x57, 0x62, 0xe0, 0x3e, 0xfb, 0x40, 0xb8, 0x02,
+	0x47, 0x08, 0xef, 0x3b, 0x04, 0x8e, 0x85, 0xf0, 0xbe, 0xd6, 0xa8, 0x9b, 0x6e, 0xc9, 0x2c, 0xfa,
+	0x4f, 0x25, 0x81, 0xcf, 0xc2, 0x81, 0x9b, 0x01, 0x71, 0xa1, 0xed, 0xd7, 0x89, 0xa0, 0x78, 0xad,
+	0xd4, 0x37, 0x16, 0x7f, 0x44, 0xe0, 0xc9, 0x5d, 0x50, 0x3d, 0xc4, 0x7c, 0x7e, 0x35, 0xca, 0xe7,
+	0xa2, 0x5d, 0x6c, 0xd4, 0x98, 0xe5, 0xad, 0x1a, 0x6e, 0x45, 0xf2, 0x79, 0x1c, 0xc6, 0x4b, 0x28,
+	0x2e, 0x54, 0x0c, 0xb7, 0x82, 0x6c, 0xee, 0x2f, 0x05, 0xde, 0xfd, 0xf0, 0xb8, 0x0c, 0x23, 0x7a,
+	0x88, 0xb9, 0xfc, 0x3c, 0x81, 0x4c, 0x08, 0xf9, 0x92, 0xe5, 0x99, 0xde, 0xb6, 0x64, 0xf1, 0x71,
+	0x18, 0x65, 0x5c, 0xd0, 0x39, 0x8f, 0x23, 0x42, 0xd0, 0xc7, 0x93, 0xf8, 0x7d, 0x02, 0x8f, 0xc7,
+	0x62, 0x78, 0x88, 0x79, 0x73, 0x82, 0x91, 0x30, 0x6b, 0x78, 0xc5, 0xca, 0x0e, 0x31, 0xb9, 0x6f,
+	0x2c, 0xfd, 0x2b, 0x14, 0xf5, 0x70, 0x4b, 0x64, 0xe8, 0x32, 0x0c, 0x6e, 0xf8, 0x02, 0x8c, 0xf0,
+	0x2f, 0xa8, 0x10, 0xe4, 0xaf, 0x93, 0x61, 0x9e, 0x2b, 0x09, 0xf0, 0x3d, 0xd0, 0x5f, 0xbe, 0xf7,
+	0xa6, 0xe7, 0xfb, 0x1b, 0xf2, 0x0b, 0xbb, 0xc1, 0xea, 0xe6, 0xe6, 0xf6, 0x15, 0x56, 0xbf, 0x55,
+	0x65, 0x6b, 0x56, 0xb1, 0xda, 0x70, 0x03, 0x41, 0xf4, 0x28, 0x8c, 0xd5, 0xf8, 0x93, 0x42, 0xdd,
+	0xb6, 0x3d, 0x74, 0x02, 0x08, 0x51, 0xde, 0xb6, 0xf9, 0x79, 0xae, 0x32, 0x63, 0x53, 0x44, 0x84,
+	0x01, 0x71, 0x9e, 0x7d, 0x01, 0x8f, 0x06, 0x47, 0x00, 0xf8, 0x43, 0xd3, 0x2a, 0xb1, 0xb7, 0x38,
+	0xd8, 0x7d, 0x79, 0xfe, 0xfa, 0x9a, 0x2f, 0xa0, 0x93, 0x30, 0xe8, 0xd4, 0x6d, 0x7b, 0x73, 0x6a,
+	0xdf, 0xb1, 0xbd, 0xcf, 0x8d, 0xe6, 0xc5, 0x0f, 0xed, 0xeb, 0x04, 0xb4, 0xdd, 0x80, 0xa1, 0x87,
+	0x6e, 0xc1, 0xfe, 0x2d, 0xff, 0x05, 0xb3, 0x28, 0xa8, 0x10, 0x8e, 0x5a, 0x48, 0xc6, 0x6c, 0x44,
+	0xe9, 0x8d, 0x80, 0x22, 0xe4, 0x3b, 0xa4, 0x5c, 0xfb, 0x7f, 0x3c, 0x29, 0x02, 0xd2, 0xae, 0x19,
+	0x43, 0x0b, 0xa6, 0xba, 0x5f, 0x45, 0xcc, 0x46, 0x2c, 0xe6, 0x33, 0x0a, 0xa7, 0xe1, 0x81, 0x48,
+	0x0b, 0x70, 0x98, 0x6f, 0xbf, 0x50, 0xad, 0x8a, 0x8f, 0x5f, 0xe2, 0x0c, 0x7f, 0x35, 0x24, 0xf5,
+	0x57, 0xf3, 0x1d, 0x99, 0xb2, 0x04, 0x76, 0x78, 0x88, 0xc3, 0xca, 0x02, 0xde, 0x6c, 0xb9, 0xba,
+	0xbd, 0xc9, 0x5c, 0xdf, 0xd9, 0x46, 0x75, 0xc9, 0x2a, 0x9b, 0x16, 0x63, 0x75, 0x49, 0xcd, 0x11,
+	0x80, 0xae, 0x3c, 0x6b, 0xd4, 0x91, 0x59, 0x96, 0xf6, 0x4d, 0xf9, 0xa5, 0xc4, 0xeb, 0x40, 0xe3,
+	0x1b, 0x70, 0xd8, 0x09, 0x3c, 0x2f, 0x30, 0x7c, 0x01, 0xa9, 0x3e, 0x9f, 0x30, 0x5d, 0x8d, 0xd9,
+	0x02, 0xa9, 0x99, 0x74, 0x62, 0x9e, 0x69, 0x5f, 0x20, 0x70, 0xb4, 0x13, 0xc4, 0x4c, 0xab, 0xbc,
+	0xc8, 0xaa, 0xac, 0x2c, 0xf2, 0x7e, 0x69, 0xdf, 0x14, 0x0c, 0x97, 0xeb, 0x86, 0xe5, 0x21, 0x98,
+	0xd1, 0xbc, 0xfc, 0xd9, 0xb7, 0x50, 0x7a, 0x27, 0x94, 0x40, 0x44, 0x51, 0x20, 0x43, 0x9f, 0x86,
+	0xb1, 0x52, 0x47, 0x8c, 0x67, 0xe4, 0xac, 0xc2, 0x19, 0x09, 0xe9, 0x45, 0x56, 0x82, 0x2a, 0xfb,
+	0x77, 0x6a, 0x18, 0xd6, 0x20, 0x97, 0xcd, 0x22, 0xf3, 0x9f, 0xa9, 0xa6, 0x94, 0x4f, 0xc3, 0x44,
+	0x55, 0x2c, 0x2d, 0x58, 0x8d, 0xda, 0x06, 0xab, 0x63, 0x68, 0x1c, 0x47, 0xe9, 0x55, 0x2e, 0xd4,
+	0x18, 0x4c, 0x86, 0xb7, 0x41, 0xa6, 0xae, 0xc0, 0x30, 0xbe, 0x88, 0xa7, 0x67, 0x26, 0x19, 0x4b,
+	0xa8, 0x07, 0xa9, 0x91, 0x3a, 0xb4, 0x67, 0x70, 0x1b, 0x99, 0x43, 0xed, 0x14, 0xba, 0x1c, 0x8c,
+	0x1d, 0x9d, 0xf7, 0x10, 0xcf, 0xc7, 0x61, 0x44, 0x66, 0x79, 0x08, 0xe8, 0xc5, 0x64, 0x80, 0xa4,
+	0xa6, 0x75, 0xcf, 0xae, 0x1b, 0x65, 0x09, 0xac, 0xad, 0x4c, 0xfb, 0x15, 0x81, 0x27, 0x42, 0x5b,
+	0xba, 0xd9, 0x70, 0x74, 0x7d, 0x0c, 0x46, 0xb8, 0xde, 0x0e, 0xd5, 0xc3, 0xfc, 0x77, 0xff, 0x92,
+	0x25, 0xba, 0x0c, 0xfb, 0xea, 0x76, 0x95, 0xf1, 0xeb, 0x69, 0x62, 0x7e, 0x5e, 0xcd, 0xb0, 0xbc,
+	0x5d, 0x65, 0x79, 0xbe, 0x5e, 0xfb, 0x05, 0x81, 0x23, 0x3b, 0xd8, 0x82, 0x34, 0xbe, 0x01, 0xa3,
+	0xd2, 0x72, 0x79, 0xfc, 0x7b, 0xe2, 0xb1, 0xa3, 0xad, 0x7f, 0x27, 0xff, 0x4b, 0x32, 0x75, 0xcc,
+	0x99, 0xd6, 0x82, 0xe7, 0x31, 0xd7, 0x0b, 0xc7, 0x92, 0xa3, 0x30, 0xd6, 0xae, 0x02, 0xda, 0x3e,
+	0x01, 0x29, 0xea, 0x63, 0x0e, 0xfb, 0x4b, 0x79, 0x34, 0xba, 0x80, 0x20, 0x9b, 0x9f, 0x82, 0xfd,
+	0x46, 0x40, 0x8e, 0x84, 0x9e, 0x4a, 0x18, 0x67, 0x43, 0x4a, 0xe5, 0x4d, 0x1a, 0xd4, 0xd7, 0x3f,
+	0x4a, 0x9f, 0xc2, 0x1e, 0x42, 0xb8, 0x10, 0x88, 0x7e, 0x7c, 0x15, 0x0c, 0x39, 0x91, 0x54, 0xfd,
+	0x3a, 0x0c, 0x89, 0xf2, 0x00, 0x3f, 0xbc, 0x93, 0xc9, 0xec, 0x13, 0x5a, 0x16, 0x8a, 0x45, 0xbb,
+	0x61, 0x79, 0xf2, 0x6e, 0x15, 0x8a, 0xb4, 0x2f, 0x4b, 0x17, 0xf3, 0x97, 0x4c, 0xe6, 0x66, 0xb7,
+	0xaf, 0x7d, 0xc6, 0xea, 0x5c, 0x87, 0xc7, 0x61, 0xdc, 0xf6, 0x7f, 0x17, 0x8c, 0x52, 0xa9, 0xce,
+	0x5c, 0x57, 0x16, 0x7a, 0x5c, 0xb8, 0x20, 0x64, 0x7d, 0x73, 0xf3, 0x4f, 0xa5, 0x9b, 0xbb, 0xc0,
+	0x20, 0x01, 0xaf, 0x83, 0xa8, 0x8f, 0x4c, 0x26, 0x5d, 0xdc, 0x03, 0x05, 0x6d, 0x55, 0xfd, 0xf3,
+	0xee, 0x57, 0xba, 0x0d, 0xb8, 0xc2, 0xfc, 0xe8, 0x2e, 0xe9, 0x7c, 0x1a, 0x26, 0x6a, 0x5c, 0x10,
+	0xe1, 0x73, 0x5c, 0x48, 0xfb, 0x4d, 0xe8, 0xb7, 0x08, 0x3c, 0x22, 0x4c, 0x17, 0x30, 0xdc, 0x8a,
+	0xe9, 0x7c, 0x08, 0xa7, 0x88, 0x52, 0x0c, 0x9b, 0xe2, 0x62, 0xe3, 0x7f, 0xfb, 0x27, 0xe7, 0xa6,
+	0x6d, 0x5a, 0xac, 0x54, 0xa8, 0x30, 0xb3, 0x5c, 0xf1, 0x78, 0x4c, 0xdd, 0x9b, 0xdf, 0x2f, 0x84,
+	0xab, 0x5c, 0xa6, 0xdd, 0x96, 0x71, 0xb2, 0x9b, 0xb0, 0xf6, 0x97, 0x3d, 0x56, 0x6b, 0x63, 0x97,
+	0x5e, 0x3f, 0xad, 0x02, 0xb9, 0x63, 0xba, 0x4c, 0x13, 0x02, 0x0a, 0xfb, 0xe7, 0xfb, 0xcf, 0x62,
+	0xa9, 0x9f, 0x63, 0x56, 0xc9, 0xb4, 0xca, 0x6b, 0xd6, 0x96, 0xe9, 0xb1, 0x60, 0xda, 0x65, 0x72,
+	0x09, 0x93, 0x57, 0x17, 0xfe, 0xec, 0x9b, 0xaf, 0x7f, 0xdc, 0x0e, 0xd6, 0x11, 0x00, 0x48, 0x64,
+	0x5e, 0x22, 0x90, 0x24, 0xce, 0xab, 0x90, 0x28, 0xb4, 0xc9, 0x64, 0x02, 0x15, 0xf5, 0x8f, 0xbc,
+	0xcf, 0x11, 0x78, 0x2c, 0x10, 0xf1, 0xd0, 0x65, 0xff, 0xd3, 0x3e, 0xc9, 0xbb, 0xb2, 0x57, 0x13,
+	0x81, 0xd0, 0xa1, 0x0f, 0x8f, 0x4d, 0x1a, 0xfa, 0x84, 0x36, 0x49, 0x1f, 0x2a, 0xea, 0x1f, 0x7d,
+	0xd7, 0x11, 0xba, 0xd8, 0x66, 0x99, 0xb1, 0xd7, 0xdd, 0x8e, 0x95, 0xbb, 0xd3, 0xf7, 0x28, 0x0c,
+	0x09, 0x38, 0xf8, 0xf1, 0xe2, 0x2f, 0xcd, 0xc6, 0xd3, 0x14, 0x55, 0x89, 0x74, 0xe4, 0x60, 0xb0,
+	0xe1, 0x0b, 0x30, 0x86, 0x9c, 0x4a, 0x5a, 0x6a, 0x07, 0x95, 0xc9, 0xbe, 0x08, 0x57, 0xd4, 0x2e,
+	0xab, 0xd7, 0x1d, 0x56, 0xbc, 0xc1, 0xea, 0xc1, 0xc6, 0x43, 0xf4, 0x7a, 0xac, 0x61, 0x59, 0x1d,
+	0x7a, 0xb5, 0x7d, 0x47, 0x0e, 0x6f, 0x09, 0x11, 0x42, 0x9b, 0x4b, 0x58, 0x54, 0x74, 0x74, 0x49,
+	0x37, 0xa1, 0x1e, 0xff, 0x8e, 0x7c, 0x32, 0xba, 0x9f, 0x9b, 0xf5, 0x2b, 0xc0, 0x9b, 0xac, 0xe8,
+	0x05, 0x0b, 0x47, 0x21, 0xe9, 0xd0, 0x3c, 0x8a, 0x92, 0x3e, 0x1e, 0xd3, 0x9f, 0xcb, 0x8e, 0xc8,
+	0x0e, 0x60, 0x90, 0x86, 0x75, 0x18, 0x41, 0xf8, 0xf2, 0xbc, 0xa6, 0xe6, 0xa1, 0xad, 0xa8, 0x7f,
+	0xe7, 0x75, 0x2d, 0xe0, 0xeb, 0x55, 0xd3, 0xf5, 0xec, 0x7a, 0x3b, 0x15, 0x9a, 0x85, 0x43, 0xae,
+	0x67, 0xd4, 0x3d, 0xd3, 0x2a, 0x17, 0x70, 0xe3, 0x0e, 0x9f, 0x07, 0xe5, 0x23, 0x44, 0xb8, 0x16,
+	0x3e, 0x0b, 0x6d, 0x55, 0x9d, 0xb3, 0x50, 0x11, 0xa2, 0x5e, 0x39, 0x90, 0x7a, 0xe6, 0xef, 0x3c,
+	0x0f, 0x83, 0x7c, 0x3f, 0xfa, 0x03, 0x02, 0x43, 0x62, 0xa0, 0x44, 0x13, 0xd6, 0xad, 0xdd, 0xf3,
+	0xad, 0xcc, 0xb9, 0x14, 0x2b, 0x85, 0x71, 0xda, 0x8b, 0x6f, 0xbf, 0xff, 0xe7, 0x77, 0x06, 0x74,
+	0x3a, 0x13, 0x1c, 0xad, 0xcd, 0x3c, 0x68, 0x3e, 0x47, 0x7f, 0x48, 0x60, 0x90, 0x57, 0x22, 0xf4,
+	0x8c, 0xc2, 0xde, 0xc1, 0x3a, 0x2c, 0x73, 0x56, 0x7d, 0x21, 0x62, 0x3e, 0xc7, 0x31, 0x9f, 0xa4,
+	0x73, 0x09, 0x31, 0x73, 0x99, 0xde, 0x34, 0x4b, 0x2d, 0xfa, 0x3e, 0x01, 0xe8, 0x4c, 0xa4, 0xe8,
+	0x05, 0x55, 0x0c, 0xc1, 0x79, 0x5a, 0xe6, 0xe5, 0x94, 0xab, 0xd1, 0x8c, 0x55, 0x6e, 0x46, 0x96,
+	0x5e, 0x52, 0x31, 0xc3, 0xd5, 0x1d, 0xa6, 0x37, 0x43, 0x63, 0xbc, 0x16, 0xfd, 0x0f, 0x81, 0xc9,
+	0xb8, 0x09, 0x11, 0x5d, 0x4e, 0x81, 0x30, 0x66, 0xf0, 0x95, 0x59, 0xe9, 0x59, 0x0f, 0xda, 0xfc,
+	0x31, 0x6e, 0xf3, 0x55, 0x7a, 0x59, 0xcd, 0xe6, 0x60, 0x2f, 0x44, 0x6f, 0x46, 0x1a, 0x26, 0x2d,
+	0xfa, 0xcf, 0x80, 0xfd, 0x8b, 0xa1, 0xd9, 0x51, 0x0a, 0xdc, 0x31, 0x83, 0xaa, 0x54, 0xf6, 0xc7,
+	0x8d, 0x97, 0xb4, 0xab, 0xdc, 0xfe, 0x55, 0xba, 0xac, 0x66, 0xbf, 0x2c, 0x86, 0xf5, 0x66, 0x68,
+	0x5e, 0xd6, 0xa2, 0x7f, 0x24, 0x30, 0x11, 0x9e, 0xc8, 0xd0, 0x4b, 0x29, 0xb0, 0x86, 0xea, 0xc8,
+	0xcc, 0x42, 0x0f, 0x1a, 0x7a, 0x3b, 0xdb, 0x22, 0x9f, 0xd0, 0x9b, 0xed, 0x44, 0xa3, 0x45, 0x7f,
+	0x2d, 0xbf, 0x58, 0x3e, 0x04, 0x51, 0xff, 0x62, 0x83, 0x73, 0x1f, 0xf5, 0x2f, 0x36, 0x34, 0xc2,
+	0xd1, 0x5e, 0xe1, 0x56, 0x9d, 0xa3, 0x67, 0x54, 0xac, 0x9a, 0xe1, 0x03, 0x1b, 0x11, 0x7e, 0xde,
+	0x1e, 0x80, 0xc3, 0xb1, 0x33, 0x08, 0xaa, 0x72, 0xc2, 0x76, 0x1b, 0xaf, 0x64, 0x56, 0x7b, 0x57,
+	0x84, 0xd6, 0xde, 0xe0, 0xd6, 0xe6, 0xe8, 0xd5, 0x84, 0xd6, 0x8a, 0x11, 0x8e, 0xde, 0x0c, 0x4c,
+	0x77, 0x5a, 0x3a, 0x9f, 0x24, 0x6c, 0xeb, 0xcd, 0xf6, 0x44, 0xa7, 0x45, 0x7f, 0x43, 0x60, 0x2c,
+	0x30, 0xca, 0xa0, 0x2f, 0x2b, 0x23, 0x0e, 0xdd, 0x23, 0x17, 0xd3, 0x2e, 0x47, 0x33, 0x2f, 0x71,
+	0x33, 0xcf, 0xd3, 0xb3, 0xca, 0xb7, 0x09, 0x1a, 0x47, 0x7f, 0x42, 0x60, 0xb4, 0x3d, 0xba, 0xa0,
+	0x2f, 0x29, 0xe0, 0x89, 0x8e, 0x54, 0x32, 0x17, 0xd2, 0x2d, 0x4e, 0x79, 0x99, 0xe3, 0x64, 0xe4,
+	0x3e, 0x81, 0xc9, 0xb8, 0x29, 0x81, 0x52, 0xf8, 0xdc, 0x65, 0x1a, 0xa2, 0x14, 0x3e, 0x77, 0x9b,
+	0x88, 0x68, 0x17, 0xb9, 0x81, 0x67, 0xe9, 0xe9, 0xa4, 0xd9, 0x8a, 0x7f, 0x57, 0x06, 0x2e, 0xca,
+	0xbf, 0x12, 0x38, 0x14, 0x33, 0x4f, 0xa0, 0x4b, 0xaa, 0x71, 0x21, 0x76, 0x2a, 0x92, 0x59, 0xee,
+	0x55, 0x0d, 0x9a, 0xb9, 0xc8, 0xcd, 0xbc, 0x48, 0x2f, 0x24, 0x34, 0x33, 0x30, 0xb0, 0xd0, 0x9b,
+	0x38, 0x88, 0x69, 0xd1, 0xdf, 0x13, 0x18, 0xc6, 0xf6, 0x3d, 0x55, 0xc9, 0x10, 0xc3, 0x13, 0x8a,
+	0xcc, 0xf9, 0x34, 0x4b, 0xd1, 0x90, 0x37, 0xb8, 0x21, 0xeb, 0xf4, 0x7a, 0x42, 0x43, 0x70, 0xbc,
+	0xd0, 0x7d, 0xc5, 0xeb, 0xcd, 0xf0, 0xf0, 0xa3, 0x45, 0x7f, 0x46, 0x60, 0x44, 0x5e, 0xb1, 0x54,
+	0x05, 0x63, 0x64, 0x64, 0x91, 0x79, 0x29, 0xd5, 0x5a, 0x34, 0xf0, 0x02, 0x37, 0xf0, 0x34, 0x3d,
+	0x95, 0xd4, 0x53, 0xed, 0x8b, 0xdc, 0xbf, 0x0e, 0xfe, 0x42, 0xe0, 0x91, 0x68, 0x6b, 0x9f, 0x66,
+	0x53, 0xe0, 0x89, 0xcc, 0x38, 0x32, 0xaf, 0xf6, 0xa4, 0x03, 0x6d, 0x5b, 0xe3, 0xb6, 0xbd, 0x4a,
+	0x17, 0x14, 0x6d, 0x73, 0x65, 0x88, 0x94, 0x63, 0x96, 0x16, 0xfd, 0x07, 0x81, 0x03, 0x91, 0xa6,
+	0x3b, 0x55, 0xc9, 0x32, 0xe2, 0x27, 0x07, 0x99, 0x6c, 0x2f, 0x2a, 0x52, 0xde, 0x72, 0x31, 0xa9,
+	0x98, 0x7f, 0x3e, 0x1d, 0xd3, 0x9a, 0x09, 0xf5, 0xfa, 0xdf, 0x25, 0x30, 0x84, 0x19, 0x99, 0x4a,
+	0xa5, 0x13, 0xce, 0xc4, 0xce, 0xa5, 0x58, 0x89, 0x76, 0x9d, 0xe7, 0x76, 0x9d, 0xa2, 0xf3, 0x09,
+	0xed, 0x92, 0xa9, 0x97, 0xef, 0xae, 0xfb, 0x04, 0x0e, 0x44, 0x9a, 0xe7, 0x4a, 0xee, 0x8a, 0x9f,
+	0x02, 0x28, 0xb9, 0x6b, 0x87, 0xde, 0xbd, 0x76, 0x85, 0x9b, 0xb5, 0x42, 0x97, 0x54, 0xcc, 0x32,
+	0x99, 0xab, 0xf3, 0x51, 0x83, 0xde, 0x0c, 0x8d, 0x21, 0x5a, 0xf4, 0xef, 0xb2, 0xb5, 0x1d, 0x68,
+	0x1a, 0xd3, 0x74, 0x38, 0x43, 0x2d, 0x7a, 0xa5, 0x2f, 0x70, 0xa7, 0xae, 0xb5, 0x76, 0x8d, 0x1b,
+	0xbb, 0x46, 0x57, 0x54, 0x8d, 0x15, 0xdd, 0x37, 0x3f, 0x15, 0x0b, 0x4e, 0x09, 0x5a, 0xf4, 0xb7,
+	0x04, 0x26, 0xc2, 0x8d, 0x5d, 0xa5, 0x72, 0x21, 0xb6, 0x29, 0xad, 0x54, 0x2e, 0xc4, 0x77, 0x95,
+	0x95, 0x73, 0x30, 0xec, 0x1c, 0xeb, 0x4d, 0x6c, 0x7f, 0xb7, 0xe8, 0x1f, 0x08, 0x8c, 0x87, 0x5a,
+	0xae, 0xf4, 0x15, 0xe5, 0x6f, 0x27, 0xdc, 0x2f, 0xce, 0x5c, 0x4a, 0xaf, 0x20, 0x65, 0x04, 0xed,
+	0x2e, 0x7f, 0x74, 0xd9, 0xe4, 0xfd, 0x1b, 0x81, 0x89, 0x70, 0xdf, 0x53, 0xc9, 0x73, 0xb1, 0x2d,
+	0x5d, 0x25, 0xcf, 0xc5, 0x77, 0x70, 0x95, 0x0b, 0xfa, 0x18, 0x13, 0x37, 0x19, 0x9b, 0xe1, 0xcd,
+	0x5b, 0x79, 0x5e, 0x5b, 0xf4, 0x0e, 0x81, 0xb1, 0x40, 0xfb, 0x4c, 0xa9, 0x44, 0xe8, 0xee, 0xfc,
+	0x2a, 0x95, 0x08, 0x31, 0xdd, 0x60, 0xf5, 0xba, 0xcf, 0x61, 0x45, 0xec, 0x3a, 0x8a, 0x80, 0xfa,
+	0x6f, 0x02, 0x87, 0x63, 0x3b, 0xad, 0x4a, 0x75, 0xdf, 0x6e, 0x8d, 0x63, 0xa5, 0xba, 0x6f, 0xd7,
+	0xa6, 0xaf, 0x96, 0xe3, 0xd6, 0xbe, 0x46, 0x57, 0xd5, 0xad, 0x75, 0x75, 0x6c, 0x55, 0xeb, 0xcd,
+	0x4e, 0x17, 0xbb, 0x45, 0x3f, 0x40, 0x77, 0x62, 0x67, 0x55, 0xd9, 0x9d, 0xe1, 0xe6, 0xae, 0xb2,
+	0x3b, 0x23, 0x0d, 0xdd, 0x54, 0x06, 0x62, 0xe7, 0x96, 0x27, 0x35, 0xd1, 0xb6, 0x72, 0x2b, 0xbb,
+	0x78, 0xfb, 0xee, 0x34, 0x79, 0xef, 0xee, 0x34, 0xf9, 0xd3, 0xdd, 0x69, 0xf2, 0xb5, 0x7b, 0xd3,
+	0x7b, 0xde, 0xbb, 0x37, 0xbd, 0xe7, 0x77, 0xf7, 0xa6, 0xf7, 0x7c, 0xe2, 0x44, 0xf7, 0x16, 0x6f,
+	0x75, 0x6f, 0xe2, 0x6d, 0x3b, 0xcc, 0xdd, 0x18, 0xe2, 0xff, 0x87, 0xe1, 0xe4, 0x7f, 0x03, 0x00,
+	0x00, 0xff, 0xff, 0xdf, 0xbc, 0xb8, 0x38, 0xf5, 0x31, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Stamp returns a stamp by ID
+	Stamp(ctx context.Context, in *QueryStampRequest, opts ...grpc.CallOption) (*QueryStampResponse, error)
+	// StampsByPE returns all stamps by a specific PE
+	StampsByPE(ctx context.Context, in *QueryStampsByPERequest, opts ...grpc.CallOption) (*QueryStampsByPEResponse, error)
+	// StampsByJurisdiction returns all stamps for a jurisdiction
+	StampsByJurisdiction(ctx context.Context, in *QueryStampsByJurisdictionRequest, opts ...grpc.CallOption) (*QueryStampsByJurisdictionResponse, error)
+	// StampsByDocumentHash returns all stamps on a document's SHA-256 hash
+	StampsByDocumentHash(ctx context.Context, in *QueryStampsByDocumentHashRequest, opts ...grpc.CallOption) (*QueryStampsByDocumentHashResponse, error)
+	// StampsByEntity returns all stamps issued under an entity
+	StampsByEntity(ctx context.Context, in *QueryStampsByEntityRequest, opts ...grpc.CallOption) (*QueryStampsByEntityResponse, error)
+	// StampBatch returns a stamp batch and a page of its stamps
+	StampBatch(ctx context.Context, in *QueryStampBatchRequest, opts ...grpc.CallOption) (*QueryStampBatchResponse, error)
+	// VerifyMerkleInclusion checks that a document hash is covered by a Merkle
+	// stamp that is in force
+	VerifyMerkleInclusion(ctx context.Context, in *QueryVerifyMerkleInclusionRequest, opts ...grpc.CallOption) (*QueryVerifyMerkleInclusionResponse, error)
+	// VerifyStamp re-verifies a stamp and reports the PE's license status
+	VerifyStamp(ctx context.Context, in *QueryVerifyStampRequest, opts ...grpc.CallOption) (*QueryVerifyStampResponse, error)
+	// AllStamps returns all stamps with pagination
+	AllStamps(ctx context.Context, in *QueryAllStampsRequest, opts ...grpc.CallOption) (*QueryAllStampsResponse, error)
+	// ProfessionalEngineer returns a registered PE by stamp public key
+	ProfessionalEngineer(ctx context.Context, in *QueryProfessionalEngineerRequest, opts ...grpc.CallOption) (*QueryProfessionalEngineerResponse, error)
+	// StampingDelegations returns the stamping delegations granted by a PE account
+	StampingDelegations(ctx context.Context, in *QueryStampingDelegationsRequest, opts ...grpc.CallOption) (*QueryStampingDelegationsResponse, error)
+	// License returns a board's record of a PE license
+	License(ctx context.Context, in *QueryLicenseRequest, opts ...grpc.CallOption) (*QueryLicenseResponse, error)
+	// Document returns a document by ID
+	Document(ctx context.Context, in *QueryDocumentRequest, opts ...grpc.CallOption) (*QueryDocumentResponse, error)
+	// DocumentsByStamp returns all documents for a stamp
+	DocumentsByStamp(ctx context.Context, in *QueryDocumentsByStampRequest, opts ...grpc.CallOption) (*QueryDocumentsByStampResponse, error)
+	// PinAttestations returns the pinning provider attestations for a document
+	PinAttestations(ctx context.Context, in *QueryPinAttestationsRequest, opts ...grpc.CallOption) (*QueryPinAttestationsResponse, error)
+	// Entity returns an entity by ID
+	Entity(ctx context.Context, in *QueryEntityRequest, opts ...grpc.CallOption) (*QueryEntityResponse, error)
+	// EntitiesByOwner returns all entities owned by an address
+	EntitiesByOwner(ctx context.Context, in *QueryEntitiesByOwnerRequest, opts ...grpc.CallOption) (*QueryEntitiesByOwnerResponse, error)
+	// EntitiesByMember returns the entities an address is a member of, with
+	// its role in each
+	EntitiesByMember(ctx context.Context, in *QueryEntitiesByMemberRequest, opts ...grpc.CallOption) (*QueryEntitiesByMemberResponse, error)
+	// PendingInvites returns the open entity invitations for an address
+	PendingInvites(ctx context.Context, in *QueryPendingInvitesRequest, opts ...grpc.CallOption) (*QueryPendingInvitesResponse, error)
+	// EntityMembers returns the members of an entity with their roles
+	EntityMembers(ctx context.Context, in *QueryEntityMembersRequest, opts ...grpc.CallOption) (*QueryEntityMembersResponse, error)
+	// MemberFeeUsage returns the fees an entity has sponsored for a member this month
+	MemberFeeUsage(ctx context.Context, in *QueryMemberFeeUsageRequest, opts ...grpc.CallOption) (*QueryMemberFeeUsageResponse, error)
+	// SpecVersion returns a spec version by ID
+	SpecVersion(ctx context.Context, in *QuerySpecVersionRequest, opts ...grpc.CallOption) (*QuerySpecVersionResponse, error)
+	// SpecVersionsByProject returns all versions for a project
+	SpecVersionsByProject(ctx context.Context, in *QuerySpecVersionsByProjectRequest, opts ...grpc.CallOption) (*QuerySpecVersionsByProjectResponse, error)
+	// SpecHistory returns the version history starting from a version
+	SpecHistory(ctx context.Context, in *QuerySpecHistoryRequest, opts ...grpc.CallOption) (*QuerySpecHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryEntitiesByOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Stamp(ctx context.Context, in *QueryStampRequest, opts ...grpc.CallOption) (*QueryStampResponse, error) {
+	out := new(QueryStampResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/Stamp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Entities) > 0 {
-		for _, e := range m.Entities {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) StampsByPE(ctx context.Context, in *QueryStampsByPERequest, opts ...grpc.CallOption) (*QueryStampsByPEResponse, error) {
+	out := new(QueryStampsByPEResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/StampsByPE", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) StampsByJurisdiction(ctx context.Context, in *QueryStampsByJurisdictionRequest, opts ...grpc.CallOption) (*QueryStampsByJurisdictionResponse, error) {
+	out := new(QueryStampsByJurisdictionResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/StampsByJurisdiction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QuerySpecVersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) StampsByDocumentHash(ctx context.Context, in *QueryStampsByDocumentHashRequest, opts ...grpc.CallOption) (*QueryStampsByDocumentHashResponse, error) {
+	out := new(QueryStampsByDocumentHashResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/StampsByDocumentHash", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) StampsByEntity(ctx context.Context, in *QueryStampsByEntityRequest, opts ...grpc.CallOption) (*QueryStampsByEntityResponse, error) {
+	out := new(QueryStampsByEntityResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/StampsByEntity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QuerySpecVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) StampBatch(ctx context.Context, in *QueryStampBatchRequest, opts ...grpc.CallOption) (*QueryStampBatchResponse, error) {
+	out := new(QueryStampBatchResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/StampBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Version.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QuerySpecVersionsByProjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) VerifyMerkleInclusion(ctx context.Context, in *QueryVerifyMerkleInclusionRequest, opts ...grpc.CallOption) (*QueryVerifyMerkleInclusionResponse, error) {
+	out := new(QueryVerifyMerkleInclusionResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/VerifyMerkleInclusion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ProjectId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) VerifyStamp(ctx context.Context, in *QueryVerifyStampRequest, opts ...grpc.CallOption) (*QueryVerifyStampResponse, error) {
+	out := new(QueryVerifyStampResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/VerifyStamp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) AllStamps(ctx context.Context, in *QueryAllStampsRequest, opts ...grpc.CallOption) (*QueryAllStampsResponse, error) {
+	out := new(QueryAllStampsResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/AllStamps", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QuerySpecVersionsByProjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ProfessionalEngineer(ctx context.Context, in *QueryProfessionalEngineerRequest, opts ...grpc.CallOption) (*QueryProfessionalEngineerResponse, error) {
+	out := new(QueryProfessionalEngineerResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/ProfessionalEngineer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Versions) > 0 {
-		for _, e := range m.Versions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) StampingDelegations(ctx context.Context, in *QueryStampingDelegationsRequest, opts ...grpc.CallOption) (*QueryStampingDelegationsResponse, error) {
+	out := new(QueryStampingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/StampingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) License(ctx context.Context, in *QueryLicenseRequest, opts ...grpc.CallOption) (*QueryLicenseResponse, error) {
+	out := new(QueryLicenseResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/License", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Document(ctx context.Context, in *QueryDocumentRequest, opts ...grpc.CallOption) (*QueryDocumentResponse, error) {
+	out := new(QueryDocumentResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/Document", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DocumentsByStamp(ctx context.Context, in *QueryDocumentsByStampRequest, opts ...grpc.CallOption) (*QueryDocumentsByStampResponse, error) {
+	out := new(QueryDocumentsByStampResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/DocumentsByStamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PinAttestations(ctx context.Context, in *QueryPinAttestationsRequest, opts ...grpc.CallOption) (*QueryPinAttestationsResponse, error) {
+	out := new(QueryPinAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/PinAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Entity(ctx context.Context, in *QueryEntityRequest, opts ...grpc.CallOption) (*QueryEntityResponse, error) {
+	out := new(QueryEntityResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/Entity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntitiesByOwner(ctx context.Context, in *QueryEntitiesByOwnerRequest, opts ...grpc.CallOption) (*QueryEntitiesByOwnerResponse, error) {
+	out := new(QueryEntitiesByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/EntitiesByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntitiesByMember(ctx context.Context, in *QueryEntitiesByMemberRequest, opts ...grpc.CallOption) (*QueryEntitiesByMemberResponse, error) {
+	out := new(QueryEntitiesByMemberResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/EntitiesByMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingInvites(ctx context.Context, in *QueryPendingInvitesRequest, opts ...grpc.CallOption) (*QueryPendingInvitesResponse, error) {
+	out := new(QueryPendingInvitesResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/PendingInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntityMembers(ctx context.Context, in *QueryEntityMembersRequest, opts ...grpc.CallOption) (*QueryEntityMembersResponse, error) {
+	out := new(QueryEntityMembersResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/EntityMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MemberFeeUsage(ctx context.Context, in *QueryMemberFeeUsageRequest, opts ...grpc.CallOption) (*QueryMemberFeeUsageResponse, error) {
+	out := new(QueryMemberFeeUsageResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/MemberFeeUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpecVersion(ctx context.Context, in *QuerySpecVersionRequest, opts ...grpc.CallOption) (*QuerySpecVersionResponse, error) {
+	out := new(QuerySpecVersionResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/SpecVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpecVersionsByProject(ctx context.Context, in *QuerySpecVersionsByProjectRequest, opts ...grpc.CallOption) (*QuerySpecVersionsByProjectResponse, error) {
+	out := new(QuerySpecVersionsByProjectResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/SpecVersionsByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpecHistory(ctx context.Context, in *QuerySpecHistoryRequest, opts ...grpc.CallOption) (*QuerySpecHistoryResponse, error) {
+	out := new(QuerySpecHistoryResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Query/SpecHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Stamp returns a stamp by ID
+	Stamp(context.Context, *QueryStampRequest) (*QueryStampResponse, error)
+	// StampsByPE returns all stamps by a specific PE
+	StampsByPE(context.Context, *QueryStampsByPERequest) (*QueryStampsByPEResponse, error)
+	// StampsByJurisdiction returns all stamps for a jurisdiction
+	StampsByJurisdiction(context.Context, *QueryStampsByJurisdictionRequest) (*QueryStampsByJurisdictionResponse, error)
+	// StampsByDocumentHash returns all stamps on a document's SHA-256 hash
+	StampsByDocumentHash(context.Context, *QueryStampsByDocumentHashRequest) (*QueryStampsByDocumentHashResponse, error)
+	// StampsByEntity returns all stamps issued under an entity
+	StampsByEntity(context.Context, *QueryStampsByEntityRequest) (*QueryStampsByEntityResponse, error)
+	// StampBatch returns a stamp batch and a page of its stamps
+	StampBatch(context.Context, *QueryStampBatchRequest) (*QueryStampBatchResponse, error)
+	// VerifyMerkleInclusion checks that a document hash is covered by a Merkle
+	// stamp that is in force
+	VerifyMerkleInclusion(context.Context, *QueryVerifyMerkleInclusionRequest) (*QueryVerifyMerkleInclusionResponse, error)
+	// VerifyStamp re-verifies a stamp and reports the PE's license status
+	VerifyStamp(context.Context, *QueryVerifyStampRequest) (*QueryVerifyStampResponse, error)
+	// AllStamps returns all stamps with pagination
+	AllStamps(context.Context, *QueryAllStampsRequest) (*QueryAllStampsResponse, error)
+	// ProfessionalEngineer returns a registered PE by stamp public key
+	ProfessionalEngineer(context.Context, *QueryProfessionalEngineerRequest) (*QueryProfessionalEngineerResponse, error)
+	// StampingDelegations returns the stamping delegations granted by a PE account
+	StampingDelegations(context.Context, *QueryStampingDelegationsRequest) (*QueryStampingDelegationsResponse, error)
+	// License returns a board's record of a PE license
+	License(context.Context, *QueryLicenseRequest) (*QueryLicenseResponse, error)
+	// Document returns a document by ID
+	Document(context.Context, *QueryDocumentRequest) (*QueryDocumentResponse, error)
+	// DocumentsByStamp returns all documents for a stamp
+	DocumentsByStamp(context.Context, *QueryDocumentsByStampRequest) (*QueryDocumentsByStampResponse, error)
+	// PinAttestations returns the pinning provider attestations for a document
+	PinAttestations(context.Context, *QueryPinAttestationsRequest) (*QueryPinAttestationsResponse, error)
+	// Entity returns an entity by ID
+	Entity(context.Context, *QueryEntityRequest) (*QueryEntityResponse, error)
+	// EntitiesByOwner returns all entities owned by an address
+	EntitiesByOwner(context.Context, *QueryEntitiesByOwnerRequest) (*QueryEntitiesByOwnerResponse, error)
+	// EntitiesByMember returns the entities an address is a member of, with
+	// its role in each
+	EntitiesByMember(context.Context, *QueryEntitiesByMemberRequest) (*QueryEntitiesByMemberResponse, error)
+	// PendingInvites returns the open entity invitations for an address
+	PendingInvites(context.Context, *QueryPendingInvitesRequest) (*QueryPendingInvitesResponse, error)
+	// EntityMembers returns the members of an entity with their roles
+	EntityMembers(context.Context, *QueryEntityMembersRequest) (*QueryEntityMembersResponse, error)
+	// MemberFeeUsage returns the fees an entity has sponsored for a member this month
+	MemberFeeUsage(context.Context, *QueryMemberFeeUsageRequest) (*QueryMemberFeeUsageResponse, error)
+	// SpecVersion returns a spec version by ID
+	SpecVersion(context.Context, *QuerySpecVersionRequest) (*QuerySpecVersionResponse, error)
+	// SpecVersionsByProject returns all versions for a project
+	SpecVersionsByProject(context.Context, *QuerySpecVersionsByProjectRequest) (*QuerySpecVersionsByProjectResponse, error)
+	// SpecHistory returns the version history starting from a version
+	SpecHistory(context.Context, *QuerySpecHistoryRequest) (*QuerySpecHistoryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Stamp(ctx context.Context, req *QueryStampRequest) (*QueryStampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stamp not implemented")
+}
+func (*UnimplementedQueryServer) StampsByPE(ctx context.Context, req *QueryStampsByPERequest) (*QueryStampsByPEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StampsByPE not implemented")
+}
+func (*UnimplementedQueryServer) StampsByJurisdiction(ctx context.Context, req *QueryStampsByJurisdictionRequest) (*QueryStampsByJurisdictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StampsByJurisdiction not implemented")
+}
+func (*UnimplementedQueryServer) StampsByDocumentHash(ctx context.Context, req *QueryStampsByDocumentHashRequest) (*QueryStampsByDocumentHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StampsByDocumentHash not implemented")
+}
+func (*UnimplementedQueryServer) StampsByEntity(ctx context.Context, req *QueryStampsByEntityRequest) (*QueryStampsByEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StampsByEntity not implemented")
+}
+func (*UnimplementedQueryServer) StampBatch(ctx context.Context, req *QueryStampBatchRequest) (*QueryStampBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StampBatch not implemented")
+}
+func (*UnimplementedQueryServer) VerifyMerkleInclusion(ctx context.Context, req *QueryVerifyMerkleInclusionRequest) (*QueryVerifyMerkleInclusionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMerkleInclusion not implemented")
+}
+func (*UnimplementedQueryServer) VerifyStamp(ctx context.Context, req *QueryVerifyStampRequest) (*QueryVerifyStampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyStamp not implemented")
+}
+func (*UnimplementedQueryServer) AllStamps(ctx context.Context, req *QueryAllStampsRequest) (*QueryAllStampsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllStamps not implemented")
+}
+func (*UnimplementedQueryServer) ProfessionalEngineer(ctx context.Context, req *QueryProfessionalEngineerRequest) (*QueryProfessionalEngineerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfessionalEngineer not implemented")
+}
+func (*UnimplementedQueryServer) StampingDelegations(ctx context.Context, req *QueryStampingDelegationsRequest) (*QueryStampingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StampingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) License(ctx context.Context, req *QueryLicenseRequest) (*QueryLicenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method License not implemented")
+}
+func (*UnimplementedQueryServer) Document(ctx context.Context, req *QueryDocumentRequest) (*QueryDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Document not implemented")
+}
+func (*UnimplementedQueryServer) DocumentsByStamp(ctx context.Context, req *QueryDocumentsByStampRequest) (*QueryDocumentsByStampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DocumentsByStamp not implemented")
+}
+func (*UnimplementedQueryServer) PinAttestations(ctx context.Context, req *QueryPinAttestationsRequest) (*QueryPinAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinAttestations not implemented")
+}
+func (*UnimplementedQueryServer) Entity(ctx context.Context, req *QueryEntityRequest) (*QueryEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Entity not implemented")
+}
+func (*UnimplementedQueryServer) EntitiesByOwner(ctx context.Context, req *QueryEntitiesByOwnerRequest) (*QueryEntitiesByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntitiesByOwner not implemented")
+}
+func (*UnimplementedQueryServer) EntitiesByMember(ctx context.Context, req *QueryEntitiesByMemberRequest) (*QueryEntitiesByMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntitiesByMember not implemented")
+}
+func (*UnimplementedQueryServer) PendingInvites(ctx context.Context, req *QueryPendingInvitesRequest) (*QueryPendingInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingInvites not implemented")
+}
+func (*UnimplementedQueryServer) EntityMembers(ctx context.Context, req *QueryEntityMembersRequest) (*QueryEntityMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntityMembers not implemented")
+}
+func (*UnimplementedQueryServer) MemberFeeUsage(ctx context.Context, req *QueryMemberFeeUsageRequest) (*QueryMemberFeeUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemberFeeUsage not implemented")
+}
+func (*UnimplementedQueryServer) SpecVersion(ctx context.Context, req *QuerySpecVersionRequest) (*QuerySpecVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecVersion not implemented")
+}
+func (*UnimplementedQueryServer) SpecVersionsByProject(ctx context.Context, req *QuerySpecVersionsByProjectRequest) (*QuerySpecVersionsByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecVersionsByProject not implemented")
+}
+func (*UnimplementedQueryServer) SpecHistory(ctx context.Context, req *QuerySpecHistoryRequest) (*QuerySpecHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecHistory not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/Stamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stamp(ctx, req.(*QueryStampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StampsByPE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStampsByPERequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StampsByPE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/StampsByPE",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StampsByPE(ctx, req.(*QueryStampsByPERequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StampsByJurisdiction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStampsByJurisdictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StampsByJurisdiction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/StampsByJurisdiction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StampsByJurisdiction(ctx, req.(*QueryStampsByJurisdictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StampsByDocumentHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStampsByDocumentHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StampsByDocumentHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/StampsByDocumentHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StampsByDocumentHash(ctx, req.(*QueryStampsByDocumentHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StampsByEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStampsByEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StampsByEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/StampsByEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StampsByEntity(ctx, req.(*QueryStampsByEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StampBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStampBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StampBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/StampBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StampBatch(ctx, req.(*QueryStampBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyMerkleInclusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyMerkleInclusionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyMerkleInclusion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/VerifyMerkleInclusion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyMerkleInclusion(ctx, req.(*QueryVerifyMerkleInclusionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyStamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyStampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyStamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/VerifyStamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyStamp(ctx, req.(*QueryVerifyStampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllStamps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllStampsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllStamps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/AllStamps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllStamps(ctx, req.(*QueryAllStampsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProfessionalEngineer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProfessionalEngineerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProfessionalEngineer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/ProfessionalEngineer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProfessionalEngineer(ctx, req.(*QueryProfessionalEngineerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StampingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStampingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StampingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/StampingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StampingDelegations(ctx, req.(*QueryStampingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_License_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).License(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/License",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).License(ctx, req.(*QueryLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Document_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Document(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/Document",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Document(ctx, req.(*QueryDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DocumentsByStamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDocumentsByStampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DocumentsByStamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/DocumentsByStamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DocumentsByStamp(ctx, req.(*QueryDocumentsByStampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PinAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPinAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PinAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/PinAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PinAttestations(ctx, req.(*QueryPinAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Entity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Entity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/Entity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Entity(ctx, req.(*QueryEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntitiesByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntitiesByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntitiesByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/EntitiesByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntitiesByOwner(ctx, req.(*QueryEntitiesByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntitiesByMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntitiesByMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntitiesByMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/EntitiesByMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntitiesByMember(ctx, req.(*QueryEntitiesByMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/PendingInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingInvites(ctx, req.(*QueryPendingInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntityMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntityMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntityMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/EntityMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntityMembers(ctx, req.(*QueryEntityMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MemberFeeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMemberFeeUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MemberFeeUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/MemberFeeUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MemberFeeUsage(ctx, req.(*QueryMemberFeeUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpecVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpecVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpecVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/SpecVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpecVersion(ctx, req.(*QuerySpecVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpecVersionsByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpecVersionsByProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpecVersionsByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/SpecVersionsByProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpecVersionsByProject(ctx, req.(*QuerySpecVersionsByProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpecHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpecHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpecHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Query/SpecHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpecHistory(ctx, req.(*QuerySpecHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stampledgerchain.stampledgerchain.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Stamp",
+			Handler:    _Query_Stamp_Handler,
+		},
+		{
+			MethodName: "StampsByPE",
+			Handler:    _Query_StampsByPE_Handler,
+		},
+		{
+			MethodName: "StampsByJurisdiction",
+			Handler:    _Query_StampsByJurisdiction_Handler,
+		},
+		{
+			MethodName: "StampsByDocumentHash",
+			Handler:    _Query_StampsByDocumentHash_Handler,
+		},
+		{
+			MethodName: "StampsByEntity",
+			Handler:    _Query_StampsByEntity_Handler,
+		},
+		{
+			MethodName: "StampBatch",
+			Handler:    _Query_StampBatch_Handler,
+		},
+		{
+			MethodName: "VerifyMerkleInclusion",
+			Handler:    _Query_VerifyMerkleInclusion_Handler,
+		},
+		{
+			MethodName: "VerifyStamp",
+			Handler:    _Query_VerifyStamp_Handler,
+		},
+		{
+			MethodName: "AllStamps",
+			Handler:    _Query_AllStamps_Handler,
+		},
+		{
+			MethodName: "ProfessionalEngineer",
+			Handler:    _Query_ProfessionalEngineer_Handler,
+		},
+		{
+			MethodName: "StampingDelegations",
+			Handler:    _Query_StampingDelegations_Handler,
+		},
+		{
+			MethodName: "License",
+			Handler:    _Query_License_Handler,
+		},
+		{
+			MethodName: "Document",
+			Handler:    _Query_Document_Handler,
+		},
+		{
+			MethodName: "DocumentsByStamp",
+			Handler:    _Query_DocumentsByStamp_Handler,
+		},
+		{
+			MethodName: "PinAttestations",
+			Handler:    _Query_PinAttestations_Handler,
+		},
+		{
+			MethodName: "Entity",
+			Handler:    _Query_Entity_Handler,
+		},
+		{
+			MethodName: "EntitiesByOwner",
+			Handler:    _Query_EntitiesByOwner_Handler,
+		},
+		{
+			MethodName: "EntitiesByMember",
+			Handler:    _Query_EntitiesByMember_Handler,
+		},
+		{
+			MethodName: "PendingInvites",
+			Handler:    _Query_PendingInvites_Handler,
+		},
+		{
+			MethodName: "EntityMembers",
+			Handler:    _Query_EntityMembers_Handler,
+		},
+		{
+			MethodName: "MemberFeeUsage",
+			Handler:    _Query_MemberFeeUsage_Handler,
+		},
+		{
+			MethodName: "SpecVersion",
+			Handler:    _Query_SpecVersion_Handler,
+		},
+		{
+			MethodName: "SpecVersionsByProject",
+			Handler:    _Query_SpecVersionsByProject_Handler,
+		},
+		{
+			MethodName: "SpecHistory",
+			Handler:    _Query_SpecHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stampledgerchain/stampledgerchain/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampsByPERequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampsByPERequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampsByPERequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PePublicKey) > 0 {
+		i -= len(m.PePublicKey)
+		copy(dAtA[i:], m.PePublicKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PePublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampsByPEResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampsByPEResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampsByPEResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stamps) > 0 {
+		for iNdEx := len(m.Stamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampsByJurisdictionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampsByJurisdictionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampsByJurisdictionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JurisdictionId) > 0 {
+		i -= len(m.JurisdictionId)
+		copy(dAtA[i:], m.JurisdictionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.JurisdictionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampsByJurisdictionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampsByJurisdictionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampsByJurisdictionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stamps) > 0 {
+		for iNdEx := len(m.Stamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampsByDocumentHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampsByDocumentHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampsByDocumentHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DocumentHash) > 0 {
+		i -= len(m.DocumentHash)
+		copy(dAtA[i:], m.DocumentHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DocumentHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampsByDocumentHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampsByDocumentHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampsByDocumentHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stamps) > 0 {
+		for iNdEx := len(m.Stamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampsByEntityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampsByEntityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampsByEntityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampsByEntityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampsByEntityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampsByEntityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stamps) > 0 {
+		for iNdEx := len(m.Stamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Stamps) > 0 {
+		for iNdEx := len(m.Stamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyMerkleInclusionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyMerkleInclusionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyMerkleInclusionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LeafIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LeafIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LeafHash) > 0 {
+		i -= len(m.LeafHash)
+		copy(dAtA[i:], m.LeafHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LeafHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyMerkleInclusionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyMerkleInclusionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyMerkleInclusionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyStampRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyStampRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyStampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyStampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyStampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyStampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStampsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStampsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStampsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStampsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStampsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStampsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stamps) > 0 {
+		for iNdEx := len(m.Stamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProfessionalEngineerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProfessionalEngineerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProfessionalEngineerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProfessionalEngineerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProfessionalEngineerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProfessionalEngineerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProfessionalEngineer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStampingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStampingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStampingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLicenseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLicenseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLicenseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LicenseNumber) > 0 {
+		i -= len(m.LicenseNumber)
+		copy(dAtA[i:], m.LicenseNumber)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LicenseNumber)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JurisdictionId) > 0 {
+		i -= len(m.JurisdictionId)
+		copy(dAtA[i:], m.JurisdictionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.JurisdictionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLicenseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLicenseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLicenseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Document.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDocumentsByStampRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDocumentsByStampRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDocumentsByStampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StampId) > 0 {
+		i -= len(m.StampId)
+		copy(dAtA[i:], m.StampId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StampId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDocumentsByStampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDocumentsByStampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDocumentsByStampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Documents) > 0 {
+		for iNdEx := len(m.Documents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Documents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPinAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPinAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPinAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPinAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPinAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPinAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntitiesByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntitiesByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntitiesByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntitiesByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntitiesByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntitiesByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entities) > 0 {
+		for iNdEx := len(m.Entities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntitiesByMemberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntitiesByMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntitiesByMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MemberAddress) > 0 {
+		i -= len(m.MemberAddress)
+		copy(dAtA[i:], m.MemberAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MemberAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntityMembership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntityMembership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntityMembership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JoinedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.JoinedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Entity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntitiesByMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntitiesByMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntitiesByMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Memberships) > 0 {
+		for iNdEx := len(m.Memberships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Memberships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingInvitesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingInvitesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingInvitesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Invitee) > 0 {
+		i -= len(m.Invitee)
+		copy(dAtA[i:], m.Invitee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Invitee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingInvitesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingInvitesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingInvitesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Invites) > 0 {
+		for iNdEx := len(m.Invites) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invites[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntityMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntityMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntityMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntityMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntityMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntityMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemberFeeUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemberFeeUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemberFeeUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemberFeeUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemberFeeUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemberFeeUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpecVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpecVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpecVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpecVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpecVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpecVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpecVersionsByProjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpecVersionsByProjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpecVersionsByProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectId) > 0 {
+		i -= len(m.ProjectId)
+		copy(dAtA[i:], m.ProjectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpecVersionsByProjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpecVersionsByProjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpecVersionsByProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpecHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpecHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpecHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StartingVersionId) > 0 {
+		i -= len(m.StartingVersionId)
+		copy(dAtA[i:], m.StartingVersionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StartingVersionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpecHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpecHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpecHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stamp.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStampsByPERequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PePublicKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStampsByPEResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stamps) > 0 {
+		for _, e := range m.Stamps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStampsByJurisdictionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JurisdictionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStampsByJurisdictionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stamps) > 0 {
+		for _, e := range m.Stamps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStampsByDocumentHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStampsByDocumentHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stamps) > 0 {
+		for _, e := range m.Stamps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStampsByEntityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStampsByEntityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stamps) > 0 {
+		for _, e := range m.Stamps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStampBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStampBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Batch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Stamps) > 0 {
+		for _, e := range m.Stamps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyMerkleInclusionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LeafHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LeafIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LeafIndex))
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVerifyMerkleInclusionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Verification.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVerifyStampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyStampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Verification.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllStampsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllStampsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stamps) > 0 {
+		for _, e := range m.Stamps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProfessionalEngineerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProfessionalEngineerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProfessionalEngineer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStampingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStampingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLicenseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JurisdictionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LicenseNumber)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLicenseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.License.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Document.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDocumentsByStampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StampId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *QueryDocumentsByStampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Documents) > 0 {
+		for _, e := range m.Documents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPinAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPinAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEntitiesByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntitiesByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entities) > 0 {
+		for _, e := range m.Entities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntitiesByMemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MemberAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EntityMembership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.JoinedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.JoinedHeight))
+	}
+	return n
+}
+
+func (m *QueryEntitiesByMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Memberships) > 0 {
+		for _, e := range m.Memberships {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingInvitesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Invitee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingInvitesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Invites) > 0 {
+		for _, e := range m.Invites {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntityMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntityMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMemberFeeUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMemberFeeUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySpecVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpecVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Version.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySpecVersionsByProjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpecVersionsByProjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpecHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartingVersionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpecHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampsByPERequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampsByPERequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampsByPERequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PePublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PePublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampsByPEResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampsByPEResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampsByPEResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stamps = append(m.Stamps, Stamp{})
+			if err := m.Stamps[len(m.Stamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampsByJurisdictionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampsByJurisdictionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampsByJurisdictionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampsByJurisdictionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampsByJurisdictionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampsByJurisdictionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stamps = append(m.Stamps, Stamp{})
+			if err := m.Stamps[len(m.Stamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampsByDocumentHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampsByDocumentHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampsByDocumentHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampsByDocumentHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampsByDocumentHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampsByDocumentHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stamps = append(m.Stamps, Stamp{})
+			if err := m.Stamps[len(m.Stamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampsByEntityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampsByEntityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampsByEntityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampsByEntityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampsByEntityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampsByEntityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stamps = append(m.Stamps, Stamp{})
+			if err := m.Stamps[len(m.Stamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stamps = append(m.Stamps, Stamp{})
+			if err := m.Stamps[len(m.Stamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyMerkleInclusionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyMerkleInclusionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyMerkleInclusionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeafHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
+			}
+			m.LeafIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyMerkleInclusionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyMerkleInclusionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyMerkleInclusionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyStampRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyStampRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyStampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyStampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyStampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyStampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStampsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStampsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStampsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStampsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStampsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStampsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stamps = append(m.Stamps, Stamp{})
+			if err := m.Stamps[len(m.Stamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProfessionalEngineerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProfessionalEngineerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProfessionalEngineerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProfessionalEngineerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProfessionalEngineerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProfessionalEngineerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfessionalEngineer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProfessionalEngineer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampingDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampingDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStampingDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStampingDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStampingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, StampingDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLicenseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLicenseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLicenseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenseNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LicenseNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLicenseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLicenseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLicenseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.License.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QuerySpecHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StartingVersionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QuerySpecHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.History) > 0 {
-		for _, e := range m.History {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDocumentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3094,12 +9494,45 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDocumentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Document.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3121,7 +9554,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDocumentsByStampRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3144,17 +9577,85 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDocumentsByStampRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDocumentsByStampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StampId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var msglen int
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3164,25 +9665,11 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Role |= DocumentRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3204,7 +9691,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStampRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDocumentsByStampResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3227,17 +9714,17 @@ func (m *QueryStampRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStampRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDocumentsByStampResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDocumentsByStampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Documents", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3247,77 +9734,29 @@ func (m *QueryStampRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Documents = append(m.Documents, DocumentStorage{})
+			if err := m.Documents[len(m.Documents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryStampResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStampResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3344,7 +9783,10 @@ func (m *QueryStampResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3369,7 +9811,7 @@ func (m *QueryStampResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStampsByPERequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPinAttestationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3392,15 +9834,15 @@ func (m *QueryStampsByPERequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStampsByPERequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPinAttestationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStampsByPERequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPinAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PePublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3428,7 +9870,7 @@ func (m *QueryStampsByPERequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PePublicKey = string(dAtA[iNdEx:postIndex])
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3487,7 +9929,7 @@ func (m *QueryStampsByPERequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStampsByPEResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPinAttestationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3510,15 +9952,15 @@ func (m *QueryStampsByPEResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStampsByPEResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPinAttestationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStampsByPEResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPinAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stamps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3545,8 +9987,8 @@ func (m *QueryStampsByPEResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stamps = append(m.Stamps, Stamp{})
-			if err := m.Stamps[len(m.Stamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attestations = append(m.Attestations, PinAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3607,7 +10049,7 @@ func (m *QueryStampsByPEResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStampsByJurisdictionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEntityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3630,15 +10072,15 @@ func (m *QueryStampsByJurisdictionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStampsByJurisdictionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEntityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStampsByJurisdictionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEntityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3666,11 +10108,61 @@ func (m *QueryStampsByJurisdictionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3697,10 +10189,7 @@ func (m *QueryStampsByJurisdictionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Entity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3725,7 +10214,7 @@ func (m *QueryStampsByJurisdictionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStampsByJurisdictionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEntitiesByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3748,17 +10237,17 @@ func (m *QueryStampsByJurisdictionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStampsByJurisdictionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEntitiesByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStampsByJurisdictionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEntitiesByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stamps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3768,25 +10257,23 @@ func (m *QueryStampsByJurisdictionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stamps = append(m.Stamps, Stamp{})
-			if err := m.Stamps[len(m.Stamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3818,7 +10305,7 @@ func (m *QueryStampsByJurisdictionResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3845,7 +10332,7 @@ func (m *QueryStampsByJurisdictionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStampsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEntitiesByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3868,13 +10355,47 @@ func (m *QueryAllStampsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStampsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEntitiesByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStampsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEntitiesByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entities = append(m.Entities, EntityAccount{})
+			if err := m.Entities[len(m.Entities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3904,7 +10425,7 @@ func (m *QueryAllStampsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3931,7 +10452,7 @@ func (m *QueryAllStampsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStampsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEntitiesByMemberRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3954,17 +10475,17 @@ func (m *QueryAllStampsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStampsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEntitiesByMemberRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStampsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEntitiesByMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stamps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3974,25 +10495,23 @@ func (m *QueryAllStampsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stamps = append(m.Stamps, Stamp{})
-			if err := m.Stamps[len(m.Stamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MemberAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4024,7 +10543,7 @@ func (m *QueryAllStampsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4051,7 +10570,7 @@ func (m *QueryAllStampsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *EntityMembership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4074,15 +10593,48 @@ func (m *QueryDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EntityMembership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EntityMembership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4110,8 +10662,27 @@ func (m *QueryDocumentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinedHeight", wireType)
+			}
+			m.JoinedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4133,7 +10704,7 @@ func (m *QueryDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEntitiesByMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4156,15 +10727,15 @@ func (m *QueryDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEntitiesByMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEntitiesByMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memberships", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4191,7 +10762,44 @@ func (m *QueryDocumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Document.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Memberships = append(m.Memberships, EntityMembership{})
+			if err := m.Memberships[len(m.Memberships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4216,7 +10824,7 @@ func (m *QueryDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDocumentsByStampRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingInvitesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4239,15 +10847,15 @@ func (m *QueryDocumentsByStampRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDocumentsByStampRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingInvitesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDocumentsByStampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingInvitesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invitee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4275,7 +10883,7 @@ func (m *QueryDocumentsByStampRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StampId = string(dAtA[iNdEx:postIndex])
+			m.Invitee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4334,7 +10942,7 @@ func (m *QueryDocumentsByStampRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDocumentsByStampResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingInvitesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4357,15 +10965,15 @@ func (m *QueryDocumentsByStampResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDocumentsByStampResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingInvitesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDocumentsByStampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingInvitesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Documents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invites", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4392,8 +11000,8 @@ func (m *QueryDocumentsByStampResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Documents = append(m.Documents, DocumentStorage{})
-			if err := m.Documents[len(m.Documents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Invites = append(m.Invites, EntityInvite{})
+			if err := m.Invites[len(m.Invites)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4454,7 +11062,7 @@ func (m *QueryDocumentsByStampResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEntityRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEntityMembersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4477,15 +11085,15 @@ func (m *QueryEntityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEntityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEntityMembersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEntityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEntityMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4513,7 +11121,43 @@ func (m *QueryEntityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4536,7 +11180,7 @@ func (m *QueryEntityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEntityResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEntityMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4559,15 +11203,15 @@ func (m *QueryEntityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEntityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEntityMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEntityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEntityMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4594,7 +11238,44 @@ func (m *QueryEntityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Entity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, EntityMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4619,7 +11300,7 @@ func (m *QueryEntityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEntitiesByOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMemberFeeUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4642,15 +11323,15 @@ func (m *QueryEntitiesByOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEntitiesByOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMemberFeeUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEntitiesByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMemberFeeUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4678,13 +11359,13 @@ func (m *QueryEntitiesByOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.EntityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4694,27 +11375,23 @@ func (m *QueryEntitiesByOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Member = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4737,7 +11414,7 @@ func (m *QueryEntitiesByOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEntitiesByOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMemberFeeUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4760,49 +11437,15 @@ func (m *QueryEntitiesByOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEntitiesByOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMemberFeeUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEntitiesByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMemberFeeUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entities", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Entities = append(m.Entities, EntityAccount{})
-			if err := m.Entities[len(m.Entities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4829,10 +11472,7 @@ func (m *QueryEntitiesByOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,43 @@
+package types
+
+// RevocationAuthority is the capacity in which an address revokes a stamp
+type RevocationAuthority string
+
+const (
+	// RevokerCreator is the account that created the stamp
+	RevokerCreator RevocationAuthority = "creator"
+	// RevokerEntityAdmin is an admin of the entity the stamp was issued under
+	RevokerEntityAdmin RevocationAuthority = "entity_admin"
+	// RevokerJurisdictionBoard is a board address of the stamp's jurisdiction
+	RevokerJurisdictionBoard RevocationAuthority = "jurisdiction_board"
+)
+
+// RevocationMatrix lists the revocation reasons each authority may use.
+// Only a jurisdiction board may revoke for disciplinary reasons.
+var RevocationMatrix = map[RevocationAuthority]map[RevocationReason]bool{
+	RevokerCreator: {
+		RevocationErrorInDesign:  true,
+		RevocationSuperseded:     true,
+		RevocationKeyCompromise:  true,
+		RevocationAdministrative: true,
+	},
+	RevokerEntityAdmin: {
+		RevocationErrorInDesign:  true,
+		RevocationAdministrative: true,
+	},
+	RevokerJurisdictionBoard: {
+		RevocationDisciplinary:   true,
+		RevocationAdministrative: true,
+	},
+}
+
+// CanRevoke reports whether the authority may revoke a stamp for reason.
+func (a RevocationAuthority) CanRevoke(reason RevocationReason) bool {
+	return RevocationMatrix[a][reason]
+}
+
+// IsValid reports whether the reason is a known, specified revocation reason.
+func (r RevocationReason) IsValid() bool {
+	_, ok := RevocationReason_name[int32(r)]
+	return ok && r != RevocationUnspecified
+}
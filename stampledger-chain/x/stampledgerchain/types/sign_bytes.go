@@ -0,0 +1,260 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+const (
+	// StampSignBytesVersion is the current version of the stamp signing payload
+	StampSignBytesVersion uint32 = 1
+
+	// StampSignDocType is the domain separation tag bound into every StampSignDoc
+	StampSignDocType = "stampledger/StampSignDoc"
+
+	// MerkleStampSignDocType is the domain separation tag for Merkle-root stamps
+	MerkleStampSignDocType = "stampledger/MerkleStampSignDoc"
+
+	// CoStampSignDocType is the domain separation tag for co-sealed stamp signatures
+	CoStampSignDocType = "stampledger/CoStampSignDoc"
+
+	// PERegistrationSignDocType is the domain separation tag for PE proof-of-possession
+	PERegistrationSignDocType = "stampledger/PERegistrationSignDoc"
+
+	// KeyRotationSignDocType is the domain separation tag for PE key rotation
+	KeyRotationSignDocType = "stampledger/KeyRotationSignDoc"
+
+	// PinAttestationSignDocType is the domain separation tag for pinning
+	// provider attestations
+	PinAttestationSignDocType = "stampledger/PinAttestationSignDoc"
+)
+
+// StampSignDoc is the canonical payload a PE signs with their Ed25519 stamp key.
+// Fields are declared in alphabetical order so the JSON encoding is canonical,
+// and integers are encoded as strings so JavaScript clients can reproduce it.
+type StampSignDoc struct {
+	ChainID        string `json:"chain_id"`
+	DocumentHash   string `json:"document_hash"`
+	Expiry         string `json:"expiry"`
+	JurisdictionID string `json:"jurisdiction_id"`
+	LicenseNumber  string `json:"license_number"`
+	Nonce          string `json:"nonce"`
+	Type           string `json:"type"`
+	Version        string `json:"version"`
+}
+
+// StampSignBytes returns the bytes a PE must sign to stamp a document.
+func StampSignBytes(
+	chainID string,
+	jurisdictionID string,
+	licenseNumber string,
+	documentHash string,
+	expiry int64,
+	nonce uint64,
+) []byte {
+	bz, err := json.Marshal(StampSignDoc{
+		ChainID:        chainID,
+		DocumentHash:   documentHash,
+		Expiry:         strconv.FormatInt(expiry, 10),
+		JurisdictionID: jurisdictionID,
+		LicenseNumber:  licenseNumber,
+		Nonce:          strconv.FormatUint(nonce, 10),
+		Type:           StampSignDocType,
+		Version:        strconv.FormatUint(uint64(StampSignBytesVersion), 10),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// MerkleStampSignDoc is the payload a PE signs to stamp a Merkle root over a
+// set of document hashes. It uses the same canonical encoding and version as
+// StampSignDoc but a distinct type, so a root signature can never be replayed
+// as a single-document stamp.
+type MerkleStampSignDoc struct {
+	ChainID        string `json:"chain_id"`
+	Expiry         string `json:"expiry"`
+	JurisdictionID string `json:"jurisdiction_id"`
+	LeafCount      string `json:"leaf_count"`
+	LicenseNumber  string `json:"license_number"`
+	MerkleRoot     string `json:"merkle_root"`
+	Nonce          string `json:"nonce"`
+	Type           string `json:"type"`
+	Version        string `json:"version"`
+}
+
+// MerkleStampSignBytes returns the bytes a PE must sign to stamp a Merkle root.
+func MerkleStampSignBytes(
+	chainID string,
+	jurisdictionID string,
+	licenseNumber string,
+	merkleRoot string,
+	leafCount uint64,
+	expiry int64,
+	nonce uint64,
+) []byte {
+	bz, err := json.Marshal(MerkleStampSignDoc{
+		ChainID:        chainID,
+		Expiry:         strconv.FormatInt(expiry, 10),
+		JurisdictionID: jurisdictionID,
+		LeafCount:      strconv.FormatUint(leafCount, 10),
+		LicenseNumber:  licenseNumber,
+		MerkleRoot:     merkleRoot,
+		Nonce:          strconv.FormatUint(nonce, 10),
+		Type:           MerkleStampSignDocType,
+		Version:        strconv.FormatUint(uint64(StampSignBytesVersion), 10),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// CoStampSignDoc is the payload a co-signer signs to seal a co-sealed stamp
+// proposal. Besides the document it binds the proposal's stamp ID, signer set
+// and threshold, so a co-signature can neither stand in for a solo stamp nor
+// be replayed into another proposal over the same document.
+type CoStampSignDoc struct {
+	ChainID        string                 `json:"chain_id"`
+	DocumentHash   string                 `json:"document_hash"`
+	Expiry         string                 `json:"expiry"`
+	JurisdictionID string                 `json:"jurisdiction_id"`
+	LicenseNumber  string                 `json:"license_number"`
+	Nonce          string                 `json:"nonce"`
+	Signers        []CoStampSignDocSigner `json:"signers"`
+	StampID        string                 `json:"stamp_id"`
+	Threshold      string                 `json:"threshold"`
+	Type           string                 `json:"type"`
+	Version        string                 `json:"version"`
+}
+
+// CoStampSignDocSigner is one required signer of a co-sealed stamp, in the
+// order the proposal lists them
+type CoStampSignDocSigner struct {
+	Discipline  string `json:"discipline"`
+	PePublicKey string `json:"pe_public_key"`
+}
+
+// CoStampSignBytes returns the bytes a co-signer must sign to seal a co-sealed
+// stamp under their own license number.
+func CoStampSignBytes(
+	chainID string,
+	stampID string,
+	jurisdictionID string,
+	licenseNumber string,
+	documentHash string,
+	signers []CoSigner,
+	threshold uint32,
+	expiry int64,
+	nonce uint64,
+) []byte {
+	docSigners := make([]CoStampSignDocSigner, len(signers))
+	for i, signer := range signers {
+		docSigners[i] = CoStampSignDocSigner{
+			Discipline:  signer.Discipline,
+			PePublicKey: signer.PePublicKey,
+		}
+	}
+	bz, err := json.Marshal(CoStampSignDoc{
+		ChainID:        chainID,
+		DocumentHash:   documentHash,
+		Expiry:         strconv.FormatInt(expiry, 10),
+		JurisdictionID: jurisdictionID,
+		LicenseNumber:  licenseNumber,
+		Nonce:          strconv.FormatUint(nonce, 10),
+		Signers:        docSigners,
+		StampID:        stampID,
+		Threshold:      strconv.FormatUint(uint64(threshold), 10),
+		Type:           CoStampSignDocType,
+		Version:        strconv.FormatUint(uint64(StampSignBytesVersion), 10),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// PERegistrationSignDoc is the proof-of-possession payload a PE signs with
+// their stamp key to bind it to an account and license number.
+type PERegistrationSignDoc struct {
+	Account       string `json:"account"`
+	ChainID       string `json:"chain_id"`
+	LicenseNumber string `json:"license_number"`
+	Type          string `json:"type"`
+}
+
+// PERegistrationSignBytes returns the bytes a PE must sign to register a stamp key.
+func PERegistrationSignBytes(chainID string, account string, licenseNumber string) []byte {
+	bz, err := json.Marshal(PERegistrationSignDoc{
+		Account:       account,
+		ChainID:       chainID,
+		LicenseNumber: licenseNumber,
+		Type:          PERegistrationSignDocType,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// KeyRotationSignDoc is the payload both the old and the new stamp key sign
+// to rotate a PE's registration from one key to the other.
+type KeyRotationSignDoc struct {
+	ChainID      string `json:"chain_id"`
+	NewPublicKey string `json:"new_public_key"`
+	OldPublicKey string `json:"old_public_key"`
+	Type         string `json:"type"`
+}
+
+// KeyRotationSignBytes returns the bytes both keys must sign to rotate a PE key.
+func KeyRotationSignBytes(chainID string, oldPublicKey string, newPublicKey string) []byte {
+	bz, err := json.Marshal(KeyRotationSignDoc{
+		ChainID:      chainID,
+		NewPublicKey: newPublicKey,
+		OldPublicKey: oldPublicKey,
+		Type:         KeyRotationSignDocType,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// PinAttestationSignDoc is the payload a pinning provider signs to attest that
+// it holds a document. The CID binds the attestation to the stored content,
+// not just the document record.
+type PinAttestationSignDoc struct {
+	ChainID           string `json:"chain_id"`
+	DocumentID        string `json:"document_id"`
+	IpfsHash          string `json:"ipfs_hash"`
+	PinnedUntilHeight string `json:"pinned_until_height"`
+	Provider          string `json:"provider"`
+	ReplicaCount      string `json:"replica_count"`
+	Type              string `json:"type"`
+}
+
+// PinAttestationSignBytes returns the bytes a pinning provider must sign to
+// attest that it holds a document.
+func PinAttestationSignBytes(
+	chainID string,
+	provider string,
+	documentID string,
+	ipfsHash string,
+	replicaCount uint32,
+	pinnedUntilHeight int64,
+) []byte {
+	bz, err := json.Marshal(PinAttestationSignDoc{
+		ChainID:           chainID,
+		DocumentID:        documentID,
+		IpfsHash:          ipfsHash,
+		PinnedUntilHeight: strconv.FormatInt(pinnedUntilHeight, 10),
+		Provider:          provider,
+		ReplicaCount:      strconv.FormatUint(uint64(replicaCount), 10),
+		Type:              PinAttestationSignDocType,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
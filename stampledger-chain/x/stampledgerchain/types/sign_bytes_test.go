@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"stampledger-chain/x/stampledgerchain/types"
+)
+
+func TestStampSignBytes(t *testing.T) {
+	bz := types.StampSignBytes("stampledger-1", "wisconsin", "PE-12345", "ab12", 1700000000, 7)
+	require.Equal(t,
+		`{"chain_id":"stampledger-1","document_hash":"ab12","expiry":"1700000000","jurisdiction_id":"wisconsin",`+
+			`"license_number":"PE-12345","nonce":"7","type":"stampledger/StampSignDoc","version":"1"}`,
+		string(bz),
+	)
+
+	// Every bound field changes the payload
+	require.NotEqual(t, bz, types.StampSignBytes("stampledger-2", "wisconsin", "PE-12345", "ab12", 1700000000, 7))
+	require.NotEqual(t, bz, types.StampSignBytes("stampledger-1", "california", "PE-12345", "ab12", 1700000000, 7))
+	require.NotEqual(t, bz, types.StampSignBytes("stampledger-1", "wisconsin", "PE-54321", "ab12", 1700000000, 7))
+	require.NotEqual(t, bz, types.StampSignBytes("stampledger-1", "wisconsin", "PE-12345", "ab12", 1700000001, 7))
+	require.NotEqual(t, bz, types.StampSignBytes("stampledger-1", "wisconsin", "PE-12345", "ab12", 1700000000, 8))
+}
+
+func TestMerkleStampSignBytes(t *testing.T) {
+	bz := types.MerkleStampSignBytes("stampledger-1", "wisconsin", "PE-12345", "cd34", 1200, 1700000000, 7)
+	require.Equal(t,
+		`{"chain_id":"stampledger-1","expiry":"1700000000","jurisdiction_id":"wisconsin","leaf_count":"1200",`+
+			`"license_number":"PE-12345","merkle_root":"cd34","nonce":"7","type":"stampledger/MerkleStampSignDoc","version":"1"}`,
+		string(bz),
+	)
+
+	// Leaf count is bound, and a root signature is not a document signature
+	require.NotEqual(t, bz, types.MerkleStampSignBytes("stampledger-1", "wisconsin", "PE-12345", "cd34", 1201, 1700000000, 7))
+	require.NotEqual(t, bz, types.StampSignBytes("stampledger-1", "wisconsin", "PE-12345", "cd34", 1700000000, 7))
+}
+
+func TestCoStampSignBytes(t *testing.T) {
+	signers := []types.CoSigner{
+		{PePublicKey: "aa11", Discipline: "structural"},
+		{PePublicKey: "bb22", Discipline: "electrical"},
+	}
+	bz := types.CoStampSignBytes("stampledger-1", "42", "wisconsin", "PE-12345", "ab12", signers, 2, 1700000000, 7)
+	require.Equal(t,
+		`{"chain_id":"stampledger-1","document_hash":"ab12","expiry":"1700000000","jurisdiction_id":"wisconsin",`+
+			`"license_number":"PE-12345","nonce":"7","signers":[{"discipline":"structural","pe_public_key":"aa11"},`+
+			`{"discipline":"electrical","pe_public_key":"bb22"}],"stamp_id":"42","threshold":"2",`+
+			`"type":"stampledger/CoStampSignDoc","version":"1"}`,
+		string(bz),
+	)
+
+	// The proposal is bound, and a co-signature is not a document signature
+	require.NotEqual(t, bz, types.CoStampSignBytes("stampledger-1", "43", "wisconsin", "PE-12345", "ab12", signers, 2, 1700000000, 7))
+	require.NotEqual(t, bz, types.CoStampSignBytes("stampledger-1", "42", "wisconsin", "PE-12345", "ab12", signers[:1], 2, 1700000000, 7))
+	require.NotEqual(t, bz, types.CoStampSignBytes("stampledger-1", "42", "wisconsin", "PE-12345", "ab12", signers, 1, 1700000000, 7))
+	require.NotEqual(t, bz, types.StampSignBytes("stampledger-1", "wisconsin", "PE-12345", "ab12", 1700000000, 7))
+}
+
+func TestPinAttestationSignBytes(t *testing.T) {
+	bz := types.PinAttestationSignBytes("stampledger-1", "cosmos1provider", "42", "bafkcid", 3, 5000)
+	require.Equal(t,
+		`{"chain_id":"stampledger-1","document_id":"42","ipfs_hash":"bafkcid","pinned_until_height":"5000",`+
+			`"provider":"cosmos1provider","replica_count":"3","type":"stampledger/PinAttestationSignDoc"}`,
+		string(bz),
+	)
+
+	// The CID and the commitment are bound
+	require.NotEqual(t, bz, types.PinAttestationSignBytes("stampledger-1", "cosmos1provider", "42", "bafkother", 3, 5000))
+	require.NotEqual(t, bz, types.PinAttestationSignBytes("stampledger-1", "cosmos1provider", "42", "bafkcid", 2, 5000))
+	require.NotEqual(t, bz, types.PinAttestationSignBytes("stampledger-1", "cosmos1provider", "42", "bafkcid", 3, 5001))
+}
@@ -0,0 +1,49 @@
+package types
+
+import "slices"
+
+// StatusAt returns the stamp's lifecycle status at the given Unix timestamp.
+// Revocation takes precedence over supersession, which takes precedence over
+// expiry; a co-sealed stamp is pending until its threshold is met.
+func (s Stamp) StatusAt(timestamp int64) StampStatus {
+	switch {
+	case s.Revoked:
+		return StampRevoked
+	case s.SupersededBy != "":
+		return StampSuperseded
+	case s.Expired || (s.ValidUntil != 0 && timestamp > s.ValidUntil):
+		return StampExpired
+	case s.Pending:
+		return StampPending
+	default:
+		return StampValid
+	}
+}
+
+// SignerKeys returns every PE public key that sealed or must seal the stamp.
+func (s Stamp) SignerKeys() []string {
+	if len(s.CoSigners) == 0 {
+		return []string{s.PePublicKey}
+	}
+	keys := make([]string, len(s.CoSigners))
+	for i, signer := range s.CoSigners {
+		keys[i] = signer.PePublicKey
+	}
+	return keys
+}
+
+// HasSigner reports whether pePublicKey sealed or must seal the stamp.
+func (s Stamp) HasSigner(pePublicKey string) bool {
+	return slices.Contains(s.SignerKeys(), pePublicKey)
+}
+
+// CoSignatureCount returns how many co-signers have signed the stamp.
+func (s Stamp) CoSignatureCount() uint32 {
+	var count uint32
+	for _, signer := range s.CoSigners {
+		if signer.Signature != "" {
+			count++
+		}
+	}
+	return count
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -24,6 +26,166 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RevocationReason classifies why a stamp was revoked
+type RevocationReason int32
+
+const (
+	// No reason given
+	RevocationUnspecified RevocationReason = 0
+	// The stamped design contains an error
+	RevocationErrorInDesign RevocationReason = 1
+	// The document was replaced off-chain
+	RevocationSuperseded RevocationReason = 2
+	// The PE's stamp key was compromised
+	RevocationKeyCompromise RevocationReason = 3
+	// Disciplinary action by a jurisdiction board
+	RevocationDisciplinary RevocationReason = 4
+	// Administrative action, e.g. the PE left the firm
+	RevocationAdministrative RevocationReason = 5
+)
+
+var RevocationReason_name = map[int32]string{
+	0: "REVOCATION_REASON_UNSPECIFIED",
+	1: "REVOCATION_REASON_ERROR_IN_DESIGN",
+	2: "REVOCATION_REASON_SUPERSEDED",
+	3: "REVOCATION_REASON_KEY_COMPROMISE",
+	4: "REVOCATION_REASON_DISCIPLINARY",
+	5: "REVOCATION_REASON_ADMINISTRATIVE",
+}
+
+var RevocationReason_value = map[string]int32{
+	"REVOCATION_REASON_UNSPECIFIED":     0,
+	"REVOCATION_REASON_ERROR_IN_DESIGN": 1,
+	"REVOCATION_REASON_SUPERSEDED":      2,
+	"REVOCATION_REASON_KEY_COMPROMISE":  3,
+	"REVOCATION_REASON_DISCIPLINARY":    4,
+	"REVOCATION_REASON_ADMINISTRATIVE":  5,
+}
+
+func (x RevocationReason) String() string {
+	return proto.EnumName(RevocationReason_name, int32(x))
+}
+
+func (RevocationReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{0}
+}
+
+// StampStatus is the lifecycle state of a stamp
+type StampStatus int32
+
+const (
+	// Unknown status
+	StampStatusUnspecified StampStatus = 0
+	// In force
+	StampValid StampStatus = 1
+	// Past its valid_until time
+	StampExpired StampStatus = 2
+	// Replaced by a newer stamp
+	StampSuperseded StampStatus = 3
+	// Revoked
+	StampRevoked StampStatus = 4
+	// Co-sealed stamp awaiting signatures
+	StampPending StampStatus = 5
+)
+
+var StampStatus_name = map[int32]string{
+	0: "STAMP_STATUS_UNSPECIFIED",
+	1: "STAMP_STATUS_VALID",
+	2: "STAMP_STATUS_EXPIRED",
+	3: "STAMP_STATUS_SUPERSEDED",
+	4: "STAMP_STATUS_REVOKED",
+	5: "STAMP_STATUS_PENDING",
+}
+
+var StampStatus_value = map[string]int32{
+	"STAMP_STATUS_UNSPECIFIED": 0,
+	"STAMP_STATUS_VALID":       1,
+	"STAMP_STATUS_EXPIRED":     2,
+	"STAMP_STATUS_SUPERSEDED":  3,
+	"STAMP_STATUS_REVOKED":     4,
+	"STAMP_STATUS_PENDING":     5,
+}
+
+func (x StampStatus) String() string {
+	return proto.EnumName(StampStatus_name, int32(x))
+}
+
+func (StampStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{1}
+}
+
+// DocumentRole is what a stored document is to the stamp it is attached to
+type DocumentRole int32
+
+const (
+	// No role given; stored as an attachment
+	DocumentRoleUnspecified DocumentRole = 0
+	// The exact file the PE sealed; its content hash is the stamp's document hash
+	DocumentSealedOriginal DocumentRole = 1
+	// Supporting file
+	DocumentAttachment DocumentRole = 2
+	// Calculation package backing the sealed design
+	DocumentCalcPackage DocumentRole = 3
+	// Marked-up copy showing changes
+	DocumentRedline DocumentRole = 4
+)
+
+var DocumentRole_name = map[int32]string{
+	0: "DOCUMENT_ROLE_UNSPECIFIED",
+	1: "DOCUMENT_ROLE_SEALED_ORIGINAL",
+	2: "DOCUMENT_ROLE_ATTACHMENT",
+	3: "DOCUMENT_ROLE_CALC_PACKAGE",
+	4: "DOCUMENT_ROLE_REDLINE",
+}
+
+var DocumentRole_value = map[string]int32{
+	"DOCUMENT_ROLE_UNSPECIFIED":     0,
+	"DOCUMENT_ROLE_SEALED_ORIGINAL": 1,
+	"DOCUMENT_ROLE_ATTACHMENT":      2,
+	"DOCUMENT_ROLE_CALC_PACKAGE":    3,
+	"DOCUMENT_ROLE_REDLINE":         4,
+}
+
+func (x DocumentRole) String() string {
+	return proto.EnumName(DocumentRole_name, int32(x))
+}
+
+func (DocumentRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{2}
+}
+
+// LicenseStatus is a PE license's standing with its jurisdiction's board
+type LicenseStatus int32
+
+const (
+	// No board attestation on record
+	LicenseUnattested LicenseStatus = 0
+	// Attested and in good standing
+	LicenseActive LicenseStatus = 1
+	// Suspended by the board
+	LicenseSuspended LicenseStatus = 2
+)
+
+var LicenseStatus_name = map[int32]string{
+	0: "LICENSE_STATUS_UNATTESTED",
+	1: "LICENSE_STATUS_ACTIVE",
+	2: "LICENSE_STATUS_SUSPENDED",
+}
+
+var LicenseStatus_value = map[string]int32{
+	"LICENSE_STATUS_UNATTESTED": 0,
+	"LICENSE_STATUS_ACTIVE":     1,
+	"LICENSE_STATUS_SUSPENDED":  2,
+}
+
+func (x LicenseStatus) String() string {
+	return proto.EnumName(LicenseStatus_name, int32(x))
+}
+
+func (LicenseStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{3}
+}
+
 // Stamp represents a PE stamp record on the blockchain
 type Stamp struct {
 	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -44,6 +206,33 @@ type Stamp struct {
 	DocumentIpfsHash string `protobuf:"bytes,14,opt,name=document_ipfs_hash,json=documentIpfsHash,proto3" json:"document_ipfs_hash,omitempty"`
 	DocumentSize     int64  `protobuf:"varint,15,opt,name=document_size,json=documentSize,proto3" json:"document_size,omitempty"`
 	DocumentFilename string `protobuf:"bytes,16,opt,name=document_filename,json=documentFilename,proto3" json:"document_filename,omitempty"`
+	// Signing payload
+	SignBytesVersion uint32 `protobuf:"varint,17,opt,name=sign_bytes_version,json=signBytesVersion,proto3" json:"sign_bytes_version,omitempty"`
+	SignatureExpiry  int64  `protobuf:"varint,18,opt,name=signature_expiry,json=signatureExpiry,proto3" json:"signature_expiry,omitempty"`
+	Nonce            uint64 `protobuf:"varint,19,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	CreatedHeight    int64  `protobuf:"varint,20,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// Validity window and supersession
+	ValidUntil   int64  `protobuf:"varint,21,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	Expired      bool   `protobuf:"varint,22,opt,name=expired,proto3" json:"expired,omitempty"`
+	Supersedes   string `protobuf:"bytes,23,opt,name=supersedes,proto3" json:"supersedes,omitempty"`
+	SupersededBy string `protobuf:"bytes,24,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
+	// Structured revocation
+	RevocationReason       RevocationReason `protobuf:"varint,25,opt,name=revocation_reason,json=revocationReason,proto3,enum=stampledgerchain.stampledgerchain.v1.RevocationReason" json:"revocation_reason,omitempty"`
+	RevocationEvidenceHash string           `protobuf:"bytes,26,opt,name=revocation_evidence_hash,json=revocationEvidenceHash,proto3" json:"revocation_evidence_hash,omitempty"`
+	RevokedBy              string           `protobuf:"bytes,27,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
+	BatchId                string           `protobuf:"bytes,28,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// Merkle-root stamps: document_hash holds the root over merkle_leaf_count
+	// sorted document hashes (see x/stampledgerchain/merkle)
+	MerkleLeafCount uint64 `protobuf:"varint,29,opt,name=merkle_leaf_count,json=merkleLeafCount,proto3" json:"merkle_leaf_count,omitempty"`
+	// Co-sealed stamps: pe_public_key and signature are empty, and the stamp is
+	// pending until co_sign_threshold of co_signers have signed
+	CoSigners       []CoSigner `protobuf:"bytes,30,rep,name=co_signers,json=coSigners,proto3" json:"co_signers"`
+	CoSignThreshold uint32     `protobuf:"varint,31,opt,name=co_sign_threshold,json=coSignThreshold,proto3" json:"co_sign_threshold,omitempty"`
+	Pending         bool       `protobuf:"varint,32,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Delegated stamping
+	PeAccount string `protobuf:"bytes,33,opt,name=pe_account,json=peAccount,proto3" json:"pe_account,omitempty"`
+	Delegate  string `protobuf:"bytes,34,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	EntityId  string `protobuf:"bytes,35,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 }
 
 func (m *Stamp) Reset()         { *m = Stamp{} }
@@ -191,141 +380,162 @@ func (m *Stamp) GetDocumentFilename() string {
 	return ""
 }
 
-// DocumentStorage for immutable document storage
-type DocumentStorage struct {
-	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	StampId    string `protobuf:"bytes,2,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
-	IpfsHash   string `protobuf:"bytes,3,opt,name=ipfs_hash,json=ipfsHash,proto3" json:"ipfs_hash,omitempty"`
-	Filename   string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
-	Size_      int64  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
-	MimeType   string `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	UploadedAt int64  `protobuf:"varint,7,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
-	UploadedBy string `protobuf:"bytes,8,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
-	Pinned     bool   `protobuf:"varint,9,opt,name=pinned,proto3" json:"pinned,omitempty"`
+func (m *Stamp) GetSignBytesVersion() uint32 {
+	if m != nil {
+		return m.SignBytesVersion
+	}
+	return 0
 }
 
-func (m *DocumentStorage) Reset()         { *m = DocumentStorage{} }
-func (m *DocumentStorage) String() string { return proto.CompactTextString(m) }
-func (*DocumentStorage) ProtoMessage()    {}
-func (*DocumentStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd5c3d9e6d4a1f93, []int{1}
-}
-func (m *DocumentStorage) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *Stamp) GetSignatureExpiry() int64 {
+	if m != nil {
+		return m.SignatureExpiry
+	}
+	return 0
 }
-func (m *DocumentStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_DocumentStorage.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Stamp) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
+	return 0
 }
-func (m *DocumentStorage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DocumentStorage.Merge(m, src)
+
+func (m *Stamp) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
 }
-func (m *DocumentStorage) XXX_Size() int {
-	return m.Size()
+
+func (m *Stamp) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
 }
-func (m *DocumentStorage) XXX_DiscardUnknown() {
-	xxx_messageInfo_DocumentStorage.DiscardUnknown(m)
+
+func (m *Stamp) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
 }
 
-var xxx_messageInfo_DocumentStorage proto.InternalMessageInfo
+func (m *Stamp) GetSupersedes() string {
+	if m != nil {
+		return m.Supersedes
+	}
+	return ""
+}
 
-func (m *DocumentStorage) GetId() string {
+func (m *Stamp) GetSupersededBy() string {
 	if m != nil {
-		return m.Id
+		return m.SupersededBy
 	}
 	return ""
 }
 
-func (m *DocumentStorage) GetStampId() string {
+func (m *Stamp) GetRevocationReason() RevocationReason {
 	if m != nil {
-		return m.StampId
+		return m.RevocationReason
+	}
+	return RevocationUnspecified
+}
+
+func (m *Stamp) GetRevocationEvidenceHash() string {
+	if m != nil {
+		return m.RevocationEvidenceHash
 	}
 	return ""
 }
 
-func (m *DocumentStorage) GetIpfsHash() string {
+func (m *Stamp) GetRevokedBy() string {
 	if m != nil {
-		return m.IpfsHash
+		return m.RevokedBy
 	}
 	return ""
 }
 
-func (m *DocumentStorage) GetFilename() string {
+func (m *Stamp) GetBatchId() string {
 	if m != nil {
-		return m.Filename
+		return m.BatchId
 	}
 	return ""
 }
 
-func (m *DocumentStorage) GetSize_() int64 {
+func (m *Stamp) GetMerkleLeafCount() uint64 {
 	if m != nil {
-		return m.Size_
+		return m.MerkleLeafCount
 	}
 	return 0
 }
 
-func (m *DocumentStorage) GetMimeType() string {
+func (m *Stamp) GetCoSigners() []CoSigner {
 	if m != nil {
-		return m.MimeType
+		return m.CoSigners
 	}
-	return ""
+	return nil
 }
 
-func (m *DocumentStorage) GetUploadedAt() int64 {
+func (m *Stamp) GetCoSignThreshold() uint32 {
 	if m != nil {
-		return m.UploadedAt
+		return m.CoSignThreshold
 	}
 	return 0
 }
 
-func (m *DocumentStorage) GetUploadedBy() string {
+func (m *Stamp) GetPending() bool {
 	if m != nil {
-		return m.UploadedBy
+		return m.Pending
+	}
+	return false
+}
+
+func (m *Stamp) GetPeAccount() string {
+	if m != nil {
+		return m.PeAccount
 	}
 	return ""
 }
 
-func (m *DocumentStorage) GetPinned() bool {
+func (m *Stamp) GetDelegate() string {
 	if m != nil {
-		return m.Pinned
+		return m.Delegate
 	}
-	return false
+	return ""
 }
 
-// EntityAccount for organizations (companies, municipalities, firms)
-type EntityAccount struct {
-	Id              string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	EntityType      string   `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
-	OwnerAddress    string   `protobuf:"bytes,4,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	MemberAddresses []string `protobuf:"bytes,5,rep,name=member_addresses,json=memberAddresses,proto3" json:"member_addresses,omitempty"`
-	AdminAddresses  []string `protobuf:"bytes,6,rep,name=admin_addresses,json=adminAddresses,proto3" json:"admin_addresses,omitempty"`
-	CreatedAt       int64    `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Active          bool     `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
-	// Permissions map: address -> role (viewer, editor, admin)
-	Permissions map[string]string `protobuf:"bytes,9,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (m *Stamp) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
 }
 
-func (m *EntityAccount) Reset()         { *m = EntityAccount{} }
-func (m *EntityAccount) String() string { return proto.CompactTextString(m) }
-func (*EntityAccount) ProtoMessage()    {}
-func (*EntityAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd5c3d9e6d4a1f93, []int{2}
+// CoSigner is one of the engineers required to seal a co-sealed stamp
+type CoSigner struct {
+	PePublicKey     string `protobuf:"bytes,1,opt,name=pe_public_key,json=pePublicKey,proto3" json:"pe_public_key,omitempty"`
+	Discipline      string `protobuf:"bytes,2,opt,name=discipline,proto3" json:"discipline,omitempty"`
+	Signature       string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	SignedAt        int64  `protobuf:"varint,4,opt,name=signed_at,json=signedAt,proto3" json:"signed_at,omitempty"`
+	PeName          string `protobuf:"bytes,5,opt,name=pe_name,json=peName,proto3" json:"pe_name,omitempty"`
+	PeLicenseNumber string `protobuf:"bytes,6,opt,name=pe_license_number,json=peLicenseNumber,proto3" json:"pe_license_number,omitempty"`
+	Delegate        string `protobuf:"bytes,7,opt,name=delegate,proto3" json:"delegate,omitempty"`
 }
-func (m *EntityAccount) XXX_Unmarshal(b []byte) error {
+
+func (m *CoSigner) Reset()         { *m = CoSigner{} }
+func (m *CoSigner) String() string { return proto.CompactTextString(m) }
+func (*CoSigner) ProtoMessage()    {}
+func (*CoSigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{1}
+}
+func (m *CoSigner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EntityAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CoSigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EntityAccount.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CoSigner.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -335,106 +545,90 @@ func (m *EntityAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *EntityAccount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntityAccount.Merge(m, src)
+func (m *CoSigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoSigner.Merge(m, src)
 }
-func (m *EntityAccount) XXX_Size() int {
+func (m *CoSigner) XXX_Size() int {
 	return m.Size()
 }
-func (m *EntityAccount) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntityAccount.DiscardUnknown(m)
+func (m *CoSigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoSigner.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntityAccount proto.InternalMessageInfo
-
-func (m *EntityAccount) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
+var xxx_messageInfo_CoSigner proto.InternalMessageInfo
 
-func (m *EntityAccount) GetName() string {
+func (m *CoSigner) GetPePublicKey() string {
 	if m != nil {
-		return m.Name
+		return m.PePublicKey
 	}
 	return ""
 }
 
-func (m *EntityAccount) GetEntityType() string {
+func (m *CoSigner) GetDiscipline() string {
 	if m != nil {
-		return m.EntityType
+		return m.Discipline
 	}
 	return ""
 }
 
-func (m *EntityAccount) GetOwnerAddress() string {
+func (m *CoSigner) GetSignature() string {
 	if m != nil {
-		return m.OwnerAddress
+		return m.Signature
 	}
 	return ""
 }
 
-func (m *EntityAccount) GetMemberAddresses() []string {
-	if m != nil {
-		return m.MemberAddresses
-	}
-	return nil
-}
-
-func (m *EntityAccount) GetAdminAddresses() []string {
+func (m *CoSigner) GetSignedAt() int64 {
 	if m != nil {
-		return m.AdminAddresses
+		return m.SignedAt
 	}
-	return nil
+	return 0
 }
 
-func (m *EntityAccount) GetCreatedAt() int64 {
+func (m *CoSigner) GetPeName() string {
 	if m != nil {
-		return m.CreatedAt
+		return m.PeName
 	}
-	return 0
+	return ""
 }
 
-func (m *EntityAccount) GetActive() bool {
+func (m *CoSigner) GetPeLicenseNumber() string {
 	if m != nil {
-		return m.Active
+		return m.PeLicenseNumber
 	}
-	return false
+	return ""
 }
 
-func (m *EntityAccount) GetPermissions() map[string]string {
+func (m *CoSigner) GetDelegate() string {
 	if m != nil {
-		return m.Permissions
+		return m.Delegate
 	}
-	return nil
+	return ""
 }
 
-// SpecVersion for specification tracking with version history
-type SpecVersion struct {
-	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId       string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Version         string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	SpecHash        string `protobuf:"bytes,4,opt,name=spec_hash,json=specHash,proto3" json:"spec_hash,omitempty"`
-	SpecIpfs        string `protobuf:"bytes,5,opt,name=spec_ipfs,json=specIpfs,proto3" json:"spec_ipfs,omitempty"`
-	CreatedAt       int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CreatedBy       string `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	Changelog       string `protobuf:"bytes,8,opt,name=changelog,proto3" json:"changelog,omitempty"`
-	ParentVersionId string `protobuf:"bytes,9,opt,name=parent_version_id,json=parentVersionId,proto3" json:"parent_version_id,omitempty"`
+// StampBatch groups the stamps of a drawing set created in one transaction
+type StampBatch struct {
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator        string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	PePublicKey    string `protobuf:"bytes,3,opt,name=pe_public_key,json=pePublicKey,proto3" json:"pe_public_key,omitempty"`
+	JurisdictionId string `protobuf:"bytes,4,opt,name=jurisdiction_id,json=jurisdictionId,proto3" json:"jurisdiction_id,omitempty"`
+	ProjectName    string `protobuf:"bytes,5,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	CreatedAt      int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StampCount     uint32 `protobuf:"varint,7,opt,name=stamp_count,json=stampCount,proto3" json:"stamp_count,omitempty"`
 }
 
-func (m *SpecVersion) Reset()         { *m = SpecVersion{} }
-func (m *SpecVersion) String() string { return proto.CompactTextString(m) }
-func (*SpecVersion) ProtoMessage()    {}
-func (*SpecVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd5c3d9e6d4a1f93, []int{3}
+func (m *StampBatch) Reset()         { *m = StampBatch{} }
+func (m *StampBatch) String() string { return proto.CompactTextString(m) }
+func (*StampBatch) ProtoMessage()    {}
+func (*StampBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{2}
 }
-func (m *SpecVersion) XXX_Unmarshal(b []byte) error {
+func (m *StampBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SpecVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StampBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SpecVersion.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StampBatch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -444,1009 +638,7850 @@ func (m *SpecVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SpecVersion) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SpecVersion.Merge(m, src)
+func (m *StampBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StampBatch.Merge(m, src)
 }
-func (m *SpecVersion) XXX_Size() int {
+func (m *StampBatch) XXX_Size() int {
 	return m.Size()
 }
-func (m *SpecVersion) XXX_DiscardUnknown() {
-	xxx_messageInfo_SpecVersion.DiscardUnknown(m)
+func (m *StampBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_StampBatch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SpecVersion proto.InternalMessageInfo
+var xxx_messageInfo_StampBatch proto.InternalMessageInfo
 
-func (m *SpecVersion) GetId() string {
+func (m *StampBatch) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *SpecVersion) GetProjectId() string {
+func (m *StampBatch) GetCreator() string {
 	if m != nil {
-		return m.ProjectId
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *SpecVersion) GetVersion() string {
+func (m *StampBatch) GetPePublicKey() string {
 	if m != nil {
-		return m.Version
+		return m.PePublicKey
 	}
 	return ""
 }
 
-func (m *SpecVersion) GetSpecHash() string {
+func (m *StampBatch) GetJurisdictionId() string {
 	if m != nil {
-		return m.SpecHash
+		return m.JurisdictionId
 	}
 	return ""
 }
 
-func (m *SpecVersion) GetSpecIpfs() string {
+func (m *StampBatch) GetProjectName() string {
 	if m != nil {
-		return m.SpecIpfs
+		return m.ProjectName
 	}
 	return ""
 }
 
-func (m *SpecVersion) GetCreatedAt() int64 {
+func (m *StampBatch) GetCreatedAt() int64 {
 	if m != nil {
 		return m.CreatedAt
 	}
 	return 0
 }
 
-func (m *SpecVersion) GetCreatedBy() string {
-	if m != nil {
-		return m.CreatedBy
-	}
-	return ""
-}
-
-func (m *SpecVersion) GetChangelog() string {
+func (m *StampBatch) GetStampCount() uint32 {
 	if m != nil {
-		return m.Changelog
+		return m.StampCount
 	}
-	return ""
+	return 0
 }
 
-func (m *SpecVersion) GetParentVersionId() string {
-	if m != nil {
-		return m.ParentVersionId
-	}
-	return ""
+// DocumentStorage for immutable document storage
+type DocumentStorage struct {
+	Id               string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StampId          string       `protobuf:"bytes,2,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
+	IpfsHash         string       `protobuf:"bytes,3,opt,name=ipfs_hash,json=ipfsHash,proto3" json:"ipfs_hash,omitempty"`
+	Filename         string       `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	Size_            int64        `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	MimeType         string       `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	UploadedAt       int64        `protobuf:"varint,7,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	UploadedBy       string       `protobuf:"bytes,8,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	Pinned           bool         `protobuf:"varint,9,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	ContentSha256    string       `protobuf:"bytes,10,opt,name=content_sha256,json=contentSha256,proto3" json:"content_sha256,omitempty"`
+	Role             DocumentRole `protobuf:"varint,11,opt,name=role,proto3,enum=stampledgerchain.stampledgerchain.v1.DocumentRole" json:"role,omitempty"`
+	PinExpiresHeight int64        `protobuf:"varint,12,opt,name=pin_expires_height,json=pinExpiresHeight,proto3" json:"pin_expires_height,omitempty"`
+	EntityId         string       `protobuf:"bytes,13,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*Stamp)(nil), "stampledgerchain.stampledgerchain.v1.Stamp")
-	proto.RegisterType((*DocumentStorage)(nil), "stampledgerchain.stampledgerchain.v1.DocumentStorage")
-	proto.RegisterType((*EntityAccount)(nil), "stampledgerchain.stampledgerchain.v1.EntityAccount")
-	proto.RegisterMapType((map[string]string)(nil), "stampledgerchain.stampledgerchain.v1.EntityAccount.PermissionsEntry")
-	proto.RegisterType((*SpecVersion)(nil), "stampledgerchain.stampledgerchain.v1.SpecVersion")
+func (m *DocumentStorage) Reset()         { *m = DocumentStorage{} }
+func (m *DocumentStorage) String() string { return proto.CompactTextString(m) }
+func (*DocumentStorage) ProtoMessage()    {}
+func (*DocumentStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{3}
 }
-
-func init() {
-	proto.RegisterFile("stampledgerchain/stampledgerchain/v1/stamp.proto", fileDescriptor_cd5c3d9e6d4a1f93)
+func (m *DocumentStorage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-var fileDescriptor_cd5c3d9e6d4a1f93 = []byte{
-	// 862 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0x8e, 0xff, 0x3d, 0xe5, 0xd8, 0x71, 0x5a, 0xab, 0x65, 0x08, 0xac, 0xd7, 0x64, 0x41, 0x84,
-	0x05, 0x1c, 0x16, 0x2e, 0x68, 0x0f, 0x48, 0x8e, 0xb2, 0x08, 0x0b, 0xb4, 0x5a, 0x39, 0x88, 0x03,
-	0x97, 0x51, 0x7b, 0xa6, 0x62, 0xf7, 0xae, 0xa7, 0xa7, 0x35, 0xdd, 0x36, 0x78, 0x1f, 0x80, 0x33,
-	0x4f, 0x80, 0x78, 0x02, 0x0e, 0x3c, 0x05, 0xc7, 0x1c, 0x39, 0xa2, 0xe4, 0xc2, 0x63, 0xa0, 0xae,
-	0xee, 0xb1, 0x9d, 0xf8, 0xc0, 0x5e, 0xac, 0xae, 0xaf, 0xbe, 0x2e, 0x57, 0x57, 0x7d, 0x55, 0x03,
-	0x9f, 0x69, 0xc3, 0x53, 0x35, 0xc7, 0x64, 0x8a, 0x79, 0x3c, 0xe3, 0x42, 0x9e, 0xee, 0x00, 0xcb,
-	0x27, 0x0e, 0x1b, 0xa8, 0x3c, 0x33, 0x19, 0x7b, 0xff, 0x2e, 0x61, 0xb0, 0x03, 0x2c, 0x9f, 0x1c,
-	0x1d, 0xf2, 0x54, 0xc8, 0xec, 0x94, 0x7e, 0xdd, 0xc5, 0xa3, 0x7b, 0xd3, 0x6c, 0x9a, 0xd1, 0xf1,
-	0xd4, 0x9e, 0x1c, 0x7a, 0xfc, 0x47, 0x15, 0x6a, 0x17, 0x36, 0x00, 0xeb, 0x40, 0x59, 0x24, 0x61,
-	0xa9, 0x5f, 0x3a, 0x09, 0xc6, 0x65, 0x91, 0xb0, 0x47, 0xd0, 0x4e, 0xb2, 0x78, 0x91, 0xa2, 0x34,
-	0xd1, 0x8c, 0xeb, 0x59, 0x58, 0x26, 0xd7, 0x7e, 0x01, 0x7e, 0xc3, 0xf5, 0x8c, 0x1d, 0x43, 0x5b,
-	0x61, 0xa4, 0x16, 0x93, 0xb9, 0x88, 0xa3, 0x57, 0xb8, 0x0a, 0x2b, 0x44, 0x6a, 0x29, 0x7c, 0x41,
-	0xd8, 0xb7, 0xb8, 0x62, 0xef, 0x42, 0xa0, 0xc5, 0x54, 0x72, 0xb3, 0xc8, 0x31, 0xac, 0x92, 0x7f,
-	0x03, 0xb0, 0x0f, 0xe1, 0xe0, 0xe5, 0x22, 0x17, 0x3a, 0x11, 0xb1, 0x11, 0x99, 0x8c, 0x44, 0x12,
-	0xd6, 0x88, 0xd3, 0xd9, 0x86, 0x47, 0x09, 0x7b, 0x00, 0x10, 0xe7, 0xc8, 0x0d, 0x26, 0x11, 0x37,
-	0x61, 0xbd, 0x5f, 0x3a, 0xa9, 0x8c, 0x03, 0x8f, 0x0c, 0x0d, 0x0b, 0xa1, 0x41, 0x46, 0x96, 0x87,
-	0x0d, 0xba, 0x5f, 0x98, 0xd6, 0x93, 0xe3, 0x32, 0x7b, 0x85, 0x49, 0xd8, 0xec, 0x97, 0x4e, 0x9a,
-	0xe3, 0xc2, 0xb4, 0x21, 0xfd, 0xd1, 0x86, 0x0c, 0x5c, 0x48, 0x8f, 0x0c, 0x0d, 0xfb, 0x00, 0x3a,
-	0x85, 0x3b, 0x47, 0xae, 0x33, 0x19, 0x02, 0x45, 0x6e, 0x7b, 0x74, 0x4c, 0x20, 0x7b, 0x0c, 0x87,
-	0x0a, 0xa3, 0xb9, 0x88, 0x51, 0x6a, 0x8c, 0xe4, 0x22, 0x9d, 0x60, 0x1e, 0xb6, 0x88, 0x79, 0xa0,
-	0xf0, 0x3b, 0x87, 0x3f, 0x27, 0x98, 0xbd, 0x05, 0x0d, 0x85, 0x91, 0xe4, 0x29, 0x86, 0xfb, 0xc4,
-	0xa8, 0x2b, 0x7c, 0xce, 0x53, 0x64, 0xef, 0xc1, 0xbe, 0xca, 0xb3, 0x97, 0x18, 0x1b, 0xe7, 0x6d,
-	0xfb, 0x3a, 0x3a, 0x8c, 0x28, 0x9f, 0x00, 0x5b, 0x37, 0x44, 0xa8, 0x4b, 0xed, 0xba, 0xd2, 0x21,
-	0x62, 0xb7, 0xf0, 0x8c, 0xd4, 0xa5, 0xa6, 0xce, 0x6c, 0xb7, 0x4f, 0x8b, 0xd7, 0x18, 0x1e, 0xd0,
-	0xf3, 0xd6, 0xed, 0xbb, 0x10, 0xaf, 0x91, 0x7d, 0x0c, 0x87, 0x6b, 0xd2, 0xa5, 0x98, 0x23, 0xfd,
-	0x75, 0xf7, 0x76, 0xc4, 0xaf, 0x3d, 0xfe, 0xb4, 0xfa, 0xef, 0xef, 0x0f, 0x4b, 0xc7, 0xbf, 0x94,
-	0xe1, 0xe0, 0xbc, 0x88, 0x61, 0xb2, 0x9c, 0x4f, 0x71, 0x47, 0x3a, 0x6f, 0x43, 0x93, 0x44, 0x69,
-	0x9b, 0xe9, 0x54, 0xd3, 0x20, 0x7b, 0x94, 0xb0, 0x77, 0x20, 0xd8, 0xe4, 0xee, 0xc4, 0xd2, 0x14,
-	0x45, 0xce, 0x47, 0xd0, 0x5c, 0x67, 0xe1, 0x84, 0xb2, 0xb6, 0x19, 0x83, 0x2a, 0x3d, 0xa3, 0x46,
-	0xcf, 0xa0, 0xb3, 0x0d, 0x96, 0x8a, 0x14, 0x23, 0xb3, 0x52, 0x48, 0x8a, 0x08, 0xc6, 0x4d, 0x0b,
-	0x7c, 0xbf, 0x52, 0xc8, 0x1e, 0x42, 0x6b, 0xa1, 0xe6, 0x19, 0x4f, 0x5c, 0x77, 0x1b, 0x74, 0x0f,
-	0x0a, 0x68, 0x68, 0x6e, 0x11, 0x26, 0x2b, 0xd2, 0x46, 0xb0, 0x21, 0x9c, 0xad, 0xd8, 0x7d, 0xa8,
-	0x2b, 0x21, 0x25, 0x26, 0x24, 0x8d, 0xe6, 0xd8, 0x5b, 0xbe, 0x10, 0x7f, 0x56, 0xa0, 0xfd, 0x4c,
-	0x1a, 0x61, 0x56, 0xc3, 0x38, 0xce, 0x16, 0xd2, 0xec, 0x94, 0x81, 0x41, 0x95, 0x9e, 0xe2, 0x4a,
-	0x40, 0x67, 0xfb, 0xa7, 0x48, 0x97, 0x5c, 0xd2, 0xae, 0x02, 0xe0, 0x20, 0x4a, 0xfb, 0x11, 0xb4,
-	0xb3, 0x9f, 0x24, 0xe6, 0x11, 0x4f, 0x92, 0x1c, 0xb5, 0xf6, 0x85, 0xd8, 0x27, 0x70, 0xe8, 0x30,
-	0xf6, 0x11, 0x74, 0x53, 0xb4, 0x82, 0x2a, 0x58, 0xa8, 0xc3, 0x5a, 0xbf, 0x62, 0x15, 0xe7, 0xf0,
-	0x61, 0x01, 0xdb, 0xf9, 0xe2, 0x49, 0x2a, 0xe4, 0x16, 0xb3, 0x4e, 0xcc, 0x0e, 0xc1, 0x1b, 0xe2,
-	0xed, 0xf9, 0x6a, 0xdc, 0x9d, 0xaf, 0xfb, 0x50, 0xe7, 0xb1, 0x11, 0x4b, 0xf4, 0x43, 0xe4, 0x2d,
-	0x76, 0x09, 0x2d, 0x85, 0x79, 0x2a, 0xb4, 0x16, 0x99, 0xd4, 0x61, 0xd0, 0xaf, 0x9c, 0xb4, 0x3e,
-	0x3f, 0x1f, 0xbc, 0xc9, 0x96, 0x1a, 0xdc, 0x2a, 0xdf, 0xe0, 0xc5, 0x26, 0xcc, 0x33, 0x69, 0xf2,
-	0xd5, 0x78, 0x3b, 0xf0, 0xd1, 0x57, 0xd0, 0xbd, 0x4b, 0x60, 0x5d, 0xa8, 0xd8, 0x9d, 0xe3, 0x2a,
-	0x6e, 0x8f, 0xec, 0x1e, 0xd4, 0x96, 0x7c, 0xbe, 0x28, 0x6a, 0xee, 0x8c, 0xa7, 0xe5, 0x2f, 0x4b,
-	0xbe, 0x69, 0xbf, 0x95, 0xa1, 0x75, 0xa1, 0x30, 0xfe, 0x01, 0x73, 0x1b, 0x67, 0xa7, 0x65, 0x0f,
-	0x00, 0x8a, 0x31, 0x5c, 0x6b, 0x37, 0xf0, 0xc8, 0x28, 0xb1, 0xab, 0x64, 0xe9, 0x6e, 0xfa, 0xce,
-	0x15, 0xa6, 0x95, 0xa2, 0x56, 0x18, 0x3b, 0x5d, 0x7b, 0xed, 0x5a, 0x80, 0x74, 0x5d, 0x38, 0xad,
-	0xd0, 0xfd, 0x76, 0x23, 0xa7, 0x1d, 0xd6, 0xff, 0xdb, 0x6b, 0x5b, 0xee, 0xc9, 0xca, 0xaf, 0xb6,
-	0xc2, 0x7d, 0x46, 0xcb, 0x35, 0x9e, 0x71, 0x39, 0xc5, 0x79, 0x36, 0xf5, 0x12, 0xde, 0x00, 0xb4,
-	0x9a, 0x78, 0x6e, 0xa7, 0xdb, 0xe7, 0x69, 0x5f, 0x15, 0xf8, 0xd5, 0x44, 0x0e, 0x5f, 0x88, 0x91,
-	0x57, 0xf5, 0xd9, 0xf9, 0x5f, 0xd7, 0xbd, 0xd2, 0xd5, 0x75, 0xaf, 0xf4, 0xcf, 0x75, 0xaf, 0xf4,
-	0xeb, 0x4d, 0x6f, 0xef, 0xea, 0xa6, 0xb7, 0xf7, 0xf7, 0x4d, 0x6f, 0xef, 0xc7, 0xc7, 0x5b, 0x1d,
-	0xfc, 0xd4, 0x7d, 0x9a, 0x7e, 0xde, 0xfd, 0x5a, 0x59, 0x55, 0xeb, 0x49, 0x9d, 0x3e, 0x2e, 0x5f,
-	0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x18, 0xd2, 0xdb, 0xcb, 0xdf, 0x06, 0x00, 0x00,
+func (m *DocumentStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DocumentStorage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DocumentStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentStorage.Merge(m, src)
+}
+func (m *DocumentStorage) XXX_Size() int {
+	return m.Size()
+}
+func (m *DocumentStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentStorage.DiscardUnknown(m)
 }
 
-func (this *Stamp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_DocumentStorage proto.InternalMessageInfo
+
+func (m *DocumentStorage) GetId() string {
+	if m != nil {
+		return m.Id
 	}
+	return ""
+}
 
-	that1, ok := that.(*Stamp)
-	if !ok {
-		that2, ok := that.(Stamp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *DocumentStorage) GetStampId() string {
+	if m != nil {
+		return m.StampId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *DocumentStorage) GetIpfsHash() string {
+	if m != nil {
+		return m.IpfsHash
 	}
-	if this.Id != that1.Id {
-		return false
+	return ""
+}
+
+func (m *DocumentStorage) GetFilename() string {
+	if m != nil {
+		return m.Filename
 	}
-	if this.DocumentHash != that1.DocumentHash {
-		return false
+	return ""
+}
+
+func (m *DocumentStorage) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
 	}
-	if this.PePublicKey != that1.PePublicKey {
-		return false
+	return 0
+}
+
+func (m *DocumentStorage) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
 	}
-	if this.Signature != that1.Signature {
-		return false
+	return ""
+}
+
+func (m *DocumentStorage) GetUploadedAt() int64 {
+	if m != nil {
+		return m.UploadedAt
 	}
-	if this.JurisdictionId != that1.JurisdictionId {
-		return false
+	return 0
+}
+
+func (m *DocumentStorage) GetUploadedBy() string {
+	if m != nil {
+		return m.UploadedBy
 	}
-	if this.CreatedAt != that1.CreatedAt {
-		return false
+	return ""
+}
+
+func (m *DocumentStorage) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
 	}
-	if this.Creator != that1.Creator {
-		return false
+	return false
+}
+
+func (m *DocumentStorage) GetContentSha256() string {
+	if m != nil {
+		return m.ContentSha256
 	}
-	if this.Revoked != that1.Revoked {
-		return false
+	return ""
+}
+
+func (m *DocumentStorage) GetRole() DocumentRole {
+	if m != nil {
+		return m.Role
 	}
-	if this.RevokedAt != that1.RevokedAt {
-		return false
+	return DocumentRoleUnspecified
+}
+
+func (m *DocumentStorage) GetPinExpiresHeight() int64 {
+	if m != nil {
+		return m.PinExpiresHeight
 	}
-	if this.RevokedReason != that1.RevokedReason {
-		return false
+	return 0
+}
+
+func (m *DocumentStorage) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
 	}
-	if this.PeLicenseNumber != that1.PeLicenseNumber {
-		return false
+	return ""
+}
+
+// PinAttestation is a pinning provider's signed statement that it holds a
+// document, the on-chain record of its storage deal
+type PinAttestation struct {
+	DocumentId        string `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Provider          string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ReplicaCount      uint32 `protobuf:"varint,3,opt,name=replica_count,json=replicaCount,proto3" json:"replica_count,omitempty"`
+	PinnedUntilHeight int64  `protobuf:"varint,4,opt,name=pinned_until_height,json=pinnedUntilHeight,proto3" json:"pinned_until_height,omitempty"`
+	AttestedHeight    int64  `protobuf:"varint,5,opt,name=attested_height,json=attestedHeight,proto3" json:"attested_height,omitempty"`
+	Signature         string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *PinAttestation) Reset()         { *m = PinAttestation{} }
+func (m *PinAttestation) String() string { return proto.CompactTextString(m) }
+func (*PinAttestation) ProtoMessage()    {}
+func (*PinAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{4}
+}
+func (m *PinAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PinAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PinAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.PeName != that1.PeName {
-		return false
+}
+func (m *PinAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinAttestation.Merge(m, src)
+}
+func (m *PinAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PinAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PinAttestation proto.InternalMessageInfo
+
+func (m *PinAttestation) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
 	}
-	if this.ProjectName != that1.ProjectName {
-		return false
+	return ""
+}
+
+func (m *PinAttestation) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	if this.DocumentIpfsHash != that1.DocumentIpfsHash {
-		return false
+	return ""
+}
+
+func (m *PinAttestation) GetReplicaCount() uint32 {
+	if m != nil {
+		return m.ReplicaCount
 	}
-	if this.DocumentSize != that1.DocumentSize {
-		return false
+	return 0
+}
+
+func (m *PinAttestation) GetPinnedUntilHeight() int64 {
+	if m != nil {
+		return m.PinnedUntilHeight
 	}
-	if this.DocumentFilename != that1.DocumentFilename {
-		return false
+	return 0
+}
+
+func (m *PinAttestation) GetAttestedHeight() int64 {
+	if m != nil {
+		return m.AttestedHeight
 	}
-	return true
+	return 0
 }
-func (this *DocumentStorage) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PinAttestation) GetSignature() string {
+	if m != nil {
+		return m.Signature
 	}
+	return ""
+}
 
-	that1, ok := that.(*DocumentStorage)
-	if !ok {
-		that2, ok := that.(DocumentStorage)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// EntityAccount for organizations (companies, municipalities, firms)
+type EntityAccount struct {
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	EntityType   string `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	OwnerAddress string `protobuf:"bytes,4,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	CreatedAt    int64  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Active       bool   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	// Membership embedded before the EntityMembers store. Only entities
+	// written before the v2 migration carry these; the migration and
+	// InitGenesis move them into EntityMember records.
+	MemberAddresses []string          `protobuf:"bytes,5,rep,name=member_addresses,json=memberAddresses,proto3" json:"member_addresses,omitempty"`                                                          // Deprecated: Do not use.
+	AdminAddresses  []string          `protobuf:"bytes,6,rep,name=admin_addresses,json=adminAddresses,proto3" json:"admin_addresses,omitempty"`                                                             // Deprecated: Do not use.
+	Permissions     map[string]string `protobuf:"bytes,9,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Deprecated: Do not use.
+	// Fee sponsorship
+	TreasuryAddress  string                                   `protobuf:"bytes,10,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+	MemberMonthlyCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=member_monthly_cap,json=memberMonthlyCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"member_monthly_cap"`
+	Metadata         string                                   `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PendingOwner     string                                   `protobuf:"bytes,13,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+}
+
+func (m *EntityAccount) Reset()         { *m = EntityAccount{} }
+func (m *EntityAccount) String() string { return proto.CompactTextString(m) }
+func (*EntityAccount) ProtoMessage()    {}
+func (*EntityAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{5}
+}
+func (m *EntityAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntityAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntityAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *EntityAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityAccount.Merge(m, src)
+}
+func (m *EntityAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntityAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntityAccount proto.InternalMessageInfo
+
+func (m *EntityAccount) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if this.Id != that1.Id {
-		return false
+	return ""
+}
+
+func (m *EntityAccount) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if this.StampId != that1.StampId {
-		return false
-	}
-	if this.IpfsHash != that1.IpfsHash {
-		return false
-	}
-	if this.Filename != that1.Filename {
-		return false
-	}
-	if this.Size_ != that1.Size_ {
-		return false
-	}
-	if this.MimeType != that1.MimeType {
-		return false
-	}
-	if this.UploadedAt != that1.UploadedAt {
-		return false
-	}
-	if this.UploadedBy != that1.UploadedBy {
-		return false
-	}
-	if this.Pinned != that1.Pinned {
-		return false
+	return ""
+}
+
+func (m *EntityAccount) GetEntityType() string {
+	if m != nil {
+		return m.EntityType
 	}
-	return true
+	return ""
 }
-func (this *EntityAccount) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EntityAccount) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
+	return ""
+}
 
-	that1, ok := that.(*EntityAccount)
-	if !ok {
-		that2, ok := that.(EntityAccount)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *EntityAccount) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *EntityAccount) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	if this.Id != that1.Id {
-		return false
+	return false
+}
+
+// Deprecated: Do not use.
+func (m *EntityAccount) GetMemberAddresses() []string {
+	if m != nil {
+		return m.MemberAddresses
 	}
-	if this.Name != that1.Name {
-		return false
+	return nil
+}
+
+// Deprecated: Do not use.
+func (m *EntityAccount) GetAdminAddresses() []string {
+	if m != nil {
+		return m.AdminAddresses
 	}
-	if this.EntityType != that1.EntityType {
-		return false
+	return nil
+}
+
+// Deprecated: Do not use.
+func (m *EntityAccount) GetPermissions() map[string]string {
+	if m != nil {
+		return m.Permissions
 	}
-	if this.OwnerAddress != that1.OwnerAddress {
-		return false
+	return nil
+}
+
+func (m *EntityAccount) GetTreasuryAddress() string {
+	if m != nil {
+		return m.TreasuryAddress
 	}
-	if len(this.MemberAddresses) != len(that1.MemberAddresses) {
-		return false
+	return ""
+}
+
+func (m *EntityAccount) GetMemberMonthlyCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MemberMonthlyCap
 	}
-	for i := range this.MemberAddresses {
-		if this.MemberAddresses[i] != that1.MemberAddresses[i] {
-			return false
-		}
+	return nil
+}
+
+func (m *EntityAccount) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(this.AdminAddresses) != len(that1.AdminAddresses) {
-		return false
+	return ""
+}
+
+func (m *EntityAccount) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
 	}
-	for i := range this.AdminAddresses {
-		if this.AdminAddresses[i] != that1.AdminAddresses[i] {
-			return false
+	return ""
+}
+
+// EntityMember is an address's membership of an entity
+type EntityMember struct {
+	EntityId     string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Role         string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	JoinedHeight int64  `protobuf:"varint,4,opt,name=joined_height,json=joinedHeight,proto3" json:"joined_height,omitempty"`
+}
+
+func (m *EntityMember) Reset()         { *m = EntityMember{} }
+func (m *EntityMember) String() string { return proto.CompactTextString(m) }
+func (*EntityMember) ProtoMessage()    {}
+func (*EntityMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{6}
+}
+func (m *EntityMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntityMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntityMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.CreatedAt != that1.CreatedAt {
-		return false
-	}
-	if this.Active != that1.Active {
-		return false
+}
+func (m *EntityMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityMember.Merge(m, src)
+}
+func (m *EntityMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntityMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntityMember proto.InternalMessageInfo
+
+func (m *EntityMember) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
 	}
-	if len(this.Permissions) != len(that1.Permissions) {
-		return false
+	return ""
+}
+
+func (m *EntityMember) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	for i := range this.Permissions {
-		if this.Permissions[i] != that1.Permissions[i] {
-			return false
-		}
+	return ""
+}
+
+func (m *EntityMember) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return true
+	return ""
 }
-func (this *SpecVersion) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EntityMember) GetJoinedHeight() int64 {
+	if m != nil {
+		return m.JoinedHeight
 	}
+	return 0
+}
 
-	that1, ok := that.(*SpecVersion)
-	if !ok {
-		that2, ok := that.(SpecVersion)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// EntityInvite is an open invitation for an address to join an entity
+type EntityInvite struct {
+	EntityId      string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Invitee       string `protobuf:"bytes,2,opt,name=invitee,proto3" json:"invitee,omitempty"`
+	Role          string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	InvitedBy     string `protobuf:"bytes,4,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	CreatedHeight int64  `protobuf:"varint,5,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	ExpiresHeight int64  `protobuf:"varint,6,opt,name=expires_height,json=expiresHeight,proto3" json:"expires_height,omitempty"`
+}
+
+func (m *EntityInvite) Reset()         { *m = EntityInvite{} }
+func (m *EntityInvite) String() string { return proto.CompactTextString(m) }
+func (*EntityInvite) ProtoMessage()    {}
+func (*EntityInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{7}
+}
+func (m *EntityInvite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntityInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntityInvite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.ProjectId != that1.ProjectId {
-		return false
-	}
-	if this.Version != that1.Version {
-		return false
-	}
-	if this.SpecHash != that1.SpecHash {
-		return false
-	}
-	if this.SpecIpfs != that1.SpecIpfs {
-		return false
+}
+func (m *EntityInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityInvite.Merge(m, src)
+}
+func (m *EntityInvite) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntityInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityInvite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntityInvite proto.InternalMessageInfo
+
+func (m *EntityInvite) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
 	}
-	if this.CreatedAt != that1.CreatedAt {
-		return false
+	return ""
+}
+
+func (m *EntityInvite) GetInvitee() string {
+	if m != nil {
+		return m.Invitee
 	}
-	if this.CreatedBy != that1.CreatedBy {
-		return false
+	return ""
+}
+
+func (m *EntityInvite) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	if this.Changelog != that1.Changelog {
-		return false
+	return ""
+}
+
+func (m *EntityInvite) GetInvitedBy() string {
+	if m != nil {
+		return m.InvitedBy
 	}
-	if this.ParentVersionId != that1.ParentVersionId {
-		return false
+	return ""
+}
+
+func (m *EntityInvite) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	return true
+	return 0
 }
-func (m *Stamp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *EntityInvite) GetExpiresHeight() int64 {
+	if m != nil {
+		return m.ExpiresHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Stamp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MemberFeeUsage tracks the fees an entity treasury has paid for one member
+// in the current calendar month
+type MemberFeeUsage struct {
+	EntityId string                                   `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Member   string                                   `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	Period   string                                   `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Spent    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
 }
 
-func (m *Stamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DocumentFilename) > 0 {
-		i -= len(m.DocumentFilename)
-		copy(dAtA[i:], m.DocumentFilename)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.DocumentFilename)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if m.DocumentSize != 0 {
-		i = encodeVarintStamp(dAtA, i, uint64(m.DocumentSize))
-		i--
-		dAtA[i] = 0x78
-	}
-	if len(m.DocumentIpfsHash) > 0 {
-		i -= len(m.DocumentIpfsHash)
-		copy(dAtA[i:], m.DocumentIpfsHash)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.DocumentIpfsHash)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.ProjectName) > 0 {
-		i -= len(m.ProjectName)
-		copy(dAtA[i:], m.ProjectName)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.ProjectName)))
-		i--
-		dAtA[i] = 0x6a
+func (m *MemberFeeUsage) Reset()         { *m = MemberFeeUsage{} }
+func (m *MemberFeeUsage) String() string { return proto.CompactTextString(m) }
+func (*MemberFeeUsage) ProtoMessage()    {}
+func (*MemberFeeUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{8}
+}
+func (m *MemberFeeUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberFeeUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberFeeUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PeName) > 0 {
-		i -= len(m.PeName)
-		copy(dAtA[i:], m.PeName)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.PeName)))
-		i--
-		dAtA[i] = 0x62
+}
+func (m *MemberFeeUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberFeeUsage.Merge(m, src)
+}
+func (m *MemberFeeUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberFeeUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberFeeUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberFeeUsage proto.InternalMessageInfo
+
+func (m *MemberFeeUsage) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
 	}
-	if len(m.PeLicenseNumber) > 0 {
-		i -= len(m.PeLicenseNumber)
-		copy(dAtA[i:], m.PeLicenseNumber)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.PeLicenseNumber)))
-		i--
-		dAtA[i] = 0x5a
+	return ""
+}
+
+func (m *MemberFeeUsage) GetMember() string {
+	if m != nil {
+		return m.Member
 	}
-	if len(m.RevokedReason) > 0 {
-		i -= len(m.RevokedReason)
-		copy(dAtA[i:], m.RevokedReason)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.RevokedReason)))
-		i--
-		dAtA[i] = 0x52
+	return ""
+}
+
+func (m *MemberFeeUsage) GetPeriod() string {
+	if m != nil {
+		return m.Period
 	}
-	if m.RevokedAt != 0 {
-		i = encodeVarintStamp(dAtA, i, uint64(m.RevokedAt))
-		i--
-		dAtA[i] = 0x48
+	return ""
+}
+
+func (m *MemberFeeUsage) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
 	}
-	if m.Revoked {
-		i--
-		if m.Revoked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+// SpecVersion for specification tracking with version history
+type SpecVersion struct {
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId       string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Version         string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	SpecHash        string `protobuf:"bytes,4,opt,name=spec_hash,json=specHash,proto3" json:"spec_hash,omitempty"`
+	SpecIpfs        string `protobuf:"bytes,5,opt,name=spec_ipfs,json=specIpfs,proto3" json:"spec_ipfs,omitempty"`
+	CreatedAt       int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy       string `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Changelog       string `protobuf:"bytes,8,opt,name=changelog,proto3" json:"changelog,omitempty"`
+	ParentVersionId string `protobuf:"bytes,9,opt,name=parent_version_id,json=parentVersionId,proto3" json:"parent_version_id,omitempty"`
+	EntityId        string `protobuf:"bytes,10,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+}
+
+func (m *SpecVersion) Reset()         { *m = SpecVersion{} }
+func (m *SpecVersion) String() string { return proto.CompactTextString(m) }
+func (*SpecVersion) ProtoMessage()    {}
+func (*SpecVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{9}
+}
+func (m *SpecVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintStamp(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *SpecVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecVersion.Merge(m, src)
+}
+func (m *SpecVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecVersion proto.InternalMessageInfo
+
+func (m *SpecVersion) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.JurisdictionId) > 0 {
-		i -= len(m.JurisdictionId)
-		copy(dAtA[i:], m.JurisdictionId)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.JurisdictionId)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *SpecVersion) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
 	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *SpecVersion) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	if len(m.PePublicKey) > 0 {
-		i -= len(m.PePublicKey)
-		copy(dAtA[i:], m.PePublicKey)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.PePublicKey)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *SpecVersion) GetSpecHash() string {
+	if m != nil {
+		return m.SpecHash
 	}
-	if len(m.DocumentHash) > 0 {
-		i -= len(m.DocumentHash)
-		copy(dAtA[i:], m.DocumentHash)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.DocumentHash)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *SpecVersion) GetSpecIpfs() string {
+	if m != nil {
+		return m.SpecIpfs
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SpecVersion) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DocumentStorage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpecVersion) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DocumentStorage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpecVersion) GetChangelog() string {
+	if m != nil {
+		return m.Changelog
+	}
+	return ""
 }
 
-func (m *DocumentStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pinned {
-		i--
-		if m.Pinned {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
+func (m *SpecVersion) GetParentVersionId() string {
+	if m != nil {
+		return m.ParentVersionId
 	}
-	if len(m.UploadedBy) > 0 {
-		i -= len(m.UploadedBy)
-		copy(dAtA[i:], m.UploadedBy)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.UploadedBy)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *SpecVersion) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
 	}
-	if m.UploadedAt != 0 {
-		i = encodeVarintStamp(dAtA, i, uint64(m.UploadedAt))
-		i--
-		dAtA[i] = 0x38
+	return ""
+}
+
+// ProfessionalEngineer binds an Ed25519 stamp key to a licensed engineer
+// and the on-chain account allowed to submit stamps with it
+type ProfessionalEngineer struct {
+	PublicKey     string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Account       string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Name          string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	LicenseNumber string   `protobuf:"bytes,4,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	Jurisdictions []string `protobuf:"bytes,5,rep,name=jurisdictions,proto3" json:"jurisdictions,omitempty"`
+	RegisteredAt  int64    `protobuf:"varint,6,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	// Key history
+	PreviousPublicKey  string `protobuf:"bytes,7,opt,name=previous_public_key,json=previousPublicKey,proto3" json:"previous_public_key,omitempty"`
+	SuccessorPublicKey string `protobuf:"bytes,8,opt,name=successor_public_key,json=successorPublicKey,proto3" json:"successor_public_key,omitempty"`
+	RetiredAt          int64  `protobuf:"varint,9,opt,name=retired_at,json=retiredAt,proto3" json:"retired_at,omitempty"`
+	// Key compromise
+	CompromisedSinceHeight int64  `protobuf:"varint,10,opt,name=compromised_since_height,json=compromisedSinceHeight,proto3" json:"compromised_since_height,omitempty"`
+	CompromiseCursor       string `protobuf:"bytes,11,opt,name=compromise_cursor,json=compromiseCursor,proto3" json:"compromise_cursor,omitempty"`
+	CompromiseComplete     bool   `protobuf:"varint,12,opt,name=compromise_complete,json=compromiseComplete,proto3" json:"compromise_complete,omitempty"`
+}
+
+func (m *ProfessionalEngineer) Reset()         { *m = ProfessionalEngineer{} }
+func (m *ProfessionalEngineer) String() string { return proto.CompactTextString(m) }
+func (*ProfessionalEngineer) ProtoMessage()    {}
+func (*ProfessionalEngineer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{10}
+}
+func (m *ProfessionalEngineer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfessionalEngineer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfessionalEngineer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.MimeType) > 0 {
-		i -= len(m.MimeType)
-		copy(dAtA[i:], m.MimeType)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.MimeType)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *ProfessionalEngineer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfessionalEngineer.Merge(m, src)
+}
+func (m *ProfessionalEngineer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfessionalEngineer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfessionalEngineer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfessionalEngineer proto.InternalMessageInfo
+
+func (m *ProfessionalEngineer) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
 	}
-	if m.Size_ != 0 {
-		i = encodeVarintStamp(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *ProfessionalEngineer) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	if len(m.Filename) > 0 {
-		i -= len(m.Filename)
-		copy(dAtA[i:], m.Filename)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.Filename)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *ProfessionalEngineer) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.IpfsHash) > 0 {
-		i -= len(m.IpfsHash)
-		copy(dAtA[i:], m.IpfsHash)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.IpfsHash)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *ProfessionalEngineer) GetLicenseNumber() string {
+	if m != nil {
+		return m.LicenseNumber
 	}
-	if len(m.StampId) > 0 {
-		i -= len(m.StampId)
-		copy(dAtA[i:], m.StampId)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.StampId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *ProfessionalEngineer) GetJurisdictions() []string {
+	if m != nil {
+		return m.Jurisdictions
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *ProfessionalEngineer) GetRegisteredAt() int64 {
+	if m != nil {
+		return m.RegisteredAt
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EntityAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProfessionalEngineer) GetPreviousPublicKey() string {
+	if m != nil {
+		return m.PreviousPublicKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EntityAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProfessionalEngineer) GetSuccessorPublicKey() string {
+	if m != nil {
+		return m.SuccessorPublicKey
+	}
+	return ""
 }
 
-func (m *EntityAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for k := range m.Permissions {
-			v := m.Permissions[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintStamp(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintStamp(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintStamp(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4a
-		}
+func (m *ProfessionalEngineer) GetRetiredAt() int64 {
+	if m != nil {
+		return m.RetiredAt
 	}
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
+	return 0
+}
+
+func (m *ProfessionalEngineer) GetCompromisedSinceHeight() int64 {
+	if m != nil {
+		return m.CompromisedSinceHeight
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintStamp(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *ProfessionalEngineer) GetCompromiseCursor() string {
+	if m != nil {
+		return m.CompromiseCursor
 	}
-	if len(m.AdminAddresses) > 0 {
-		for iNdEx := len(m.AdminAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AdminAddresses[iNdEx])
-			copy(dAtA[i:], m.AdminAddresses[iNdEx])
-			i = encodeVarintStamp(dAtA, i, uint64(len(m.AdminAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
+	return ""
+}
+
+func (m *ProfessionalEngineer) GetCompromiseComplete() bool {
+	if m != nil {
+		return m.CompromiseComplete
 	}
-	if len(m.MemberAddresses) > 0 {
-		for iNdEx := len(m.MemberAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.MemberAddresses[iNdEx])
-			copy(dAtA[i:], m.MemberAddresses[iNdEx])
-			i = encodeVarintStamp(dAtA, i, uint64(len(m.MemberAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+	return false
+}
+
+// LicenseStatusChange records one board action on a license
+type LicenseStatusChange struct {
+	Status    LicenseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=stampledgerchain.stampledgerchain.v1.LicenseStatus" json:"status,omitempty"`
+	ChangedAt int64         `protobuf:"varint,2,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	ChangedBy string        `protobuf:"bytes,3,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	Reason    string        `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *LicenseStatusChange) Reset()         { *m = LicenseStatusChange{} }
+func (m *LicenseStatusChange) String() string { return proto.CompactTextString(m) }
+func (*LicenseStatusChange) ProtoMessage()    {}
+func (*LicenseStatusChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{11}
+}
+func (m *LicenseStatusChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LicenseStatusChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LicenseStatusChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.EntityType) > 0 {
-		i -= len(m.EntityType)
-		copy(dAtA[i:], m.EntityType)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.EntityType)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *LicenseStatusChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LicenseStatusChange.Merge(m, src)
+}
+func (m *LicenseStatusChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *LicenseStatusChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_LicenseStatusChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LicenseStatusChange proto.InternalMessageInfo
+
+func (m *LicenseStatusChange) GetStatus() LicenseStatus {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	return LicenseUnattested
+}
+
+func (m *LicenseStatusChange) GetChangedAt() int64 {
+	if m != nil {
+		return m.ChangedAt
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *LicenseStatusChange) GetChangedBy() string {
+	if m != nil {
+		return m.ChangedBy
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SpecVersion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LicenseStatusChange) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SpecVersion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StampingDelegation lets a PE's account authorize another account, such as
+// a drafter or a firm's service account, to submit stamps carrying the PE's
+// signature
+type StampingDelegation struct {
+	Granter         string   `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee         string   `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	JurisdictionIds []string `protobuf:"bytes,3,rep,name=jurisdiction_ids,json=jurisdictionIds,proto3" json:"jurisdiction_ids,omitempty"`
+	ProjectNames    []string `protobuf:"bytes,4,rep,name=project_names,json=projectNames,proto3" json:"project_names,omitempty"`
+	Expiration      int64    `protobuf:"varint,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	CreatedAt       int64    `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (m *SpecVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ParentVersionId) > 0 {
-		i -= len(m.ParentVersionId)
-		copy(dAtA[i:], m.ParentVersionId)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.ParentVersionId)))
-		i--
-		dAtA[i] = 0x4a
+func (m *StampingDelegation) Reset()         { *m = StampingDelegation{} }
+func (m *StampingDelegation) String() string { return proto.CompactTextString(m) }
+func (*StampingDelegation) ProtoMessage()    {}
+func (*StampingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{12}
+}
+func (m *StampingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StampingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StampingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Changelog) > 0 {
-		i -= len(m.Changelog)
-		copy(dAtA[i:], m.Changelog)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.Changelog)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.CreatedBy) > 0 {
-		i -= len(m.CreatedBy)
-		copy(dAtA[i:], m.CreatedBy)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.CreatedBy)))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *StampingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StampingDelegation.Merge(m, src)
+}
+func (m *StampingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *StampingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_StampingDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StampingDelegation proto.InternalMessageInfo
+
+func (m *StampingDelegation) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintStamp(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *StampingDelegation) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	if len(m.SpecIpfs) > 0 {
-		i -= len(m.SpecIpfs)
-		copy(dAtA[i:], m.SpecIpfs)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.SpecIpfs)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *StampingDelegation) GetJurisdictionIds() []string {
+	if m != nil {
+		return m.JurisdictionIds
 	}
-	if len(m.SpecHash) > 0 {
-		i -= len(m.SpecHash)
-		copy(dAtA[i:], m.SpecHash)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.SpecHash)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *StampingDelegation) GetProjectNames() []string {
+	if m != nil {
+		return m.ProjectNames
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *StampingDelegation) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
 	}
-	if len(m.ProjectId) > 0 {
-		i -= len(m.ProjectId)
-		copy(dAtA[i:], m.ProjectId)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.ProjectId)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *StampingDelegation) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintStamp(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+// License is a board's record of a PE license in its jurisdiction
+type License struct {
+	JurisdictionId string                `protobuf:"bytes,1,opt,name=jurisdiction_id,json=jurisdictionId,proto3" json:"jurisdiction_id,omitempty"`
+	LicenseNumber  string                `protobuf:"bytes,2,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	Status         LicenseStatus         `protobuf:"varint,3,opt,name=status,proto3,enum=stampledgerchain.stampledgerchain.v1.LicenseStatus" json:"status,omitempty"`
+	History        []LicenseStatusChange `protobuf:"bytes,4,rep,name=history,proto3" json:"history"`
+	PeAccount      string                `protobuf:"bytes,5,opt,name=pe_account,json=peAccount,proto3" json:"pe_account,omitempty"`
+}
+
+func (m *License) Reset()         { *m = License{} }
+func (m *License) String() string { return proto.CompactTextString(m) }
+func (*License) ProtoMessage()    {}
+func (*License) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{13}
+}
+func (m *License) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *License) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_License.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *License) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_License.Merge(m, src)
+}
+func (m *License) XXX_Size() int {
+	return m.Size()
+}
+func (m *License) XXX_DiscardUnknown() {
+	xxx_messageInfo_License.DiscardUnknown(m)
 }
 
-func encodeVarintStamp(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStamp(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_License proto.InternalMessageInfo
+
+func (m *License) GetJurisdictionId() string {
+	if m != nil {
+		return m.JurisdictionId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Stamp) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *License) GetLicenseNumber() string {
+	if m != nil {
+		return m.LicenseNumber
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return ""
+}
+
+func (m *License) GetStatus() LicenseStatus {
+	if m != nil {
+		return m.Status
 	}
-	l = len(m.DocumentHash)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return LicenseUnattested
+}
+
+func (m *License) GetHistory() []LicenseStatusChange {
+	if m != nil {
+		return m.History
 	}
-	l = len(m.PePublicKey)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return nil
+}
+
+func (m *License) GetPeAccount() string {
+	if m != nil {
+		return m.PeAccount
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return ""
+}
+
+// StampVerification is the result of re-verifying a stamp
+type StampVerification struct {
+	StampId              string                 `protobuf:"bytes,1,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
+	Valid                bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	Reason               string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	LicenseStatusAtStamp LicenseStatus          `protobuf:"varint,4,opt,name=license_status_at_stamp,json=licenseStatusAtStamp,proto3,enum=stampledgerchain.stampledgerchain.v1.LicenseStatus" json:"license_status_at_stamp,omitempty"`
+	LicenseStatusNow     LicenseStatus          `protobuf:"varint,5,opt,name=license_status_now,json=licenseStatusNow,proto3,enum=stampledgerchain.stampledgerchain.v1.LicenseStatus" json:"license_status_now,omitempty"`
+	Status               StampStatus            `protobuf:"varint,6,opt,name=status,proto3,enum=stampledgerchain.stampledgerchain.v1.StampStatus" json:"status,omitempty"`
+	SuccessorId          string                 `protobuf:"bytes,7,opt,name=successor_id,json=successorId,proto3" json:"successor_id,omitempty"`
+	CoSigners            []CoSignerVerification `protobuf:"bytes,8,rep,name=co_signers,json=coSigners,proto3" json:"co_signers"`
+}
+
+func (m *StampVerification) Reset()         { *m = StampVerification{} }
+func (m *StampVerification) String() string { return proto.CompactTextString(m) }
+func (*StampVerification) ProtoMessage()    {}
+func (*StampVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{14}
+}
+func (m *StampVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StampVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StampVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.JurisdictionId)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+}
+func (m *StampVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StampVerification.Merge(m, src)
+}
+func (m *StampVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *StampVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_StampVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StampVerification proto.InternalMessageInfo
+
+func (m *StampVerification) GetStampId() string {
+	if m != nil {
+		return m.StampId
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovStamp(uint64(m.CreatedAt))
+	return ""
+}
+
+func (m *StampVerification) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return false
+}
+
+func (m *StampVerification) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if m.Revoked {
-		n += 2
+	return ""
+}
+
+func (m *StampVerification) GetLicenseStatusAtStamp() LicenseStatus {
+	if m != nil {
+		return m.LicenseStatusAtStamp
 	}
-	if m.RevokedAt != 0 {
-		n += 1 + sovStamp(uint64(m.RevokedAt))
+	return LicenseUnattested
+}
+
+func (m *StampVerification) GetLicenseStatusNow() LicenseStatus {
+	if m != nil {
+		return m.LicenseStatusNow
 	}
-	l = len(m.RevokedReason)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return LicenseUnattested
+}
+
+func (m *StampVerification) GetStatus() StampStatus {
+	if m != nil {
+		return m.Status
 	}
-	l = len(m.PeLicenseNumber)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return StampStatusUnspecified
+}
+
+func (m *StampVerification) GetSuccessorId() string {
+	if m != nil {
+		return m.SuccessorId
 	}
-	l = len(m.PeName)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return ""
+}
+
+func (m *StampVerification) GetCoSigners() []CoSignerVerification {
+	if m != nil {
+		return m.CoSigners
 	}
-	l = len(m.ProjectName)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return nil
+}
+
+// CoSignerVerification reports one co-signer of a co-sealed stamp
+type CoSignerVerification struct {
+	PePublicKey            string        `protobuf:"bytes,1,opt,name=pe_public_key,json=pePublicKey,proto3" json:"pe_public_key,omitempty"`
+	Discipline             string        `protobuf:"bytes,2,opt,name=discipline,proto3" json:"discipline,omitempty"`
+	PeName                 string        `protobuf:"bytes,3,opt,name=pe_name,json=peName,proto3" json:"pe_name,omitempty"`
+	Signed                 bool          `protobuf:"varint,4,opt,name=signed,proto3" json:"signed,omitempty"`
+	SignatureValid         bool          `protobuf:"varint,5,opt,name=signature_valid,json=signatureValid,proto3" json:"signature_valid,omitempty"`
+	LicenseStatusNow       LicenseStatus `protobuf:"varint,6,opt,name=license_status_now,json=licenseStatusNow,proto3,enum=stampledgerchain.stampledgerchain.v1.LicenseStatus" json:"license_status_now,omitempty"`
+	LicenseStatusAtSigning LicenseStatus `protobuf:"varint,7,opt,name=license_status_at_signing,json=licenseStatusAtSigning,proto3,enum=stampledgerchain.stampledgerchain.v1.LicenseStatus" json:"license_status_at_signing,omitempty"`
+}
+
+func (m *CoSignerVerification) Reset()         { *m = CoSignerVerification{} }
+func (m *CoSignerVerification) String() string { return proto.CompactTextString(m) }
+func (*CoSignerVerification) ProtoMessage()    {}
+func (*CoSignerVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{15}
+}
+func (m *CoSignerVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoSignerVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoSignerVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.DocumentIpfsHash)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+}
+func (m *CoSignerVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoSignerVerification.Merge(m, src)
+}
+func (m *CoSignerVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoSignerVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoSignerVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoSignerVerification proto.InternalMessageInfo
+
+func (m *CoSignerVerification) GetPePublicKey() string {
+	if m != nil {
+		return m.PePublicKey
 	}
-	if m.DocumentSize != 0 {
-		n += 1 + sovStamp(uint64(m.DocumentSize))
+	return ""
+}
+
+func (m *CoSignerVerification) GetDiscipline() string {
+	if m != nil {
+		return m.Discipline
 	}
-	l = len(m.DocumentFilename)
-	if l > 0 {
-		n += 2 + l + sovStamp(uint64(l))
+	return ""
+}
+
+func (m *CoSignerVerification) GetPeName() string {
+	if m != nil {
+		return m.PeName
 	}
-	return n
+	return ""
 }
 
-func (m *DocumentStorage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CoSignerVerification) GetSigned() bool {
+	if m != nil {
+		return m.Signed
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return false
+}
+
+func (m *CoSignerVerification) GetSignatureValid() bool {
+	if m != nil {
+		return m.SignatureValid
 	}
-	l = len(m.StampId)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return false
+}
+
+func (m *CoSignerVerification) GetLicenseStatusNow() LicenseStatus {
+	if m != nil {
+		return m.LicenseStatusNow
 	}
-	l = len(m.IpfsHash)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return LicenseUnattested
+}
+
+func (m *CoSignerVerification) GetLicenseStatusAtSigning() LicenseStatus {
+	if m != nil {
+		return m.LicenseStatusAtSigning
 	}
-	l = len(m.Filename)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return LicenseUnattested
+}
+
+// MerkleInclusionVerification is the result of checking that a document hash
+// is covered by a Merkle-root stamp
+type MerkleInclusionVerification struct {
+	MerkleRoot string `protobuf:"bytes,1,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	LeafHash   string `protobuf:"bytes,2,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	Valid      bool   `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	StampId    string `protobuf:"bytes,4,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
+	Reason     string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MerkleInclusionVerification) Reset()         { *m = MerkleInclusionVerification{} }
+func (m *MerkleInclusionVerification) String() string { return proto.CompactTextString(m) }
+func (*MerkleInclusionVerification) ProtoMessage()    {}
+func (*MerkleInclusionVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd5c3d9e6d4a1f93, []int{16}
+}
+func (m *MerkleInclusionVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleInclusionVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleInclusionVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovStamp(uint64(m.Size_))
+}
+func (m *MerkleInclusionVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleInclusionVerification.Merge(m, src)
+}
+func (m *MerkleInclusionVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleInclusionVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleInclusionVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleInclusionVerification proto.InternalMessageInfo
+
+func (m *MerkleInclusionVerification) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
 	}
-	l = len(m.MimeType)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return ""
+}
+
+func (m *MerkleInclusionVerification) GetLeafHash() string {
+	if m != nil {
+		return m.LeafHash
 	}
-	if m.UploadedAt != 0 {
-		n += 1 + sovStamp(uint64(m.UploadedAt))
+	return ""
+}
+
+func (m *MerkleInclusionVerification) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	l = len(m.UploadedBy)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	return false
+}
+
+func (m *MerkleInclusionVerification) GetStampId() string {
+	if m != nil {
+		return m.StampId
 	}
-	if m.Pinned {
-		n += 2
+	return ""
+}
+
+func (m *MerkleInclusionVerification) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return n
+	return ""
 }
 
-func (m *EntityAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("stampledgerchain.stampledgerchain.v1.RevocationReason", RevocationReason_name, RevocationReason_value)
+	proto.RegisterEnum("stampledgerchain.stampledgerchain.v1.StampStatus", StampStatus_name, StampStatus_value)
+	proto.RegisterEnum("stampledgerchain.stampledgerchain.v1.DocumentRole", DocumentRole_name, DocumentRole_value)
+	proto.RegisterEnum("stampledgerchain.stampledgerchain.v1.LicenseStatus", LicenseStatus_name, LicenseStatus_value)
+	proto.RegisterType((*Stamp)(nil), "stampledgerchain.stampledgerchain.v1.Stamp")
+	proto.RegisterType((*CoSigner)(nil), "stampledgerchain.stampledgerchain.v1.CoSigner")
+	proto.RegisterType((*StampBatch)(nil), "stampledgerchain.stampledgerchain.v1.StampBatch")
+	proto.RegisterType((*DocumentStorage)(nil), "stampledgerchain.stampledgerchain.v1.DocumentStorage")
+	proto.RegisterType((*PinAttestation)(nil), "stampledgerchain.stampledgerchain.v1.PinAttestation")
+	proto.RegisterType((*EntityAccount)(nil), "stampledgerchain.stampledgerchain.v1.EntityAccount")
+	proto.RegisterMapType((map[string]string)(nil), "stampledgerchain.stampledgerchain.v1.EntityAccount.PermissionsEntry")
+	proto.RegisterType((*EntityMember)(nil), "stampledgerchain.stampledgerchain.v1.EntityMember")
+	proto.RegisterType((*EntityInvite)(nil), "stampledgerchain.stampledgerchain.v1.EntityInvite")
+	proto.RegisterType((*MemberFeeUsage)(nil), "stampledgerchain.stampledgerchain.v1.MemberFeeUsage")
+	proto.RegisterType((*SpecVersion)(nil), "stampledgerchain.stampledgerchain.v1.SpecVersion")
+	proto.RegisterType((*ProfessionalEngineer)(nil), "stampledgerchain.stampledgerchain.v1.ProfessionalEngineer")
+	proto.RegisterType((*LicenseStatusChange)(nil), "stampledgerchain.stampledgerchain.v1.LicenseStatusChange")
+	proto.RegisterType((*StampingDelegation)(nil), "stampledgerchain.stampledgerchain.v1.StampingDelegation")
+	proto.RegisterType((*License)(nil), "stampledgerchain.stampledgerchain.v1.License")
+	proto.RegisterType((*StampVerification)(nil), "stampledgerchain.stampledgerchain.v1.StampVerification")
+	proto.RegisterType((*CoSignerVerification)(nil), "stampledgerchain.stampledgerchain.v1.CoSignerVerification")
+	proto.RegisterType((*MerkleInclusionVerification)(nil), "stampledgerchain.stampledgerchain.v1.MerkleInclusionVerification")
+}
+
+func init() {
+	proto.RegisterFile("stampledgerchain/stampledgerchain/v1/stamp.proto", fileDescriptor_cd5c3d9e6d4a1f93)
+}
+
+var fileDescriptor_cd5c3d9e6d4a1f93 = []byte{
+	// 3010 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xd9, 0xf7, 0x8a, 0xa4, 0x44, 0x8d, 0x44, 0x8a, 0x1a, 0xcb, 0xf2, 0x8a, 0xb6, 0x65, 0xda, 0x4e,
+	0xde, 0xe8, 0x75, 0x12, 0x29, 0x56, 0x3e, 0xde, 0xbc, 0xc6, 0xfb, 0x06, 0x58, 0x91, 0x6b, 0x67,
+	0x61, 0x89, 0x22, 0x96, 0x94, 0xd1, 0xf4, 0xb2, 0x58, 0xed, 0x8e, 0xc8, 0xb1, 0xc9, 0xdd, 0xc5,
+	0xee, 0x52, 0x09, 0x73, 0xe8, 0xa9, 0x40, 0x0b, 0x9d, 0xfa, 0x0f, 0x10, 0x28, 0xd0, 0xa2, 0x28,
+	0x52, 0xb4, 0xe8, 0xbd, 0x45, 0xd1, 0x63, 0x80, 0xf6, 0x90, 0x63, 0x2f, 0xfd, 0x40, 0x72, 0x68,
+	0x0f, 0x05, 0xda, 0x43, 0x4f, 0x3d, 0x15, 0xf3, 0xcc, 0xec, 0x27, 0x89, 0x46, 0x75, 0xd3, 0x8b,
+	0xcd, 0xe7, 0xf7, 0xcc, 0xcc, 0xce, 0xf3, 0xfd, 0x3c, 0x23, 0xf4, 0x46, 0x10, 0x9a, 0x23, 0x6f,
+	0x48, 0xec, 0x3e, 0xf1, 0xad, 0x81, 0x49, 0x9d, 0xbd, 0x19, 0xe0, 0xfc, 0x01, 0xc7, 0x76, 0x3d,
+	0xdf, 0x0d, 0x5d, 0xfc, 0x52, 0x7e, 0xc1, 0xee, 0x0c, 0x70, 0xfe, 0xa0, 0xbe, 0x6e, 0x8e, 0xa8,
+	0xe3, 0xee, 0xc1, 0xbf, 0x7c, 0x63, 0x7d, 0xdb, 0x72, 0x83, 0x91, 0x1b, 0xec, 0x9d, 0x9a, 0x01,
+	0xd9, 0x3b, 0x7f, 0x70, 0x4a, 0x42, 0xf3, 0xc1, 0x9e, 0xe5, 0x52, 0x47, 0xf0, 0x37, 0xfa, 0x6e,
+	0xdf, 0x85, 0x9f, 0x7b, 0xec, 0x17, 0x47, 0xef, 0xfe, 0x1c, 0xa1, 0x52, 0x97, 0x7d, 0x00, 0x57,
+	0xd1, 0x02, 0xb5, 0x65, 0xa9, 0x21, 0xed, 0x2c, 0xeb, 0x0b, 0xd4, 0xc6, 0xf7, 0x50, 0xc5, 0x76,
+	0xad, 0xf1, 0x88, 0x38, 0xa1, 0x31, 0x30, 0x83, 0x81, 0xbc, 0x00, 0xac, 0xd5, 0x08, 0x7c, 0xdf,
+	0x0c, 0x06, 0xf8, 0x2e, 0xaa, 0x78, 0xc4, 0xf0, 0xc6, 0xa7, 0x43, 0x6a, 0x19, 0xcf, 0xc9, 0x44,
+	0x2e, 0xc0, 0xa2, 0x15, 0x8f, 0x74, 0x00, 0x7b, 0x42, 0x26, 0xf8, 0x26, 0x5a, 0x0e, 0x68, 0xdf,
+	0x31, 0xc3, 0xb1, 0x4f, 0xe4, 0x22, 0xf0, 0x13, 0x00, 0xbf, 0x82, 0xd6, 0x9e, 0x8d, 0x7d, 0x1a,
+	0xd8, 0xd4, 0x0a, 0xa9, 0xeb, 0x18, 0xd4, 0x96, 0x4b, 0xb0, 0xa6, 0x9a, 0x86, 0x35, 0x1b, 0xdf,
+	0x42, 0xc8, 0xf2, 0x89, 0x19, 0x12, 0xdb, 0x30, 0x43, 0x79, 0xb1, 0x21, 0xed, 0x14, 0xf4, 0x65,
+	0x81, 0x28, 0x21, 0x96, 0xd1, 0x12, 0x10, 0xae, 0x2f, 0x2f, 0xc1, 0xfe, 0x88, 0x64, 0x1c, 0x9f,
+	0x9c, 0xbb, 0xcf, 0x89, 0x2d, 0x97, 0x1b, 0xd2, 0x4e, 0x59, 0x8f, 0x48, 0x76, 0xa4, 0xf8, 0xc9,
+	0x8e, 0x5c, 0xe6, 0x47, 0x0a, 0x44, 0x09, 0xf1, 0xcb, 0xa8, 0x1a, 0xb1, 0x7d, 0x62, 0x06, 0xae,
+	0x23, 0x23, 0x38, 0xb9, 0x22, 0x50, 0x1d, 0x40, 0x7c, 0x1f, 0xad, 0x7b, 0xc4, 0x18, 0x52, 0x8b,
+	0x38, 0x01, 0x31, 0x9c, 0xf1, 0xe8, 0x94, 0xf8, 0xf2, 0x0a, 0xac, 0x5c, 0xf3, 0xc8, 0x21, 0xc7,
+	0xdb, 0x00, 0xe3, 0xeb, 0x68, 0xc9, 0x23, 0x86, 0x63, 0x8e, 0x88, 0xbc, 0x0a, 0x2b, 0x16, 0x3d,
+	0xd2, 0x36, 0x47, 0x04, 0xdf, 0x41, 0xab, 0x9e, 0xef, 0x3e, 0x23, 0x56, 0xc8, 0xb9, 0x15, 0xa1,
+	0x47, 0x8e, 0xc1, 0x92, 0xd7, 0x10, 0x8e, 0x0d, 0x42, 0xbd, 0xb3, 0x80, 0x5b, 0xa5, 0x0a, 0x0b,
+	0x6b, 0x11, 0x47, 0xf3, 0xce, 0x02, 0xb0, 0x4c, 0xda, 0x7c, 0x01, 0xfd, 0x98, 0xc8, 0x6b, 0x20,
+	0x5e, 0x6c, 0xbe, 0x2e, 0xfd, 0x98, 0xe0, 0x57, 0xd1, 0x7a, 0xbc, 0xe8, 0x8c, 0x0e, 0x09, 0x7c,
+	0xba, 0x96, 0x3d, 0xf1, 0x91, 0xc0, 0xd9, 0xf7, 0x99, 0xd9, 0x8c, 0xd3, 0x49, 0x48, 0x02, 0xe3,
+	0x9c, 0xf8, 0x01, 0x75, 0x1d, 0x79, 0xbd, 0x21, 0xed, 0x54, 0xf4, 0x1a, 0xe3, 0x1c, 0x30, 0xc6,
+	0x53, 0x8e, 0xe3, 0xff, 0x46, 0xb5, 0xd8, 0xc8, 0x06, 0xf9, 0xc8, 0xa3, 0xfe, 0x44, 0xc6, 0x70,
+	0x85, 0xb5, 0x18, 0x57, 0x01, 0xc6, 0x1b, 0xa8, 0xe4, 0xb8, 0x8e, 0x45, 0xe4, 0xab, 0x0d, 0x69,
+	0xa7, 0xa8, 0x73, 0x82, 0x69, 0x3f, 0xb2, 0xf7, 0x80, 0xd0, 0xfe, 0x20, 0x94, 0x37, 0x60, 0x7b,
+	0x45, 0xa0, 0xef, 0x03, 0x88, 0x6f, 0xa3, 0x95, 0x73, 0x73, 0x48, 0x6d, 0x63, 0xec, 0x84, 0x74,
+	0x28, 0x5f, 0x83, 0x35, 0x08, 0xa0, 0x13, 0x86, 0x30, 0xf3, 0xc3, 0xe7, 0x89, 0x2d, 0x6f, 0x72,
+	0xf3, 0x0b, 0x12, 0x6f, 0x23, 0x14, 0x8c, 0x3d, 0xe2, 0x07, 0xc4, 0x26, 0x81, 0x7c, 0x1d, 0xc4,
+	0x4e, 0x21, 0x4c, 0x85, 0x31, 0x65, 0x1b, 0xa7, 0x13, 0x59, 0xe6, 0x11, 0x90, 0x80, 0x07, 0x13,
+	0x6c, 0xa1, 0x75, 0xe6, 0x0e, 0x96, 0x09, 0xde, 0x2b, 0xfc, 0x64, 0xab, 0x21, 0xed, 0x54, 0xf7,
+	0xdf, 0xd9, 0xbd, 0x4c, 0x2c, 0xef, 0xea, 0xf1, 0x76, 0xee, 0x50, 0x7a, 0xcd, 0xcf, 0x21, 0xf8,
+	0x5d, 0x24, 0xa7, 0x3e, 0x42, 0xce, 0xa9, 0x4d, 0x1c, 0x8b, 0x70, 0x07, 0xa8, 0xc3, 0xa5, 0x36,
+	0x13, 0xbe, 0x2a, 0xd8, 0xe0, 0x06, 0x29, 0x17, 0x3f, 0x9d, 0xc8, 0x37, 0x78, 0xf4, 0x09, 0xe4,
+	0x60, 0x82, 0xb7, 0x50, 0xf9, 0xd4, 0x0c, 0xad, 0x01, 0x0b, 0xbb, 0x9b, 0x3c, 0x6c, 0x80, 0xd6,
+	0x6c, 0xe6, 0xd6, 0x23, 0xe2, 0x3f, 0x1f, 0x12, 0x63, 0x48, 0xcc, 0x33, 0xc3, 0x72, 0xc7, 0x4e,
+	0x28, 0xdf, 0x02, 0x0b, 0xad, 0x71, 0xc6, 0x21, 0x31, 0xcf, 0x9a, 0x0c, 0xc6, 0x5d, 0x84, 0x2c,
+	0xd7, 0x60, 0x76, 0x25, 0x7e, 0x20, 0x6f, 0x37, 0x0a, 0x3b, 0x2b, 0xfb, 0xbb, 0x97, 0x93, 0xbe,
+	0xe9, 0x76, 0x61, 0xdb, 0x41, 0xf1, 0xd3, 0xdf, 0xdd, 0xbe, 0xa2, 0x2f, 0x5b, 0x82, 0x0e, 0xd8,
+	0x05, 0xc4, 0xa1, 0x46, 0x38, 0xf0, 0x49, 0x30, 0x70, 0x87, 0xb6, 0x7c, 0x1b, 0xdc, 0x6d, 0x8d,
+	0xaf, 0xea, 0x45, 0x30, 0x33, 0xb2, 0x47, 0x1c, 0x9b, 0x3a, 0x7d, 0xb9, 0xc1, 0x8d, 0x2c, 0x48,
+	0xa6, 0x00, 0x8f, 0x18, 0xa6, 0xc5, 0xef, 0x7f, 0x87, 0x2b, 0xc0, 0x23, 0x0a, 0x07, 0x70, 0x1d,
+	0x95, 0x6d, 0x32, 0x24, 0x7d, 0x33, 0x24, 0xf2, 0x5d, 0x60, 0xc6, 0x34, 0xbe, 0x81, 0x96, 0x89,
+	0x13, 0xd2, 0x70, 0xc2, 0xb4, 0x73, 0x8f, 0x33, 0x39, 0xa0, 0xd9, 0x0f, 0x8b, 0x7f, 0xfa, 0xee,
+	0x6d, 0xe9, 0xee, 0x5f, 0x25, 0x54, 0x8e, 0x24, 0x98, 0x4d, 0x86, 0xd2, 0x6c, 0x32, 0xdc, 0x46,
+	0xc8, 0xa6, 0x81, 0x45, 0xbd, 0x21, 0x75, 0x88, 0x48, 0xa9, 0x29, 0x24, 0x9b, 0x2c, 0x0b, 0xf9,
+	0x64, 0x79, 0x83, 0x73, 0x79, 0xbe, 0x2a, 0x82, 0xab, 0x97, 0x39, 0xa0, 0x84, 0xe9, 0xdc, 0x52,
+	0xca, 0xe4, 0x96, 0xb9, 0x09, 0x6a, 0x71, 0x7e, 0x82, 0x4a, 0xeb, 0x63, 0x29, 0xab, 0x0f, 0x21,
+	0xf2, 0x9f, 0x25, 0x84, 0xa0, 0x62, 0x1c, 0x30, 0x47, 0x99, 0x29, 0x1b, 0xa9, 0x3c, 0xbc, 0x90,
+	0xcd, 0xc3, 0x97, 0xa9, 0x15, 0x73, 0xaa, 0x41, 0x71, 0x6e, 0x35, 0xc8, 0xe7, 0xcb, 0xd2, 0x6c,
+	0xbe, 0xfc, 0x92, 0x82, 0x71, 0x1b, 0xad, 0x80, 0x3f, 0x0a, 0xcf, 0x5e, 0x02, 0xc7, 0x42, 0x00,
+	0x81, 0x53, 0x0b, 0x71, 0x7f, 0x59, 0x40, 0x6b, 0xad, 0x28, 0x67, 0x86, 0xae, 0x6f, 0xf6, 0xc9,
+	0x8c, 0xcc, 0x5b, 0xa8, 0xcc, 0x8f, 0xa2, 0x76, 0x24, 0x34, 0xd0, 0x9a, 0xcd, 0x2c, 0x96, 0xe4,
+	0x6a, 0x2e, 0x70, 0x99, 0x46, 0x39, 0xba, 0x8e, 0xca, 0x71, 0xd6, 0xe5, 0x62, 0xc6, 0x34, 0xc6,
+	0xa8, 0x08, 0x69, 0xbb, 0x04, 0xf7, 0x86, 0xdf, 0xec, 0xb0, 0x11, 0x1d, 0x11, 0x23, 0x9c, 0x78,
+	0x44, 0x18, 0xb0, 0xcc, 0x80, 0xde, 0xc4, 0x23, 0x4c, 0x9e, 0xb1, 0x37, 0x74, 0x4d, 0x9b, 0xcb,
+	0xbb, 0xc4, 0x13, 0x61, 0x04, 0x71, 0x81, 0xe3, 0x05, 0xa7, 0x13, 0xa8, 0x85, 0xcb, 0xc9, 0x82,
+	0x83, 0x09, 0xde, 0x44, 0x8b, 0x1e, 0x75, 0x1c, 0x62, 0x43, 0x29, 0x2c, 0xeb, 0x82, 0x82, 0x4c,
+	0xec, 0x3a, 0x21, 0x54, 0x92, 0x81, 0xb9, 0xff, 0xf6, 0x3b, 0x51, 0x1d, 0x14, 0x68, 0x17, 0x40,
+	0xfc, 0x08, 0x15, 0x7d, 0x77, 0x48, 0xa0, 0xf4, 0x55, 0xf7, 0xf7, 0x2f, 0x17, 0xfe, 0x91, 0x6a,
+	0x75, 0x77, 0x48, 0x74, 0xd8, 0xcf, 0xea, 0x8c, 0x47, 0x1d, 0x5e, 0x33, 0x48, 0x10, 0x25, 0xff,
+	0x55, 0x90, 0xa7, 0xe6, 0x51, 0x47, 0xe5, 0x0c, 0x91, 0xff, 0x33, 0x41, 0x5a, 0x99, 0x1b, 0xa4,
+	0x7f, 0x91, 0x50, 0xb5, 0x43, 0x1d, 0x25, 0x0c, 0x49, 0x10, 0x42, 0x8a, 0x64, 0xba, 0x48, 0x6a,
+	0x69, 0x64, 0x4a, 0x14, 0x17, 0x51, 0x9b, 0x99, 0xc6, 0xf3, 0x5d, 0x96, 0x49, 0x23, 0x3f, 0x8e,
+	0x69, 0x56, 0x17, 0x7c, 0xe2, 0x0d, 0xa9, 0x65, 0x0a, 0xdf, 0x29, 0x80, 0xef, 0xac, 0x0a, 0x90,
+	0xa7, 0xc4, 0x5d, 0x74, 0x95, 0xab, 0x8f, 0x17, 0xa6, 0x48, 0x0c, 0x1e, 0xb4, 0xeb, 0x9c, 0x05,
+	0x05, 0x4a, 0xc8, 0xf1, 0x0a, 0x5a, 0x33, 0xe1, 0x82, 0x49, 0xbd, 0xe3, 0xa6, 0xaf, 0x46, 0xb0,
+	0x58, 0x98, 0xc9, 0x10, 0x8b, 0xb9, 0x0c, 0x21, 0x24, 0xfe, 0xa4, 0x84, 0x2a, 0x2a, 0x28, 0x21,
+	0xca, 0x73, 0x79, 0x97, 0xc5, 0xa8, 0x08, 0x6e, 0xc7, 0x65, 0x83, 0xdf, 0x4c, 0x29, 0x42, 0x95,
+	0xe0, 0x60, 0xdc, 0x5b, 0x11, 0x87, 0xc0, 0xc5, 0xee, 0xa1, 0x8a, 0xfb, 0xa1, 0x43, 0x7c, 0xc3,
+	0xb4, 0x6d, 0x9f, 0x04, 0x81, 0x70, 0xda, 0x55, 0x00, 0x15, 0x8e, 0xe5, 0xc2, 0x6e, 0x29, 0x1f,
+	0x76, 0x9b, 0x68, 0xd1, 0xb4, 0x42, 0x7a, 0x4e, 0x44, 0x33, 0x26, 0x28, 0xfc, 0x3a, 0xaa, 0x8d,
+	0x08, 0x4b, 0x41, 0xd1, 0xe1, 0x24, 0x90, 0x4b, 0x8d, 0xc2, 0xce, 0xf2, 0xc1, 0x82, 0x2c, 0xb1,
+	0x8a, 0xc3, 0x78, 0x4a, 0xc4, 0xc2, 0xaf, 0xa2, 0x35, 0xd3, 0x1e, 0x51, 0x27, 0xb5, 0x7a, 0x31,
+	0x5e, 0x5d, 0x05, 0x56, 0xb2, 0xf8, 0x19, 0x5a, 0xf1, 0x88, 0x3f, 0xa2, 0x01, 0xeb, 0x4c, 0x02,
+	0x79, 0x19, 0xea, 0x53, 0xeb, 0x72, 0x0e, 0x9a, 0x51, 0xe3, 0x6e, 0x27, 0x39, 0x46, 0x75, 0x42,
+	0x7f, 0x02, 0x9f, 0x4b, 0x1f, 0xce, 0xfa, 0x9e, 0x90, 0x75, 0x01, 0x63, 0x7f, 0x12, 0xab, 0x89,
+	0x87, 0xcb, 0x5a, 0x84, 0x47, 0x9a, 0xfa, 0x06, 0xc2, 0x42, 0xe4, 0x91, 0xeb, 0x84, 0x83, 0xe1,
+	0xc4, 0xb0, 0x4c, 0x4f, 0x5e, 0x81, 0xdb, 0x6d, 0xed, 0xf2, 0x76, 0x7e, 0x97, 0xb5, 0xf3, 0xbb,
+	0xa2, 0x9d, 0xdf, 0x6d, 0xba, 0xd4, 0x39, 0x78, 0x9b, 0x15, 0xca, 0x4f, 0x7e, 0x7f, 0x7b, 0xa7,
+	0x4f, 0xc3, 0xc1, 0xf8, 0x74, 0xd7, 0x72, 0x47, 0x7b, 0xa2, 0xf7, 0xe7, 0xff, 0xbd, 0x1e, 0xd8,
+	0xcf, 0xf7, 0x98, 0x09, 0x03, 0xd8, 0x10, 0xfc, 0xf0, 0x8f, 0x3f, 0xbd, 0x2f, 0xe9, 0x42, 0xbd,
+	0x47, 0xfc, 0x53, 0x4d, 0xd3, 0x63, 0x3e, 0x3e, 0x22, 0xa1, 0x69, 0x9b, 0xa1, 0x29, 0xba, 0xd1,
+	0x98, 0x66, 0xa6, 0x16, 0x15, 0xd4, 0x00, 0xeb, 0x8a, 0xd0, 0x5a, 0x15, 0xe0, 0x31, 0xc3, 0xea,
+	0xef, 0xa1, 0x5a, 0x5e, 0x21, 0xb8, 0x86, 0x0a, 0x49, 0xe9, 0x63, 0x3f, 0x59, 0x7b, 0x77, 0x6e,
+	0x0e, 0xc7, 0x91, 0xaf, 0x71, 0xe2, 0xe1, 0xc2, 0xbb, 0x92, 0x70, 0xd6, 0x6f, 0x4a, 0x68, 0x95,
+	0x6b, 0xf9, 0x08, 0x6e, 0x98, 0x0d, 0x69, 0x29, 0x1b, 0xd2, 0xac, 0xbe, 0x44, 0x6a, 0x15, 0xa9,
+	0x56, 0x90, 0xcc, 0xa5, 0x21, 0xff, 0x70, 0xbf, 0xe5, 0xb9, 0xe4, 0x1e, 0xaa, 0x3c, 0x73, 0xa9,
+	0x93, 0xc4, 0x14, 0x8f, 0xbf, 0x55, 0x0e, 0xf2, 0x88, 0x12, 0xd7, 0xf8, 0x75, 0x7c, 0x0d, 0xcd,
+	0x39, 0xa7, 0xf9, 0xf2, 0x3f, 0xe7, 0x1a, 0x14, 0x96, 0x45, 0x62, 0x45, 0xe4, 0xdc, 0x6b, 0xdc,
+	0x42, 0x88, 0xb3, 0x21, 0xf3, 0x8a, 0x19, 0x48, 0x20, 0x07, 0x93, 0x39, 0xad, 0x6e, 0x69, 0x5e,
+	0xab, 0xfb, 0x32, 0xaa, 0xe6, 0x92, 0x22, 0x2f, 0x6a, 0x15, 0x92, 0xce, 0x88, 0x42, 0x9c, 0x5f,
+	0x49, 0xa8, 0xca, 0xf5, 0xf9, 0x88, 0x90, 0x93, 0x80, 0x95, 0xad, 0x7f, 0x2a, 0xd0, 0x26, 0x5a,
+	0xe4, 0x0e, 0x22, 0xe4, 0x11, 0x14, 0x14, 0x05, 0xe2, 0x53, 0xd7, 0x16, 0x02, 0x09, 0x0a, 0x9f,
+	0xa1, 0x52, 0xe0, 0x11, 0x87, 0x69, 0xf4, 0x3f, 0xe3, 0xaf, 0xfc, 0x78, 0x21, 0xcd, 0xcf, 0x16,
+	0xd0, 0x4a, 0xd7, 0x23, 0x56, 0x34, 0x5d, 0xe4, 0xd3, 0x19, 0xeb, 0xf2, 0x44, 0x3b, 0x10, 0xd7,
+	0xe0, 0x65, 0x81, 0x70, 0x6b, 0x45, 0xf3, 0x0a, 0x97, 0x22, 0x22, 0xa1, 0xa3, 0xf2, 0x88, 0xc5,
+	0xeb, 0xb3, 0xa8, 0xc1, 0x0c, 0x80, 0xfa, 0x1c, 0x31, 0x59, 0xc1, 0x16, 0x1d, 0x06, 0x30, 0xd9,
+	0x90, 0xf5, 0x65, 0xed, 0x45, 0x8a, 0x7d, 0x3a, 0x11, 0xad, 0x54, 0xc4, 0x3e, 0x80, 0xa1, 0xd8,
+	0x1a, 0x98, 0x4e, 0x9f, 0x0c, 0xdd, 0xbe, 0x28, 0xc5, 0x09, 0x00, 0x1d, 0x9b, 0xe9, 0xb3, 0xe2,
+	0x24, 0xee, 0xc9, 0xa4, 0x5a, 0x16, 0x1d, 0x1b, 0x30, 0x84, 0x22, 0x78, 0x87, 0x91, 0x58, 0x15,
+	0xcd, 0x2d, 0x80, 0x7f, 0x2b, 0xa0, 0x8d, 0x8e, 0xef, 0x9e, 0x11, 0x08, 0x54, 0x73, 0xa8, 0x3a,
+	0x7d, 0xea, 0x10, 0xe2, 0x83, 0xda, 0xf2, 0xed, 0xea, 0xb2, 0x17, 0x77, 0x63, 0x2c, 0xd6, 0x44,
+	0xe3, 0x1c, 0xc5, 0x9a, 0x28, 0x27, 0x51, 0xf9, 0x28, 0xa4, 0xca, 0xc7, 0xcb, 0xa8, 0x9a, 0xeb,
+	0x31, 0xb9, 0x3e, 0x2b, 0xc3, 0x4c, 0x87, 0xf9, 0x12, 0xaa, 0xa4, 0x7b, 0x39, 0x91, 0xe5, 0xf5,
+	0x2c, 0xc8, 0x6b, 0x6c, 0x9f, 0x06, 0x21, 0xf1, 0xd3, 0x0a, 0x5e, 0x4d, 0x40, 0x85, 0xd7, 0x58,
+	0x9f, 0x9c, 0x53, 0x77, 0x1c, 0xa4, 0xfb, 0x4a, 0xae, 0xec, 0xf5, 0x88, 0x95, 0x74, 0x97, 0x6f,
+	0xa0, 0x8d, 0x60, 0x6c, 0x59, 0x24, 0x08, 0x5c, 0x3f, 0xbd, 0x81, 0xeb, 0x1f, 0xc7, 0xbc, 0x64,
+	0x07, 0x8c, 0x4f, 0x21, 0xf5, 0x73, 0x2f, 0x04, 0x80, 0x28, 0x21, 0x9b, 0xcb, 0x2c, 0x77, 0xe4,
+	0xf9, 0xee, 0x88, 0x06, 0xc4, 0x36, 0x02, 0x0a, 0x53, 0x19, 0x8f, 0x4d, 0x04, 0x8b, 0x37, 0x53,
+	0xfc, 0x2e, 0x63, 0x8b, 0x58, 0x7e, 0x95, 0x0d, 0x37, 0x11, 0xc7, 0xb0, 0xc6, 0x7e, 0xe0, 0x46,
+	0x8f, 0x06, 0xb5, 0x84, 0xd1, 0x04, 0x1c, 0xef, 0xa1, 0xab, 0xe9, 0xc5, 0x2e, 0x2b, 0x52, 0x21,
+	0x7f, 0x41, 0x28, 0xeb, 0x38, 0xb5, 0x5c, 0x70, 0x84, 0xd9, 0x7f, 0x21, 0xa1, 0xab, 0xa2, 0xbb,
+	0xef, 0x86, 0x66, 0x38, 0x0e, 0x9a, 0xe0, 0x60, 0xf8, 0x09, 0x5a, 0x0c, 0x80, 0x06, 0x8b, 0x57,
+	0xf7, 0xdf, 0xbc, 0x5c, 0x25, 0xcc, 0x1c, 0xa5, 0x8b, 0x23, 0xc0, 0xcf, 0xe1, 0x58, 0xd0, 0xd0,
+	0x82, 0x08, 0x03, 0x8e, 0x88, 0x30, 0x10, 0xec, 0xd3, 0xa8, 0xe3, 0x8f, 0xd8, 0xbc, 0xe5, 0x14,
+	0x23, 0x33, 0xf7, 0x15, 0x41, 0x09, 0x01, 0x7e, 0x2b, 0x21, 0x0c, 0xa3, 0x06, 0x75, 0xfa, 0x2d,
+	0x3e, 0x85, 0xb0, 0x98, 0x95, 0xd1, 0x52, 0xdf, 0x37, 0x9d, 0x90, 0xf8, 0xc2, 0x65, 0x23, 0x32,
+	0xe1, 0xc4, 0x59, 0x59, 0x90, 0xac, 0x2c, 0xe7, 0x06, 0x8b, 0x40, 0x2e, 0x80, 0xe3, 0xad, 0x65,
+	0x27, 0x0b, 0x70, 0xbd, 0xf4, 0x68, 0x11, 0x40, 0x86, 0x63, 0xa5, 0x2f, 0x99, 0x2d, 0x02, 0x36,
+	0xc7, 0x41, 0xd6, 0x85, 0x1b, 0x89, 0x74, 0x9d, 0x42, 0xbe, 0x24, 0x3b, 0x08, 0xf9, 0x7e, 0xb0,
+	0x80, 0x96, 0x84, 0x56, 0xe7, 0x4d, 0x3e, 0xd2, 0xdc, 0xc9, 0x67, 0x36, 0xcc, 0x16, 0xe6, 0x85,
+	0x59, 0x62, 0xe4, 0xc2, 0xbf, 0x6f, 0xe4, 0x0f, 0xd0, 0xd2, 0x80, 0x06, 0xa1, 0xeb, 0x4f, 0x44,
+	0xba, 0xff, 0xdf, 0x17, 0x38, 0x8d, 0x7b, 0x9f, 0x98, 0xf3, 0xa3, 0xf3, 0x72, 0xf3, 0x79, 0x29,
+	0x37, 0x9f, 0x0b, 0x45, 0xfd, 0xbd, 0x80, 0xd6, 0xc1, 0x11, 0x9e, 0x12, 0x9f, 0x9e, 0x51, 0xfe,
+	0xce, 0x91, 0x19, 0xbb, 0xa4, 0xec, 0xd8, 0xc5, 0x7b, 0x0e, 0x51, 0x0a, 0xca, 0x3a, 0x27, 0x52,
+	0xde, 0x56, 0x48, 0x7b, 0x1b, 0x7e, 0x86, 0xae, 0x47, 0x2a, 0xe5, 0x02, 0x1b, 0x66, 0x68, 0xc0,
+	0x51, 0xe0, 0x96, 0x2f, 0xa8, 0xbc, 0x8d, 0x61, 0x9a, 0x54, 0x42, 0xfe, 0xcc, 0x6a, 0x22, 0x9c,
+	0xfb, 0x96, 0xe3, 0x7e, 0x08, 0x72, 0xbf, 0xe0, 0x67, 0x6a, 0x99, 0xcf, 0xb4, 0xdd, 0x0f, 0xb1,
+	0x16, 0x9b, 0x7e, 0x11, 0x8e, 0x7d, 0x70, 0xb9, 0x63, 0xe1, 0x7e, 0x39, 0xc3, 0xdf, 0x41, 0xab,
+	0x49, 0xc6, 0xa4, 0xb6, 0x48, 0xad, 0x2b, 0x31, 0xa6, 0xd9, 0xd8, 0xc8, 0xbc, 0xfd, 0x94, 0xc1,
+	0x3d, 0x1e, 0xfe, 0x6b, 0x6f, 0x3f, 0x69, 0xab, 0xce, 0xbc, 0x03, 0xdd, 0xfd, 0x56, 0x01, 0x6d,
+	0xcc, 0x5b, 0xf9, 0x95, 0xbc, 0xb7, 0xa4, 0x1e, 0x4d, 0x0a, 0x99, 0x47, 0x93, 0x4d, 0xb4, 0xc8,
+	0x5f, 0x56, 0xc0, 0x05, 0xca, 0xba, 0xa0, 0x58, 0x9c, 0x26, 0xef, 0x9a, 0xdc, 0xc7, 0x4a, 0xb0,
+	0xa0, 0x1a, 0xc3, 0x4f, 0xc1, 0xd9, 0xe6, 0x1b, 0x7a, 0xf1, 0xab, 0x34, 0xb4, 0x83, 0xb6, 0xe6,
+	0xf8, 0x2d, 0xed, 0x3b, 0xd4, 0xe9, 0x83, 0xa9, 0x5e, 0xf0, 0x4b, 0x9b, 0x79, 0xcf, 0xe5, 0x47,
+	0xde, 0xfd, 0xbe, 0x84, 0x6e, 0x1c, 0xc1, 0xd3, 0x9f, 0xe6, 0x58, 0xc3, 0x31, 0x6b, 0x26, 0x32,
+	0x06, 0xb9, 0x8d, 0x56, 0xc4, 0x93, 0xa1, 0xef, 0xba, 0x61, 0x34, 0x55, 0x73, 0x48, 0x77, 0x5d,
+	0x18, 0xd6, 0xe1, 0x31, 0x31, 0xf5, 0xf7, 0x84, 0x32, 0x03, 0xa0, 0xdb, 0x8a, 0x63, 0xb6, 0x90,
+	0x8e, 0xd9, 0x74, 0x90, 0x17, 0xb3, 0x41, 0x9e, 0x84, 0x73, 0x29, 0x1d, 0xce, 0xf7, 0xa7, 0x05,
+	0x54, 0xcb, 0x3f, 0xaa, 0xe2, 0xff, 0x43, 0xb7, 0x74, 0xf5, 0xe9, 0x71, 0x53, 0xe9, 0x69, 0xc7,
+	0x6d, 0x43, 0x57, 0x95, 0xee, 0x71, 0xdb, 0x38, 0x69, 0x77, 0x3b, 0x6a, 0x53, 0x7b, 0xa4, 0xa9,
+	0xad, 0xda, 0x95, 0xfa, 0xd6, 0xc5, 0xb4, 0x71, 0x2d, 0xd9, 0x78, 0xe2, 0xb0, 0x5e, 0x8f, 0x9e,
+	0x51, 0x62, 0xe3, 0x03, 0x74, 0x67, 0x76, 0xb7, 0xaa, 0xeb, 0xc7, 0xba, 0xa1, 0xb5, 0x8d, 0x96,
+	0xda, 0xd5, 0x1e, 0xb7, 0x6b, 0x52, 0xfd, 0xc6, 0xc5, 0xb4, 0x71, 0x3d, 0x39, 0x41, 0xf5, 0x7d,
+	0xd7, 0xd7, 0x9c, 0x16, 0x61, 0x36, 0xc1, 0x0f, 0xd1, 0xcd, 0xd9, 0x33, 0xba, 0x27, 0x1d, 0x55,
+	0xef, 0xaa, 0x2d, 0xb5, 0x55, 0x5b, 0xa8, 0xcb, 0x17, 0xd3, 0xc6, 0x46, 0xb2, 0xbd, 0x1b, 0xbf,
+	0x33, 0x63, 0x05, 0x35, 0x66, 0xf7, 0x3e, 0x51, 0x3f, 0x30, 0x9a, 0xc7, 0x47, 0x1d, 0xfd, 0xf8,
+	0x48, 0xeb, 0xaa, 0xb5, 0x42, 0xfe, 0xf3, 0x4f, 0xc8, 0xa4, 0x19, 0xf7, 0x06, 0xf8, 0x3d, 0xb4,
+	0x3d, 0x7b, 0x44, 0x4b, 0xeb, 0x36, 0xb5, 0xce, 0xa1, 0xd6, 0x56, 0xf4, 0x0f, 0x6a, 0xc5, 0x7a,
+	0xfd, 0x62, 0xda, 0xd8, 0x4c, 0x0e, 0x68, 0x45, 0x71, 0x62, 0xfa, 0x13, 0x7c, 0x30, 0xef, 0x0a,
+	0x4a, 0xeb, 0x48, 0x6b, 0x6b, 0xdd, 0x9e, 0xae, 0xf4, 0xb4, 0xa7, 0x6a, 0xad, 0x54, 0xbf, 0x79,
+	0x31, 0x6d, 0xc8, 0xc9, 0x09, 0x0a, 0x1b, 0xc4, 0x69, 0x10, 0xb2, 0xaa, 0x78, 0x4e, 0xea, 0xc5,
+	0x6f, 0x7f, 0x6f, 0xfb, 0xca, 0xfd, 0x1f, 0xb1, 0x66, 0x3e, 0x49, 0x36, 0xac, 0x8b, 0xea, 0xf6,
+	0x94, 0xa3, 0x8e, 0xd1, 0xed, 0x29, 0xbd, 0x93, 0x6e, 0xce, 0x2a, 0x70, 0xa7, 0xd4, 0xf2, 0xb4,
+	0x59, 0xfe, 0x0b, 0xe1, 0xcc, 0xce, 0xa7, 0xca, 0xa1, 0xd6, 0xaa, 0x49, 0xf5, 0xea, 0xc5, 0xb4,
+	0xc1, 0x1f, 0x29, 0x79, 0x2c, 0xde, 0x47, 0x1b, 0x99, 0x75, 0xea, 0xd7, 0x3a, 0x9a, 0x0e, 0x2a,
+	0xaf, 0x5d, 0x4c, 0x1b, 0xab, 0xb0, 0x52, 0x15, 0x7f, 0x15, 0x78, 0x03, 0x5d, 0xcf, 0xac, 0x4d,
+	0x59, 0xa8, 0x50, 0xbf, 0x7a, 0x31, 0x6d, 0xac, 0xf1, 0xcb, 0x24, 0xc6, 0xc9, 0x9f, 0xce, 0xd4,
+	0xf4, 0x44, 0x6d, 0xd5, 0x8a, 0xa9, 0xd3, 0x75, 0xf1, 0x27, 0xa7, 0xfc, 0xda, 0x8e, 0xda, 0x6e,
+	0x69, 0xed, 0xc7, 0xb5, 0x52, 0x6a, 0x6d, 0x87, 0xcf, 0xd8, 0x42, 0x5b, 0x3f, 0x59, 0x40, 0xab,
+	0xe9, 0x57, 0x32, 0xfc, 0x10, 0x6d, 0xb5, 0x8e, 0x9b, 0x27, 0x47, 0x6a, 0xbb, 0x67, 0xe8, 0xc7,
+	0x87, 0x6a, 0x4e, 0x5f, 0xe0, 0x04, 0xe9, 0x0d, 0x69, 0x85, 0xfd, 0x3f, 0xba, 0x95, 0xdd, 0xdb,
+	0x55, 0x95, 0x43, 0xb5, 0x65, 0x1c, 0xeb, 0xda, 0x63, 0xad, 0xad, 0x1c, 0xd6, 0x24, 0xae, 0xef,
+	0xf8, 0xc5, 0x93, 0x98, 0x43, 0x62, 0x1f, 0xfb, 0xb4, 0x4f, 0x1d, 0x73, 0x88, 0xdf, 0x42, 0x72,
+	0x76, 0xbb, 0xd2, 0xeb, 0x29, 0xcd, 0xf7, 0x19, 0x5d, 0x5b, 0xa8, 0x6f, 0x5e, 0x4c, 0x1b, 0x38,
+	0xda, 0xa9, 0x84, 0xa1, 0x69, 0x0d, 0xd8, 0x2f, 0xfc, 0x3f, 0xa8, 0x9e, 0xdd, 0xd5, 0x54, 0x0e,
+	0x9b, 0x46, 0x47, 0x69, 0x3e, 0x51, 0x1e, 0x33, 0xb7, 0xbd, 0x7e, 0x31, 0x6d, 0x5c, 0x8d, 0xf6,
+	0x35, 0xcd, 0xa1, 0xd5, 0x31, 0xad, 0xe7, 0x6c, 0x60, 0xdd, 0x45, 0xd7, 0xb2, 0x1b, 0x75, 0xb5,
+	0x75, 0xa8, 0xb5, 0xd5, 0x5a, 0x91, 0x1b, 0x22, 0x96, 0x92, 0xd8, 0x2c, 0x99, 0x0b, 0x85, 0xfd,
+	0x58, 0x42, 0x95, 0x4c, 0x3e, 0xc3, 0x6f, 0xa1, 0xad, 0x43, 0xad, 0xa9, 0xb6, 0xbb, 0x6a, 0xe2,
+	0x62, 0x4a, 0xaf, 0xa7, 0x76, 0x7b, 0xa0, 0xb1, 0x6b, 0x17, 0xd3, 0xc6, 0xba, 0xd8, 0x71, 0xe2,
+	0x44, 0xcf, 0x6d, 0xf8, 0x35, 0x74, 0x2d, 0xb7, 0x4b, 0x69, 0x82, 0x97, 0x4b, 0xf5, 0xf5, 0x8b,
+	0x69, 0x23, 0xfa, 0x86, 0xc2, 0xdf, 0xaf, 0xf6, 0x91, 0x9c, 0x5b, 0xdd, 0x3d, 0xe9, 0x32, 0xeb,
+	0x82, 0x9b, 0x6d, 0x5c, 0x4c, 0x1b, 0xb5, 0xe8, 0x52, 0x63, 0x36, 0xd9, 0xda, 0xc4, 0xe6, 0xf7,
+	0x3d, 0x68, 0x7d, 0xfa, 0xf9, 0xb6, 0xf4, 0xd9, 0xe7, 0xdb, 0xd2, 0x1f, 0x3e, 0xdf, 0x96, 0xbe,
+	0xf3, 0xc5, 0xf6, 0x95, 0xcf, 0xbe, 0xd8, 0xbe, 0xf2, 0x9b, 0x2f, 0xb6, 0xaf, 0x7c, 0xfd, 0x7e,
+	0x2a, 0x55, 0xbf, 0xce, 0xff, 0x5a, 0xfc, 0xd1, 0xec, 0x1f, 0x90, 0x61, 0x72, 0x3e, 0x5d, 0x84,
+	0xbf, 0xe7, 0xbe, 0xf9, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x38, 0xc5, 0x6c, 0x6a, 0x72, 0x1e,
+	0x00, 0x00,
+}
+
+func (this *Stamp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+
+	that1, ok := that.(*Stamp)
+	if !ok {
+		that2, ok := that.(Stamp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.EntityType)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	if this.Id != that1.Id {
+		return false
 	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	if this.DocumentHash != that1.DocumentHash {
+		return false
 	}
-	if len(m.MemberAddresses) > 0 {
-		for _, s := range m.MemberAddresses {
-			l = len(s)
-			n += 1 + l + sovStamp(uint64(l))
+	if this.PePublicKey != that1.PePublicKey {
+		return false
+	}
+	if this.Signature != that1.Signature {
+		return false
+	}
+	if this.JurisdictionId != that1.JurisdictionId {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.Revoked != that1.Revoked {
+		return false
+	}
+	if this.RevokedAt != that1.RevokedAt {
+		return false
+	}
+	if this.RevokedReason != that1.RevokedReason {
+		return false
+	}
+	if this.PeLicenseNumber != that1.PeLicenseNumber {
+		return false
+	}
+	if this.PeName != that1.PeName {
+		return false
+	}
+	if this.ProjectName != that1.ProjectName {
+		return false
+	}
+	if this.DocumentIpfsHash != that1.DocumentIpfsHash {
+		return false
+	}
+	if this.DocumentSize != that1.DocumentSize {
+		return false
+	}
+	if this.DocumentFilename != that1.DocumentFilename {
+		return false
+	}
+	if this.SignBytesVersion != that1.SignBytesVersion {
+		return false
+	}
+	if this.SignatureExpiry != that1.SignatureExpiry {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.CreatedHeight != that1.CreatedHeight {
+		return false
+	}
+	if this.ValidUntil != that1.ValidUntil {
+		return false
+	}
+	if this.Expired != that1.Expired {
+		return false
+	}
+	if this.Supersedes != that1.Supersedes {
+		return false
+	}
+	if this.SupersededBy != that1.SupersededBy {
+		return false
+	}
+	if this.RevocationReason != that1.RevocationReason {
+		return false
+	}
+	if this.RevocationEvidenceHash != that1.RevocationEvidenceHash {
+		return false
+	}
+	if this.RevokedBy != that1.RevokedBy {
+		return false
+	}
+	if this.BatchId != that1.BatchId {
+		return false
+	}
+	if this.MerkleLeafCount != that1.MerkleLeafCount {
+		return false
+	}
+	if len(this.CoSigners) != len(that1.CoSigners) {
+		return false
+	}
+	for i := range this.CoSigners {
+		if !this.CoSigners[i].Equal(&that1.CoSigners[i]) {
+			return false
 		}
 	}
-	if len(m.AdminAddresses) > 0 {
-		for _, s := range m.AdminAddresses {
-			l = len(s)
-			n += 1 + l + sovStamp(uint64(l))
+	if this.CoSignThreshold != that1.CoSignThreshold {
+		return false
+	}
+	if this.Pending != that1.Pending {
+		return false
+	}
+	if this.PeAccount != that1.PeAccount {
+		return false
+	}
+	if this.Delegate != that1.Delegate {
+		return false
+	}
+	if this.EntityId != that1.EntityId {
+		return false
+	}
+	return true
+}
+func (this *CoSigner) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CoSigner)
+	if !ok {
+		that2, ok := that.(CoSigner)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovStamp(uint64(m.CreatedAt))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Active {
-		n += 2
+	if this.PePublicKey != that1.PePublicKey {
+		return false
 	}
-	if len(m.Permissions) > 0 {
-		for k, v := range m.Permissions {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovStamp(uint64(len(k))) + 1 + len(v) + sovStamp(uint64(len(v)))
-			n += mapEntrySize + 1 + sovStamp(uint64(mapEntrySize))
+	if this.Discipline != that1.Discipline {
+		return false
+	}
+	if this.Signature != that1.Signature {
+		return false
+	}
+	if this.SignedAt != that1.SignedAt {
+		return false
+	}
+	if this.PeName != that1.PeName {
+		return false
+	}
+	if this.PeLicenseNumber != that1.PeLicenseNumber {
+		return false
+	}
+	if this.Delegate != that1.Delegate {
+		return false
+	}
+	return true
+}
+func (this *StampBatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StampBatch)
+	if !ok {
+		that2, ok := that.(StampBatch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.PePublicKey != that1.PePublicKey {
+		return false
+	}
+	if this.JurisdictionId != that1.JurisdictionId {
+		return false
+	}
+	if this.ProjectName != that1.ProjectName {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.StampCount != that1.StampCount {
+		return false
+	}
+	return true
+}
+func (this *DocumentStorage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DocumentStorage)
+	if !ok {
+		that2, ok := that.(DocumentStorage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.StampId != that1.StampId {
+		return false
+	}
+	if this.IpfsHash != that1.IpfsHash {
+		return false
+	}
+	if this.Filename != that1.Filename {
+		return false
+	}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
+	if this.MimeType != that1.MimeType {
+		return false
+	}
+	if this.UploadedAt != that1.UploadedAt {
+		return false
+	}
+	if this.UploadedBy != that1.UploadedBy {
+		return false
+	}
+	if this.Pinned != that1.Pinned {
+		return false
+	}
+	if this.ContentSha256 != that1.ContentSha256 {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.PinExpiresHeight != that1.PinExpiresHeight {
+		return false
+	}
+	if this.EntityId != that1.EntityId {
+		return false
+	}
+	return true
+}
+func (this *PinAttestation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PinAttestation)
+	if !ok {
+		that2, ok := that.(PinAttestation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DocumentId != that1.DocumentId {
+		return false
+	}
+	if this.Provider != that1.Provider {
+		return false
+	}
+	if this.ReplicaCount != that1.ReplicaCount {
+		return false
+	}
+	if this.PinnedUntilHeight != that1.PinnedUntilHeight {
+		return false
+	}
+	if this.AttestedHeight != that1.AttestedHeight {
+		return false
+	}
+	if this.Signature != that1.Signature {
+		return false
+	}
+	return true
+}
+func (this *EntityAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EntityAccount)
+	if !ok {
+		that2, ok := that.(EntityAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.EntityType != that1.EntityType {
+		return false
+	}
+	if this.OwnerAddress != that1.OwnerAddress {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.Active != that1.Active {
+		return false
+	}
+	if len(this.MemberAddresses) != len(that1.MemberAddresses) {
+		return false
+	}
+	for i := range this.MemberAddresses {
+		if this.MemberAddresses[i] != that1.MemberAddresses[i] {
+			return false
+		}
+	}
+	if len(this.AdminAddresses) != len(that1.AdminAddresses) {
+		return false
+	}
+	for i := range this.AdminAddresses {
+		if this.AdminAddresses[i] != that1.AdminAddresses[i] {
+			return false
+		}
+	}
+	if len(this.Permissions) != len(that1.Permissions) {
+		return false
+	}
+	for i := range this.Permissions {
+		if this.Permissions[i] != that1.Permissions[i] {
+			return false
+		}
+	}
+	if this.TreasuryAddress != that1.TreasuryAddress {
+		return false
+	}
+	if len(this.MemberMonthlyCap) != len(that1.MemberMonthlyCap) {
+		return false
+	}
+	for i := range this.MemberMonthlyCap {
+		if !this.MemberMonthlyCap[i].Equal(&that1.MemberMonthlyCap[i]) {
+			return false
+		}
+	}
+	if this.Metadata != that1.Metadata {
+		return false
+	}
+	if this.PendingOwner != that1.PendingOwner {
+		return false
+	}
+	return true
+}
+func (this *EntityMember) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EntityMember)
+	if !ok {
+		that2, ok := that.(EntityMember)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EntityId != that1.EntityId {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.JoinedHeight != that1.JoinedHeight {
+		return false
+	}
+	return true
+}
+func (this *EntityInvite) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EntityInvite)
+	if !ok {
+		that2, ok := that.(EntityInvite)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EntityId != that1.EntityId {
+		return false
+	}
+	if this.Invitee != that1.Invitee {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.InvitedBy != that1.InvitedBy {
+		return false
+	}
+	if this.CreatedHeight != that1.CreatedHeight {
+		return false
+	}
+	if this.ExpiresHeight != that1.ExpiresHeight {
+		return false
+	}
+	return true
+}
+func (this *MemberFeeUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MemberFeeUsage)
+	if !ok {
+		that2, ok := that.(MemberFeeUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EntityId != that1.EntityId {
+		return false
+	}
+	if this.Member != that1.Member {
+		return false
+	}
+	if this.Period != that1.Period {
+		return false
+	}
+	if len(this.Spent) != len(that1.Spent) {
+		return false
+	}
+	for i := range this.Spent {
+		if !this.Spent[i].Equal(&that1.Spent[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SpecVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SpecVersion)
+	if !ok {
+		that2, ok := that.(SpecVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ProjectId != that1.ProjectId {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.SpecHash != that1.SpecHash {
+		return false
+	}
+	if this.SpecIpfs != that1.SpecIpfs {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.CreatedBy != that1.CreatedBy {
+		return false
+	}
+	if this.Changelog != that1.Changelog {
+		return false
+	}
+	if this.ParentVersionId != that1.ParentVersionId {
+		return false
+	}
+	if this.EntityId != that1.EntityId {
+		return false
+	}
+	return true
+}
+func (this *ProfessionalEngineer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProfessionalEngineer)
+	if !ok {
+		that2, ok := that.(ProfessionalEngineer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PublicKey != that1.PublicKey {
+		return false
+	}
+	if this.Account != that1.Account {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.LicenseNumber != that1.LicenseNumber {
+		return false
+	}
+	if len(this.Jurisdictions) != len(that1.Jurisdictions) {
+		return false
+	}
+	for i := range this.Jurisdictions {
+		if this.Jurisdictions[i] != that1.Jurisdictions[i] {
+			return false
+		}
+	}
+	if this.RegisteredAt != that1.RegisteredAt {
+		return false
+	}
+	if this.PreviousPublicKey != that1.PreviousPublicKey {
+		return false
+	}
+	if this.SuccessorPublicKey != that1.SuccessorPublicKey {
+		return false
+	}
+	if this.RetiredAt != that1.RetiredAt {
+		return false
+	}
+	if this.CompromisedSinceHeight != that1.CompromisedSinceHeight {
+		return false
+	}
+	if this.CompromiseCursor != that1.CompromiseCursor {
+		return false
+	}
+	if this.CompromiseComplete != that1.CompromiseComplete {
+		return false
+	}
+	return true
+}
+func (this *LicenseStatusChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LicenseStatusChange)
+	if !ok {
+		that2, ok := that.(LicenseStatusChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.ChangedAt != that1.ChangedAt {
+		return false
+	}
+	if this.ChangedBy != that1.ChangedBy {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *StampingDelegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StampingDelegation)
+	if !ok {
+		that2, ok := that.(StampingDelegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Granter != that1.Granter {
+		return false
+	}
+	if this.Grantee != that1.Grantee {
+		return false
+	}
+	if len(this.JurisdictionIds) != len(that1.JurisdictionIds) {
+		return false
+	}
+	for i := range this.JurisdictionIds {
+		if this.JurisdictionIds[i] != that1.JurisdictionIds[i] {
+			return false
+		}
+	}
+	if len(this.ProjectNames) != len(that1.ProjectNames) {
+		return false
+	}
+	for i := range this.ProjectNames {
+		if this.ProjectNames[i] != that1.ProjectNames[i] {
+			return false
+		}
+	}
+	if this.Expiration != that1.Expiration {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	return true
+}
+func (this *License) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*License)
+	if !ok {
+		that2, ok := that.(License)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.JurisdictionId != that1.JurisdictionId {
+		return false
+	}
+	if this.LicenseNumber != that1.LicenseNumber {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if len(this.History) != len(that1.History) {
+		return false
+	}
+	for i := range this.History {
+		if !this.History[i].Equal(&that1.History[i]) {
+			return false
+		}
+	}
+	if this.PeAccount != that1.PeAccount {
+		return false
+	}
+	return true
+}
+func (m *Stamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.PeAccount) > 0 {
+		i -= len(m.PeAccount)
+		copy(dAtA[i:], m.PeAccount)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PeAccount)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.CoSignThreshold != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.CoSignThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.CoSigners) > 0 {
+		for iNdEx := len(m.CoSigners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoSigners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStamp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if m.MerkleLeafCount != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.MerkleLeafCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.BatchId) > 0 {
+		i -= len(m.BatchId)
+		copy(dAtA[i:], m.BatchId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.BatchId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.RevokedBy) > 0 {
+		i -= len(m.RevokedBy)
+		copy(dAtA[i:], m.RevokedBy)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.RevokedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.RevocationEvidenceHash) > 0 {
+		i -= len(m.RevocationEvidenceHash)
+		copy(dAtA[i:], m.RevocationEvidenceHash)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.RevocationEvidenceHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.RevocationReason != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.RevocationReason))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.SupersededBy) > 0 {
+		i -= len(m.SupersededBy)
+		copy(dAtA[i:], m.SupersededBy)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.SupersededBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.Supersedes) > 0 {
+		i -= len(m.Supersedes)
+		copy(dAtA[i:], m.Supersedes)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Supersedes)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.ValidUntil != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.ValidUntil))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.SignatureExpiry != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.SignatureExpiry))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.SignBytesVersion != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.SignBytesVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.DocumentFilename) > 0 {
+		i -= len(m.DocumentFilename)
+		copy(dAtA[i:], m.DocumentFilename)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.DocumentFilename)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.DocumentSize != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.DocumentSize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.DocumentIpfsHash) > 0 {
+		i -= len(m.DocumentIpfsHash)
+		copy(dAtA[i:], m.DocumentIpfsHash)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.DocumentIpfsHash)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.PeName) > 0 {
+		i -= len(m.PeName)
+		copy(dAtA[i:], m.PeName)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PeName)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.PeLicenseNumber) > 0 {
+		i -= len(m.PeLicenseNumber)
+		copy(dAtA[i:], m.PeLicenseNumber)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PeLicenseNumber)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.RevokedReason) > 0 {
+		i -= len(m.RevokedReason)
+		copy(dAtA[i:], m.RevokedReason)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.RevokedReason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RevokedAt != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.RevokedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.JurisdictionId) > 0 {
+		i -= len(m.JurisdictionId)
+		copy(dAtA[i:], m.JurisdictionId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.JurisdictionId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PePublicKey) > 0 {
+		i -= len(m.PePublicKey)
+		copy(dAtA[i:], m.PePublicKey)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PePublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentHash) > 0 {
+		i -= len(m.DocumentHash)
+		copy(dAtA[i:], m.DocumentHash)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.DocumentHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoSigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoSigner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PeLicenseNumber) > 0 {
+		i -= len(m.PeLicenseNumber)
+		copy(dAtA[i:], m.PeLicenseNumber)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PeLicenseNumber)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PeName) > 0 {
+		i -= len(m.PeName)
+		copy(dAtA[i:], m.PeName)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PeName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SignedAt != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.SignedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Discipline) > 0 {
+		i -= len(m.Discipline)
+		copy(dAtA[i:], m.Discipline)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Discipline)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PePublicKey) > 0 {
+		i -= len(m.PePublicKey)
+		copy(dAtA[i:], m.PePublicKey)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PePublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StampBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StampBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StampBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StampCount != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.StampCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.JurisdictionId) > 0 {
+		i -= len(m.JurisdictionId)
+		copy(dAtA[i:], m.JurisdictionId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.JurisdictionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PePublicKey) > 0 {
+		i -= len(m.PePublicKey)
+		copy(dAtA[i:], m.PePublicKey)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PePublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocumentStorage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentStorage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.PinExpiresHeight != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.PinExpiresHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Role != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ContentSha256) > 0 {
+		i -= len(m.ContentSha256)
+		copy(dAtA[i:], m.ContentSha256)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.ContentSha256)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Pinned {
+		i--
+		if m.Pinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.UploadedBy) > 0 {
+		i -= len(m.UploadedBy)
+		copy(dAtA[i:], m.UploadedBy)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.UploadedBy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.UploadedAt != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.UploadedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IpfsHash) > 0 {
+		i -= len(m.IpfsHash)
+		copy(dAtA[i:], m.IpfsHash)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.IpfsHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StampId) > 0 {
+		i -= len(m.StampId)
+		copy(dAtA[i:], m.StampId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.StampId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PinAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PinAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PinAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AttestedHeight != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.AttestedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PinnedUntilHeight != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.PinnedUntilHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReplicaCount != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.ReplicaCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntityAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntityAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntityAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.MemberMonthlyCap) > 0 {
+		for iNdEx := len(m.MemberMonthlyCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberMonthlyCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStamp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Permissions) > 0 {
+		for k := range m.Permissions {
+			v := m.Permissions[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintStamp(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintStamp(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintStamp(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AdminAddresses) > 0 {
+		for iNdEx := len(m.AdminAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdminAddresses[iNdEx])
+			copy(dAtA[i:], m.AdminAddresses[iNdEx])
+			i = encodeVarintStamp(dAtA, i, uint64(len(m.AdminAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MemberAddresses) > 0 {
+		for iNdEx := len(m.MemberAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MemberAddresses[iNdEx])
+			copy(dAtA[i:], m.MemberAddresses[iNdEx])
+			i = encodeVarintStamp(dAtA, i, uint64(len(m.MemberAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EntityType) > 0 {
+		i -= len(m.EntityType)
+		copy(dAtA[i:], m.EntityType)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.EntityType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntityMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntityMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntityMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JoinedHeight != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.JoinedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntityInvite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntityInvite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntityInvite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresHeight != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.ExpiresHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.InvitedBy) > 0 {
+		i -= len(m.InvitedBy)
+		copy(dAtA[i:], m.InvitedBy)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.InvitedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Invitee) > 0 {
+		i -= len(m.Invitee)
+		copy(dAtA[i:], m.Invitee)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Invitee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemberFeeUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberFeeUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberFeeUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStamp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ParentVersionId) > 0 {
+		i -= len(m.ParentVersionId)
+		copy(dAtA[i:], m.ParentVersionId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.ParentVersionId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Changelog) > 0 {
+		i -= len(m.Changelog)
+		copy(dAtA[i:], m.Changelog)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Changelog)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SpecIpfs) > 0 {
+		i -= len(m.SpecIpfs)
+		copy(dAtA[i:], m.SpecIpfs)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.SpecIpfs)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SpecHash) > 0 {
+		i -= len(m.SpecHash)
+		copy(dAtA[i:], m.SpecHash)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.SpecHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProjectId) > 0 {
+		i -= len(m.ProjectId)
+		copy(dAtA[i:], m.ProjectId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.ProjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProfessionalEngineer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfessionalEngineer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfessionalEngineer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompromiseComplete {
+		i--
+		if m.CompromiseComplete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.CompromiseCursor) > 0 {
+		i -= len(m.CompromiseCursor)
+		copy(dAtA[i:], m.CompromiseCursor)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.CompromiseCursor)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.CompromisedSinceHeight != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.CompromisedSinceHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RetiredAt != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.RetiredAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.SuccessorPublicKey) > 0 {
+		i -= len(m.SuccessorPublicKey)
+		copy(dAtA[i:], m.SuccessorPublicKey)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.SuccessorPublicKey)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PreviousPublicKey) > 0 {
+		i -= len(m.PreviousPublicKey)
+		copy(dAtA[i:], m.PreviousPublicKey)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PreviousPublicKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RegisteredAt != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.RegisteredAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Jurisdictions) > 0 {
+		for iNdEx := len(m.Jurisdictions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Jurisdictions[iNdEx])
+			copy(dAtA[i:], m.Jurisdictions[iNdEx])
+			i = encodeVarintStamp(dAtA, i, uint64(len(m.Jurisdictions[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LicenseNumber) > 0 {
+		i -= len(m.LicenseNumber)
+		copy(dAtA[i:], m.LicenseNumber)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.LicenseNumber)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LicenseStatusChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LicenseStatusChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LicenseStatusChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChangedBy) > 0 {
+		i -= len(m.ChangedBy)
+		copy(dAtA[i:], m.ChangedBy)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.ChangedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChangedAt != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.ChangedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StampingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StampingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StampingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Expiration != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ProjectNames) > 0 {
+		for iNdEx := len(m.ProjectNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProjectNames[iNdEx])
+			copy(dAtA[i:], m.ProjectNames[iNdEx])
+			i = encodeVarintStamp(dAtA, i, uint64(len(m.ProjectNames[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.JurisdictionIds) > 0 {
+		for iNdEx := len(m.JurisdictionIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JurisdictionIds[iNdEx])
+			copy(dAtA[i:], m.JurisdictionIds[iNdEx])
+			i = encodeVarintStamp(dAtA, i, uint64(len(m.JurisdictionIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *License) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *License) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *License) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeAccount) > 0 {
+		i -= len(m.PeAccount)
+		copy(dAtA[i:], m.PeAccount)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PeAccount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStamp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LicenseNumber) > 0 {
+		i -= len(m.LicenseNumber)
+		copy(dAtA[i:], m.LicenseNumber)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.LicenseNumber)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JurisdictionId) > 0 {
+		i -= len(m.JurisdictionId)
+		copy(dAtA[i:], m.JurisdictionId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.JurisdictionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StampVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StampVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StampVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoSigners) > 0 {
+		for iNdEx := len(m.CoSigners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoSigners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStamp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SuccessorId) > 0 {
+		i -= len(m.SuccessorId)
+		copy(dAtA[i:], m.SuccessorId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.SuccessorId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LicenseStatusNow != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.LicenseStatusNow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LicenseStatusAtStamp != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.LicenseStatusAtStamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StampId) > 0 {
+		i -= len(m.StampId)
+		copy(dAtA[i:], m.StampId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.StampId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoSignerVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoSignerVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoSignerVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LicenseStatusAtSigning != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.LicenseStatusAtSigning))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LicenseStatusNow != 0 {
+		i = encodeVarintStamp(dAtA, i, uint64(m.LicenseStatusNow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SignatureValid {
+		i--
+		if m.SignatureValid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Signed {
+		i--
+		if m.Signed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PeName) > 0 {
+		i -= len(m.PeName)
+		copy(dAtA[i:], m.PeName)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PeName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Discipline) > 0 {
+		i -= len(m.Discipline)
+		copy(dAtA[i:], m.Discipline)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Discipline)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PePublicKey) > 0 {
+		i -= len(m.PePublicKey)
+		copy(dAtA[i:], m.PePublicKey)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.PePublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MerkleInclusionVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleInclusionVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleInclusionVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StampId) > 0 {
+		i -= len(m.StampId)
+		copy(dAtA[i:], m.StampId)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.StampId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LeafHash) > 0 {
+		i -= len(m.LeafHash)
+		copy(dAtA[i:], m.LeafHash)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.LeafHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintStamp(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStamp(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStamp(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Stamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.PePublicKey)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.JurisdictionId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovStamp(uint64(m.CreatedAt))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.Revoked {
+		n += 2
+	}
+	if m.RevokedAt != 0 {
+		n += 1 + sovStamp(uint64(m.RevokedAt))
+	}
+	l = len(m.RevokedReason)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.PeLicenseNumber)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.PeName)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.DocumentIpfsHash)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.DocumentSize != 0 {
+		n += 1 + sovStamp(uint64(m.DocumentSize))
+	}
+	l = len(m.DocumentFilename)
+	if l > 0 {
+		n += 2 + l + sovStamp(uint64(l))
+	}
+	if m.SignBytesVersion != 0 {
+		n += 2 + sovStamp(uint64(m.SignBytesVersion))
+	}
+	if m.SignatureExpiry != 0 {
+		n += 2 + sovStamp(uint64(m.SignatureExpiry))
+	}
+	if m.Nonce != 0 {
+		n += 2 + sovStamp(uint64(m.Nonce))
+	}
+	if m.CreatedHeight != 0 {
+		n += 2 + sovStamp(uint64(m.CreatedHeight))
+	}
+	if m.ValidUntil != 0 {
+		n += 2 + sovStamp(uint64(m.ValidUntil))
+	}
+	if m.Expired {
+		n += 3
+	}
+	l = len(m.Supersedes)
+	if l > 0 {
+		n += 2 + l + sovStamp(uint64(l))
+	}
+	l = len(m.SupersededBy)
+	if l > 0 {
+		n += 2 + l + sovStamp(uint64(l))
+	}
+	if m.RevocationReason != 0 {
+		n += 2 + sovStamp(uint64(m.RevocationReason))
+	}
+	l = len(m.RevocationEvidenceHash)
+	if l > 0 {
+		n += 2 + l + sovStamp(uint64(l))
+	}
+	l = len(m.RevokedBy)
+	if l > 0 {
+		n += 2 + l + sovStamp(uint64(l))
+	}
+	l = len(m.BatchId)
+	if l > 0 {
+		n += 2 + l + sovStamp(uint64(l))
+	}
+	if m.MerkleLeafCount != 0 {
+		n += 2 + sovStamp(uint64(m.MerkleLeafCount))
+	}
+	if len(m.CoSigners) > 0 {
+		for _, e := range m.CoSigners {
+			l = e.Size()
+			n += 2 + l + sovStamp(uint64(l))
+		}
+	}
+	if m.CoSignThreshold != 0 {
+		n += 2 + sovStamp(uint64(m.CoSignThreshold))
+	}
+	if m.Pending {
+		n += 3
+	}
+	l = len(m.PeAccount)
+	if l > 0 {
+		n += 2 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 2 + l + sovStamp(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 2 + l + sovStamp(uint64(l))
+	}
+	return n
+}
+
+func (m *CoSigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PePublicKey)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Discipline)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.SignedAt != 0 {
+		n += 1 + sovStamp(uint64(m.SignedAt))
+	}
+	l = len(m.PeName)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.PeLicenseNumber)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	return n
+}
+
+func (m *StampBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.PePublicKey)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.JurisdictionId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovStamp(uint64(m.CreatedAt))
+	}
+	if m.StampCount != 0 {
+		n += 1 + sovStamp(uint64(m.StampCount))
+	}
+	return n
+}
+
+func (m *DocumentStorage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.StampId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.IpfsHash)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovStamp(uint64(m.Size_))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.UploadedAt != 0 {
+		n += 1 + sovStamp(uint64(m.UploadedAt))
+	}
+	l = len(m.UploadedBy)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.Pinned {
+		n += 2
+	}
+	l = len(m.ContentSha256)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovStamp(uint64(m.Role))
+	}
+	if m.PinExpiresHeight != 0 {
+		n += 1 + sovStamp(uint64(m.PinExpiresHeight))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	return n
+}
+
+func (m *PinAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.ReplicaCount != 0 {
+		n += 1 + sovStamp(uint64(m.ReplicaCount))
+	}
+	if m.PinnedUntilHeight != 0 {
+		n += 1 + sovStamp(uint64(m.PinnedUntilHeight))
+	}
+	if m.AttestedHeight != 0 {
+		n += 1 + sovStamp(uint64(m.AttestedHeight))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	return n
+}
+
+func (m *EntityAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.EntityType)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if len(m.MemberAddresses) > 0 {
+		for _, s := range m.MemberAddresses {
+			l = len(s)
+			n += 1 + l + sovStamp(uint64(l))
+		}
+	}
+	if len(m.AdminAddresses) > 0 {
+		for _, s := range m.AdminAddresses {
+			l = len(s)
+			n += 1 + l + sovStamp(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovStamp(uint64(m.CreatedAt))
+	}
+	if m.Active {
+		n += 2
+	}
+	if len(m.Permissions) > 0 {
+		for k, v := range m.Permissions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovStamp(uint64(len(k))) + 1 + len(v) + sovStamp(uint64(len(v)))
+			n += mapEntrySize + 1 + sovStamp(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if len(m.MemberMonthlyCap) > 0 {
+		for _, e := range m.MemberMonthlyCap {
+			l = e.Size()
+			n += 1 + l + sovStamp(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	return n
+}
+
+func (m *EntityMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.JoinedHeight != 0 {
+		n += 1 + sovStamp(uint64(m.JoinedHeight))
+	}
+	return n
+}
+
+func (m *EntityInvite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Invitee)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.InvitedBy)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovStamp(uint64(m.CreatedHeight))
+	}
+	if m.ExpiresHeight != 0 {
+		n += 1 + sovStamp(uint64(m.ExpiresHeight))
+	}
+	return n
+}
+
+func (m *MemberFeeUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovStamp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpecVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.ProjectId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.SpecHash)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.SpecIpfs)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovStamp(uint64(m.CreatedAt))
+	}
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Changelog)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.ParentVersionId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	return n
+}
+
+func (m *ProfessionalEngineer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.LicenseNumber)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if len(m.Jurisdictions) > 0 {
+		for _, s := range m.Jurisdictions {
+			l = len(s)
+			n += 1 + l + sovStamp(uint64(l))
+		}
+	}
+	if m.RegisteredAt != 0 {
+		n += 1 + sovStamp(uint64(m.RegisteredAt))
+	}
+	l = len(m.PreviousPublicKey)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.SuccessorPublicKey)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.RetiredAt != 0 {
+		n += 1 + sovStamp(uint64(m.RetiredAt))
+	}
+	if m.CompromisedSinceHeight != 0 {
+		n += 1 + sovStamp(uint64(m.CompromisedSinceHeight))
+	}
+	l = len(m.CompromiseCursor)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.CompromiseComplete {
+		n += 2
+	}
+	return n
+}
+
+func (m *LicenseStatusChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovStamp(uint64(m.Status))
+	}
+	if m.ChangedAt != 0 {
+		n += 1 + sovStamp(uint64(m.ChangedAt))
+	}
+	l = len(m.ChangedBy)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	return n
+}
+
+func (m *StampingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if len(m.JurisdictionIds) > 0 {
+		for _, s := range m.JurisdictionIds {
+			l = len(s)
+			n += 1 + l + sovStamp(uint64(l))
+		}
+	}
+	if len(m.ProjectNames) > 0 {
+		for _, s := range m.ProjectNames {
+			l = len(s)
+			n += 1 + l + sovStamp(uint64(l))
+		}
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovStamp(uint64(m.Expiration))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovStamp(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *License) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JurisdictionId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.LicenseNumber)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovStamp(uint64(m.Status))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovStamp(uint64(l))
+		}
+	}
+	l = len(m.PeAccount)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	return n
+}
+
+func (m *StampVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StampId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.LicenseStatusAtStamp != 0 {
+		n += 1 + sovStamp(uint64(m.LicenseStatusAtStamp))
+	}
+	if m.LicenseStatusNow != 0 {
+		n += 1 + sovStamp(uint64(m.LicenseStatusNow))
+	}
+	if m.Status != 0 {
+		n += 1 + sovStamp(uint64(m.Status))
+	}
+	l = len(m.SuccessorId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if len(m.CoSigners) > 0 {
+		for _, e := range m.CoSigners {
+			l = e.Size()
+			n += 1 + l + sovStamp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CoSignerVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PePublicKey)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Discipline)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.PeName)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.Signed {
+		n += 2
+	}
+	if m.SignatureValid {
+		n += 2
+	}
+	if m.LicenseStatusNow != 0 {
+		n += 1 + sovStamp(uint64(m.LicenseStatusNow))
+	}
+	if m.LicenseStatusAtSigning != 0 {
+		n += 1 + sovStamp(uint64(m.LicenseStatusAtSigning))
+	}
+	return n
+}
+
+func (m *MerkleInclusionVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.LeafHash)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.StampId)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovStamp(uint64(l))
+	}
+	return n
+}
+
+func sovStamp(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStamp(x uint64) (n int) {
+	return sovStamp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Stamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PePublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PePublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+			}
+			m.RevokedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeLicenseNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeLicenseNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentIpfsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentIpfsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentSize", wireType)
+			}
+			m.DocumentSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentFilename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentFilename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytesVersion", wireType)
+			}
+			m.SignBytesVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignBytesVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureExpiry", wireType)
+			}
+			m.SignatureExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supersedes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supersedes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersededBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocationReason", wireType)
+			}
+			m.RevocationReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevocationReason |= RevocationReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocationEvidenceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocationEvidenceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleLeafCount", wireType)
+			}
+			m.MerkleLeafCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerkleLeafCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoSigners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoSigners = append(m.CoSigners, CoSigner{})
+			if err := m.CoSigners[len(m.CoSigners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoSignThreshold", wireType)
+			}
+			m.CoSignThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoSignThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoSigner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoSigner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoSigner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PePublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PePublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discipline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discipline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedAt", wireType)
+			}
+			m.SignedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeLicenseNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeLicenseNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StampBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StampBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StampBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PePublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PePublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StampCount", wireType)
+			}
+			m.StampCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StampCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DocumentStorage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DocumentStorage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DocumentStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StampId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadedAt", wireType)
+			}
+			m.UploadedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pinned = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentSha256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentSha256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= DocumentRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinExpiresHeight", wireType)
+			}
+			m.PinExpiresHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PinExpiresHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PinAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PinAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PinAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaCount", wireType)
+			}
+			m.ReplicaCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinnedUntilHeight", wireType)
+			}
+			m.PinnedUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PinnedUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedHeight", wireType)
+			}
+			m.AttestedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntityAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberAddresses = append(m.MemberAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAddresses = append(m.AdminAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStamp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStamp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthStamp
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthStamp
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStamp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthStamp
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthStamp
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipStamp(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthStamp
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Permissions[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberMonthlyCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberMonthlyCap = append(m.MemberMonthlyCap, types.Coin{})
+			if err := m.MemberMonthlyCap[len(m.MemberMonthlyCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntityMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinedHeight", wireType)
+			}
+			m.JoinedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntityInvite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityInvite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityInvite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invitee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invitee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvitedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresHeight", wireType)
+			}
+			m.ExpiresHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberFeeUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberFeeUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberFeeUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *SpecVersion) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
-	}
-	l = len(m.ProjectId)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
-	}
-	l = len(m.SpecHash)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
-	}
-	l = len(m.SpecIpfs)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovStamp(uint64(m.CreatedAt))
-	}
-	l = len(m.CreatedBy)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
-	}
-	l = len(m.Changelog)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
-	}
-	l = len(m.ParentVersionId)
-	if l > 0 {
-		n += 1 + l + sovStamp(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovStamp(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStamp(x uint64) (n int) {
-	return sovStamp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Stamp) Unmarshal(dAtA []byte) error {
+func (m *SpecVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1469,10 +8504,10 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Stamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpecVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Stamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpecVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1509,7 +8544,7 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1537,11 +8572,11 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			m.ProjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PePublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1569,11 +8604,11 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PePublicKey = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1601,11 +8636,11 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.SpecHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecIpfs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1633,7 +8668,7 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			m.SpecIpfs = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
@@ -1656,7 +8691,7 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 			}
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1684,13 +8719,13 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changelog", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -1700,17 +8735,175 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changelog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentVersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentVersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfessionalEngineer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfessionalEngineer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfessionalEngineer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Revoked = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			m.RevokedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -1720,14 +8913,27 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RevokedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevokedReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1755,11 +8961,11 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RevokedReason = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeLicenseNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenseNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1787,11 +8993,11 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeLicenseNumber = string(dAtA[iNdEx:postIndex])
+			m.LicenseNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdictions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1819,11 +9025,30 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeName = string(dAtA[iNdEx:postIndex])
+			m.Jurisdictions = append(m.Jurisdictions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 13:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+			}
+			m.RegisteredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPublicKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1851,11 +9076,11 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			m.PreviousPublicKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentIpfsHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorPublicKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1883,13 +9108,13 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentIpfsHash = string(dAtA[iNdEx:postIndex])
+			m.SuccessorPublicKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAt", wireType)
 			}
-			m.DocumentSize = 0
+			m.RetiredAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -1899,14 +9124,33 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DocumentSize |= int64(b&0x7F) << shift
+				m.RetiredAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompromisedSinceHeight", wireType)
+			}
+			m.CompromisedSinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompromisedSinceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentFilename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompromiseCursor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1934,8 +9178,28 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentFilename = string(dAtA[iNdEx:postIndex])
+			m.CompromiseCursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompromiseComplete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CompromiseComplete = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStamp(dAtA[iNdEx:])
@@ -1957,7 +9221,7 @@ func (m *Stamp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DocumentStorage) Unmarshal(dAtA []byte) error {
+func (m *LicenseStatusChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1980,17 +9244,17 @@ func (m *DocumentStorage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DocumentStorage: wiretype end group for non-group")
+			return fmt.Errorf("proto: LicenseStatusChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DocumentStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LicenseStatusChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -2000,29 +9264,16 @@ func (m *DocumentStorage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= LicenseStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStamp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStamp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedAt", wireType)
 			}
-			var stringLen uint64
+			m.ChangedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -2032,27 +9283,14 @@ func (m *DocumentStorage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChangedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStamp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStamp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StampId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpfsHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2080,11 +9318,11 @@ func (m *DocumentStorage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IpfsHash = string(dAtA[iNdEx:postIndex])
+			m.ChangedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2112,13 +9350,63 @@ func (m *DocumentStorage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Size_ = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StampingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StampingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StampingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -2128,14 +9416,27 @@ func (m *DocumentStorage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2163,13 +9464,13 @@ func (m *DocumentStorage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MimeType = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadedAt", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionIds", wireType)
 			}
-			m.UploadedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -2179,14 +9480,27 @@ func (m *DocumentStorage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UploadedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionIds = append(m.JurisdictionIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2214,13 +9528,32 @@ func (m *DocumentStorage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UploadedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			m.ProjectNames = append(m.ProjectNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var v int
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -2230,12 +9563,11 @@ func (m *DocumentStorage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Pinned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStamp(dAtA[iNdEx:])
@@ -2257,7 +9589,7 @@ func (m *DocumentStorage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EntityAccount) Unmarshal(dAtA []byte) error {
+func (m *License) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2280,15 +9612,15 @@ func (m *EntityAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EntityAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: License: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EntityAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: License: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2316,11 +9648,11 @@ func (m *EntityAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenseNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2348,13 +9680,13 @@ func (m *EntityAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.LicenseNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -2364,29 +9696,16 @@ func (m *EntityAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= LicenseStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStamp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStamp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EntityType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -2396,27 +9715,29 @@ func (m *EntityAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStamp
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStamp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.History = append(m.History, LicenseStatusChange{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2444,11 +9765,61 @@ func (m *EntityAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemberAddresses = append(m.MemberAddresses, string(dAtA[iNdEx:postIndex]))
+			m.PeAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StampVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StampVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StampVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2476,13 +9847,13 @@ func (m *EntityAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdminAddresses = append(m.AdminAddresses, string(dAtA[iNdEx:postIndex]))
+			m.StampId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			m.CreatedAt = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -2492,16 +9863,17 @@ func (m *EntityAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			m.Valid = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -2511,17 +9883,29 @@ func (m *EntityAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenseStatusAtStamp", wireType)
+			}
+			m.LicenseStatusAtStamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -2531,118 +9915,114 @@ func (m *EntityAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LicenseStatusAtStamp |= LicenseStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStamp
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStamp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Permissions == nil {
-				m.Permissions = make(map[string]string)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenseStatusNow", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStamp
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStamp
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthStamp
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthStamp
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStamp
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthStamp
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthStamp
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipStamp(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthStamp
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			m.LicenseStatusNow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LicenseStatusNow |= LicenseStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Permissions[mapkey] = mapvalue
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StampStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoSigners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoSigners = append(m.CoSigners, CoSignerVerification{})
+			if err := m.CoSigners[len(m.CoSigners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2665,7 +10045,7 @@ func (m *EntityAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpecVersion) Unmarshal(dAtA []byte) error {
+func (m *CoSignerVerification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2688,15 +10068,15 @@ func (m *SpecVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpecVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: CoSignerVerification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpecVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CoSignerVerification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PePublicKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2724,11 +10104,11 @@ func (m *SpecVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.PePublicKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Discipline", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2756,11 +10136,11 @@ func (m *SpecVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProjectId = string(dAtA[iNdEx:postIndex])
+			m.Discipline = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2788,13 +10168,13 @@ func (m *SpecVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.PeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -2804,29 +10184,56 @@ func (m *SpecVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStamp
+			m.Signed = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureValid", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStamp
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SignatureValid = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenseStatusNow", wireType)
 			}
-			m.SpecHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecIpfs", wireType)
+			m.LicenseStatusNow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LicenseStatusNow |= LicenseStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenseStatusAtSigning", wireType)
+			}
+			m.LicenseStatusAtSigning = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -2836,29 +10243,66 @@ func (m *SpecVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LicenseStatusAtSigning |= LicenseStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStamp
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthStamp
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecIpfs = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerkleInclusionVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStamp
 			}
-			m.CreatedAt = 0
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleInclusionVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleInclusionVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStamp
@@ -2868,14 +10312,27 @@ func (m *SpecVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2903,11 +10360,31 @@ func (m *SpecVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			m.LeafHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changelog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2935,11 +10412,11 @@ func (m *SpecVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Changelog = string(dAtA[iNdEx:postIndex])
+			m.StampId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentVersionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2967,7 +10444,7 @@ func (m *SpecVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentVersionId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -140,6 +142,11 @@ type MsgCreateStamp struct {
 	DocumentIpfsHash string `protobuf:"bytes,9,opt,name=document_ipfs_hash,json=documentIpfsHash,proto3" json:"document_ipfs_hash,omitempty"`
 	DocumentSize     int64  `protobuf:"varint,10,opt,name=document_size,json=documentSize,proto3" json:"document_size,omitempty"`
 	DocumentFilename string `protobuf:"bytes,11,opt,name=document_filename,json=documentFilename,proto3" json:"document_filename,omitempty"`
+	// Signing payload (see types.StampSignBytes)
+	SignatureExpiry int64  `protobuf:"varint,12,opt,name=signature_expiry,json=signatureExpiry,proto3" json:"signature_expiry,omitempty"`
+	Nonce           uint64 `protobuf:"varint,13,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ValidUntil      int64  `protobuf:"varint,14,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	EntityId        string `protobuf:"bytes,15,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 }
 
 func (m *MsgCreateStamp) Reset()         { *m = MsgCreateStamp{} }
@@ -252,6 +259,34 @@ func (m *MsgCreateStamp) GetDocumentFilename() string {
 	return ""
 }
 
+func (m *MsgCreateStamp) GetSignatureExpiry() int64 {
+	if m != nil {
+		return m.SignatureExpiry
+	}
+	return 0
+}
+
+func (m *MsgCreateStamp) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MsgCreateStamp) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
+func (m *MsgCreateStamp) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
 // MsgCreateStampResponse is the response for CreateStamp
 type MsgCreateStampResponse struct {
 	StampId string `protobuf:"bytes,1,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
@@ -307,9 +342,12 @@ func (m *MsgCreateStampResponse) GetTxHash() string {
 
 // MsgRevokeStamp revokes an existing stamp
 type MsgRevokeStamp struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	StampId string `protobuf:"bytes,2,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
-	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Creator      string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	StampId      string           `protobuf:"bytes,2,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
+	Reason       string           `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReasonCode   RevocationReason `protobuf:"varint,4,opt,name=reason_code,json=reasonCode,proto3,enum=stampledgerchain.stampledgerchain.v1.RevocationReason" json:"reason_code,omitempty"`
+	EvidenceHash string           `protobuf:"bytes,5,opt,name=evidence_hash,json=evidenceHash,proto3" json:"evidence_hash,omitempty"`
+	EntityId     string           `protobuf:"bytes,6,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 }
 
 func (m *MsgRevokeStamp) Reset()         { *m = MsgRevokeStamp{} }
@@ -366,6 +404,27 @@ func (m *MsgRevokeStamp) GetReason() string {
 	return ""
 }
 
+func (m *MsgRevokeStamp) GetReasonCode() RevocationReason {
+	if m != nil {
+		return m.ReasonCode
+	}
+	return RevocationUnspecified
+}
+
+func (m *MsgRevokeStamp) GetEvidenceHash() string {
+	if m != nil {
+		return m.EvidenceHash
+	}
+	return ""
+}
+
+func (m *MsgRevokeStamp) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
 // MsgRevokeStampResponse is the response for RevokeStamp
 type MsgRevokeStampResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -411,29 +470,28 @@ func (m *MsgRevokeStampResponse) GetSuccess() bool {
 	return false
 }
 
-// MsgStoreDocument stores a document reference on the blockchain
-type MsgStoreDocument struct {
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	StampId    string `protobuf:"bytes,2,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
-	IpfsHash   string `protobuf:"bytes,3,opt,name=ipfs_hash,json=ipfsHash,proto3" json:"ipfs_hash,omitempty"`
-	Filename   string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
-	Size_      int64  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
-	MimeType   string `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	PinForever bool   `protobuf:"varint,7,opt,name=pin_forever,json=pinForever,proto3" json:"pin_forever,omitempty"`
+// StampBatchEntry is one sheet of a MsgCreateStampBatch. Each entry carries
+// its own signature over the StampSignDoc for its document hash.
+type StampBatchEntry struct {
+	DocumentHash     string `protobuf:"bytes,1,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
+	Signature        string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	DocumentIpfsHash string `protobuf:"bytes,3,opt,name=document_ipfs_hash,json=documentIpfsHash,proto3" json:"document_ipfs_hash,omitempty"`
+	DocumentSize     int64  `protobuf:"varint,4,opt,name=document_size,json=documentSize,proto3" json:"document_size,omitempty"`
+	DocumentFilename string `protobuf:"bytes,5,opt,name=document_filename,json=documentFilename,proto3" json:"document_filename,omitempty"`
 }
 
-func (m *MsgStoreDocument) Reset()         { *m = MsgStoreDocument{} }
-func (m *MsgStoreDocument) String() string { return proto.CompactTextString(m) }
-func (*MsgStoreDocument) ProtoMessage()    {}
-func (*MsgStoreDocument) Descriptor() ([]byte, []int) {
+func (m *StampBatchEntry) Reset()         { *m = StampBatchEntry{} }
+func (m *StampBatchEntry) String() string { return proto.CompactTextString(m) }
+func (*StampBatchEntry) ProtoMessage()    {}
+func (*StampBatchEntry) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e6b0ca2d425e53fb, []int{6}
 }
-func (m *MsgStoreDocument) XXX_Unmarshal(b []byte) error {
+func (m *StampBatchEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgStoreDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StampBatchEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgStoreDocument.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StampBatchEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -443,85 +501,81 @@ func (m *MsgStoreDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgStoreDocument) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgStoreDocument.Merge(m, src)
+func (m *StampBatchEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StampBatchEntry.Merge(m, src)
 }
-func (m *MsgStoreDocument) XXX_Size() int {
+func (m *StampBatchEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgStoreDocument) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgStoreDocument.DiscardUnknown(m)
+func (m *StampBatchEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StampBatchEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgStoreDocument proto.InternalMessageInfo
-
-func (m *MsgStoreDocument) GetCreator() string {
-	if m != nil {
-		return m.Creator
-	}
-	return ""
-}
+var xxx_messageInfo_StampBatchEntry proto.InternalMessageInfo
 
-func (m *MsgStoreDocument) GetStampId() string {
+func (m *StampBatchEntry) GetDocumentHash() string {
 	if m != nil {
-		return m.StampId
+		return m.DocumentHash
 	}
 	return ""
 }
 
-func (m *MsgStoreDocument) GetIpfsHash() string {
+func (m *StampBatchEntry) GetSignature() string {
 	if m != nil {
-		return m.IpfsHash
+		return m.Signature
 	}
 	return ""
 }
 
-func (m *MsgStoreDocument) GetFilename() string {
+func (m *StampBatchEntry) GetDocumentIpfsHash() string {
 	if m != nil {
-		return m.Filename
+		return m.DocumentIpfsHash
 	}
 	return ""
 }
 
-func (m *MsgStoreDocument) GetSize_() int64 {
+func (m *StampBatchEntry) GetDocumentSize() int64 {
 	if m != nil {
-		return m.Size_
+		return m.DocumentSize
 	}
 	return 0
 }
 
-func (m *MsgStoreDocument) GetMimeType() string {
+func (m *StampBatchEntry) GetDocumentFilename() string {
 	if m != nil {
-		return m.MimeType
+		return m.DocumentFilename
 	}
 	return ""
 }
 
-func (m *MsgStoreDocument) GetPinForever() bool {
-	if m != nil {
-		return m.PinForever
-	}
-	return false
-}
-
-// MsgStoreDocumentResponse is the response for StoreDocument
-type MsgStoreDocumentResponse struct {
-	DocumentId string `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
-	IpfsUrl    string `protobuf:"bytes,2,opt,name=ipfs_url,json=ipfsUrl,proto3" json:"ipfs_url,omitempty"`
+// MsgCreateStampBatch stamps a set of drawings under one PE key in a single
+// transaction. Either every entry is stamped or none is.
+type MsgCreateStampBatch struct {
+	Creator         string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PePublicKey     string            `protobuf:"bytes,2,opt,name=pe_public_key,json=pePublicKey,proto3" json:"pe_public_key,omitempty"`
+	JurisdictionId  string            `protobuf:"bytes,3,opt,name=jurisdiction_id,json=jurisdictionId,proto3" json:"jurisdiction_id,omitempty"`
+	PeLicenseNumber string            `protobuf:"bytes,4,opt,name=pe_license_number,json=peLicenseNumber,proto3" json:"pe_license_number,omitempty"`
+	PeName          string            `protobuf:"bytes,5,opt,name=pe_name,json=peName,proto3" json:"pe_name,omitempty"`
+	ProjectName     string            `protobuf:"bytes,6,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	SignatureExpiry int64             `protobuf:"varint,7,opt,name=signature_expiry,json=signatureExpiry,proto3" json:"signature_expiry,omitempty"`
+	Nonce           uint64            `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ValidUntil      int64             `protobuf:"varint,9,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	Entries         []StampBatchEntry `protobuf:"bytes,10,rep,name=entries,proto3" json:"entries"`
+	EntityId        string            `protobuf:"bytes,11,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 }
 
-func (m *MsgStoreDocumentResponse) Reset()         { *m = MsgStoreDocumentResponse{} }
-func (m *MsgStoreDocumentResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgStoreDocumentResponse) ProtoMessage()    {}
-func (*MsgStoreDocumentResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateStampBatch) Reset()         { *m = MsgCreateStampBatch{} }
+func (m *MsgCreateStampBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateStampBatch) ProtoMessage()    {}
+func (*MsgCreateStampBatch) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e6b0ca2d425e53fb, []int{7}
 }
-func (m *MsgStoreDocumentResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateStampBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgStoreDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateStampBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgStoreDocumentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateStampBatch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -531,110 +585,113 @@ func (m *MsgStoreDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgStoreDocumentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgStoreDocumentResponse.Merge(m, src)
+func (m *MsgCreateStampBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateStampBatch.Merge(m, src)
 }
-func (m *MsgStoreDocumentResponse) XXX_Size() int {
+func (m *MsgCreateStampBatch) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgStoreDocumentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgStoreDocumentResponse.DiscardUnknown(m)
+func (m *MsgCreateStampBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateStampBatch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgStoreDocumentResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateStampBatch proto.InternalMessageInfo
 
-func (m *MsgStoreDocumentResponse) GetDocumentId() string {
+func (m *MsgCreateStampBatch) GetCreator() string {
 	if m != nil {
-		return m.DocumentId
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgStoreDocumentResponse) GetIpfsUrl() string {
+func (m *MsgCreateStampBatch) GetPePublicKey() string {
 	if m != nil {
-		return m.IpfsUrl
+		return m.PePublicKey
 	}
 	return ""
 }
 
-// MsgCreateEntity creates a new organization/entity account
-type MsgCreateEntity struct {
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	EntityType string `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+func (m *MsgCreateStampBatch) GetJurisdictionId() string {
+	if m != nil {
+		return m.JurisdictionId
+	}
+	return ""
 }
 
-func (m *MsgCreateEntity) Reset()         { *m = MsgCreateEntity{} }
-func (m *MsgCreateEntity) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateEntity) ProtoMessage()    {}
-func (*MsgCreateEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6b0ca2d425e53fb, []int{8}
-}
-func (m *MsgCreateEntity) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MsgCreateEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MsgCreateEntity.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *MsgCreateStampBatch) GetPeLicenseNumber() string {
+	if m != nil {
+		return m.PeLicenseNumber
 	}
+	return ""
 }
-func (m *MsgCreateEntity) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateEntity.Merge(m, src)
+
+func (m *MsgCreateStampBatch) GetPeName() string {
+	if m != nil {
+		return m.PeName
+	}
+	return ""
 }
-func (m *MsgCreateEntity) XXX_Size() int {
-	return m.Size()
+
+func (m *MsgCreateStampBatch) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
+	}
+	return ""
 }
-func (m *MsgCreateEntity) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateEntity.DiscardUnknown(m)
+
+func (m *MsgCreateStampBatch) GetSignatureExpiry() int64 {
+	if m != nil {
+		return m.SignatureExpiry
+	}
+	return 0
 }
 
-var xxx_messageInfo_MsgCreateEntity proto.InternalMessageInfo
+func (m *MsgCreateStampBatch) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
 
-func (m *MsgCreateEntity) GetCreator() string {
+func (m *MsgCreateStampBatch) GetValidUntil() int64 {
 	if m != nil {
-		return m.Creator
+		return m.ValidUntil
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgCreateEntity) GetName() string {
+func (m *MsgCreateStampBatch) GetEntries() []StampBatchEntry {
 	if m != nil {
-		return m.Name
+		return m.Entries
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgCreateEntity) GetEntityType() string {
+func (m *MsgCreateStampBatch) GetEntityId() string {
 	if m != nil {
-		return m.EntityType
+		return m.EntityId
 	}
 	return ""
 }
 
-// MsgCreateEntityResponse is the response for CreateEntity
-type MsgCreateEntityResponse struct {
-	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+// MsgCreateStampBatchResponse is the response for CreateStampBatch
+type MsgCreateStampBatchResponse struct {
+	BatchId  string   `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	StampIds []string `protobuf:"bytes,2,rep,name=stamp_ids,json=stampIds,proto3" json:"stamp_ids,omitempty"`
 }
 
-func (m *MsgCreateEntityResponse) Reset()         { *m = MsgCreateEntityResponse{} }
-func (m *MsgCreateEntityResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateEntityResponse) ProtoMessage()    {}
-func (*MsgCreateEntityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6b0ca2d425e53fb, []int{9}
+func (m *MsgCreateStampBatchResponse) Reset()         { *m = MsgCreateStampBatchResponse{} }
+func (m *MsgCreateStampBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateStampBatchResponse) ProtoMessage()    {}
+func (*MsgCreateStampBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{8}
 }
-func (m *MsgCreateEntityResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateStampBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateEntityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateStampBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateEntityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateStampBatchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -644,45 +701,54 @@ func (m *MsgCreateEntityResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateEntityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateEntityResponse.Merge(m, src)
+func (m *MsgCreateStampBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateStampBatchResponse.Merge(m, src)
 }
-func (m *MsgCreateEntityResponse) XXX_Size() int {
+func (m *MsgCreateStampBatchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateEntityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateEntityResponse.DiscardUnknown(m)
+func (m *MsgCreateStampBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateStampBatchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateEntityResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateStampBatchResponse proto.InternalMessageInfo
 
-func (m *MsgCreateEntityResponse) GetEntityId() string {
+func (m *MsgCreateStampBatchResponse) GetBatchId() string {
 	if m != nil {
-		return m.EntityId
+		return m.BatchId
 	}
 	return ""
 }
 
-// MsgAddEntityMember adds a member to an entity
-type MsgAddEntityMember struct {
-	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	EntityId      string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
-	MemberAddress string `protobuf:"bytes,3,opt,name=member_address,json=memberAddress,proto3" json:"member_address,omitempty"`
-	Role          string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+func (m *MsgCreateStampBatchResponse) GetStampIds() []string {
+	if m != nil {
+		return m.StampIds
+	}
+	return nil
 }
 
-func (m *MsgAddEntityMember) Reset()         { *m = MsgAddEntityMember{} }
-func (m *MsgAddEntityMember) String() string { return proto.CompactTextString(m) }
-func (*MsgAddEntityMember) ProtoMessage()    {}
-func (*MsgAddEntityMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6b0ca2d425e53fb, []int{10}
+// MsgRevokeStampBatch revokes every stamp of a batch that is not yet revoked
+type MsgRevokeStampBatch struct {
+	Creator      string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	BatchId      string           `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Reason       string           `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReasonCode   RevocationReason `protobuf:"varint,4,opt,name=reason_code,json=reasonCode,proto3,enum=stampledgerchain.stampledgerchain.v1.RevocationReason" json:"reason_code,omitempty"`
+	EvidenceHash string           `protobuf:"bytes,5,opt,name=evidence_hash,json=evidenceHash,proto3" json:"evidence_hash,omitempty"`
+	EntityId     string           `protobuf:"bytes,6,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 }
-func (m *MsgAddEntityMember) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgRevokeStampBatch) Reset()         { *m = MsgRevokeStampBatch{} }
+func (m *MsgRevokeStampBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeStampBatch) ProtoMessage()    {}
+func (*MsgRevokeStampBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{9}
+}
+func (m *MsgRevokeStampBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddEntityMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRevokeStampBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddEntityMember.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRevokeStampBatch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -692,63 +758,77 @@ func (m *MsgAddEntityMember) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgAddEntityMember) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddEntityMember.Merge(m, src)
+func (m *MsgRevokeStampBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeStampBatch.Merge(m, src)
 }
-func (m *MsgAddEntityMember) XXX_Size() int {
+func (m *MsgRevokeStampBatch) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddEntityMember) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddEntityMember.DiscardUnknown(m)
+func (m *MsgRevokeStampBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeStampBatch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddEntityMember proto.InternalMessageInfo
+var xxx_messageInfo_MsgRevokeStampBatch proto.InternalMessageInfo
 
-func (m *MsgAddEntityMember) GetCreator() string {
+func (m *MsgRevokeStampBatch) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgAddEntityMember) GetEntityId() string {
+func (m *MsgRevokeStampBatch) GetBatchId() string {
 	if m != nil {
-		return m.EntityId
+		return m.BatchId
 	}
 	return ""
 }
 
-func (m *MsgAddEntityMember) GetMemberAddress() string {
+func (m *MsgRevokeStampBatch) GetReason() string {
 	if m != nil {
-		return m.MemberAddress
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *MsgAddEntityMember) GetRole() string {
+func (m *MsgRevokeStampBatch) GetReasonCode() RevocationReason {
 	if m != nil {
-		return m.Role
+		return m.ReasonCode
+	}
+	return RevocationUnspecified
+}
+
+func (m *MsgRevokeStampBatch) GetEvidenceHash() string {
+	if m != nil {
+		return m.EvidenceHash
 	}
 	return ""
 }
 
-// MsgAddEntityMemberResponse is the response for AddEntityMember
-type MsgAddEntityMemberResponse struct {
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+func (m *MsgRevokeStampBatch) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
 }
 
-func (m *MsgAddEntityMemberResponse) Reset()         { *m = MsgAddEntityMemberResponse{} }
-func (m *MsgAddEntityMemberResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddEntityMemberResponse) ProtoMessage()    {}
-func (*MsgAddEntityMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6b0ca2d425e53fb, []int{11}
+// MsgRevokeStampBatchResponse is the response for RevokeStampBatch
+type MsgRevokeStampBatchResponse struct {
+	RevokedCount uint64 `protobuf:"varint,1,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
 }
-func (m *MsgAddEntityMemberResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgRevokeStampBatchResponse) Reset()         { *m = MsgRevokeStampBatchResponse{} }
+func (m *MsgRevokeStampBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeStampBatchResponse) ProtoMessage()    {}
+func (*MsgRevokeStampBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{10}
+}
+func (m *MsgRevokeStampBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddEntityMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRevokeStampBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddEntityMemberResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRevokeStampBatchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -758,44 +838,56 @@ func (m *MsgAddEntityMemberResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgAddEntityMemberResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddEntityMemberResponse.Merge(m, src)
+func (m *MsgRevokeStampBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeStampBatchResponse.Merge(m, src)
 }
-func (m *MsgAddEntityMemberResponse) XXX_Size() int {
+func (m *MsgRevokeStampBatchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddEntityMemberResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddEntityMemberResponse.DiscardUnknown(m)
+func (m *MsgRevokeStampBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeStampBatchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddEntityMemberResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRevokeStampBatchResponse proto.InternalMessageInfo
 
-func (m *MsgAddEntityMemberResponse) GetSuccess() bool {
+func (m *MsgRevokeStampBatchResponse) GetRevokedCount() uint64 {
 	if m != nil {
-		return m.Success
+		return m.RevokedCount
 	}
-	return false
+	return 0
 }
 
-// MsgRemoveEntityMember removes a member from an entity
-type MsgRemoveEntityMember struct {
-	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	EntityId      string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
-	MemberAddress string `protobuf:"bytes,3,opt,name=member_address,json=memberAddress,proto3" json:"member_address,omitempty"`
+// MsgCreateMerkleStamp stamps a Merkle root over a sorted list of document
+// SHA-256 hashes. The PE signs a MerkleStampSignDoc binding the root and leaf
+// count.
+type MsgCreateMerkleStamp struct {
+	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	MerkleRoot       string `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	LeafCount        uint64 `protobuf:"varint,3,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	PePublicKey      string `protobuf:"bytes,4,opt,name=pe_public_key,json=pePublicKey,proto3" json:"pe_public_key,omitempty"`
+	Signature        string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	JurisdictionId   string `protobuf:"bytes,6,opt,name=jurisdiction_id,json=jurisdictionId,proto3" json:"jurisdiction_id,omitempty"`
+	PeLicenseNumber  string `protobuf:"bytes,7,opt,name=pe_license_number,json=peLicenseNumber,proto3" json:"pe_license_number,omitempty"`
+	PeName           string `protobuf:"bytes,8,opt,name=pe_name,json=peName,proto3" json:"pe_name,omitempty"`
+	ProjectName      string `protobuf:"bytes,9,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	ManifestIpfsHash string `protobuf:"bytes,10,opt,name=manifest_ipfs_hash,json=manifestIpfsHash,proto3" json:"manifest_ipfs_hash,omitempty"`
+	SignatureExpiry  int64  `protobuf:"varint,11,opt,name=signature_expiry,json=signatureExpiry,proto3" json:"signature_expiry,omitempty"`
+	Nonce            uint64 `protobuf:"varint,12,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ValidUntil       int64  `protobuf:"varint,13,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
 }
 
-func (m *MsgRemoveEntityMember) Reset()         { *m = MsgRemoveEntityMember{} }
-func (m *MsgRemoveEntityMember) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveEntityMember) ProtoMessage()    {}
-func (*MsgRemoveEntityMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6b0ca2d425e53fb, []int{12}
+func (m *MsgCreateMerkleStamp) Reset()         { *m = MsgCreateMerkleStamp{} }
+func (m *MsgCreateMerkleStamp) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMerkleStamp) ProtoMessage()    {}
+func (*MsgCreateMerkleStamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{11}
 }
-func (m *MsgRemoveEntityMember) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateMerkleStamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveEntityMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateMerkleStamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveEntityMember.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateMerkleStamp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -805,56 +897,126 @@ func (m *MsgRemoveEntityMember) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveEntityMember) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveEntityMember.Merge(m, src)
+func (m *MsgCreateMerkleStamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMerkleStamp.Merge(m, src)
 }
-func (m *MsgRemoveEntityMember) XXX_Size() int {
+func (m *MsgCreateMerkleStamp) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveEntityMember) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveEntityMember.DiscardUnknown(m)
+func (m *MsgCreateMerkleStamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMerkleStamp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveEntityMember proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateMerkleStamp proto.InternalMessageInfo
 
-func (m *MsgRemoveEntityMember) GetCreator() string {
+func (m *MsgCreateMerkleStamp) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgRemoveEntityMember) GetEntityId() string {
+func (m *MsgCreateMerkleStamp) GetMerkleRoot() string {
 	if m != nil {
-		return m.EntityId
+		return m.MerkleRoot
 	}
 	return ""
 }
 
-func (m *MsgRemoveEntityMember) GetMemberAddress() string {
+func (m *MsgCreateMerkleStamp) GetLeafCount() uint64 {
 	if m != nil {
-		return m.MemberAddress
+		return m.LeafCount
+	}
+	return 0
+}
+
+func (m *MsgCreateMerkleStamp) GetPePublicKey() string {
+	if m != nil {
+		return m.PePublicKey
 	}
 	return ""
 }
 
-// MsgRemoveEntityMemberResponse is the response for RemoveEntityMember
-type MsgRemoveEntityMemberResponse struct {
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+func (m *MsgCreateMerkleStamp) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
 }
 
-func (m *MsgRemoveEntityMemberResponse) Reset()         { *m = MsgRemoveEntityMemberResponse{} }
-func (m *MsgRemoveEntityMemberResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveEntityMemberResponse) ProtoMessage()    {}
-func (*MsgRemoveEntityMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6b0ca2d425e53fb, []int{13}
+func (m *MsgCreateMerkleStamp) GetJurisdictionId() string {
+	if m != nil {
+		return m.JurisdictionId
+	}
+	return ""
 }
-func (m *MsgRemoveEntityMemberResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgCreateMerkleStamp) GetPeLicenseNumber() string {
+	if m != nil {
+		return m.PeLicenseNumber
+	}
+	return ""
+}
+
+func (m *MsgCreateMerkleStamp) GetPeName() string {
+	if m != nil {
+		return m.PeName
+	}
+	return ""
+}
+
+func (m *MsgCreateMerkleStamp) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
+	}
+	return ""
+}
+
+func (m *MsgCreateMerkleStamp) GetManifestIpfsHash() string {
+	if m != nil {
+		return m.ManifestIpfsHash
+	}
+	return ""
+}
+
+func (m *MsgCreateMerkleStamp) GetSignatureExpiry() int64 {
+	if m != nil {
+		return m.SignatureExpiry
+	}
+	return 0
+}
+
+func (m *MsgCreateMerkleStamp) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MsgCreateMerkleStamp) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
+// MsgCreateMerkleStampResponse is the response for CreateMerkleStamp
+type MsgCreateMerkleStampResponse struct {
+	StampId string `protobuf:"bytes,1,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
+}
+
+func (m *MsgCreateMerkleStampResponse) Reset()         { *m = MsgCreateMerkleStampResponse{} }
+func (m *MsgCreateMerkleStampResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMerkleStampResponse) ProtoMessage()    {}
+func (*MsgCreateMerkleStampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{12}
+}
+func (m *MsgCreateMerkleStampResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveEntityMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateMerkleStampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveEntityMemberResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateMerkleStampResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -864,48 +1026,54 @@ func (m *MsgRemoveEntityMemberResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveEntityMemberResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveEntityMemberResponse.Merge(m, src)
+func (m *MsgCreateMerkleStampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMerkleStampResponse.Merge(m, src)
 }
-func (m *MsgRemoveEntityMemberResponse) XXX_Size() int {
+func (m *MsgCreateMerkleStampResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveEntityMemberResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveEntityMemberResponse.DiscardUnknown(m)
+func (m *MsgCreateMerkleStampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMerkleStampResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveEntityMemberResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateMerkleStampResponse proto.InternalMessageInfo
 
-func (m *MsgRemoveEntityMemberResponse) GetSuccess() bool {
+func (m *MsgCreateMerkleStampResponse) GetStampId() string {
 	if m != nil {
-		return m.Success
+		return m.StampId
 	}
-	return false
+	return ""
 }
 
-// MsgCreateSpecVersion creates a new version of a spec on the blockchain
-type MsgCreateSpecVersion struct {
-	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ProjectId       string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Version         string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	SpecHash        string `protobuf:"bytes,4,opt,name=spec_hash,json=specHash,proto3" json:"spec_hash,omitempty"`
-	SpecIpfs        string `protobuf:"bytes,5,opt,name=spec_ipfs,json=specIpfs,proto3" json:"spec_ipfs,omitempty"`
-	Changelog       string `protobuf:"bytes,6,opt,name=changelog,proto3" json:"changelog,omitempty"`
-	ParentVersionId string `protobuf:"bytes,7,opt,name=parent_version_id,json=parentVersionId,proto3" json:"parent_version_id,omitempty"`
+// MsgProposeCoStamp opens a pending co-sealed stamp on a document. Each signer
+// seals it with MsgAddCoSignature; it becomes valid once threshold have.
+type MsgProposeCoStamp struct {
+	Creator          string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DocumentHash     string     `protobuf:"bytes,2,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
+	JurisdictionId   string     `protobuf:"bytes,3,opt,name=jurisdiction_id,json=jurisdictionId,proto3" json:"jurisdiction_id,omitempty"`
+	ProjectName      string     `protobuf:"bytes,4,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	DocumentIpfsHash string     `protobuf:"bytes,5,opt,name=document_ipfs_hash,json=documentIpfsHash,proto3" json:"document_ipfs_hash,omitempty"`
+	DocumentSize     int64      `protobuf:"varint,6,opt,name=document_size,json=documentSize,proto3" json:"document_size,omitempty"`
+	DocumentFilename string     `protobuf:"bytes,7,opt,name=document_filename,json=documentFilename,proto3" json:"document_filename,omitempty"`
+	CoSigners        []CoSigner `protobuf:"bytes,8,rep,name=co_signers,json=coSigners,proto3" json:"co_signers"`
+	Threshold        uint32     `protobuf:"varint,9,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	SignatureExpiry  int64      `protobuf:"varint,10,opt,name=signature_expiry,json=signatureExpiry,proto3" json:"signature_expiry,omitempty"`
+	Nonce            uint64     `protobuf:"varint,11,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ValidUntil       int64      `protobuf:"varint,12,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
 }
 
-func (m *MsgCreateSpecVersion) Reset()         { *m = MsgCreateSpecVersion{} }
-func (m *MsgCreateSpecVersion) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateSpecVersion) ProtoMessage()    {}
-func (*MsgCreateSpecVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6b0ca2d425e53fb, []int{14}
+func (m *MsgProposeCoStamp) Reset()         { *m = MsgProposeCoStamp{} }
+func (m *MsgProposeCoStamp) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeCoStamp) ProtoMessage()    {}
+func (*MsgProposeCoStamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{13}
 }
-func (m *MsgCreateSpecVersion) XXX_Unmarshal(b []byte) error {
+func (m *MsgProposeCoStamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateSpecVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgProposeCoStamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateSpecVersion.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgProposeCoStamp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -915,84 +1083,119 @@ func (m *MsgCreateSpecVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateSpecVersion) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateSpecVersion.Merge(m, src)
+func (m *MsgProposeCoStamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeCoStamp.Merge(m, src)
 }
-func (m *MsgCreateSpecVersion) XXX_Size() int {
+func (m *MsgProposeCoStamp) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateSpecVersion) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateSpecVersion.DiscardUnknown(m)
+func (m *MsgProposeCoStamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeCoStamp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateSpecVersion proto.InternalMessageInfo
+var xxx_messageInfo_MsgProposeCoStamp proto.InternalMessageInfo
 
-func (m *MsgCreateSpecVersion) GetCreator() string {
+func (m *MsgProposeCoStamp) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreateSpecVersion) GetProjectId() string {
+func (m *MsgProposeCoStamp) GetDocumentHash() string {
 	if m != nil {
-		return m.ProjectId
+		return m.DocumentHash
 	}
 	return ""
 }
 
-func (m *MsgCreateSpecVersion) GetVersion() string {
+func (m *MsgProposeCoStamp) GetJurisdictionId() string {
 	if m != nil {
-		return m.Version
+		return m.JurisdictionId
 	}
 	return ""
 }
 
-func (m *MsgCreateSpecVersion) GetSpecHash() string {
+func (m *MsgProposeCoStamp) GetProjectName() string {
 	if m != nil {
-		return m.SpecHash
+		return m.ProjectName
 	}
 	return ""
 }
 
-func (m *MsgCreateSpecVersion) GetSpecIpfs() string {
+func (m *MsgProposeCoStamp) GetDocumentIpfsHash() string {
 	if m != nil {
-		return m.SpecIpfs
+		return m.DocumentIpfsHash
 	}
 	return ""
 }
 
-func (m *MsgCreateSpecVersion) GetChangelog() string {
+func (m *MsgProposeCoStamp) GetDocumentSize() int64 {
 	if m != nil {
-		return m.Changelog
+		return m.DocumentSize
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgCreateSpecVersion) GetParentVersionId() string {
+func (m *MsgProposeCoStamp) GetDocumentFilename() string {
 	if m != nil {
-		return m.ParentVersionId
+		return m.DocumentFilename
 	}
 	return ""
 }
 
-// MsgCreateSpecVersionResponse is the response for CreateSpecVersion
-type MsgCreateSpecVersionResponse struct {
-	VersionId string `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+func (m *MsgProposeCoStamp) GetCoSigners() []CoSigner {
+	if m != nil {
+		return m.CoSigners
+	}
+	return nil
 }
 
-func (m *MsgCreateSpecVersionResponse) Reset()         { *m = MsgCreateSpecVersionResponse{} }
-func (m *MsgCreateSpecVersionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateSpecVersionResponse) ProtoMessage()    {}
-func (*MsgCreateSpecVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6b0ca2d425e53fb, []int{15}
+func (m *MsgProposeCoStamp) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
 }
-func (m *MsgCreateSpecVersionResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgProposeCoStamp) GetSignatureExpiry() int64 {
+	if m != nil {
+		return m.SignatureExpiry
+	}
+	return 0
+}
+
+func (m *MsgProposeCoStamp) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MsgProposeCoStamp) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
+// MsgProposeCoStampResponse is the response for ProposeCoStamp
+type MsgProposeCoStampResponse struct {
+	StampId string `protobuf:"bytes,1,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
+}
+
+func (m *MsgProposeCoStampResponse) Reset()         { *m = MsgProposeCoStampResponse{} }
+func (m *MsgProposeCoStampResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeCoStampResponse) ProtoMessage()    {}
+func (*MsgProposeCoStampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{14}
+}
+func (m *MsgProposeCoStampResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateSpecVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgProposeCoStampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateSpecVersionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgProposeCoStampResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1002,1546 +1205,17888 @@ func (m *MsgCreateSpecVersionResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateSpecVersionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateSpecVersionResponse.Merge(m, src)
+func (m *MsgProposeCoStampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeCoStampResponse.Merge(m, src)
 }
-func (m *MsgCreateSpecVersionResponse) XXX_Size() int {
+func (m *MsgProposeCoStampResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateSpecVersionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateSpecVersionResponse.DiscardUnknown(m)
+func (m *MsgProposeCoStampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeCoStampResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateSpecVersionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgProposeCoStampResponse proto.InternalMessageInfo
 
-func (m *MsgCreateSpecVersionResponse) GetVersionId() string {
+func (m *MsgProposeCoStampResponse) GetStampId() string {
 	if m != nil {
-		return m.VersionId
+		return m.StampId
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "stampledgerchain.stampledgerchain.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateStamp)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateStamp")
-	proto.RegisterType((*MsgCreateStampResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateStampResponse")
-	proto.RegisterType((*MsgRevokeStamp)(nil), "stampledgerchain.stampledgerchain.v1.MsgRevokeStamp")
-	proto.RegisterType((*MsgRevokeStampResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgRevokeStampResponse")
-	proto.RegisterType((*MsgStoreDocument)(nil), "stampledgerchain.stampledgerchain.v1.MsgStoreDocument")
-	proto.RegisterType((*MsgStoreDocumentResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgStoreDocumentResponse")
-	proto.RegisterType((*MsgCreateEntity)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateEntity")
-	proto.RegisterType((*MsgCreateEntityResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateEntityResponse")
-	proto.RegisterType((*MsgAddEntityMember)(nil), "stampledgerchain.stampledgerchain.v1.MsgAddEntityMember")
-	proto.RegisterType((*MsgAddEntityMemberResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgAddEntityMemberResponse")
-	proto.RegisterType((*MsgRemoveEntityMember)(nil), "stampledgerchain.stampledgerchain.v1.MsgRemoveEntityMember")
-	proto.RegisterType((*MsgRemoveEntityMemberResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgRemoveEntityMemberResponse")
-	proto.RegisterType((*MsgCreateSpecVersion)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateSpecVersion")
-	proto.RegisterType((*MsgCreateSpecVersionResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateSpecVersionResponse")
+// MsgAddCoSignature adds one required signer's seal to a co-sealed stamp
+type MsgAddCoSignature struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	StampId     string `protobuf:"bytes,2,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
+	PePublicKey string `protobuf:"bytes,3,opt,name=pe_public_key,json=pePublicKey,proto3" json:"pe_public_key,omitempty"`
+	Signature   string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("stampledgerchain/stampledgerchain/v1/tx.proto", fileDescriptor_e6b0ca2d425e53fb)
+func (m *MsgAddCoSignature) Reset()         { *m = MsgAddCoSignature{} }
+func (m *MsgAddCoSignature) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCoSignature) ProtoMessage()    {}
+func (*MsgAddCoSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{15}
+}
+func (m *MsgAddCoSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCoSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCoSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCoSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCoSignature.Merge(m, src)
+}
+func (m *MsgAddCoSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCoSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCoSignature.DiscardUnknown(m)
 }
 
-var fileDescriptor_e6b0ca2d425e53fb = []byte{
-	// 1225 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x26, 0x8d, 0x3f, 0x9e, 0xf3, 0xd1, 0x8c, 0x42, 0xb3, 0xd9, 0x26, 0x4e, 0xd8, 0x82,
-	0x88, 0x42, 0x13, 0x2b, 0x49, 0x1b, 0x81, 0xa1, 0x88, 0xa6, 0x25, 0xc2, 0xa2, 0x2e, 0x95, 0x43,
-	0x7b, 0xe0, 0xb2, 0xda, 0xec, 0x4e, 0xd6, 0xdb, 0x7a, 0x3f, 0xb4, 0xb3, 0xb6, 0x92, 0x9e, 0x00,
-	0x21, 0x21, 0x21, 0x21, 0x21, 0x21, 0x71, 0xe3, 0x8a, 0x10, 0xa7, 0x1c, 0x38, 0x72, 0x45, 0xea,
-	0xb1, 0x82, 0x0b, 0x27, 0x84, 0x92, 0x43, 0x0e, 0xfd, 0x27, 0xd0, 0x7c, 0xec, 0x7a, 0x77, 0xed,
-	0x08, 0xc7, 0xe5, 0xc0, 0xc5, 0xda, 0xf9, 0xbd, 0x79, 0x5f, 0xbf, 0x79, 0xf3, 0xde, 0x18, 0xd6,
-	0x48, 0xa8, 0x3b, 0x7e, 0x0b, 0x9b, 0x16, 0x0e, 0x8c, 0xa6, 0x6e, 0xbb, 0x95, 0x1e, 0xa0, 0xb3,
-	0x51, 0x09, 0x0f, 0xd7, 0xfd, 0xc0, 0x0b, 0x3d, 0xf4, 0x5a, 0x56, 0xba, 0xde, 0x03, 0x74, 0x36,
-	0x94, 0x19, 0xdd, 0xb1, 0x5d, 0xaf, 0xc2, 0x7e, 0xb9, 0xa2, 0x32, 0x67, 0x78, 0xc4, 0xf1, 0x48,
-	0xc5, 0x21, 0x16, 0x35, 0xe8, 0x10, 0x4b, 0x08, 0xe6, 0xb9, 0x40, 0x63, 0xab, 0x0a, 0x5f, 0x08,
-	0xd1, 0xac, 0xe5, 0x59, 0x1e, 0xc7, 0xe9, 0x97, 0x40, 0x37, 0x06, 0x8a, 0xd8, 0xd7, 0x03, 0xdd,
-	0x11, 0x86, 0xd4, 0x13, 0x09, 0xa6, 0xeb, 0xc4, 0x7a, 0xe8, 0x9b, 0x7a, 0x88, 0x1f, 0x30, 0x09,
-	0xda, 0x86, 0xa2, 0xde, 0x0e, 0x9b, 0x5e, 0x60, 0x87, 0x47, 0xb2, 0xb4, 0x2c, 0xad, 0x14, 0x77,
-	0xe4, 0xdf, 0x7f, 0x59, 0x9b, 0x15, 0x11, 0xdc, 0x36, 0xcd, 0x00, 0x13, 0xb2, 0x17, 0x06, 0xb6,
-	0x6b, 0x35, 0xba, 0x5b, 0xd1, 0xc7, 0x90, 0xe3, 0xb6, 0xe5, 0xd1, 0x65, 0x69, 0xa5, 0xb4, 0x79,
-	0x7d, 0x7d, 0x10, 0x4a, 0xd6, 0xb9, 0xd7, 0x9d, 0xe2, 0xb3, 0xbf, 0x96, 0x46, 0x7e, 0x3a, 0x3b,
-	0x5e, 0x95, 0x1a, 0xc2, 0x4c, 0x75, 0xf7, 0x8b, 0xb3, 0xe3, 0xd5, 0xae, 0x83, 0xaf, 0xcf, 0x8e,
-	0x57, 0xb7, 0x7a, 0x32, 0x3a, 0xec, 0x4d, 0x32, 0x93, 0x90, 0x3a, 0x0f, 0x73, 0x19, 0xa8, 0x81,
-	0x89, 0xef, 0xb9, 0x04, 0xab, 0x2f, 0xc6, 0x60, 0xaa, 0x4e, 0xac, 0x3b, 0x01, 0xd6, 0x43, 0xbc,
-	0x47, 0x0d, 0xa1, 0x4d, 0xc8, 0x1b, 0x74, 0xe9, 0x05, 0xff, 0x9a, 0x7c, 0xb4, 0x11, 0x5d, 0x83,
-	0x49, 0xd3, 0x33, 0xda, 0x0e, 0x76, 0x43, 0xad, 0xa9, 0x93, 0x26, 0x63, 0xa0, 0xd8, 0x98, 0x88,
-	0xc0, 0x0f, 0x75, 0xd2, 0x44, 0x2a, 0x4c, 0xfa, 0x58, 0xf3, 0xdb, 0xfb, 0x2d, 0xdb, 0xd0, 0x9e,
-	0xe0, 0x23, 0x79, 0x8c, 0x6d, 0x2a, 0xf9, 0xf8, 0x01, 0xc3, 0x3e, 0xc2, 0x47, 0x68, 0x01, 0x8a,
-	0xc4, 0xb6, 0x5c, 0x3d, 0x6c, 0x07, 0x58, 0xbe, 0xc4, 0xe4, 0x5d, 0x00, 0xbd, 0x01, 0xd3, 0x8f,
-	0xdb, 0x81, 0x4d, 0x4c, 0xdb, 0x08, 0x6d, 0xcf, 0xd5, 0x6c, 0x53, 0x1e, 0x67, 0x7b, 0xa6, 0x92,
-	0x70, 0xcd, 0x44, 0xab, 0x30, 0xe3, 0x63, 0xad, 0x65, 0x1b, 0xd8, 0x25, 0x58, 0x73, 0xdb, 0xce,
-	0x3e, 0x0e, 0xe4, 0x1c, 0xdb, 0x3a, 0xed, 0xe3, 0x7b, 0x1c, 0xbf, 0xcf, 0x60, 0x34, 0x07, 0x79,
-	0x1f, 0x6b, 0xae, 0xee, 0x60, 0x39, 0xcf, 0x76, 0xe4, 0x7c, 0x7c, 0x5f, 0x77, 0x30, 0x7a, 0x15,
-	0x26, 0xfc, 0xc0, 0x7b, 0x8c, 0x8d, 0x90, 0x4b, 0x0b, 0x22, 0x5c, 0x8e, 0xb1, 0x2d, 0xd7, 0x01,
-	0xc5, 0x79, 0xdb, 0xfe, 0x01, 0xe1, 0xc9, 0x17, 0xd9, 0xc6, 0xcb, 0x91, 0xa4, 0xe6, 0x1f, 0x10,
-	0x46, 0x40, 0x92, 0x25, 0x62, 0x3f, 0xc5, 0x32, 0x2c, 0x4b, 0x2b, 0x63, 0x5d, 0x96, 0xf6, 0xec,
-	0xa7, 0x18, 0xbd, 0x09, 0x33, 0xf1, 0xa6, 0x03, 0xbb, 0x85, 0x99, 0xeb, 0x52, 0xda, 0xe2, 0xae,
-	0xc0, 0xab, 0x6b, 0xb4, 0x42, 0xa2, 0x53, 0xa0, 0xf5, 0xb1, 0xd0, 0x53, 0x0c, 0x89, 0xa3, 0x55,
-	0xef, 0xc1, 0x95, 0xf4, 0x61, 0x47, 0x75, 0x80, 0xe6, 0xa1, 0xc0, 0x34, 0x29, 0xa5, 0xec, 0xd4,
-	0x1b, 0x79, 0xb6, 0xae, 0x99, 0x94, 0x9f, 0xf0, 0x30, 0x79, 0xaa, 0xb9, 0xf0, 0x90, 0xa6, 0xa3,
-	0xfe, 0x28, 0xb1, 0xda, 0x69, 0xe0, 0x8e, 0xf7, 0xe4, 0x25, 0x6a, 0x27, 0xe9, 0x7a, 0x34, 0xed,
-	0xfa, 0x0a, 0xe4, 0x02, 0xac, 0x13, 0xcf, 0x15, 0xa5, 0x22, 0x56, 0x83, 0xa4, 0x9d, 0x88, 0x4a,
-	0xdd, 0x64, 0x69, 0x27, 0x90, 0x38, 0x6d, 0x19, 0xf2, 0xa4, 0x6d, 0x18, 0x98, 0x10, 0x16, 0x6f,
-	0xa1, 0x11, 0x2d, 0xd5, 0x1f, 0x46, 0xe1, 0x72, 0x9d, 0x58, 0x7b, 0xa1, 0x17, 0xe0, 0xbb, 0x82,
-	0xf6, 0xff, 0x3a, 0xbd, 0xab, 0x50, 0xec, 0x16, 0x0d, 0xcf, 0xb0, 0x60, 0x47, 0xc5, 0xa2, 0x40,
-	0x21, 0x3e, 0x7e, 0x7e, 0x11, 0xe2, 0x35, 0x42, 0x70, 0x89, 0xd5, 0xcf, 0x38, 0xab, 0x1f, 0xf6,
-	0x4d, 0x8d, 0x39, 0xb6, 0x83, 0xb5, 0xf0, 0xc8, 0xc7, 0xa2, 0xd4, 0x0b, 0x14, 0xf8, 0xe4, 0xc8,
-	0xc7, 0x68, 0x09, 0x4a, 0xbe, 0xed, 0x6a, 0x07, 0x5e, 0x80, 0x3b, 0x38, 0x60, 0x75, 0x5e, 0x68,
-	0x80, 0x6f, 0xbb, 0xbb, 0x1c, 0xa9, 0x56, 0xb2, 0x8c, 0x96, 0x7b, 0x18, 0x4d, 0x51, 0xa1, 0x3e,
-	0x02, 0x39, 0x4b, 0x4f, 0xcc, 0xea, 0x12, 0x94, 0xba, 0xb7, 0x22, 0xaa, 0x27, 0x88, 0xaf, 0x83,
-	0x49, 0x39, 0x61, 0x89, 0xb7, 0x83, 0x56, 0xc4, 0x09, 0x5d, 0x3f, 0x0c, 0x5a, 0xea, 0xcf, 0xbc,
-	0x21, 0xf3, 0x1a, 0xfd, 0xc0, 0x0d, 0x69, 0x63, 0x1d, 0x86, 0x76, 0x04, 0x97, 0x18, 0x75, 0xdc,
-	0x3c, 0xfb, 0xa6, 0x71, 0x61, 0x66, 0x91, 0x93, 0xc4, 0x19, 0x07, 0x0e, 0x51, 0x9a, 0xaa, 0xeb,
-	0x59, 0x16, 0x16, 0xcf, 0xb9, 0x4e, 0x3c, 0x30, 0x75, 0x9b, 0x35, 0xd6, 0x24, 0x14, 0x73, 0x70,
-	0x15, 0x8a, 0xc2, 0x57, 0xcc, 0x40, 0x81, 0x03, 0x35, 0x53, 0xfd, 0x43, 0x02, 0x54, 0x27, 0xd6,
-	0x6d, 0xd3, 0xe4, 0x5a, 0x75, 0xcc, 0x3a, 0xd1, 0x30, 0x79, 0xa6, 0xfc, 0x8c, 0xa6, 0xfd, 0xa0,
-	0xd7, 0x61, 0xca, 0x61, 0xa6, 0x35, 0x9d, 0x6b, 0x8b, 0x9c, 0x27, 0x39, 0x2a, 0x4c, 0x52, 0xae,
-	0x02, 0xaf, 0x15, 0x95, 0x19, 0xfb, 0xae, 0x6e, 0x64, 0xa9, 0x58, 0xee, 0xa1, 0x22, 0x13, 0xbe,
-	0xba, 0x0d, 0x4a, 0x6f, 0x52, 0x03, 0x5c, 0xb5, 0xdf, 0x24, 0x78, 0x85, 0xdd, 0x4f, 0xc7, 0xeb,
-	0xe0, 0xff, 0x03, 0x21, 0xd5, 0x1b, 0xd9, 0xe4, 0xaf, 0xf5, 0xe9, 0x2f, 0xd9, 0x68, 0xd5, 0xb7,
-	0x61, 0xb1, 0x6f, 0x1a, 0x03, 0x50, 0xf0, 0xeb, 0x28, 0xcc, 0x76, 0x3b, 0xb3, 0x8f, 0x8d, 0x47,
-	0x38, 0x20, 0xb6, 0xe7, 0x0e, 0xc5, 0xc0, 0x22, 0x40, 0x34, 0xb7, 0x62, 0x0a, 0x8a, 0x02, 0xa9,
-	0x99, 0x34, 0x8a, 0x0e, 0xb7, 0x2e, 0x92, 0x8f, 0x96, 0x94, 0x3a, 0xe2, 0x63, 0x83, 0xf7, 0x23,
-	0xd1, 0x73, 0x28, 0xc0, 0xfa, 0x51, 0x24, 0xa4, 0x17, 0x55, 0x4c, 0x5d, 0x26, 0xa4, 0xd3, 0x8d,
-	0x8e, 0x6d, 0xa3, 0xa9, 0xbb, 0x16, 0x6e, 0x79, 0x96, 0x68, 0x3e, 0x5d, 0x80, 0x4d, 0x63, 0x3d,
-	0xa0, 0xdd, 0x40, 0x78, 0xa2, 0x71, 0xe5, 0xc5, 0x34, 0x66, 0x02, 0x91, 0x6e, 0xcd, 0xac, 0x6e,
-	0x65, 0xa9, 0x57, 0xcf, 0x9b, 0x68, 0x5d, 0x96, 0xd4, 0x5b, 0xb0, 0xd0, 0x8f, 0xbd, 0x98, 0xf8,
-	0x45, 0x80, 0x84, 0x67, 0x7e, 0x1b, 0x8b, 0x9d, 0xc8, 0xe7, 0xe6, 0x8b, 0x02, 0x8c, 0xd5, 0x89,
-	0x85, 0xbe, 0x94, 0x60, 0x22, 0xf5, 0x12, 0xbc, 0x39, 0xd8, 0x0b, 0x2e, 0xf3, 0xb8, 0x52, 0x6e,
-	0x0d, 0xa5, 0x16, 0x47, 0xfb, 0xb9, 0x04, 0xa5, 0xe4, 0x83, 0xec, 0xc6, 0xc0, 0xe6, 0x12, 0x5a,
-	0xca, 0xbb, 0xc3, 0x68, 0xa5, 0x62, 0x48, 0x0e, 0xf6, 0xc1, 0x63, 0x48, 0x68, 0x5d, 0x20, 0x86,
-	0x7e, 0xc3, 0xf9, 0x2b, 0x09, 0x26, 0xd3, 0xf3, 0x77, 0x7b, 0x60, 0x7b, 0x29, 0x3d, 0xe5, 0xbd,
-	0xe1, 0xf4, 0xe2, 0x48, 0x68, 0x61, 0xa4, 0x26, 0xd2, 0xcd, 0x0b, 0x92, 0xcb, 0xd5, 0x2e, 0x50,
-	0x18, 0x7d, 0x67, 0xca, 0x37, 0x12, 0x4c, 0x67, 0x67, 0xc6, 0x5b, 0x03, 0x9b, 0xcc, 0x68, 0x2a,
-	0xef, 0x0f, 0xab, 0x19, 0xc7, 0xf3, 0xbd, 0x04, 0xa8, 0x4f, 0xd7, 0x7e, 0xe7, 0x02, 0xa7, 0x9e,
-	0x55, 0x56, 0xee, 0xbc, 0x84, 0x72, 0x1c, 0xd8, 0x77, 0x12, 0xcc, 0xf4, 0xf6, 0xd2, 0xea, 0x45,
-	0x6f, 0x44, 0x57, 0x57, 0xd9, 0x19, 0x5e, 0x37, 0x8a, 0x4a, 0x19, 0xff, 0x8c, 0xfe, 0xbb, 0xdb,
-	0xb9, 0xfb, 0xec, 0xa4, 0x2c, 0x3d, 0x3f, 0x29, 0x4b, 0x7f, 0x9f, 0x94, 0xa5, 0x6f, 0x4f, 0xcb,
-	0x23, 0xcf, 0x4f, 0xcb, 0x23, 0x7f, 0x9e, 0x96, 0x47, 0x3e, 0x5d, 0x4d, 0x98, 0x5c, 0x3b, 0xf7,
-	0xdf, 0x1d, 0x7d, 0xbb, 0x90, 0xfd, 0x1c, 0xfb, 0xff, 0xba, 0xf5, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x8c, 0x95, 0x94, 0x35, 0xa6, 0x0f, 0x00, 0x00,
+var xxx_messageInfo_MsgAddCoSignature proto.InternalMessageInfo
+
+func (m *MsgAddCoSignature) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgAddCoSignature) GetStampId() string {
+	if m != nil {
+		return m.StampId
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgAddCoSignature) GetPePublicKey() string {
+	if m != nil {
+		return m.PePublicKey
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// Stamp operations
-	CreateStamp(ctx context.Context, in *MsgCreateStamp, opts ...grpc.CallOption) (*MsgCreateStampResponse, error)
-	RevokeStamp(ctx context.Context, in *MsgRevokeStamp, opts ...grpc.CallOption) (*MsgRevokeStampResponse, error)
-	// Document storage operations
-	StoreDocument(ctx context.Context, in *MsgStoreDocument, opts ...grpc.CallOption) (*MsgStoreDocumentResponse, error)
-	// Entity account operations
-	CreateEntity(ctx context.Context, in *MsgCreateEntity, opts ...grpc.CallOption) (*MsgCreateEntityResponse, error)
-	AddEntityMember(ctx context.Context, in *MsgAddEntityMember, opts ...grpc.CallOption) (*MsgAddEntityMemberResponse, error)
-	RemoveEntityMember(ctx context.Context, in *MsgRemoveEntityMember, opts ...grpc.CallOption) (*MsgRemoveEntityMemberResponse, error)
-	// Spec tracking operations
-	CreateSpecVersion(ctx context.Context, in *MsgCreateSpecVersion, opts ...grpc.CallOption) (*MsgCreateSpecVersionResponse, error)
+func (m *MsgAddCoSignature) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+// MsgAddCoSignatureResponse is the response for AddCoSignature
+type MsgAddCoSignatureResponse struct {
+	SignatureCount uint32 `protobuf:"varint,1,opt,name=signature_count,json=signatureCount,proto3" json:"signature_count,omitempty"`
+	Complete       bool   `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAddCoSignatureResponse) Reset()         { *m = MsgAddCoSignatureResponse{} }
+func (m *MsgAddCoSignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCoSignatureResponse) ProtoMessage()    {}
+func (*MsgAddCoSignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{16}
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAddCoSignatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) CreateStamp(ctx context.Context, in *MsgCreateStamp, opts ...grpc.CallOption) (*MsgCreateStampResponse, error) {
-	out := new(MsgCreateStampResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/CreateStamp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddCoSignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCoSignatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) RevokeStamp(ctx context.Context, in *MsgRevokeStamp, opts ...grpc.CallOption) (*MsgRevokeStampResponse, error) {
-	out := new(MsgRevokeStampResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/RevokeStamp", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAddCoSignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCoSignatureResponse.Merge(m, src)
 }
-
-func (c *msgClient) StoreDocument(ctx context.Context, in *MsgStoreDocument, opts ...grpc.CallOption) (*MsgStoreDocumentResponse, error) {
-	out := new(MsgStoreDocumentResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/StoreDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAddCoSignatureResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) CreateEntity(ctx context.Context, in *MsgCreateEntity, opts ...grpc.CallOption) (*MsgCreateEntityResponse, error) {
-	out := new(MsgCreateEntityResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/CreateEntity", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAddCoSignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCoSignatureResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddEntityMember(ctx context.Context, in *MsgAddEntityMember, opts ...grpc.CallOption) (*MsgAddEntityMemberResponse, error) {
-	out := new(MsgAddEntityMemberResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/AddEntityMember", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgAddCoSignatureResponse proto.InternalMessageInfo
 
-func (c *msgClient) RemoveEntityMember(ctx context.Context, in *MsgRemoveEntityMember, opts ...grpc.CallOption) (*MsgRemoveEntityMemberResponse, error) {
-	out := new(MsgRemoveEntityMemberResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/RemoveEntityMember", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddCoSignatureResponse) GetSignatureCount() uint32 {
+	if m != nil {
+		return m.SignatureCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CreateSpecVersion(ctx context.Context, in *MsgCreateSpecVersion, opts ...grpc.CallOption) (*MsgCreateSpecVersionResponse, error) {
-	out := new(MsgCreateSpecVersionResponse)
-	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/CreateSpecVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddCoSignatureResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
 	}
-	return out, nil
-}
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// Stamp operations
-	CreateStamp(context.Context, *MsgCreateStamp) (*MsgCreateStampResponse, error)
-	RevokeStamp(context.Context, *MsgRevokeStamp) (*MsgRevokeStampResponse, error)
-	// Document storage operations
-	StoreDocument(context.Context, *MsgStoreDocument) (*MsgStoreDocumentResponse, error)
-	// Entity account operations
-	CreateEntity(context.Context, *MsgCreateEntity) (*MsgCreateEntityResponse, error)
-	AddEntityMember(context.Context, *MsgAddEntityMember) (*MsgAddEntityMemberResponse, error)
-	RemoveEntityMember(context.Context, *MsgRemoveEntityMember) (*MsgRemoveEntityMemberResponse, error)
-	// Spec tracking operations
-	CreateSpecVersion(context.Context, *MsgCreateSpecVersion) (*MsgCreateSpecVersionResponse, error)
+	return false
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgSupersedeStamp creates a new stamp that replaces an existing one, e.g.
+// when a drawing is re-issued. The replaced stamp is marked superseded.
+type MsgSupersedeStamp struct {
+	Creator           string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SupersededStampId string `protobuf:"bytes,2,opt,name=superseded_stamp_id,json=supersededStampId,proto3" json:"superseded_stamp_id,omitempty"`
+	// New stamp, as in MsgCreateStamp
+	DocumentHash     string `protobuf:"bytes,3,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
+	PePublicKey      string `protobuf:"bytes,4,opt,name=pe_public_key,json=pePublicKey,proto3" json:"pe_public_key,omitempty"`
+	Signature        string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	JurisdictionId   string `protobuf:"bytes,6,opt,name=jurisdiction_id,json=jurisdictionId,proto3" json:"jurisdiction_id,omitempty"`
+	PeLicenseNumber  string `protobuf:"bytes,7,opt,name=pe_license_number,json=peLicenseNumber,proto3" json:"pe_license_number,omitempty"`
+	PeName           string `protobuf:"bytes,8,opt,name=pe_name,json=peName,proto3" json:"pe_name,omitempty"`
+	ProjectName      string `protobuf:"bytes,9,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	DocumentIpfsHash string `protobuf:"bytes,10,opt,name=document_ipfs_hash,json=documentIpfsHash,proto3" json:"document_ipfs_hash,omitempty"`
+	DocumentSize     int64  `protobuf:"varint,11,opt,name=document_size,json=documentSize,proto3" json:"document_size,omitempty"`
+	DocumentFilename string `protobuf:"bytes,12,opt,name=document_filename,json=documentFilename,proto3" json:"document_filename,omitempty"`
+	SignatureExpiry  int64  `protobuf:"varint,13,opt,name=signature_expiry,json=signatureExpiry,proto3" json:"signature_expiry,omitempty"`
+	Nonce            uint64 `protobuf:"varint,14,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ValidUntil       int64  `protobuf:"varint,15,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
-}
-func (*UnimplementedMsgServer) CreateStamp(ctx context.Context, req *MsgCreateStamp) (*MsgCreateStampResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateStamp not implemented")
+func (m *MsgSupersedeStamp) Reset()         { *m = MsgSupersedeStamp{} }
+func (m *MsgSupersedeStamp) String() string { return proto.CompactTextString(m) }
+func (*MsgSupersedeStamp) ProtoMessage()    {}
+func (*MsgSupersedeStamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{17}
 }
-func (*UnimplementedMsgServer) RevokeStamp(ctx context.Context, req *MsgRevokeStamp) (*MsgRevokeStampResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeStamp not implemented")
-}
-func (*UnimplementedMsgServer) StoreDocument(ctx context.Context, req *MsgStoreDocument) (*MsgStoreDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoreDocument not implemented")
+func (m *MsgSupersedeStamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateEntity(ctx context.Context, req *MsgCreateEntity) (*MsgCreateEntityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateEntity not implemented")
+func (m *MsgSupersedeStamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSupersedeStamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) AddEntityMember(ctx context.Context, req *MsgAddEntityMember) (*MsgAddEntityMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddEntityMember not implemented")
+func (m *MsgSupersedeStamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSupersedeStamp.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RemoveEntityMember(ctx context.Context, req *MsgRemoveEntityMember) (*MsgRemoveEntityMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveEntityMember not implemented")
+func (m *MsgSupersedeStamp) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CreateSpecVersion(ctx context.Context, req *MsgCreateSpecVersion) (*MsgCreateSpecVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSpecVersion not implemented")
+func (m *MsgSupersedeStamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSupersedeStamp.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSupersedeStamp proto.InternalMessageInfo
+
+func (m *MsgSupersedeStamp) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSupersedeStamp) GetSupersededStampId() string {
+	if m != nil {
+		return m.SupersededStampId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return ""
+}
+
+func (m *MsgSupersedeStamp) GetDocumentHash() string {
+	if m != nil {
+		return m.DocumentHash
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/UpdateParams",
+	return ""
+}
+
+func (m *MsgSupersedeStamp) GetPePublicKey() string {
+	if m != nil {
+		return m.PePublicKey
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return ""
+}
+
+func (m *MsgSupersedeStamp) GetSignature() string {
+	if m != nil {
+		return m.Signature
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateStamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateStamp)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSupersedeStamp) GetJurisdictionId() string {
+	if m != nil {
+		return m.JurisdictionId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateStamp(ctx, in)
+	return ""
+}
+
+func (m *MsgSupersedeStamp) GetPeLicenseNumber() string {
+	if m != nil {
+		return m.PeLicenseNumber
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/CreateStamp",
+	return ""
+}
+
+func (m *MsgSupersedeStamp) GetPeName() string {
+	if m != nil {
+		return m.PeName
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateStamp(ctx, req.(*MsgCreateStamp))
+	return ""
+}
+
+func (m *MsgSupersedeStamp) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RevokeStamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeStamp)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSupersedeStamp) GetDocumentIpfsHash() string {
+	if m != nil {
+		return m.DocumentIpfsHash
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokeStamp(ctx, in)
+	return ""
+}
+
+func (m *MsgSupersedeStamp) GetDocumentSize() int64 {
+	if m != nil {
+		return m.DocumentSize
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/RevokeStamp",
+	return 0
+}
+
+func (m *MsgSupersedeStamp) GetDocumentFilename() string {
+	if m != nil {
+		return m.DocumentFilename
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokeStamp(ctx, req.(*MsgRevokeStamp))
+	return ""
+}
+
+func (m *MsgSupersedeStamp) GetSignatureExpiry() int64 {
+	if m != nil {
+		return m.SignatureExpiry
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_StoreDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStoreDocument)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSupersedeStamp) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).StoreDocument(ctx, in)
+	return 0
+}
+
+func (m *MsgSupersedeStamp) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/StoreDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StoreDocument(ctx, req.(*MsgStoreDocument))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreateEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateEntity)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateEntity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/CreateEntity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateEntity(ctx, req.(*MsgCreateEntity))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSupersedeStampResponse is the response for SupersedeStamp
+type MsgSupersedeStampResponse struct {
+	StampId string `protobuf:"bytes,1,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
 }
 
-func _Msg_AddEntityMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddEntityMember)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddEntityMember(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/AddEntityMember",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddEntityMember(ctx, req.(*MsgAddEntityMember))
+func (m *MsgSupersedeStampResponse) Reset()         { *m = MsgSupersedeStampResponse{} }
+func (m *MsgSupersedeStampResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSupersedeStampResponse) ProtoMessage()    {}
+func (*MsgSupersedeStampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{18}
+}
+func (m *MsgSupersedeStampResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSupersedeStampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSupersedeStampResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSupersedeStampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSupersedeStampResponse.Merge(m, src)
+}
+func (m *MsgSupersedeStampResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSupersedeStampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSupersedeStampResponse.DiscardUnknown(m)
 }
 
-func _Msg_RemoveEntityMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveEntityMember)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSupersedeStampResponse proto.InternalMessageInfo
+
+func (m *MsgSupersedeStampResponse) GetStampId() string {
+	if m != nil {
+		return m.StampId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveEntityMember(ctx, in)
+	return ""
+}
+
+// MsgRegisterPE binds an Ed25519 stamp key to the signing account
+type MsgRegisterPE struct {
+	Creator       string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PublicKey     string   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Name          string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	LicenseNumber string   `protobuf:"bytes,4,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	Jurisdictions []string `protobuf:"bytes,5,rep,name=jurisdictions,proto3" json:"jurisdictions,omitempty"`
+	PopSignature  string   `protobuf:"bytes,6,opt,name=pop_signature,json=popSignature,proto3" json:"pop_signature,omitempty"`
+}
+
+func (m *MsgRegisterPE) Reset()         { *m = MsgRegisterPE{} }
+func (m *MsgRegisterPE) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPE) ProtoMessage()    {}
+func (*MsgRegisterPE) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{19}
+}
+func (m *MsgRegisterPE) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPE) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPE.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/RemoveEntityMember",
+}
+func (m *MsgRegisterPE) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPE.Merge(m, src)
+}
+func (m *MsgRegisterPE) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPE) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPE.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterPE proto.InternalMessageInfo
+
+func (m *MsgRegisterPE) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveEntityMember(ctx, req.(*MsgRemoveEntityMember))
+	return ""
+}
+
+func (m *MsgRegisterPE) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateSpecVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateSpecVersion)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgRegisterPE) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateSpecVersion(ctx, in)
+	return ""
+}
+
+func (m *MsgRegisterPE) GetLicenseNumber() string {
+	if m != nil {
+		return m.LicenseNumber
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/CreateSpecVersion",
+	return ""
+}
+
+func (m *MsgRegisterPE) GetJurisdictions() []string {
+	if m != nil {
+		return m.Jurisdictions
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateSpecVersion(ctx, req.(*MsgCreateSpecVersion))
+	return nil
+}
+
+func (m *MsgRegisterPE) GetPopSignature() string {
+	if m != nil {
+		return m.PopSignature
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stampledgerchain.stampledgerchain.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateStamp",
-			Handler:    _Msg_CreateStamp_Handler,
-		},
-		{
-			MethodName: "RevokeStamp",
-			Handler:    _Msg_RevokeStamp_Handler,
-		},
-		{
-			MethodName: "StoreDocument",
-			Handler:    _Msg_StoreDocument_Handler,
-		},
-		{
-			MethodName: "CreateEntity",
-			Handler:    _Msg_CreateEntity_Handler,
-		},
-		{
-			MethodName: "AddEntityMember",
-			Handler:    _Msg_AddEntityMember_Handler,
-		},
-		{
-			MethodName: "RemoveEntityMember",
-			Handler:    _Msg_RemoveEntityMember_Handler,
-		},
-		{
-			MethodName: "CreateSpecVersion",
-			Handler:    _Msg_CreateSpecVersion_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stampledgerchain/stampledgerchain/v1/tx.proto",
+// MsgRegisterPEResponse is the response for RegisterPE
+type MsgRegisterPEResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterPEResponse) Reset()         { *m = MsgRegisterPEResponse{} }
+func (m *MsgRegisterPEResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPEResponse) ProtoMessage()    {}
+func (*MsgRegisterPEResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{20}
+}
+func (m *MsgRegisterPEResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPEResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPEResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRegisterPEResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPEResponse.Merge(m, src)
+}
+func (m *MsgRegisterPEResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPEResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPEResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterPEResponse proto.InternalMessageInfo
+
+// MsgRotatePEKey replaces a registered stamp key with a new one
+type MsgRotatePEKey struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	OldPublicKey    string `protobuf:"bytes,2,opt,name=old_public_key,json=oldPublicKey,proto3" json:"old_public_key,omitempty"`
+	NewPublicKey    string `protobuf:"bytes,3,opt,name=new_public_key,json=newPublicKey,proto3" json:"new_public_key,omitempty"`
+	OldKeySignature string `protobuf:"bytes,4,opt,name=old_key_signature,json=oldKeySignature,proto3" json:"old_key_signature,omitempty"`
+	NewKeySignature string `protobuf:"bytes,5,opt,name=new_key_signature,json=newKeySignature,proto3" json:"new_key_signature,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRotatePEKey) Reset()         { *m = MsgRotatePEKey{} }
+func (m *MsgRotatePEKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotatePEKey) ProtoMessage()    {}
+func (*MsgRotatePEKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{21}
+}
+func (m *MsgRotatePEKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotatePEKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotatePEKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRotatePEKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotatePEKey.Merge(m, src)
+}
+func (m *MsgRotatePEKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotatePEKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotatePEKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotatePEKey proto.InternalMessageInfo
+
+func (m *MsgRotatePEKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRotatePEKey) GetOldPublicKey() string {
+	if m != nil {
+		return m.OldPublicKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRotatePEKey) GetNewPublicKey() string {
+	if m != nil {
+		return m.NewPublicKey
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRotatePEKey) GetOldKeySignature() string {
+	if m != nil {
+		return m.OldKeySignature
+	}
+	return ""
 }
 
-func (m *MsgCreateStamp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRotatePEKey) GetNewKeySignature() string {
+	if m != nil {
+		return m.NewKeySignature
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateStamp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRotatePEKeyResponse is the response for RotatePEKey
+type MsgRotatePEKeyResponse struct {
 }
 
-func (m *MsgCreateStamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DocumentFilename) > 0 {
-		i -= len(m.DocumentFilename)
-		copy(dAtA[i:], m.DocumentFilename)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentFilename)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.DocumentSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DocumentSize))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.DocumentIpfsHash) > 0 {
-		i -= len(m.DocumentIpfsHash)
-		copy(dAtA[i:], m.DocumentIpfsHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentIpfsHash)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ProjectName) > 0 {
-		i -= len(m.ProjectName)
-		copy(dAtA[i:], m.ProjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProjectName)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.PeName) > 0 {
-		i -= len(m.PeName)
-		copy(dAtA[i:], m.PeName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PeName)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.PeLicenseNumber) > 0 {
-		i -= len(m.PeLicenseNumber)
-		copy(dAtA[i:], m.PeLicenseNumber)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PeLicenseNumber)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.JurisdictionId) > 0 {
-		i -= len(m.JurisdictionId)
-		copy(dAtA[i:], m.JurisdictionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.JurisdictionId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PePublicKey) > 0 {
-		i -= len(m.PePublicKey)
-		copy(dAtA[i:], m.PePublicKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PePublicKey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DocumentHash) > 0 {
-		i -= len(m.DocumentHash)
-		copy(dAtA[i:], m.DocumentHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRotatePEKeyResponse) Reset()         { *m = MsgRotatePEKeyResponse{} }
+func (m *MsgRotatePEKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotatePEKeyResponse) ProtoMessage()    {}
+func (*MsgRotatePEKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{22}
 }
-
-func (m *MsgCreateStampResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRotatePEKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotatePEKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotatePEKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateStampResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRotatePEKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotatePEKeyResponse.Merge(m, src)
+}
+func (m *MsgRotatePEKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotatePEKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotatePEKeyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateStampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StampId) > 0 {
-		i -= len(m.StampId)
-		copy(dAtA[i:], m.StampId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StampId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRotatePEKeyResponse proto.InternalMessageInfo
+
+// MsgReportKeyCompromise revokes every stamp made with a leaked key from a
+// block height on. Large stamp sets are revoked in batches; resubmit until
+// complete is returned.
+type MsgReportKeyCompromise struct {
+	Creator                string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PublicKey              string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	CompromisedSinceHeight int64  `protobuf:"varint,3,opt,name=compromised_since_height,json=compromisedSinceHeight,proto3" json:"compromised_since_height,omitempty"`
 }
 
-func (m *MsgRevokeStamp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReportKeyCompromise) Reset()         { *m = MsgReportKeyCompromise{} }
+func (m *MsgReportKeyCompromise) String() string { return proto.CompactTextString(m) }
+func (*MsgReportKeyCompromise) ProtoMessage()    {}
+func (*MsgReportKeyCompromise) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{23}
+}
+func (m *MsgReportKeyCompromise) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportKeyCompromise) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportKeyCompromise.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRevokeStamp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReportKeyCompromise) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportKeyCompromise.Merge(m, src)
+}
+func (m *MsgReportKeyCompromise) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportKeyCompromise) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportKeyCompromise.DiscardUnknown(m)
 }
 
-func (m *MsgRevokeStamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.StampId) > 0 {
-		i -= len(m.StampId)
-		copy(dAtA[i:], m.StampId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StampId)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_MsgReportKeyCompromise proto.InternalMessageInfo
+
+func (m *MsgReportKeyCompromise) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgReportKeyCompromise) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRevokeStampResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReportKeyCompromise) GetCompromisedSinceHeight() int64 {
+	if m != nil {
+		return m.CompromisedSinceHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRevokeStampResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgReportKeyCompromiseResponse is the response for ReportKeyCompromise
+type MsgReportKeyCompromiseResponse struct {
+	RevokedCount uint64 `protobuf:"varint,1,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+	Complete     bool   `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
 }
 
-func (m *MsgRevokeStampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgReportKeyCompromiseResponse) Reset()         { *m = MsgReportKeyCompromiseResponse{} }
+func (m *MsgReportKeyCompromiseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportKeyCompromiseResponse) ProtoMessage()    {}
+func (*MsgReportKeyCompromiseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{24}
+}
+func (m *MsgReportKeyCompromiseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportKeyCompromiseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportKeyCompromiseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgReportKeyCompromiseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportKeyCompromiseResponse.Merge(m, src)
+}
+func (m *MsgReportKeyCompromiseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportKeyCompromiseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportKeyCompromiseResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStoreDocument) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReportKeyCompromiseResponse proto.InternalMessageInfo
+
+func (m *MsgReportKeyCompromiseResponse) GetRevokedCount() uint64 {
+	if m != nil {
+		return m.RevokedCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgStoreDocument) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReportKeyCompromiseResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
 }
 
-func (m *MsgStoreDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PinForever {
-		i--
-		if m.PinForever {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// MsgGrantStampingDelegation lets grantee submit stamps made with any of the
+// creator's PE keys, replacing any existing grant to the same grantee
+type MsgGrantStampingDelegation struct {
+	Creator         string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Grantee         string   `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	JurisdictionIds []string `protobuf:"bytes,3,rep,name=jurisdiction_ids,json=jurisdictionIds,proto3" json:"jurisdiction_ids,omitempty"`
+	ProjectNames    []string `protobuf:"bytes,4,rep,name=project_names,json=projectNames,proto3" json:"project_names,omitempty"`
+	Expiration      int64    `protobuf:"varint,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *MsgGrantStampingDelegation) Reset()         { *m = MsgGrantStampingDelegation{} }
+func (m *MsgGrantStampingDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantStampingDelegation) ProtoMessage()    {}
+func (*MsgGrantStampingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{25}
+}
+func (m *MsgGrantStampingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantStampingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantStampingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.MimeType) > 0 {
-		i -= len(m.MimeType)
-		copy(dAtA[i:], m.MimeType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MimeType)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Size_ != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Filename) > 0 {
-		i -= len(m.Filename)
-		copy(dAtA[i:], m.Filename)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Filename)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.IpfsHash) > 0 {
-		i -= len(m.IpfsHash)
-		copy(dAtA[i:], m.IpfsHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.StampId) > 0 {
-		i -= len(m.StampId)
-		copy(dAtA[i:], m.StampId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StampId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgGrantStampingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantStampingDelegation.Merge(m, src)
+}
+func (m *MsgGrantStampingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantStampingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantStampingDelegation.DiscardUnknown(m)
 }
 
-func (m *MsgStoreDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgGrantStampingDelegation proto.InternalMessageInfo
+
+func (m *MsgGrantStampingDelegation) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgStoreDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgGrantStampingDelegation) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
 }
 
-func (m *MsgStoreDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IpfsUrl) > 0 {
-		i -= len(m.IpfsUrl)
-		copy(dAtA[i:], m.IpfsUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsUrl)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgGrantStampingDelegation) GetJurisdictionIds() []string {
+	if m != nil {
+		return m.JurisdictionIds
 	}
-	if len(m.DocumentId) > 0 {
-		i -= len(m.DocumentId)
-		copy(dAtA[i:], m.DocumentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgGrantStampingDelegation) GetProjectNames() []string {
+	if m != nil {
+		return m.ProjectNames
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgCreateEntity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantStampingDelegation) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateEntity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgGrantStampingDelegationResponse is the response for GrantStampingDelegation
+type MsgGrantStampingDelegationResponse struct {
 }
 
-func (m *MsgCreateEntity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EntityType) > 0 {
-		i -= len(m.EntityType)
-		copy(dAtA[i:], m.EntityType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityType)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgGrantStampingDelegationResponse) Reset()         { *m = MsgGrantStampingDelegationResponse{} }
+func (m *MsgGrantStampingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantStampingDelegationResponse) ProtoMessage()    {}
+func (*MsgGrantStampingDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{26}
+}
+func (m *MsgGrantStampingDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantStampingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantStampingDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgGrantStampingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantStampingDelegationResponse.Merge(m, src)
+}
+func (m *MsgGrantStampingDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantStampingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantStampingDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantStampingDelegationResponse proto.InternalMessageInfo
+
+// MsgRevokeStampingDelegation removes a stamping delegation
+type MsgRevokeStampingDelegation struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *MsgRevokeStampingDelegation) Reset()         { *m = MsgRevokeStampingDelegation{} }
+func (m *MsgRevokeStampingDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeStampingDelegation) ProtoMessage()    {}
+func (*MsgRevokeStampingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{27}
+}
+func (m *MsgRevokeStampingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeStampingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeStampingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRevokeStampingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeStampingDelegation.Merge(m, src)
+}
+func (m *MsgRevokeStampingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeStampingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeStampingDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeStampingDelegation proto.InternalMessageInfo
+
+func (m *MsgRevokeStampingDelegation) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateEntityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeStampingDelegation) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateEntityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRevokeStampingDelegationResponse is the response for RevokeStampingDelegation
+type MsgRevokeStampingDelegationResponse struct {
 }
 
-func (m *MsgCreateEntityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EntityId) > 0 {
-		i -= len(m.EntityId)
-		copy(dAtA[i:], m.EntityId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRevokeStampingDelegationResponse) Reset()         { *m = MsgRevokeStampingDelegationResponse{} }
+func (m *MsgRevokeStampingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeStampingDelegationResponse) ProtoMessage()    {}
+func (*MsgRevokeStampingDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{28}
+}
+func (m *MsgRevokeStampingDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeStampingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeStampingDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRevokeStampingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeStampingDelegationResponse.Merge(m, src)
+}
+func (m *MsgRevokeStampingDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeStampingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeStampingDelegationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddEntityMember) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgRevokeStampingDelegationResponse proto.InternalMessageInfo
+
+// MsgAttestLicense records that a board has verified a PE license
+type MsgAttestLicense struct {
+	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	JurisdictionId string `protobuf:"bytes,2,opt,name=jurisdiction_id,json=jurisdictionId,proto3" json:"jurisdiction_id,omitempty"`
+	LicenseNumber  string `protobuf:"bytes,3,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	Reason         string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	PeAccount      string `protobuf:"bytes,5,opt,name=pe_account,json=peAccount,proto3" json:"pe_account,omitempty"`
 }
 
-func (m *MsgAddEntityMember) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAttestLicense) Reset()         { *m = MsgAttestLicense{} }
+func (m *MsgAttestLicense) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestLicense) ProtoMessage()    {}
+func (*MsgAttestLicense) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{29}
+}
+func (m *MsgAttestLicense) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestLicense) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestLicense.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestLicense) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestLicense.Merge(m, src)
+}
+func (m *MsgAttestLicense) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestLicense) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestLicense.DiscardUnknown(m)
 }
 
-func (m *MsgAddEntityMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x22
+var xxx_messageInfo_MsgAttestLicense proto.InternalMessageInfo
+
+func (m *MsgAttestLicense) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.MemberAddress) > 0 {
-		i -= len(m.MemberAddress)
-		copy(dAtA[i:], m.MemberAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MemberAddress)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *MsgAttestLicense) GetJurisdictionId() string {
+	if m != nil {
+		return m.JurisdictionId
 	}
-	if len(m.EntityId) > 0 {
-		i -= len(m.EntityId)
-		copy(dAtA[i:], m.EntityId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgAttestLicense) GetLicenseNumber() string {
+	if m != nil {
+		return m.LicenseNumber
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgAttestLicense) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddEntityMemberResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAttestLicense) GetPeAccount() string {
+	if m != nil {
+		return m.PeAccount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddEntityMemberResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAttestLicenseResponse is the response for AttestLicense
+type MsgAttestLicenseResponse struct {
 }
 
-func (m *MsgAddEntityMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgAttestLicenseResponse) Reset()         { *m = MsgAttestLicenseResponse{} }
+func (m *MsgAttestLicenseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestLicenseResponse) ProtoMessage()    {}
+func (*MsgAttestLicenseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{30}
+}
+func (m *MsgAttestLicenseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestLicenseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestLicenseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAttestLicenseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestLicenseResponse.Merge(m, src)
+}
+func (m *MsgAttestLicenseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestLicenseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestLicenseResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveEntityMember) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAttestLicenseResponse proto.InternalMessageInfo
+
+// MsgSuspendLicense suspends an attested PE license
+type MsgSuspendLicense struct {
+	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	JurisdictionId string `protobuf:"bytes,2,opt,name=jurisdiction_id,json=jurisdictionId,proto3" json:"jurisdiction_id,omitempty"`
+	LicenseNumber  string `protobuf:"bytes,3,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	Reason         string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgSuspendLicense) Reset()         { *m = MsgSuspendLicense{} }
+func (m *MsgSuspendLicense) String() string { return proto.CompactTextString(m) }
+func (*MsgSuspendLicense) ProtoMessage()    {}
+func (*MsgSuspendLicense) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{31}
+}
+func (m *MsgSuspendLicense) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuspendLicense) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuspendLicense.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSuspendLicense) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuspendLicense.Merge(m, src)
+}
+func (m *MsgSuspendLicense) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuspendLicense) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuspendLicense.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveEntityMember) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSuspendLicense proto.InternalMessageInfo
+
+func (m *MsgSuspendLicense) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgRemoveEntityMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MemberAddress) > 0 {
-		i -= len(m.MemberAddress)
-		copy(dAtA[i:], m.MemberAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MemberAddress)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgSuspendLicense) GetJurisdictionId() string {
+	if m != nil {
+		return m.JurisdictionId
 	}
-	if len(m.EntityId) > 0 {
-		i -= len(m.EntityId)
-		copy(dAtA[i:], m.EntityId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgSuspendLicense) GetLicenseNumber() string {
+	if m != nil {
+		return m.LicenseNumber
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSuspendLicense) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRemoveEntityMemberResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgSuspendLicenseResponse is the response for SuspendLicense
+type MsgSuspendLicenseResponse struct {
+}
+
+func (m *MsgSuspendLicenseResponse) Reset()         { *m = MsgSuspendLicenseResponse{} }
+func (m *MsgSuspendLicenseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuspendLicenseResponse) ProtoMessage()    {}
+func (*MsgSuspendLicenseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{32}
+}
+func (m *MsgSuspendLicenseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuspendLicenseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuspendLicenseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSuspendLicenseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuspendLicenseResponse.Merge(m, src)
+}
+func (m *MsgSuspendLicenseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuspendLicenseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuspendLicenseResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveEntityMemberResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSuspendLicenseResponse proto.InternalMessageInfo
+
+// MsgReinstateLicense reinstates a suspended PE license
+type MsgReinstateLicense struct {
+	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	JurisdictionId string `protobuf:"bytes,2,opt,name=jurisdiction_id,json=jurisdictionId,proto3" json:"jurisdiction_id,omitempty"`
+	LicenseNumber  string `protobuf:"bytes,3,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	Reason         string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgRemoveEntityMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgReinstateLicense) Reset()         { *m = MsgReinstateLicense{} }
+func (m *MsgReinstateLicense) String() string { return proto.CompactTextString(m) }
+func (*MsgReinstateLicense) ProtoMessage()    {}
+func (*MsgReinstateLicense) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{33}
+}
+func (m *MsgReinstateLicense) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReinstateLicense) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReinstateLicense.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgReinstateLicense) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReinstateLicense.Merge(m, src)
+}
+func (m *MsgReinstateLicense) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReinstateLicense) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReinstateLicense.DiscardUnknown(m)
 }
 
-func (m *MsgCreateSpecVersion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReinstateLicense proto.InternalMessageInfo
+
+func (m *MsgReinstateLicense) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateSpecVersion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReinstateLicense) GetJurisdictionId() string {
+	if m != nil {
+		return m.JurisdictionId
+	}
+	return ""
 }
 
-func (m *MsgCreateSpecVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ParentVersionId) > 0 {
-		i -= len(m.ParentVersionId)
-		copy(dAtA[i:], m.ParentVersionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ParentVersionId)))
-		i--
-		dAtA[i] = 0x3a
+func (m *MsgReinstateLicense) GetLicenseNumber() string {
+	if m != nil {
+		return m.LicenseNumber
 	}
-	if len(m.Changelog) > 0 {
-		i -= len(m.Changelog)
-		copy(dAtA[i:], m.Changelog)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Changelog)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *MsgReinstateLicense) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(m.SpecIpfs) > 0 {
-		i -= len(m.SpecIpfs)
-		copy(dAtA[i:], m.SpecIpfs)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecIpfs)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SpecHash) > 0 {
-		i -= len(m.SpecHash)
-		copy(dAtA[i:], m.SpecHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ProjectId) > 0 {
-		i -= len(m.ProjectId)
-		copy(dAtA[i:], m.ProjectId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProjectId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateSpecVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgReinstateLicenseResponse is the response for ReinstateLicense
+type MsgReinstateLicenseResponse struct {
+}
+
+func (m *MsgReinstateLicenseResponse) Reset()         { *m = MsgReinstateLicenseResponse{} }
+func (m *MsgReinstateLicenseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReinstateLicenseResponse) ProtoMessage()    {}
+func (*MsgReinstateLicenseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{34}
+}
+func (m *MsgReinstateLicenseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReinstateLicenseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReinstateLicenseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgReinstateLicenseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReinstateLicenseResponse.Merge(m, src)
+}
+func (m *MsgReinstateLicenseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReinstateLicenseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReinstateLicenseResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateSpecVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgReinstateLicenseResponse proto.InternalMessageInfo
+
+// MsgStoreDocument stores a document reference on the blockchain
+type MsgStoreDocument struct {
+	Creator       string       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	StampId       string       `protobuf:"bytes,2,opt,name=stamp_id,json=stampId,proto3" json:"stamp_id,omitempty"`
+	IpfsHash      string       `protobuf:"bytes,3,opt,name=ipfs_hash,json=ipfsHash,proto3" json:"ipfs_hash,omitempty"`
+	Filename      string       `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	Size_         int64        `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	MimeType      string       `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	PinForever    bool         `protobuf:"varint,7,opt,name=pin_forever,json=pinForever,proto3" json:"pin_forever,omitempty"`
+	ContentSha256 string       `protobuf:"bytes,8,opt,name=content_sha256,json=contentSha256,proto3" json:"content_sha256,omitempty"`
+	Role          DocumentRole `protobuf:"varint,9,opt,name=role,proto3,enum=stampledgerchain.stampledgerchain.v1.DocumentRole" json:"role,omitempty"`
+	EntityId      string       `protobuf:"bytes,10,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 }
 
-func (m *MsgCreateSpecVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VersionId) > 0 {
-		i -= len(m.VersionId)
-		copy(dAtA[i:], m.VersionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VersionId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgStoreDocument) Reset()         { *m = MsgStoreDocument{} }
+func (m *MsgStoreDocument) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreDocument) ProtoMessage()    {}
+func (*MsgStoreDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{35}
+}
+func (m *MsgStoreDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStoreDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStoreDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgStoreDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreDocument.Merge(m, src)
+}
+func (m *MsgStoreDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStoreDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreDocument.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgStoreDocument proto.InternalMessageInfo
+
+func (m *MsgStoreDocument) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgStoreDocument) GetStampId() string {
+	if m != nil {
+		return m.StampId
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgStoreDocument) GetIpfsHash() string {
+	if m != nil {
+		return m.IpfsHash
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgCreateStamp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DocumentHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PePublicKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.JurisdictionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PeLicenseNumber)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PeName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DocumentIpfsHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DocumentSize != 0 {
-		n += 1 + sovTx(uint64(m.DocumentSize))
-	}
-	l = len(m.DocumentFilename)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgStoreDocument) GetFilename() string {
+	if m != nil {
+		return m.Filename
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCreateStampResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StampId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgStoreDocument) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
 	}
-	return n
+	return 0
 }
 
-func (m *MsgRevokeStamp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StampId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgStoreDocument) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
 	}
-	return n
+	return ""
 }
 
-func (m *MsgRevokeStampResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgStoreDocument) GetPinForever() bool {
+	if m != nil {
+		return m.PinForever
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	return false
+}
+
+func (m *MsgStoreDocument) GetContentSha256() string {
+	if m != nil {
+		return m.ContentSha256
 	}
-	return n
+	return ""
 }
 
-func (m *MsgStoreDocument) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgStoreDocument) GetRole() DocumentRole {
+	if m != nil {
+		return m.Role
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StampId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.IpfsHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Filename)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Size_ != 0 {
-		n += 1 + sovTx(uint64(m.Size_))
-	}
-	l = len(m.MimeType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PinForever {
-		n += 2
-	}
-	return n
+	return DocumentRoleUnspecified
 }
 
-func (m *MsgStoreDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DocumentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.IpfsUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgStoreDocument) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCreateEntity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.EntityType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+// MsgStoreDocumentResponse is the response for StoreDocument
+type MsgStoreDocumentResponse struct {
+	DocumentId string `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	IpfsUrl    string `protobuf:"bytes,2,opt,name=ipfs_url,json=ipfsUrl,proto3" json:"ipfs_url,omitempty"`
+}
+
+func (m *MsgStoreDocumentResponse) Reset()         { *m = MsgStoreDocumentResponse{} }
+func (m *MsgStoreDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreDocumentResponse) ProtoMessage()    {}
+func (*MsgStoreDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{36}
+}
+func (m *MsgStoreDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStoreDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStoreDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgStoreDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreDocumentResponse.Merge(m, src)
+}
+func (m *MsgStoreDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStoreDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreDocumentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateEntityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgStoreDocumentResponse proto.InternalMessageInfo
+
+func (m *MsgStoreDocumentResponse) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
 	}
-	var l int
-	_ = l
-	l = len(m.EntityId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgStoreDocumentResponse) GetIpfsUrl() string {
+	if m != nil {
+		return m.IpfsUrl
 	}
-	return n
+	return ""
 }
 
-func (m *MsgAddEntityMember) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgRenewPin extends a document's pin by the pin duration, or pins it again
+// if it lapsed
+type MsgRenewPin struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DocumentId string `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	PinForever bool   `protobuf:"varint,3,opt,name=pin_forever,json=pinForever,proto3" json:"pin_forever,omitempty"`
+}
+
+func (m *MsgRenewPin) Reset()         { *m = MsgRenewPin{} }
+func (m *MsgRenewPin) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewPin) ProtoMessage()    {}
+func (*MsgRenewPin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{37}
+}
+func (m *MsgRenewPin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewPin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewPin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRenewPin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewPin.Merge(m, src)
+}
+func (m *MsgRenewPin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewPin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewPin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewPin proto.InternalMessageInfo
+
+func (m *MsgRenewPin) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = len(m.EntityId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgRenewPin) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
 	}
-	l = len(m.MemberAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgRenewPin) GetPinForever() bool {
+	if m != nil {
+		return m.PinForever
 	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return false
+}
+
+// MsgRenewPinResponse is the response for RenewPin
+type MsgRenewPinResponse struct {
+	PinExpiresHeight int64 `protobuf:"varint,1,opt,name=pin_expires_height,json=pinExpiresHeight,proto3" json:"pin_expires_height,omitempty"`
+}
+
+func (m *MsgRenewPinResponse) Reset()         { *m = MsgRenewPinResponse{} }
+func (m *MsgRenewPinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewPinResponse) ProtoMessage()    {}
+func (*MsgRenewPinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{38}
+}
+func (m *MsgRenewPinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewPinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewPinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgRenewPinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewPinResponse.Merge(m, src)
+}
+func (m *MsgRenewPinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewPinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewPinResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddEntityMemberResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRenewPinResponse proto.InternalMessageInfo
+
+func (m *MsgRenewPinResponse) GetPinExpiresHeight() int64 {
+	if m != nil {
+		return m.PinExpiresHeight
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	return 0
+}
+
+// MsgUnpin releases a document's pin
+type MsgUnpin struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DocumentId string `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+}
+
+func (m *MsgUnpin) Reset()         { *m = MsgUnpin{} }
+func (m *MsgUnpin) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpin) ProtoMessage()    {}
+func (*MsgUnpin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{39}
+}
+func (m *MsgUnpin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUnpin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpin.Merge(m, src)
+}
+func (m *MsgUnpin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpin.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveEntityMember) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUnpin proto.InternalMessageInfo
+
+func (m *MsgUnpin) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgUnpin) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
 	}
-	l = len(m.EntityId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgUnpinResponse is the response for Unpin
+type MsgUnpinResponse struct {
+}
+
+func (m *MsgUnpinResponse) Reset()         { *m = MsgUnpinResponse{} }
+func (m *MsgUnpinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpinResponse) ProtoMessage()    {}
+func (*MsgUnpinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{40}
+}
+func (m *MsgUnpinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.MemberAddress)
+}
+func (m *MsgUnpinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpinResponse.Merge(m, src)
+}
+func (m *MsgUnpinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpinResponse proto.InternalMessageInfo
+
+// MsgAttestPinned records a registered pinning provider's attestation that it
+// holds a document
+type MsgAttestPinned struct {
+	Creator           string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DocumentId        string `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	ReplicaCount      uint32 `protobuf:"varint,3,opt,name=replica_count,json=replicaCount,proto3" json:"replica_count,omitempty"`
+	PinnedUntilHeight int64  `protobuf:"varint,4,opt,name=pinned_until_height,json=pinnedUntilHeight,proto3" json:"pinned_until_height,omitempty"`
+	Signature         string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgAttestPinned) Reset()         { *m = MsgAttestPinned{} }
+func (m *MsgAttestPinned) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestPinned) ProtoMessage()    {}
+func (*MsgAttestPinned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{41}
+}
+func (m *MsgAttestPinned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestPinned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestPinned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestPinned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestPinned.Merge(m, src)
+}
+func (m *MsgAttestPinned) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestPinned) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestPinned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestPinned proto.InternalMessageInfo
+
+func (m *MsgAttestPinned) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAttestPinned) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *MsgAttestPinned) GetReplicaCount() uint32 {
+	if m != nil {
+		return m.ReplicaCount
+	}
+	return 0
+}
+
+func (m *MsgAttestPinned) GetPinnedUntilHeight() int64 {
+	if m != nil {
+		return m.PinnedUntilHeight
+	}
+	return 0
+}
+
+func (m *MsgAttestPinned) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+// MsgAttestPinnedResponse is the response for AttestPinned
+type MsgAttestPinnedResponse struct {
+}
+
+func (m *MsgAttestPinnedResponse) Reset()         { *m = MsgAttestPinnedResponse{} }
+func (m *MsgAttestPinnedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestPinnedResponse) ProtoMessage()    {}
+func (*MsgAttestPinnedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{42}
+}
+func (m *MsgAttestPinnedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestPinnedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestPinnedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestPinnedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestPinnedResponse.Merge(m, src)
+}
+func (m *MsgAttestPinnedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestPinnedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestPinnedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestPinnedResponse proto.InternalMessageInfo
+
+// MsgCreateEntity creates a new organization/entity account
+type MsgCreateEntity struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	EntityType string `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+}
+
+func (m *MsgCreateEntity) Reset()         { *m = MsgCreateEntity{} }
+func (m *MsgCreateEntity) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEntity) ProtoMessage()    {}
+func (*MsgCreateEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{43}
+}
+func (m *MsgCreateEntity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEntity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEntity.Merge(m, src)
+}
+func (m *MsgCreateEntity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateEntity proto.InternalMessageInfo
+
+func (m *MsgCreateEntity) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreateEntity) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgCreateEntity) GetEntityType() string {
+	if m != nil {
+		return m.EntityType
+	}
+	return ""
+}
+
+// MsgCreateEntityResponse is the response for CreateEntity
+type MsgCreateEntityResponse struct {
+	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+}
+
+func (m *MsgCreateEntityResponse) Reset()         { *m = MsgCreateEntityResponse{} }
+func (m *MsgCreateEntityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEntityResponse) ProtoMessage()    {}
+func (*MsgCreateEntityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{44}
+}
+func (m *MsgCreateEntityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEntityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEntityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateEntityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEntityResponse.Merge(m, src)
+}
+func (m *MsgCreateEntityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEntityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEntityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateEntityResponse proto.InternalMessageInfo
+
+func (m *MsgCreateEntityResponse) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+// MsgAddEntityMember added a member to an entity directly. It is deprecated
+// and always rejected: members now join by accepting a MsgInviteMember. It
+// stays registered so existing clients get a clear error and past
+// transactions still decode.
+//
+// Deprecated: Do not use.
+type MsgAddEntityMember struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId      string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	MemberAddress string `protobuf:"bytes,3,opt,name=member_address,json=memberAddress,proto3" json:"member_address,omitempty"`
+	Role          string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (m *MsgAddEntityMember) Reset()         { *m = MsgAddEntityMember{} }
+func (m *MsgAddEntityMember) String() string { return proto.CompactTextString(m) }
+func (*MsgAddEntityMember) ProtoMessage()    {}
+func (*MsgAddEntityMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{45}
+}
+func (m *MsgAddEntityMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddEntityMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddEntityMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddEntityMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddEntityMember.Merge(m, src)
+}
+func (m *MsgAddEntityMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddEntityMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddEntityMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddEntityMember proto.InternalMessageInfo
+
+func (m *MsgAddEntityMember) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAddEntityMember) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *MsgAddEntityMember) GetMemberAddress() string {
+	if m != nil {
+		return m.MemberAddress
+	}
+	return ""
+}
+
+func (m *MsgAddEntityMember) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+// MsgAddEntityMemberResponse is the response for AddEntityMember
+type MsgAddEntityMemberResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (m *MsgAddEntityMemberResponse) Reset()         { *m = MsgAddEntityMemberResponse{} }
+func (m *MsgAddEntityMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddEntityMemberResponse) ProtoMessage()    {}
+func (*MsgAddEntityMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{46}
+}
+func (m *MsgAddEntityMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddEntityMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddEntityMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddEntityMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddEntityMemberResponse.Merge(m, src)
+}
+func (m *MsgAddEntityMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddEntityMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddEntityMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddEntityMemberResponse proto.InternalMessageInfo
+
+func (m *MsgAddEntityMemberResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+// MsgInviteMember invites an address to join an entity. The invitee becomes
+// a member only once it accepts. Inviting an address again replaces its
+// open invite.
+type MsgInviteMember struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Invitee  string `protobuf:"bytes,3,opt,name=invitee,proto3" json:"invitee,omitempty"`
+	Role     string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (m *MsgInviteMember) Reset()         { *m = MsgInviteMember{} }
+func (m *MsgInviteMember) String() string { return proto.CompactTextString(m) }
+func (*MsgInviteMember) ProtoMessage()    {}
+func (*MsgInviteMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{47}
+}
+func (m *MsgInviteMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInviteMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInviteMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInviteMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInviteMember.Merge(m, src)
+}
+func (m *MsgInviteMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInviteMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInviteMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInviteMember proto.InternalMessageInfo
+
+func (m *MsgInviteMember) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgInviteMember) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *MsgInviteMember) GetInvitee() string {
+	if m != nil {
+		return m.Invitee
+	}
+	return ""
+}
+
+func (m *MsgInviteMember) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+// MsgInviteMemberResponse is the response for InviteMember
+type MsgInviteMemberResponse struct {
+	ExpiresHeight int64 `protobuf:"varint,1,opt,name=expires_height,json=expiresHeight,proto3" json:"expires_height,omitempty"`
+}
+
+func (m *MsgInviteMemberResponse) Reset()         { *m = MsgInviteMemberResponse{} }
+func (m *MsgInviteMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInviteMemberResponse) ProtoMessage()    {}
+func (*MsgInviteMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{48}
+}
+func (m *MsgInviteMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInviteMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInviteMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInviteMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInviteMemberResponse.Merge(m, src)
+}
+func (m *MsgInviteMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInviteMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInviteMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInviteMemberResponse proto.InternalMessageInfo
+
+func (m *MsgInviteMemberResponse) GetExpiresHeight() int64 {
+	if m != nil {
+		return m.ExpiresHeight
+	}
+	return 0
+}
+
+// MsgAcceptInvite accepts an open invitation to join an entity
+type MsgAcceptInvite struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+}
+
+func (m *MsgAcceptInvite) Reset()         { *m = MsgAcceptInvite{} }
+func (m *MsgAcceptInvite) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptInvite) ProtoMessage()    {}
+func (*MsgAcceptInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{49}
+}
+func (m *MsgAcceptInvite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptInvite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptInvite.Merge(m, src)
+}
+func (m *MsgAcceptInvite) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptInvite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptInvite proto.InternalMessageInfo
+
+func (m *MsgAcceptInvite) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAcceptInvite) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+// MsgAcceptInviteResponse is the response for AcceptInvite
+type MsgAcceptInviteResponse struct {
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (m *MsgAcceptInviteResponse) Reset()         { *m = MsgAcceptInviteResponse{} }
+func (m *MsgAcceptInviteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptInviteResponse) ProtoMessage()    {}
+func (*MsgAcceptInviteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{50}
+}
+func (m *MsgAcceptInviteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptInviteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptInviteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptInviteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptInviteResponse.Merge(m, src)
+}
+func (m *MsgAcceptInviteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptInviteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptInviteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptInviteResponse proto.InternalMessageInfo
+
+func (m *MsgAcceptInviteResponse) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+// MsgDeclineInvite declines an open invitation to join an entity
+type MsgDeclineInvite struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+}
+
+func (m *MsgDeclineInvite) Reset()         { *m = MsgDeclineInvite{} }
+func (m *MsgDeclineInvite) String() string { return proto.CompactTextString(m) }
+func (*MsgDeclineInvite) ProtoMessage()    {}
+func (*MsgDeclineInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{51}
+}
+func (m *MsgDeclineInvite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeclineInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeclineInvite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeclineInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeclineInvite.Merge(m, src)
+}
+func (m *MsgDeclineInvite) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeclineInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeclineInvite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeclineInvite proto.InternalMessageInfo
+
+func (m *MsgDeclineInvite) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgDeclineInvite) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+// MsgDeclineInviteResponse is the response for DeclineInvite
+type MsgDeclineInviteResponse struct {
+}
+
+func (m *MsgDeclineInviteResponse) Reset()         { *m = MsgDeclineInviteResponse{} }
+func (m *MsgDeclineInviteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeclineInviteResponse) ProtoMessage()    {}
+func (*MsgDeclineInviteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{52}
+}
+func (m *MsgDeclineInviteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeclineInviteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeclineInviteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeclineInviteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeclineInviteResponse.Merge(m, src)
+}
+func (m *MsgDeclineInviteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeclineInviteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeclineInviteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeclineInviteResponse proto.InternalMessageInfo
+
+// MsgLeaveEntity removes the creator from an entity it is a member of
+type MsgLeaveEntity struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+}
+
+func (m *MsgLeaveEntity) Reset()         { *m = MsgLeaveEntity{} }
+func (m *MsgLeaveEntity) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveEntity) ProtoMessage()    {}
+func (*MsgLeaveEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{53}
+}
+func (m *MsgLeaveEntity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveEntity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLeaveEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveEntity.Merge(m, src)
+}
+func (m *MsgLeaveEntity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLeaveEntity proto.InternalMessageInfo
+
+func (m *MsgLeaveEntity) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgLeaveEntity) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+// MsgLeaveEntityResponse is the response for LeaveEntity
+type MsgLeaveEntityResponse struct {
+}
+
+func (m *MsgLeaveEntityResponse) Reset()         { *m = MsgLeaveEntityResponse{} }
+func (m *MsgLeaveEntityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveEntityResponse) ProtoMessage()    {}
+func (*MsgLeaveEntityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{54}
+}
+func (m *MsgLeaveEntityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveEntityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveEntityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLeaveEntityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveEntityResponse.Merge(m, src)
+}
+func (m *MsgLeaveEntityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveEntityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveEntityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLeaveEntityResponse proto.InternalMessageInfo
+
+// MsgRemoveEntityMember removes a member from an entity
+type MsgRemoveEntityMember struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId      string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	MemberAddress string `protobuf:"bytes,3,opt,name=member_address,json=memberAddress,proto3" json:"member_address,omitempty"`
+}
+
+func (m *MsgRemoveEntityMember) Reset()         { *m = MsgRemoveEntityMember{} }
+func (m *MsgRemoveEntityMember) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveEntityMember) ProtoMessage()    {}
+func (*MsgRemoveEntityMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{55}
+}
+func (m *MsgRemoveEntityMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveEntityMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveEntityMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveEntityMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveEntityMember.Merge(m, src)
+}
+func (m *MsgRemoveEntityMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveEntityMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveEntityMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveEntityMember proto.InternalMessageInfo
+
+func (m *MsgRemoveEntityMember) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRemoveEntityMember) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *MsgRemoveEntityMember) GetMemberAddress() string {
+	if m != nil {
+		return m.MemberAddress
+	}
+	return ""
+}
+
+// MsgRemoveEntityMemberResponse is the response for RemoveEntityMember
+type MsgRemoveEntityMemberResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (m *MsgRemoveEntityMemberResponse) Reset()         { *m = MsgRemoveEntityMemberResponse{} }
+func (m *MsgRemoveEntityMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveEntityMemberResponse) ProtoMessage()    {}
+func (*MsgRemoveEntityMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{56}
+}
+func (m *MsgRemoveEntityMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveEntityMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveEntityMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveEntityMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveEntityMemberResponse.Merge(m, src)
+}
+func (m *MsgRemoveEntityMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveEntityMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveEntityMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveEntityMemberResponse proto.InternalMessageInfo
+
+func (m *MsgRemoveEntityMemberResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+// MsgUpdateMemberRole changes the role of an existing entity member
+type MsgUpdateMemberRole struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId      string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	MemberAddress string `protobuf:"bytes,3,opt,name=member_address,json=memberAddress,proto3" json:"member_address,omitempty"`
+	Role          string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (m *MsgUpdateMemberRole) Reset()         { *m = MsgUpdateMemberRole{} }
+func (m *MsgUpdateMemberRole) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMemberRole) ProtoMessage()    {}
+func (*MsgUpdateMemberRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{57}
+}
+func (m *MsgUpdateMemberRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMemberRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMemberRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMemberRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMemberRole.Merge(m, src)
+}
+func (m *MsgUpdateMemberRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMemberRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMemberRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMemberRole proto.InternalMessageInfo
+
+func (m *MsgUpdateMemberRole) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateMemberRole) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *MsgUpdateMemberRole) GetMemberAddress() string {
+	if m != nil {
+		return m.MemberAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateMemberRole) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+// MsgUpdateMemberRoleResponse is the response for UpdateMemberRole
+type MsgUpdateMemberRoleResponse struct {
+}
+
+func (m *MsgUpdateMemberRoleResponse) Reset()         { *m = MsgUpdateMemberRoleResponse{} }
+func (m *MsgUpdateMemberRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMemberRoleResponse) ProtoMessage()    {}
+func (*MsgUpdateMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{58}
+}
+func (m *MsgUpdateMemberRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMemberRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMemberRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMemberRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMemberRoleResponse.Merge(m, src)
+}
+func (m *MsgUpdateMemberRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMemberRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMemberRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMemberRoleResponse proto.InternalMessageInfo
+
+// MsgFundEntity transfers coins from the creator into an entity treasury
+type MsgFundEntity struct {
+	Creator  string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId string                                   `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundEntity) Reset()         { *m = MsgFundEntity{} }
+func (m *MsgFundEntity) String() string { return proto.CompactTextString(m) }
+func (*MsgFundEntity) ProtoMessage()    {}
+func (*MsgFundEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{59}
+}
+func (m *MsgFundEntity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundEntity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundEntity.Merge(m, src)
+}
+func (m *MsgFundEntity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundEntity proto.InternalMessageInfo
+
+func (m *MsgFundEntity) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgFundEntity) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *MsgFundEntity) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgFundEntityResponse is the response for FundEntity
+type MsgFundEntityResponse struct {
+	TreasuryAddress string `protobuf:"bytes,1,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+}
+
+func (m *MsgFundEntityResponse) Reset()         { *m = MsgFundEntityResponse{} }
+func (m *MsgFundEntityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundEntityResponse) ProtoMessage()    {}
+func (*MsgFundEntityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{60}
+}
+func (m *MsgFundEntityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundEntityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundEntityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundEntityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundEntityResponse.Merge(m, src)
+}
+func (m *MsgFundEntityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundEntityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundEntityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundEntityResponse proto.InternalMessageInfo
+
+func (m *MsgFundEntityResponse) GetTreasuryAddress() string {
+	if m != nil {
+		return m.TreasuryAddress
+	}
+	return ""
+}
+
+// MsgSetEntityFeeCap sets the fees an entity treasury sponsors for each
+// member per month
+type MsgSetEntityFeeCap struct {
+	Creator          string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId         string                                   `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	MemberMonthlyCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=member_monthly_cap,json=memberMonthlyCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"member_monthly_cap"`
+}
+
+func (m *MsgSetEntityFeeCap) Reset()         { *m = MsgSetEntityFeeCap{} }
+func (m *MsgSetEntityFeeCap) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEntityFeeCap) ProtoMessage()    {}
+func (*MsgSetEntityFeeCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{61}
+}
+func (m *MsgSetEntityFeeCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEntityFeeCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEntityFeeCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEntityFeeCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEntityFeeCap.Merge(m, src)
+}
+func (m *MsgSetEntityFeeCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEntityFeeCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEntityFeeCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEntityFeeCap proto.InternalMessageInfo
+
+func (m *MsgSetEntityFeeCap) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetEntityFeeCap) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *MsgSetEntityFeeCap) GetMemberMonthlyCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MemberMonthlyCap
+	}
+	return nil
+}
+
+// MsgSetEntityFeeCapResponse is the response for SetEntityFeeCap
+type MsgSetEntityFeeCapResponse struct {
+}
+
+func (m *MsgSetEntityFeeCapResponse) Reset()         { *m = MsgSetEntityFeeCapResponse{} }
+func (m *MsgSetEntityFeeCapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEntityFeeCapResponse) ProtoMessage()    {}
+func (*MsgSetEntityFeeCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{62}
+}
+func (m *MsgSetEntityFeeCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEntityFeeCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEntityFeeCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEntityFeeCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEntityFeeCapResponse.Merge(m, src)
+}
+func (m *MsgSetEntityFeeCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEntityFeeCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEntityFeeCapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEntityFeeCapResponse proto.InternalMessageInfo
+
+// MsgUpdateEntity replaces an entity's name, type and metadata
+type MsgUpdateEntity struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId   string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	EntityType string `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	Metadata   string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *MsgUpdateEntity) Reset()         { *m = MsgUpdateEntity{} }
+func (m *MsgUpdateEntity) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEntity) ProtoMessage()    {}
+func (*MsgUpdateEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{63}
+}
+func (m *MsgUpdateEntity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEntity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEntity.Merge(m, src)
+}
+func (m *MsgUpdateEntity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEntity proto.InternalMessageInfo
+
+func (m *MsgUpdateEntity) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateEntity) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *MsgUpdateEntity) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgUpdateEntity) GetEntityType() string {
+	if m != nil {
+		return m.EntityType
+	}
+	return ""
+}
+
+func (m *MsgUpdateEntity) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+// MsgUpdateEntityResponse is the response for UpdateEntity
+type MsgUpdateEntityResponse struct {
+}
+
+func (m *MsgUpdateEntityResponse) Reset()         { *m = MsgUpdateEntityResponse{} }
+func (m *MsgUpdateEntityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEntityResponse) ProtoMessage()    {}
+func (*MsgUpdateEntityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{64}
+}
+func (m *MsgUpdateEntityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEntityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEntityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEntityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEntityResponse.Merge(m, src)
+}
+func (m *MsgUpdateEntityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEntityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEntityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEntityResponse proto.InternalMessageInfo
+
+// MsgTransferEntityOwnership offers ownership of an entity to another
+// address, which takes effect when it accepts. Offering ownership to the
+// current owner cancels a pending offer.
+type MsgTransferEntityOwnership struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *MsgTransferEntityOwnership) Reset()         { *m = MsgTransferEntityOwnership{} }
+func (m *MsgTransferEntityOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferEntityOwnership) ProtoMessage()    {}
+func (*MsgTransferEntityOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{65}
+}
+func (m *MsgTransferEntityOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferEntityOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferEntityOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferEntityOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferEntityOwnership.Merge(m, src)
+}
+func (m *MsgTransferEntityOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferEntityOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferEntityOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferEntityOwnership proto.InternalMessageInfo
+
+func (m *MsgTransferEntityOwnership) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTransferEntityOwnership) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *MsgTransferEntityOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+// MsgTransferEntityOwnershipResponse is the response for TransferEntityOwnership
+type MsgTransferEntityOwnershipResponse struct {
+}
+
+func (m *MsgTransferEntityOwnershipResponse) Reset()         { *m = MsgTransferEntityOwnershipResponse{} }
+func (m *MsgTransferEntityOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferEntityOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferEntityOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{66}
+}
+func (m *MsgTransferEntityOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferEntityOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferEntityOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferEntityOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferEntityOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferEntityOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferEntityOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferEntityOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferEntityOwnershipResponse proto.InternalMessageInfo
+
+// MsgAcceptEntityOwnership accepts a pending offer of entity ownership
+type MsgAcceptEntityOwnership struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+}
+
+func (m *MsgAcceptEntityOwnership) Reset()         { *m = MsgAcceptEntityOwnership{} }
+func (m *MsgAcceptEntityOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptEntityOwnership) ProtoMessage()    {}
+func (*MsgAcceptEntityOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{67}
+}
+func (m *MsgAcceptEntityOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptEntityOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptEntityOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptEntityOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptEntityOwnership.Merge(m, src)
+}
+func (m *MsgAcceptEntityOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptEntityOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptEntityOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptEntityOwnership proto.InternalMessageInfo
+
+func (m *MsgAcceptEntityOwnership) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAcceptEntityOwnership) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+// MsgAcceptEntityOwnershipResponse is the response for AcceptEntityOwnership
+type MsgAcceptEntityOwnershipResponse struct {
+}
+
+func (m *MsgAcceptEntityOwnershipResponse) Reset()         { *m = MsgAcceptEntityOwnershipResponse{} }
+func (m *MsgAcceptEntityOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptEntityOwnershipResponse) ProtoMessage()    {}
+func (*MsgAcceptEntityOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{68}
+}
+func (m *MsgAcceptEntityOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptEntityOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptEntityOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptEntityOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptEntityOwnershipResponse.Merge(m, src)
+}
+func (m *MsgAcceptEntityOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptEntityOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptEntityOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptEntityOwnershipResponse proto.InternalMessageInfo
+
+// MsgDeactivateEntity suspends an entity: it stops sponsoring fees and
+// cannot take on members until reactivated
+type MsgDeactivateEntity struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgDeactivateEntity) Reset()         { *m = MsgDeactivateEntity{} }
+func (m *MsgDeactivateEntity) String() string { return proto.CompactTextString(m) }
+func (*MsgDeactivateEntity) ProtoMessage()    {}
+func (*MsgDeactivateEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{69}
+}
+func (m *MsgDeactivateEntity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeactivateEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeactivateEntity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeactivateEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeactivateEntity.Merge(m, src)
+}
+func (m *MsgDeactivateEntity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeactivateEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeactivateEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeactivateEntity proto.InternalMessageInfo
+
+func (m *MsgDeactivateEntity) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgDeactivateEntity) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *MsgDeactivateEntity) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgDeactivateEntityResponse is the response for DeactivateEntity
+type MsgDeactivateEntityResponse struct {
+}
+
+func (m *MsgDeactivateEntityResponse) Reset()         { *m = MsgDeactivateEntityResponse{} }
+func (m *MsgDeactivateEntityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeactivateEntityResponse) ProtoMessage()    {}
+func (*MsgDeactivateEntityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{70}
+}
+func (m *MsgDeactivateEntityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeactivateEntityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeactivateEntityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeactivateEntityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeactivateEntityResponse.Merge(m, src)
+}
+func (m *MsgDeactivateEntityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeactivateEntityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeactivateEntityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeactivateEntityResponse proto.InternalMessageInfo
+
+// MsgReactivateEntity restores a deactivated entity
+type MsgReactivateEntity struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EntityId string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+}
+
+func (m *MsgReactivateEntity) Reset()         { *m = MsgReactivateEntity{} }
+func (m *MsgReactivateEntity) String() string { return proto.CompactTextString(m) }
+func (*MsgReactivateEntity) ProtoMessage()    {}
+func (*MsgReactivateEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{71}
+}
+func (m *MsgReactivateEntity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReactivateEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReactivateEntity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReactivateEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReactivateEntity.Merge(m, src)
+}
+func (m *MsgReactivateEntity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReactivateEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReactivateEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReactivateEntity proto.InternalMessageInfo
+
+func (m *MsgReactivateEntity) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgReactivateEntity) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+// MsgReactivateEntityResponse is the response for ReactivateEntity
+type MsgReactivateEntityResponse struct {
+}
+
+func (m *MsgReactivateEntityResponse) Reset()         { *m = MsgReactivateEntityResponse{} }
+func (m *MsgReactivateEntityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReactivateEntityResponse) ProtoMessage()    {}
+func (*MsgReactivateEntityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{72}
+}
+func (m *MsgReactivateEntityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReactivateEntityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReactivateEntityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReactivateEntityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReactivateEntityResponse.Merge(m, src)
+}
+func (m *MsgReactivateEntityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReactivateEntityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReactivateEntityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReactivateEntityResponse proto.InternalMessageInfo
+
+// MsgCreateSpecVersion creates a new version of a spec on the blockchain
+type MsgCreateSpecVersion struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ProjectId       string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Version         string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	SpecHash        string `protobuf:"bytes,4,opt,name=spec_hash,json=specHash,proto3" json:"spec_hash,omitempty"`
+	SpecIpfs        string `protobuf:"bytes,5,opt,name=spec_ipfs,json=specIpfs,proto3" json:"spec_ipfs,omitempty"`
+	Changelog       string `protobuf:"bytes,6,opt,name=changelog,proto3" json:"changelog,omitempty"`
+	ParentVersionId string `protobuf:"bytes,7,opt,name=parent_version_id,json=parentVersionId,proto3" json:"parent_version_id,omitempty"`
+	EntityId        string `protobuf:"bytes,8,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+}
+
+func (m *MsgCreateSpecVersion) Reset()         { *m = MsgCreateSpecVersion{} }
+func (m *MsgCreateSpecVersion) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSpecVersion) ProtoMessage()    {}
+func (*MsgCreateSpecVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{73}
+}
+func (m *MsgCreateSpecVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSpecVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSpecVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSpecVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSpecVersion.Merge(m, src)
+}
+func (m *MsgCreateSpecVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSpecVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSpecVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSpecVersion proto.InternalMessageInfo
+
+func (m *MsgCreateSpecVersion) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreateSpecVersion) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *MsgCreateSpecVersion) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *MsgCreateSpecVersion) GetSpecHash() string {
+	if m != nil {
+		return m.SpecHash
+	}
+	return ""
+}
+
+func (m *MsgCreateSpecVersion) GetSpecIpfs() string {
+	if m != nil {
+		return m.SpecIpfs
+	}
+	return ""
+}
+
+func (m *MsgCreateSpecVersion) GetChangelog() string {
+	if m != nil {
+		return m.Changelog
+	}
+	return ""
+}
+
+func (m *MsgCreateSpecVersion) GetParentVersionId() string {
+	if m != nil {
+		return m.ParentVersionId
+	}
+	return ""
+}
+
+func (m *MsgCreateSpecVersion) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+// MsgCreateSpecVersionResponse is the response for CreateSpecVersion
+type MsgCreateSpecVersionResponse struct {
+	VersionId string `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+}
+
+func (m *MsgCreateSpecVersionResponse) Reset()         { *m = MsgCreateSpecVersionResponse{} }
+func (m *MsgCreateSpecVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSpecVersionResponse) ProtoMessage()    {}
+func (*MsgCreateSpecVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b0ca2d425e53fb, []int{74}
+}
+func (m *MsgCreateSpecVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSpecVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSpecVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSpecVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSpecVersionResponse.Merge(m, src)
+}
+func (m *MsgCreateSpecVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSpecVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSpecVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSpecVersionResponse proto.InternalMessageInfo
+
+func (m *MsgCreateSpecVersionResponse) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "stampledgerchain.stampledgerchain.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateStamp)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateStamp")
+	proto.RegisterType((*MsgCreateStampResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateStampResponse")
+	proto.RegisterType((*MsgRevokeStamp)(nil), "stampledgerchain.stampledgerchain.v1.MsgRevokeStamp")
+	proto.RegisterType((*MsgRevokeStampResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgRevokeStampResponse")
+	proto.RegisterType((*StampBatchEntry)(nil), "stampledgerchain.stampledgerchain.v1.StampBatchEntry")
+	proto.RegisterType((*MsgCreateStampBatch)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateStampBatch")
+	proto.RegisterType((*MsgCreateStampBatchResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateStampBatchResponse")
+	proto.RegisterType((*MsgRevokeStampBatch)(nil), "stampledgerchain.stampledgerchain.v1.MsgRevokeStampBatch")
+	proto.RegisterType((*MsgRevokeStampBatchResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgRevokeStampBatchResponse")
+	proto.RegisterType((*MsgCreateMerkleStamp)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateMerkleStamp")
+	proto.RegisterType((*MsgCreateMerkleStampResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateMerkleStampResponse")
+	proto.RegisterType((*MsgProposeCoStamp)(nil), "stampledgerchain.stampledgerchain.v1.MsgProposeCoStamp")
+	proto.RegisterType((*MsgProposeCoStampResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgProposeCoStampResponse")
+	proto.RegisterType((*MsgAddCoSignature)(nil), "stampledgerchain.stampledgerchain.v1.MsgAddCoSignature")
+	proto.RegisterType((*MsgAddCoSignatureResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgAddCoSignatureResponse")
+	proto.RegisterType((*MsgSupersedeStamp)(nil), "stampledgerchain.stampledgerchain.v1.MsgSupersedeStamp")
+	proto.RegisterType((*MsgSupersedeStampResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgSupersedeStampResponse")
+	proto.RegisterType((*MsgRegisterPE)(nil), "stampledgerchain.stampledgerchain.v1.MsgRegisterPE")
+	proto.RegisterType((*MsgRegisterPEResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgRegisterPEResponse")
+	proto.RegisterType((*MsgRotatePEKey)(nil), "stampledgerchain.stampledgerchain.v1.MsgRotatePEKey")
+	proto.RegisterType((*MsgRotatePEKeyResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgRotatePEKeyResponse")
+	proto.RegisterType((*MsgReportKeyCompromise)(nil), "stampledgerchain.stampledgerchain.v1.MsgReportKeyCompromise")
+	proto.RegisterType((*MsgReportKeyCompromiseResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgReportKeyCompromiseResponse")
+	proto.RegisterType((*MsgGrantStampingDelegation)(nil), "stampledgerchain.stampledgerchain.v1.MsgGrantStampingDelegation")
+	proto.RegisterType((*MsgGrantStampingDelegationResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgGrantStampingDelegationResponse")
+	proto.RegisterType((*MsgRevokeStampingDelegation)(nil), "stampledgerchain.stampledgerchain.v1.MsgRevokeStampingDelegation")
+	proto.RegisterType((*MsgRevokeStampingDelegationResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgRevokeStampingDelegationResponse")
+	proto.RegisterType((*MsgAttestLicense)(nil), "stampledgerchain.stampledgerchain.v1.MsgAttestLicense")
+	proto.RegisterType((*MsgAttestLicenseResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgAttestLicenseResponse")
+	proto.RegisterType((*MsgSuspendLicense)(nil), "stampledgerchain.stampledgerchain.v1.MsgSuspendLicense")
+	proto.RegisterType((*MsgSuspendLicenseResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgSuspendLicenseResponse")
+	proto.RegisterType((*MsgReinstateLicense)(nil), "stampledgerchain.stampledgerchain.v1.MsgReinstateLicense")
+	proto.RegisterType((*MsgReinstateLicenseResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgReinstateLicenseResponse")
+	proto.RegisterType((*MsgStoreDocument)(nil), "stampledgerchain.stampledgerchain.v1.MsgStoreDocument")
+	proto.RegisterType((*MsgStoreDocumentResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgStoreDocumentResponse")
+	proto.RegisterType((*MsgRenewPin)(nil), "stampledgerchain.stampledgerchain.v1.MsgRenewPin")
+	proto.RegisterType((*MsgRenewPinResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgRenewPinResponse")
+	proto.RegisterType((*MsgUnpin)(nil), "stampledgerchain.stampledgerchain.v1.MsgUnpin")
+	proto.RegisterType((*MsgUnpinResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgUnpinResponse")
+	proto.RegisterType((*MsgAttestPinned)(nil), "stampledgerchain.stampledgerchain.v1.MsgAttestPinned")
+	proto.RegisterType((*MsgAttestPinnedResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgAttestPinnedResponse")
+	proto.RegisterType((*MsgCreateEntity)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateEntity")
+	proto.RegisterType((*MsgCreateEntityResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateEntityResponse")
+	proto.RegisterType((*MsgAddEntityMember)(nil), "stampledgerchain.stampledgerchain.v1.MsgAddEntityMember")
+	proto.RegisterType((*MsgAddEntityMemberResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgAddEntityMemberResponse")
+	proto.RegisterType((*MsgInviteMember)(nil), "stampledgerchain.stampledgerchain.v1.MsgInviteMember")
+	proto.RegisterType((*MsgInviteMemberResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgInviteMemberResponse")
+	proto.RegisterType((*MsgAcceptInvite)(nil), "stampledgerchain.stampledgerchain.v1.MsgAcceptInvite")
+	proto.RegisterType((*MsgAcceptInviteResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgAcceptInviteResponse")
+	proto.RegisterType((*MsgDeclineInvite)(nil), "stampledgerchain.stampledgerchain.v1.MsgDeclineInvite")
+	proto.RegisterType((*MsgDeclineInviteResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgDeclineInviteResponse")
+	proto.RegisterType((*MsgLeaveEntity)(nil), "stampledgerchain.stampledgerchain.v1.MsgLeaveEntity")
+	proto.RegisterType((*MsgLeaveEntityResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgLeaveEntityResponse")
+	proto.RegisterType((*MsgRemoveEntityMember)(nil), "stampledgerchain.stampledgerchain.v1.MsgRemoveEntityMember")
+	proto.RegisterType((*MsgRemoveEntityMemberResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgRemoveEntityMemberResponse")
+	proto.RegisterType((*MsgUpdateMemberRole)(nil), "stampledgerchain.stampledgerchain.v1.MsgUpdateMemberRole")
+	proto.RegisterType((*MsgUpdateMemberRoleResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgUpdateMemberRoleResponse")
+	proto.RegisterType((*MsgFundEntity)(nil), "stampledgerchain.stampledgerchain.v1.MsgFundEntity")
+	proto.RegisterType((*MsgFundEntityResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgFundEntityResponse")
+	proto.RegisterType((*MsgSetEntityFeeCap)(nil), "stampledgerchain.stampledgerchain.v1.MsgSetEntityFeeCap")
+	proto.RegisterType((*MsgSetEntityFeeCapResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgSetEntityFeeCapResponse")
+	proto.RegisterType((*MsgUpdateEntity)(nil), "stampledgerchain.stampledgerchain.v1.MsgUpdateEntity")
+	proto.RegisterType((*MsgUpdateEntityResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgUpdateEntityResponse")
+	proto.RegisterType((*MsgTransferEntityOwnership)(nil), "stampledgerchain.stampledgerchain.v1.MsgTransferEntityOwnership")
+	proto.RegisterType((*MsgTransferEntityOwnershipResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgTransferEntityOwnershipResponse")
+	proto.RegisterType((*MsgAcceptEntityOwnership)(nil), "stampledgerchain.stampledgerchain.v1.MsgAcceptEntityOwnership")
+	proto.RegisterType((*MsgAcceptEntityOwnershipResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgAcceptEntityOwnershipResponse")
+	proto.RegisterType((*MsgDeactivateEntity)(nil), "stampledgerchain.stampledgerchain.v1.MsgDeactivateEntity")
+	proto.RegisterType((*MsgDeactivateEntityResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgDeactivateEntityResponse")
+	proto.RegisterType((*MsgReactivateEntity)(nil), "stampledgerchain.stampledgerchain.v1.MsgReactivateEntity")
+	proto.RegisterType((*MsgReactivateEntityResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgReactivateEntityResponse")
+	proto.RegisterType((*MsgCreateSpecVersion)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateSpecVersion")
+	proto.RegisterType((*MsgCreateSpecVersionResponse)(nil), "stampledgerchain.stampledgerchain.v1.MsgCreateSpecVersionResponse")
+}
+
+func init() {
+	proto.RegisterFile("stampledgerchain/stampledgerchain/v1/tx.proto", fileDescriptor_e6b0ca2d425e53fb)
+}
+
+var fileDescriptor_e6b0ca2d425e53fb = []byte{
+	// 3591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5c, 0xdd, 0x6f, 0x1b, 0xc7,
+	0xb5, 0xf7, 0x92, 0x94, 0x44, 0x1e, 0x8a, 0x92, 0xb5, 0x76, 0x6c, 0x9a, 0xb6, 0x25, 0x67, 0xed,
+	0xdc, 0xc8, 0xba, 0xb6, 0x14, 0xcb, 0xb1, 0x12, 0xf1, 0xde, 0xe4, 0xc6, 0x92, 0xad, 0x1b, 0x21,
+	0x51, 0x62, 0x50, 0x71, 0x0a, 0xf4, 0x85, 0x5d, 0xed, 0x8e, 0xa8, 0x8d, 0xc9, 0xdd, 0xc5, 0xee,
+	0x4a, 0x36, 0xf3, 0x50, 0xf4, 0x0b, 0x68, 0x1a, 0xa0, 0x68, 0xd0, 0x14, 0x69, 0x9b, 0x97, 0x02,
+	0x45, 0x0b, 0xa4, 0x79, 0x89, 0x51, 0xf4, 0xbd, 0xc8, 0x43, 0x81, 0x00, 0x7d, 0x09, 0x8a, 0xa6,
+	0x68, 0x8b, 0x22, 0x69, 0x6d, 0x14, 0x06, 0xfa, 0x57, 0x14, 0xf3, 0xb1, 0xcb, 0xd9, 0xd9, 0xa5,
+	0x34, 0x4b, 0x59, 0x4d, 0xd3, 0x97, 0x84, 0x7b, 0x66, 0xce, 0xcc, 0x39, 0x67, 0xce, 0xf9, 0xcd,
+	0x99, 0x33, 0x63, 0xc1, 0x45, 0x3f, 0xd0, 0x3b, 0x6e, 0x1b, 0x99, 0x2d, 0xe4, 0x19, 0x5b, 0xba,
+	0x65, 0xcf, 0x25, 0x08, 0x3b, 0x97, 0xe6, 0x82, 0x3b, 0xb3, 0xae, 0xe7, 0x04, 0x8e, 0x7a, 0x4e,
+	0x6c, 0x9d, 0x4d, 0x10, 0x76, 0x2e, 0xd5, 0x26, 0xf4, 0x8e, 0x65, 0x3b, 0x73, 0xe4, 0xbf, 0x94,
+	0xb1, 0x36, 0x69, 0x38, 0x7e, 0xc7, 0xf1, 0xe7, 0x36, 0x74, 0x1f, 0xcd, 0xed, 0x5c, 0xda, 0x40,
+	0x81, 0x7e, 0x69, 0xce, 0x70, 0x2c, 0x9b, 0xb5, 0x1f, 0x67, 0xed, 0x1d, 0xbf, 0x85, 0x27, 0xec,
+	0xf8, 0x2d, 0xd6, 0x70, 0x82, 0x36, 0x34, 0xc9, 0xd7, 0x1c, 0xfd, 0x60, 0x4d, 0x47, 0x5b, 0x4e,
+	0xcb, 0xa1, 0x74, 0xfc, 0x8b, 0x51, 0x2f, 0x49, 0x69, 0xe4, 0xea, 0x9e, 0xde, 0x09, 0x07, 0x7a,
+	0x42, 0x8a, 0x85, 0xd0, 0x28, 0x87, 0x76, 0x4f, 0x81, 0xf1, 0x35, 0xbf, 0x75, 0xd3, 0x35, 0xf5,
+	0x00, 0xdd, 0x20, 0x63, 0xa9, 0x0b, 0x50, 0xd2, 0xb7, 0x83, 0x2d, 0xc7, 0xb3, 0x82, 0x6e, 0x55,
+	0x39, 0xa3, 0x4c, 0x97, 0x96, 0xaa, 0xbf, 0xfb, 0xd5, 0xc5, 0xa3, 0x4c, 0xe6, 0xab, 0xa6, 0xe9,
+	0x21, 0xdf, 0x5f, 0x0f, 0x3c, 0xcb, 0x6e, 0x35, 0x7a, 0x5d, 0xd5, 0x97, 0x61, 0x98, 0x4a, 0x53,
+	0xcd, 0x9d, 0x51, 0xa6, 0xcb, 0xf3, 0x17, 0x66, 0x65, 0x8c, 0x3c, 0x4b, 0x67, 0x5d, 0x2a, 0x7d,
+	0xf4, 0xe9, 0xd4, 0xa1, 0xf7, 0x1e, 0xdc, 0x9d, 0x51, 0x1a, 0x6c, 0x98, 0xfa, 0xca, 0x37, 0x1e,
+	0xdc, 0x9d, 0xe9, 0x4d, 0xf0, 0xe6, 0x83, 0xbb, 0x33, 0x97, 0x13, 0x0a, 0xdd, 0x49, 0xea, 0x28,
+	0x28, 0xa4, 0x9d, 0x80, 0xe3, 0x02, 0xa9, 0x81, 0x7c, 0xd7, 0xb1, 0x7d, 0xa4, 0xfd, 0xa3, 0x00,
+	0x63, 0x6b, 0x7e, 0x6b, 0xd9, 0x43, 0x7a, 0x80, 0xd6, 0xf1, 0x40, 0xea, 0x3c, 0x8c, 0x18, 0xf8,
+	0xd3, 0xf1, 0xf6, 0x54, 0x3e, 0xec, 0xa8, 0x9e, 0x85, 0x8a, 0xe9, 0x18, 0xdb, 0x1d, 0x64, 0x07,
+	0xcd, 0x2d, 0xdd, 0xdf, 0x22, 0x16, 0x28, 0x35, 0x46, 0x43, 0xe2, 0xf3, 0xba, 0xbf, 0xa5, 0x6a,
+	0x50, 0x71, 0x51, 0xd3, 0xdd, 0xde, 0x68, 0x5b, 0x46, 0xf3, 0x16, 0xea, 0x56, 0xf3, 0xa4, 0x53,
+	0xd9, 0x45, 0x37, 0x08, 0xed, 0x05, 0xd4, 0x55, 0x4f, 0x41, 0xc9, 0xb7, 0x5a, 0xb6, 0x1e, 0x6c,
+	0x7b, 0xa8, 0x5a, 0x20, 0xed, 0x3d, 0x82, 0xfa, 0x38, 0x8c, 0xbf, 0xb6, 0xed, 0x59, 0xbe, 0x69,
+	0x19, 0x81, 0xe5, 0xd8, 0x4d, 0xcb, 0xac, 0x0e, 0x91, 0x3e, 0x63, 0x3c, 0x79, 0xd5, 0x54, 0x67,
+	0x60, 0xc2, 0x45, 0xcd, 0xb6, 0x65, 0x20, 0xdb, 0x47, 0x4d, 0x7b, 0xbb, 0xb3, 0x81, 0xbc, 0xea,
+	0x30, 0xe9, 0x3a, 0xee, 0xa2, 0x17, 0x29, 0xfd, 0x25, 0x42, 0x56, 0x8f, 0xc3, 0x88, 0x8b, 0x9a,
+	0xb6, 0xde, 0x41, 0xd5, 0x11, 0xd2, 0x63, 0xd8, 0x45, 0x2f, 0xe9, 0x1d, 0xa4, 0x3e, 0x0a, 0xa3,
+	0xae, 0xe7, 0xbc, 0x86, 0x8c, 0x80, 0xb6, 0x16, 0x99, 0xb8, 0x94, 0x46, 0xba, 0x5c, 0x00, 0x35,
+	0xd2, 0xdb, 0x72, 0x37, 0x7d, 0xaa, 0x7c, 0x89, 0x74, 0x3c, 0x1c, 0xb6, 0xac, 0xba, 0x9b, 0x3e,
+	0x31, 0x00, 0x6f, 0x25, 0xdf, 0x7a, 0x1d, 0x55, 0xe1, 0x8c, 0x32, 0x9d, 0xef, 0x59, 0x69, 0xdd,
+	0x7a, 0x1d, 0xa9, 0xff, 0x0d, 0x13, 0x51, 0xa7, 0x4d, 0xab, 0x8d, 0xc8, 0xd4, 0xe5, 0xf8, 0x88,
+	0x2b, 0x8c, 0xae, 0x9e, 0x87, 0xc3, 0x91, 0x75, 0x9a, 0xe8, 0x8e, 0x6b, 0x79, 0xdd, 0xea, 0x28,
+	0x19, 0x74, 0x3c, 0xa2, 0x5f, 0x27, 0x64, 0xf5, 0x28, 0x0c, 0xd9, 0x8e, 0x6d, 0xa0, 0x6a, 0xe5,
+	0x8c, 0x32, 0x5d, 0x68, 0xd0, 0x0f, 0x75, 0x0a, 0xca, 0x3b, 0x7a, 0xdb, 0x32, 0x9b, 0xdb, 0x76,
+	0x60, 0xb5, 0xab, 0x63, 0x84, 0x17, 0x08, 0xe9, 0x26, 0xa6, 0xa8, 0x27, 0xa1, 0x84, 0xec, 0xc0,
+	0x0a, 0xba, 0xd8, 0xd8, 0xe3, 0x44, 0x8c, 0x22, 0x25, 0xac, 0x9a, 0xf5, 0x8b, 0xd8, 0x41, 0x43,
+	0x27, 0xc0, 0xee, 0x79, 0x2a, 0xe1, 0x8b, 0x9c, 0x67, 0x69, 0x2f, 0xc2, 0xb1, 0xb8, 0xaf, 0x85,
+	0x6e, 0xa8, 0x9e, 0x80, 0x22, 0xe1, 0xc4, 0x93, 0x10, 0xa7, 0x6b, 0x8c, 0x90, 0xef, 0x55, 0x13,
+	0x2f, 0x4f, 0x70, 0x87, 0x77, 0xaa, 0xe1, 0xe0, 0x0e, 0xb6, 0xa6, 0xf6, 0x61, 0x8e, 0xb8, 0x6e,
+	0x03, 0xed, 0x38, 0xb7, 0xf6, 0xe1, 0xba, 0xfc, 0xd4, 0xb9, 0xf8, 0xd4, 0xc7, 0x60, 0xd8, 0x43,
+	0xba, 0xef, 0xd8, 0xcc, 0x53, 0xd9, 0x97, 0xfa, 0x25, 0x28, 0xd3, 0x5f, 0x4d, 0xc3, 0x31, 0xa9,
+	0x9b, 0x8e, 0xcd, 0x2f, 0xc8, 0x45, 0x3b, 0x16, 0xd7, 0xd0, 0xb1, 0x9b, 0x36, 0xc8, 0x10, 0x0d,
+	0xa0, 0x43, 0x2d, 0x3b, 0x26, 0xc2, 0x0e, 0x82, 0x76, 0x2c, 0x13, 0xd9, 0x06, 0xa2, 0x1a, 0x53,
+	0xef, 0x1e, 0x0d, 0x89, 0xc4, 0x8b, 0x62, 0x2b, 0x32, 0x9c, 0x7d, 0x45, 0x38, 0x83, 0x69, 0xf3,
+	0x64, 0x45, 0x38, 0x4a, 0xb4, 0x22, 0x55, 0x18, 0xf1, 0xb7, 0x0d, 0x03, 0xf9, 0x3e, 0x31, 0x65,
+	0xb1, 0x11, 0x7e, 0x6a, 0xbf, 0x57, 0x60, 0x9c, 0xf4, 0x5d, 0xd2, 0x03, 0x63, 0xeb, 0xba, 0x1d,
+	0x78, 0xdd, 0x64, 0xfc, 0x2b, 0x29, 0xf1, 0x1f, 0x8b, 0xed, 0x9c, 0x18, 0xdb, 0xe9, 0xa1, 0x94,
+	0x97, 0x0d, 0xa5, 0x82, 0x6c, 0x28, 0x0d, 0xa5, 0x87, 0x92, 0xf6, 0x46, 0x01, 0x8e, 0xc4, 0xbd,
+	0x93, 0xe8, 0x37, 0x90, 0x4f, 0x25, 0x90, 0x2e, 0x97, 0x44, 0xba, 0x14, 0x2c, 0xcb, 0xcb, 0x63,
+	0x59, 0x61, 0x4f, 0x2c, 0x1b, 0xda, 0x15, 0xcb, 0x86, 0x93, 0x58, 0x96, 0x86, 0x25, 0x23, 0x7b,
+	0x60, 0x49, 0x71, 0x17, 0x2c, 0x29, 0x25, 0xb0, 0xe4, 0x26, 0x8c, 0x20, 0x3b, 0xf0, 0x2c, 0xe4,
+	0x57, 0xe1, 0x4c, 0x7e, 0xba, 0x3c, 0x7f, 0x45, 0x2e, 0x66, 0x04, 0x6f, 0x5b, 0x2a, 0xe0, 0xad,
+	0xb2, 0x11, 0x8e, 0x15, 0x0f, 0x88, 0xb2, 0x10, 0x10, 0xf3, 0x62, 0x40, 0x3c, 0xba, 0x1b, 0x44,
+	0x91, 0x49, 0xb4, 0x9b, 0x70, 0x32, 0xc5, 0x13, 0x78, 0xb0, 0xda, 0xc0, 0x04, 0x0e, 0xac, 0xc8,
+	0xf7, 0xaa, 0x89, 0x45, 0x09, 0xc1, 0x04, 0x67, 0x01, 0x79, 0x2c, 0x0a, 0x43, 0x13, 0x5f, 0xfb,
+	0x6d, 0x8e, 0x78, 0x18, 0x17, 0x6d, 0x83, 0x7b, 0x18, 0x2f, 0x43, 0x2e, 0x2e, 0xc3, 0x17, 0x10,
+	0xb5, 0x24, 0x16, 0x49, 0xb4, 0x9a, 0xb6, 0x44, 0x16, 0x49, 0x24, 0x47, 0x8b, 0x74, 0x16, 0x2a,
+	0x1e, 0x69, 0x33, 0x9b, 0x86, 0xb3, 0x6d, 0x07, 0xc4, 0xb4, 0x85, 0xc6, 0x28, 0x23, 0x2e, 0x63,
+	0x9a, 0xf6, 0xd3, 0x02, 0x1c, 0x8d, 0x56, 0x7a, 0x0d, 0x79, 0xb7, 0xda, 0xfb, 0xd8, 0x48, 0xa6,
+	0xa0, 0xdc, 0x21, 0x43, 0x34, 0x3d, 0xc7, 0x09, 0xd8, 0xaa, 0x00, 0x25, 0x35, 0x1c, 0x27, 0x50,
+	0x4f, 0x03, 0xb4, 0x91, 0xbe, 0xc9, 0xe4, 0xc9, 0x13, 0x79, 0x4a, 0x98, 0x42, 0x84, 0x49, 0x82,
+	0x46, 0x61, 0x8f, 0xf4, 0x68, 0x48, 0x22, 0x3d, 0x1a, 0x96, 0x87, 0x94, 0x91, 0x3d, 0x21, 0xa5,
+	0xb8, 0x2b, 0xa4, 0x94, 0x52, 0xd3, 0xa3, 0x8e, 0x6e, 0x5b, 0x9b, 0xc8, 0xe7, 0x31, 0x1d, 0x28,
+	0x02, 0x87, 0x2d, 0x11, 0xa6, 0xa7, 0x01, 0x50, 0x79, 0x0f, 0x00, 0x1a, 0xdd, 0x05, 0x80, 0x2a,
+	0x22, 0x00, 0xd5, 0x2f, 0x8b, 0x7e, 0xa6, 0xf5, 0x01, 0x03, 0xce, 0x17, 0xb4, 0x45, 0x38, 0x95,
+	0xe6, 0x23, 0x12, 0xb9, 0x8b, 0xf6, 0xeb, 0x02, 0x4c, 0xac, 0xf9, 0xad, 0x1b, 0x9e, 0xe3, 0x3a,
+	0x3e, 0x5a, 0x76, 0x0e, 0x38, 0xc1, 0x96, 0xde, 0x52, 0xc4, 0xa5, 0x2b, 0xc8, 0x66, 0xb6, 0x43,
+	0xb2, 0xdb, 0xf1, 0xb0, 0xec, 0x76, 0x3c, 0xd2, 0x27, 0xb3, 0x5d, 0x07, 0x30, 0x9c, 0x26, 0x5e,
+	0x77, 0xe4, 0xf9, 0xd5, 0x22, 0xd9, 0x2e, 0x66, 0xe5, 0xc0, 0x6a, 0xd9, 0x59, 0x27, 0x6c, 0x6c,
+	0x9f, 0x28, 0x19, 0xec, 0xdb, 0xc7, 0xe1, 0x13, 0x6c, 0x79, 0xc8, 0xdf, 0x72, 0xda, 0x26, 0xf1,
+	0xd7, 0x4a, 0xa3, 0x47, 0x48, 0xf5, 0x3f, 0xd8, 0xc3, 0xff, 0xca, 0xbb, 0xf8, 0xdf, 0x68, 0xc2,
+	0xff, 0x9e, 0x10, 0xfd, 0x6f, 0x2a, 0xe1, 0x7f, 0x71, 0x5f, 0xd1, 0x16, 0xe0, 0x44, 0xc2, 0x81,
+	0x64, 0x3c, 0xef, 0x0f, 0x0a, 0xf1, 0xbc, 0xab, 0xa6, 0x49, 0xad, 0x41, 0x01, 0xe2, 0x21, 0xe7,
+	0xc7, 0xfb, 0x3e, 0xd0, 0xc9, 0x18, 0x24, 0xae, 0x82, 0xf6, 0x15, 0x62, 0x90, 0x38, 0x31, 0x32,
+	0xc8, 0xe3, 0xd0, 0x5b, 0x29, 0x0e, 0xf6, 0x2b, 0x8d, 0xb1, 0x88, 0x4c, 0xb1, 0xb6, 0x06, 0x45,
+	0xc3, 0xc1, 0xf3, 0x04, 0x34, 0x13, 0x2d, 0x36, 0xa2, 0x6f, 0xed, 0x9b, 0x43, 0xc4, 0x74, 0xeb,
+	0xdb, 0x2e, 0xf2, 0x7c, 0x64, 0xee, 0x63, 0x47, 0x98, 0x85, 0x23, 0x7e, 0x38, 0x8a, 0xd9, 0x14,
+	0xac, 0x38, 0xd1, 0x6b, 0x5a, 0x67, 0xf6, 0x4c, 0x04, 0x79, 0x5e, 0xe6, 0x14, 0xfd, 0x1f, 0xb1,
+	0x4d, 0xa4, 0x60, 0x0d, 0xc8, 0x62, 0x4d, 0x59, 0x16, 0x6b, 0x46, 0x33, 0x9c, 0xa2, 0x2b, 0x7b,
+	0x04, 0xfe, 0xd8, 0x2e, 0x81, 0x3f, 0x3e, 0x48, 0xe0, 0xc7, 0xfd, 0x8d, 0x05, 0x7e, 0x9c, 0x28,
+	0x13, 0xf8, 0xef, 0xe4, 0xa0, 0x42, 0xf2, 0xa2, 0x96, 0xe5, 0x07, 0xc8, 0xbb, 0x71, 0x7d, 0x20,
+	0xcf, 0x3d, 0x0d, 0x90, 0x38, 0xbd, 0x94, 0xdc, 0xc8, 0xbf, 0x54, 0x28, 0x10, 0x83, 0x52, 0xff,
+	0x24, 0xbf, 0xd5, 0xc7, 0x60, 0x2c, 0xf5, 0x8c, 0x52, 0x69, 0xc7, 0xfc, 0xe4, 0x1c, 0x54, 0x78,
+	0x2f, 0xf3, 0xab, 0x43, 0x24, 0x4b, 0x8e, 0x13, 0xf1, 0x1a, 0xbb, 0x8e, 0xdb, 0xec, 0x39, 0x31,
+	0x75, 0xd0, 0x51, 0xd7, 0x71, 0xa3, 0xa8, 0xaf, 0x5f, 0x10, 0x8d, 0x7a, 0x32, 0x25, 0x6b, 0x0c,
+	0xcd, 0xa0, 0x1d, 0x87, 0x47, 0x62, 0x76, 0x89, 0x4a, 0x60, 0x3f, 0x64, 0x75, 0x04, 0x27, 0xd0,
+	0x03, 0x74, 0xe3, 0x3a, 0xd6, 0x6f, 0x10, 0x93, 0x9d, 0x83, 0x31, 0xa7, 0x6d, 0x26, 0x0f, 0x7d,
+	0xa3, 0x4e, 0xdb, 0xec, 0x45, 0xe6, 0x39, 0x18, 0xb3, 0xd1, 0xed, 0x24, 0x66, 0x8e, 0xda, 0xe8,
+	0x76, 0xaf, 0xd7, 0x0c, 0x4c, 0xe0, 0xb1, 0x6e, 0xa1, 0x6e, 0x53, 0x04, 0xcf, 0x71, 0xa7, 0x6d,
+	0xbe, 0x80, 0xba, 0x3d, 0x4c, 0x9f, 0x81, 0x09, 0x3c, 0x62, 0xbc, 0x2f, 0x8d, 0xf9, 0x71, 0x1b,
+	0xdd, 0xe6, 0xfb, 0x4a, 0x55, 0x07, 0x7a, 0x66, 0xd0, 0xaa, 0xb4, 0x3a, 0xd0, 0xa3, 0x44, 0x36,
+	0xfb, 0x8b, 0xc2, 0x0a, 0x07, 0xae, 0xe3, 0x05, 0x2f, 0xa0, 0xee, 0xb2, 0xd3, 0x71, 0x3d, 0xa7,
+	0x63, 0xf9, 0xe8, 0x20, 0xdc, 0xed, 0x69, 0xa8, 0x1a, 0xd1, 0x04, 0x66, 0xd3, 0xb7, 0xc8, 0x49,
+	0x03, 0x59, 0xad, 0x2d, 0x9a, 0x46, 0xe7, 0x1b, 0xc7, 0xb8, 0xf6, 0x75, 0xdc, 0xfc, 0x3c, 0x69,
+	0xad, 0x5f, 0x11, 0x15, 0x3e, 0x97, 0xe2, 0x22, 0x09, 0x1d, 0x34, 0x1d, 0x26, 0xd3, 0xb5, 0xcb,
+	0x74, 0xbc, 0xd8, 0x75, 0x97, 0xf9, 0x20, 0x07, 0xb5, 0x35, 0xbf, 0xf5, 0xff, 0x9e, 0x6e, 0x07,
+	0x24, 0xb8, 0x2d, 0xbb, 0x75, 0x0d, 0xb5, 0x51, 0x8b, 0x9c, 0xb2, 0x06, 0xb2, 0xe2, 0x3c, 0x8c,
+	0xb4, 0xf0, 0x70, 0x88, 0x55, 0x57, 0x76, 0xe3, 0x61, 0x1d, 0x31, 0xf4, 0x09, 0x7b, 0x81, 0x5f,
+	0xcd, 0x93, 0x88, 0x1c, 0x8f, 0x6f, 0x06, 0x34, 0x26, 0x39, 0x20, 0xf7, 0xab, 0x05, 0xd2, 0x6f,
+	0x94, 0x43, 0x72, 0x5f, 0x9d, 0x04, 0x20, 0x00, 0x4a, 0xb4, 0x20, 0x6e, 0x98, 0x6f, 0x70, 0x94,
+	0xfa, 0xa2, 0xb8, 0x20, 0xd3, 0x89, 0x05, 0xe9, 0x63, 0x12, 0xed, 0x1c, 0x68, 0xfd, 0x0d, 0x16,
+	0x79, 0xe6, 0x87, 0x8a, 0x78, 0x2e, 0xfc, 0x5c, 0x0c, 0x5b, 0xaf, 0x8b, 0x8a, 0x9e, 0xdf, 0xed,
+	0x48, 0x1b, 0xd7, 0xf4, 0x31, 0x38, 0xbb, 0x8b, 0x0a, 0x91, 0xaa, 0x3f, 0xca, 0xc1, 0x61, 0x9c,
+	0x0b, 0x05, 0x01, 0xf2, 0x03, 0xb6, 0x1b, 0x0f, 0xa4, 0x5f, 0x4a, 0x42, 0x90, 0x4b, 0x4d, 0x08,
+	0x92, 0x18, 0x9f, 0x4f, 0xc3, 0xf8, 0x5e, 0x05, 0xa2, 0x10, 0xab, 0x40, 0x3c, 0x05, 0xe0, 0xa2,
+	0xa6, 0x6e, 0xd0, 0x88, 0x19, 0xda, 0xeb, 0x66, 0xc5, 0x45, 0x57, 0x69, 0xd7, 0xfa, 0x9c, 0x68,
+	0xcc, 0xc9, 0x64, 0x9a, 0xc8, 0x5b, 0x41, 0xab, 0x41, 0x55, 0xb4, 0x4c, 0x64, 0xb6, 0xbf, 0x29,
+	0x2c, 0xbf, 0xf3, 0x5d, 0x64, 0x9b, 0x5f, 0x00, 0xbb, 0xc9, 0x65, 0x0f, 0xbc, 0x36, 0xda, 0x49,
+	0x96, 0x3d, 0xf0, 0xc4, 0xc8, 0x00, 0x7f, 0x57, 0x58, 0x1d, 0xca, 0xb2, 0x7d, 0x0c, 0xed, 0x5f,
+	0x04, 0x13, 0x48, 0x55, 0x88, 0xe2, 0xfa, 0x68, 0xa7, 0x19, 0x12, 0xc4, 0xc9, 0x91, 0x19, 0x7e,
+	0x91, 0x27, 0xe1, 0xb3, 0x1e, 0x38, 0x1e, 0xba, 0xc6, 0x52, 0xc2, 0x87, 0x7d, 0x42, 0x3a, 0x09,
+	0x25, 0xb1, 0x96, 0x5d, 0xb4, 0xc2, 0x44, 0xb6, 0x06, 0xc5, 0x28, 0x35, 0xa5, 0xda, 0x46, 0xdf,
+	0x38, 0xc3, 0x22, 0xb9, 0x2d, 0x45, 0x50, 0xf2, 0x1b, 0x0f, 0xd6, 0xb1, 0x3a, 0xa8, 0x19, 0x74,
+	0xdd, 0x30, 0x21, 0x2a, 0x62, 0xc2, 0x2b, 0x5d, 0x97, 0xa4, 0xa0, 0xae, 0x65, 0x37, 0x37, 0x1d,
+	0x0f, 0xed, 0xb0, 0x2c, 0xbd, 0xd8, 0x00, 0xd7, 0xb2, 0x57, 0x28, 0x05, 0x2f, 0x80, 0xe1, 0xd8,
+	0x01, 0xc9, 0x9a, 0xb7, 0xf4, 0xf9, 0x2b, 0x0b, 0x2c, 0x4f, 0xaf, 0x30, 0xea, 0x3a, 0x21, 0xaa,
+	0x2b, 0x50, 0xf0, 0x9c, 0x36, 0x4d, 0xd3, 0xc7, 0xe6, 0xe7, 0xe5, 0x4e, 0xdc, 0xa1, 0xf9, 0x1a,
+	0x4e, 0x1b, 0x35, 0x08, 0x7f, 0xbc, 0xde, 0x07, 0x42, 0xbd, 0x4f, 0x22, 0x9e, 0x63, 0xcb, 0xa2,
+	0xbd, 0x4a, 0xe2, 0x39, 0x46, 0x8b, 0xb6, 0xe2, 0x29, 0x28, 0xf7, 0x4e, 0x0f, 0x61, 0x3e, 0x0c,
+	0xd1, 0xb1, 0xc1, 0xc4, 0xeb, 0x43, 0x16, 0x61, 0xdb, 0x6b, 0x87, 0xeb, 0x83, 0xbf, 0x6f, 0x7a,
+	0x6d, 0xed, 0x03, 0x05, 0xca, 0xc4, 0x47, 0x70, 0xfa, 0x65, 0xd9, 0x83, 0xd6, 0xfd, 0xf8, 0xf9,
+	0x73, 0x89, 0xf9, 0x85, 0xa5, 0xc9, 0x8b, 0x4b, 0x53, 0x9f, 0x11, 0xcd, 0x71, 0x22, 0xc5, 0xb9,
+	0xa9, 0x84, 0xda, 0x32, 0x8b, 0x5d, 0xfa, 0x19, 0x19, 0xe1, 0x02, 0xa8, 0x78, 0x0e, 0xb2, 0xd3,
+	0x22, 0x3f, 0x4c, 0x8e, 0x14, 0xe2, 0x3d, 0x87, 0x5d, 0xcb, 0xbe, 0x4e, 0x1b, 0x68, 0x5a, 0xa4,
+	0xbd, 0xa1, 0x40, 0x71, 0xcd, 0x6f, 0xdd, 0xb4, 0xdd, 0x03, 0xd2, 0xb9, 0xfe, 0xb8, 0xa8, 0xd2,
+	0xb1, 0x84, 0x4a, 0x64, 0x76, 0x4d, 0x25, 0x41, 0x48, 0x7e, 0x47, 0x91, 0xf9, 0x9d, 0x1c, 0xb9,
+	0x94, 0xa7, 0xf0, 0x7d, 0xc3, 0xb2, 0x6d, 0x64, 0x1e, 0xcc, 0xca, 0x90, 0x2c, 0xce, 0x6d, 0x5b,
+	0x86, 0xce, 0x15, 0x65, 0x2b, 0x38, 0x8b, 0x23, 0x44, 0x9a, 0xc5, 0xcd, 0xc2, 0x11, 0x97, 0xc8,
+	0x40, 0x4f, 0x77, 0xa1, 0x6d, 0xe9, 0x85, 0xd3, 0x04, 0x6d, 0x22, 0xa7, 0x3c, 0x6a, 0xdc, 0xdd,
+	0x0f, 0xdf, 0xf5, 0x59, 0xd1, 0x30, 0xa7, 0xfb, 0x6c, 0x65, 0x54, 0x6f, 0x76, 0x77, 0xcf, 0x93,
+	0x22, 0x33, 0xbd, 0x4f, 0xdf, 0x2e, 0xd0, 0xca, 0xe4, 0x75, 0x12, 0x5b, 0x03, 0x99, 0x29, 0x3c,
+	0xcd, 0xe5, 0xb8, 0xd3, 0xdc, 0x14, 0x94, 0x59, 0xf8, 0x12, 0xb4, 0xa1, 0xd0, 0x05, 0x94, 0x84,
+	0xf1, 0x46, 0x46, 0x0f, 0x5e, 0x30, 0x6d, 0x81, 0xe8, 0xc1, 0x93, 0x22, 0xdf, 0x8d, 0x41, 0x85,
+	0x12, 0x87, 0x0a, 0xed, 0x13, 0x05, 0x54, 0x5a, 0xf0, 0xa1, 0x5c, 0x6b, 0x88, 0xec, 0x13, 0x83,
+	0xe8, 0x19, 0x9b, 0x27, 0x17, 0x9f, 0x07, 0xc3, 0x63, 0x87, 0x0c, 0xdd, 0xd4, 0x29, 0x77, 0xb8,
+	0x3f, 0x51, 0x2a, 0x1b, 0x12, 0xdb, 0x8a, 0xc0, 0x23, 0xc5, 0x6b, 0xf2, 0x3b, 0x59, 0x55, 0x3e,
+	0x93, 0x56, 0xc4, 0xe2, 0xc5, 0xaf, 0x2a, 0xda, 0x02, 0x49, 0xff, 0x05, 0xba, 0xc4, 0xed, 0xeb,
+	0x9f, 0xe9, 0xa2, 0xaf, 0xda, 0x3b, 0x56, 0x80, 0x0e, 0xca, 0x18, 0xf3, 0x30, 0x62, 0x91, 0x09,
+	0xd8, 0xca, 0xef, 0x36, 0x20, 0xeb, 0x98, 0x6a, 0x19, 0x09, 0x27, 0xe1, 0x15, 0xd1, 0x9e, 0x23,
+	0x4e, 0xc2, 0x93, 0x22, 0x8b, 0x3c, 0x06, 0x63, 0xa9, 0xe0, 0x56, 0x41, 0x31, 0x64, 0xfb, 0x3e,
+	0x35, 0xcf, 0x55, 0xc3, 0x40, 0x6e, 0x40, 0x07, 0x7a, 0xe8, 0xe6, 0x91, 0x8a, 0x61, 0x4e, 0x00,
+	0xed, 0x22, 0x8d, 0x61, 0x8e, 0x14, 0xa9, 0x15, 0x5a, 0x4d, 0xe9, 0x59, 0x4d, 0xfb, 0x81, 0x42,
+	0x30, 0xf1, 0x1a, 0x32, 0xda, 0x96, 0x8d, 0x0e, 0x4a, 0x09, 0x89, 0x3d, 0x38, 0x26, 0x01, 0xcb,
+	0xa9, 0x63, 0xb4, 0x08, 0x8a, 0xde, 0x52, 0x48, 0x0d, 0xe5, 0x45, 0xa4, 0xef, 0xec, 0x07, 0x89,
+	0x76, 0x15, 0x58, 0xa2, 0x78, 0xc1, 0xcd, 0xcf, 0x8a, 0x17, 0x1c, 0x25, 0x12, 0xf6, 0x37, 0x0a,
+	0x2b, 0x05, 0x75, 0x9c, 0xb0, 0xed, 0xf3, 0x45, 0x95, 0xfa, 0x93, 0xa2, 0x6a, 0x67, 0x53, 0x12,
+	0x00, 0x51, 0x5a, 0x6d, 0x11, 0x4e, 0xa7, 0xaa, 0x21, 0x81, 0x22, 0x9f, 0xd0, 0x23, 0x00, 0x7d,
+	0x12, 0xc6, 0xb8, 0x70, 0xd6, 0xf6, 0xef, 0x04, 0xab, 0x12, 0x29, 0xbf, 0x28, 0x3f, 0x4b, 0xf9,
+	0x45, 0x32, 0x9f, 0x58, 0x54, 0xd6, 0xfc, 0xd6, 0xca, 0xb6, 0x6d, 0x1e, 0x90, 0x97, 0xaa, 0x5d,
+	0x18, 0xd6, 0x3b, 0x2c, 0x97, 0xc8, 0x4f, 0x97, 0xe7, 0x4f, 0xcc, 0xb2, 0xc1, 0x36, 0x74, 0x1f,
+	0xcd, 0xb2, 0x07, 0x93, 0xb3, 0xcb, 0x8e, 0x65, 0x2f, 0xad, 0x7c, 0xf4, 0xe9, 0xd4, 0xa1, 0xf7,
+	0x3f, 0x9b, 0x9a, 0x6e, 0x59, 0xc1, 0xd6, 0xf6, 0xc6, 0xac, 0xe1, 0x74, 0xd8, 0xbb, 0x48, 0xf6,
+	0xbf, 0x8b, 0xbe, 0x79, 0x6b, 0x0e, 0xef, 0xbf, 0x3e, 0x61, 0xf0, 0xdf, 0x7d, 0x70, 0x77, 0x66,
+	0xb4, 0x8d, 0x5a, 0xba, 0xd1, 0x6d, 0x1a, 0x98, 0xc0, 0x9e, 0x0b, 0xd2, 0x09, 0x65, 0xea, 0xa1,
+	0x3d, 0xcd, 0xb5, 0x25, 0x12, 0x04, 0x3d, 0x42, 0xe4, 0x35, 0xe7, 0xe1, 0x70, 0x80, 0x4f, 0x5d,
+	0xdb, 0x5e, 0x37, 0x5a, 0x34, 0x0a, 0x4f, 0xe3, 0x21, 0x9d, 0x19, 0x46, 0x7b, 0x3f, 0x47, 0x36,
+	0xe7, 0x75, 0x14, 0xd0, 0x31, 0x56, 0x10, 0x5a, 0xd6, 0xdd, 0x87, 0x6f, 0xd4, 0xef, 0x29, 0xa0,
+	0x32, 0x37, 0xea, 0x38, 0x76, 0xb0, 0xd5, 0xee, 0x36, 0x0d, 0xdd, 0xfd, 0xd7, 0x59, 0xf8, 0x30,
+	0x9d, 0x7c, 0x8d, 0xce, 0xbd, 0xac, 0xbb, 0xf5, 0x4b, 0x7b, 0xef, 0xf9, 0x82, 0x55, 0xb4, 0x53,
+	0x64, 0xc7, 0x17, 0xa8, 0x91, 0x6b, 0xde, 0xe7, 0x1f, 0xa2, 0x1e, 0x94, 0x73, 0xa6, 0xd5, 0xed,
+	0x85, 0x4c, 0xaf, 0x20, 0x66, 0x7a, 0xf8, 0x98, 0xda, 0x41, 0x81, 0x6e, 0xea, 0x81, 0xce, 0xd2,
+	0xd9, 0xe8, 0x5b, 0x66, 0x27, 0xe4, 0x35, 0x8a, 0xbd, 0x44, 0x15, 0x50, 0xf9, 0x33, 0x85, 0xd8,
+	0xe7, 0x15, 0x4f, 0xb7, 0xfd, 0x4d, 0xe4, 0xd1, 0xd6, 0x97, 0x6f, 0xdb, 0xc8, 0xf3, 0xb7, 0xac,
+	0x03, 0xf0, 0xa9, 0x2b, 0x50, 0xb2, 0xd1, 0xed, 0xa6, 0x83, 0x67, 0xd8, 0x33, 0xcb, 0x29, 0xda,
+	0xe8, 0x36, 0x91, 0x45, 0xa6, 0x80, 0xd9, 0x47, 0x05, 0x56, 0xc0, 0xec, 0xd3, 0x1a, 0xd9, 0xe1,
+	0x67, 0x0a, 0xad, 0x5d, 0x91, 0x6c, 0xe1, 0xa0, 0xad, 0x50, 0x7f, 0x4a, 0x54, 0xe7, 0xbf, 0xfa,
+	0xa4, 0x32, 0xa2, 0x32, 0x1a, 0x9c, 0xe9, 0x27, 0x65, 0xa4, 0xca, 0x2f, 0xe9, 0x2e, 0x73, 0x0d,
+	0xe9, 0x46, 0x60, 0xed, 0x1c, 0xa0, 0x5f, 0xf7, 0x79, 0xf2, 0x24, 0xb3, 0x85, 0x88, 0xc2, 0xb1,
+	0x2d, 0x44, 0x24, 0x47, 0x3a, 0xbd, 0x1b, 0x16, 0xcf, 0x0e, 0x58, 0x27, 0xb9, 0x8a, 0x57, 0xaa,
+	0xec, 0x8d, 0x7e, 0xb2, 0xff, 0x29, 0xc7, 0x3d, 0x77, 0x5a, 0x77, 0x91, 0xf1, 0x2a, 0xf2, 0xfc,
+	0x41, 0x8b, 0xe2, 0xa7, 0x01, 0xc2, 0xeb, 0x80, 0x48, 0xfa, 0x12, 0xa3, 0xac, 0x9a, 0x38, 0xf7,
+	0xd8, 0xa1, 0xa3, 0xb3, 0x35, 0x09, 0x3f, 0xc9, 0x1b, 0x39, 0x17, 0x19, 0xb4, 0x26, 0xc6, 0xea,
+	0x5e, 0x98, 0x10, 0x3e, 0x13, 0x23, 0x8d, 0x96, 0xbb, 0xe9, 0x87, 0x68, 0x83, 0x09, 0xab, 0xee,
+	0x26, 0x79, 0xbe, 0x61, 0x6c, 0xe9, 0x76, 0x0b, 0xb5, 0x9d, 0x16, 0x2b, 0x80, 0xf5, 0x08, 0xe4,
+	0xb6, 0x5a, 0xf7, 0xf0, 0x59, 0x9f, 0xcd, 0x84, 0xe5, 0x0a, 0x6f, 0xab, 0x49, 0x03, 0x53, 0x97,
+	0xd6, 0xe5, 0x7a, 0x96, 0x2f, 0x0a, 0x96, 0x97, 0x7e, 0x25, 0xc4, 0x99, 0x50, 0x7b, 0x86, 0x7b,
+	0x25, 0xc4, 0xd1, 0xa3, 0x5d, 0xf5, 0x34, 0x00, 0x27, 0x16, 0xdd, 0x4f, 0x4b, 0x3b, 0xa1, 0x40,
+	0xf3, 0x6f, 0x9f, 0x87, 0xfc, 0x9a, 0xdf, 0x52, 0xbf, 0xa5, 0xc0, 0x68, 0xec, 0x1f, 0x23, 0x48,
+	0x3e, 0x91, 0x14, 0xde, 0xf7, 0xd7, 0x9e, 0x19, 0x88, 0x2d, 0x92, 0xf6, 0xeb, 0x0a, 0x94, 0xf9,
+	0x7f, 0x13, 0xf0, 0xa4, 0xf4, 0x70, 0x1c, 0x57, 0xed, 0x7f, 0x07, 0xe1, 0x8a, 0xc9, 0xc0, 0x3f,
+	0xee, 0x96, 0x97, 0x81, 0xe3, 0xca, 0x20, 0x43, 0xda, 0x2b, 0xe8, 0x37, 0x15, 0x18, 0x13, 0x1e,
+	0x82, 0x3c, 0x25, 0x3d, 0x60, 0x9c, 0xb1, 0xf6, 0x7f, 0x03, 0x32, 0x46, 0xc2, 0xbc, 0xa5, 0xc0,
+	0xe1, 0xc4, 0xf3, 0xe4, 0xc5, 0x41, 0x6c, 0x4c, 0x58, 0x6b, 0x57, 0x07, 0x66, 0x8d, 0x89, 0x94,
+	0x78, 0xcf, 0xba, 0x38, 0x88, 0xc9, 0xb3, 0x8a, 0xd4, 0xf7, 0xe1, 0xe7, 0xdb, 0x0a, 0x4c, 0x24,
+	0x1f, 0x74, 0xd6, 0x33, 0xea, 0xca, 0xf1, 0xd6, 0x96, 0x06, 0xe7, 0x8d, 0x39, 0x92, 0xf0, 0x0c,
+	0x50, 0xde, 0x91, 0xe2, 0x8c, 0x19, 0x1c, 0xa9, 0xcf, 0xbb, 0x31, 0x2c, 0x8c, 0xf0, 0x32, 0x4c,
+	0x5e, 0x98, 0x38, 0x63, 0x06, 0x61, 0xfa, 0xbc, 0xd9, 0xfa, 0x2a, 0x00, 0xf7, 0x58, 0xe5, 0x72,
+	0x06, 0x07, 0x08, 0x99, 0x6a, 0xff, 0x33, 0x00, 0x53, 0x1c, 0x66, 0xb8, 0xb7, 0x1f, 0x19, 0x60,
+	0xa6, 0xc7, 0x95, 0x05, 0x66, 0x92, 0xcf, 0x29, 0xd4, 0x1f, 0x2b, 0x70, 0x24, 0xed, 0x2d, 0x45,
+	0x16, 0xf0, 0x4a, 0x70, 0xd7, 0xae, 0xed, 0x87, 0x3b, 0x92, 0xed, 0xe7, 0x0a, 0x1c, 0xef, 0xf7,
+	0x4a, 0xe1, 0x39, 0xe9, 0x19, 0xfa, 0x8c, 0x50, 0x7b, 0x7e, 0xbf, 0x23, 0x44, 0x72, 0xbe, 0xa7,
+	0x40, 0xb5, 0xef, 0xad, 0xff, 0x40, 0xb8, 0x12, 0x97, 0x74, 0x75, 0xdf, 0x43, 0x44, 0xa2, 0x7e,
+	0x5b, 0x81, 0x4a, 0xfc, 0xd6, 0x7e, 0x41, 0x3e, 0x8a, 0x78, 0xbe, 0xda, 0xb3, 0x83, 0xf1, 0x09,
+	0xfb, 0x5b, 0xec, 0x22, 0x3c, 0xcb, 0xfe, 0xc6, 0x33, 0x66, 0xda, 0xdf, 0xd2, 0xee, 0xa5, 0xd9,
+	0x66, 0x22, 0x5c, 0x4a, 0x67, 0xd9, 0x4c, 0xe2, 0xac, 0x99, 0x36, 0x93, 0xf4, 0x3b, 0x62, 0xb2,
+	0x52, 0xf1, 0x0b, 0x62, 0xf9, 0x95, 0x8a, 0xf1, 0x65, 0x58, 0xa9, 0xf4, 0x5b, 0xce, 0x3b, 0x50,
+	0x8c, 0x6e, 0x29, 0x2f, 0x65, 0x50, 0x8c, 0xb2, 0xd4, 0x16, 0x33, 0xb3, 0x44, 0x33, 0x3b, 0x30,
+	0x44, 0x2f, 0x0a, 0x67, 0xe5, 0x73, 0x4a, 0xdc, 0xbf, 0xb6, 0x90, 0xad, 0x7f, 0x34, 0x21, 0xce,
+	0x81, 0x63, 0x77, 0x7f, 0x57, 0x32, 0x7a, 0x39, 0x65, 0xcb, 0x90, 0x03, 0xa7, 0x5d, 0xaf, 0x11,
+	0x31, 0x62, 0x77, 0x6b, 0x57, 0x32, 0xe6, 0x01, 0x94, 0x2d, 0x83, 0x18, 0xa9, 0xb7, 0x63, 0xdf,
+	0x55, 0x60, 0x5c, 0xbc, 0xfd, 0x7a, 0x3a, 0xcb, 0xa6, 0xcb, 0x73, 0xd6, 0x9e, 0x1b, 0x94, 0x33,
+	0x66, 0x96, 0xd8, 0xed, 0x93, 0xbc, 0x59, 0x78, 0xb6, 0x0c, 0x66, 0x49, 0xbd, 0x0f, 0x22, 0x4e,
+	0xc2, 0xdf, 0xf2, 0x64, 0x70, 0x12, 0x8e, 0x2d, 0x8b, 0x93, 0xa4, 0xdd, 0xdf, 0x60, 0x80, 0x88,
+	0x5f, 0xd4, 0xc8, 0x7b, 0x7d, 0x8c, 0x2f, 0x03, 0x40, 0xa4, 0x5e, 0xc1, 0x90, 0x3c, 0x86, 0xbf,
+	0x7f, 0x91, 0xcf, 0x63, 0x38, 0xae, 0x0c, 0x79, 0x4c, 0xca, 0xcd, 0x8a, 0xfa, 0x8e, 0x02, 0x6a,
+	0xca, 0xb5, 0x4a, 0x96, 0xfc, 0x4c, 0x64, 0xae, 0x2d, 0xef, 0x83, 0x39, 0xb6, 0xb5, 0x24, 0x2e,
+	0x3b, 0x16, 0x33, 0x9e, 0x91, 0x7b, 0xac, 0x19, 0xb6, 0x96, 0x7e, 0x77, 0x11, 0x38, 0xef, 0xe5,
+	0xee, 0x21, 0xe4, 0xf3, 0xde, 0x1e, 0x53, 0x86, 0xbc, 0x37, 0xa5, 0xcc, 0x8f, 0x71, 0x45, 0x2c,
+	0xdc, 0xcb, 0xe3, 0x8a, 0xc0, 0x99, 0x01, 0x57, 0xfa, 0x14, 0xc0, 0xb9, 0xca, 0x47, 0x66, 0xb8,
+	0xe5, 0xd9, 0x32, 0x57, 0x3e, 0x04, 0xb3, 0xe0, 0x74, 0xb7, 0x5f, 0x0d, 0x5a, 0x5e, 0xc9, 0x3e,
+	0x23, 0x64, 0x48, 0x77, 0xf7, 0x28, 0x13, 0xab, 0x3f, 0x51, 0xe0, 0x91, 0xf4, 0x1a, 0xf1, 0xb3,
+	0x19, 0x11, 0x4d, 0x94, 0x71, 0x65, 0x7f, 0xfc, 0xb1, 0x98, 0x4b, 0x94, 0x7e, 0x17, 0x33, 0xa0,
+	0x5c, 0x9c, 0x35, 0x43, 0xcc, 0xf5, 0x2b, 0xde, 0xb2, 0x0c, 0x73, 0x60, 0x91, 0x1a, 0x83, 0x8b,
+	0xd4, 0xaf, 0x26, 0xcb, 0x95, 0x2b, 0xf8, 0x82, 0x6c, 0xd6, 0x72, 0x05, 0xc7, 0x9b, 0xb9, 0x5c,
+	0x91, 0x52, 0xad, 0xac, 0x0d, 0x7d, 0xed, 0xc1, 0xdd, 0x19, 0x65, 0xe9, 0xda, 0x47, 0xf7, 0x26,
+	0x95, 0x8f, 0xef, 0x4d, 0x2a, 0x7f, 0xbd, 0x37, 0xa9, 0xbc, 0x75, 0x7f, 0xf2, 0xd0, 0xc7, 0xf7,
+	0x27, 0x0f, 0xfd, 0xf1, 0xfe, 0xe4, 0xa1, 0x2f, 0xcf, 0x70, 0x43, 0x5e, 0xec, 0xfb, 0x87, 0x48,
+	0xc8, 0xcd, 0xda, 0xc6, 0x30, 0xf9, 0x53, 0x2b, 0x97, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x1c,
+	0x87, 0x79, 0x56, 0xa3, 0x46, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// Stamp operations
+	CreateStamp(ctx context.Context, in *MsgCreateStamp, opts ...grpc.CallOption) (*MsgCreateStampResponse, error)
+	RevokeStamp(ctx context.Context, in *MsgRevokeStamp, opts ...grpc.CallOption) (*MsgRevokeStampResponse, error)
+	SupersedeStamp(ctx context.Context, in *MsgSupersedeStamp, opts ...grpc.CallOption) (*MsgSupersedeStampResponse, error)
+	CreateStampBatch(ctx context.Context, in *MsgCreateStampBatch, opts ...grpc.CallOption) (*MsgCreateStampBatchResponse, error)
+	RevokeStampBatch(ctx context.Context, in *MsgRevokeStampBatch, opts ...grpc.CallOption) (*MsgRevokeStampBatchResponse, error)
+	CreateMerkleStamp(ctx context.Context, in *MsgCreateMerkleStamp, opts ...grpc.CallOption) (*MsgCreateMerkleStampResponse, error)
+	ProposeCoStamp(ctx context.Context, in *MsgProposeCoStamp, opts ...grpc.CallOption) (*MsgProposeCoStampResponse, error)
+	AddCoSignature(ctx context.Context, in *MsgAddCoSignature, opts ...grpc.CallOption) (*MsgAddCoSignatureResponse, error)
+	// PE registry operations
+	RegisterPE(ctx context.Context, in *MsgRegisterPE, opts ...grpc.CallOption) (*MsgRegisterPEResponse, error)
+	RotatePEKey(ctx context.Context, in *MsgRotatePEKey, opts ...grpc.CallOption) (*MsgRotatePEKeyResponse, error)
+	ReportKeyCompromise(ctx context.Context, in *MsgReportKeyCompromise, opts ...grpc.CallOption) (*MsgReportKeyCompromiseResponse, error)
+	GrantStampingDelegation(ctx context.Context, in *MsgGrantStampingDelegation, opts ...grpc.CallOption) (*MsgGrantStampingDelegationResponse, error)
+	RevokeStampingDelegation(ctx context.Context, in *MsgRevokeStampingDelegation, opts ...grpc.CallOption) (*MsgRevokeStampingDelegationResponse, error)
+	// Jurisdiction board operations
+	AttestLicense(ctx context.Context, in *MsgAttestLicense, opts ...grpc.CallOption) (*MsgAttestLicenseResponse, error)
+	SuspendLicense(ctx context.Context, in *MsgSuspendLicense, opts ...grpc.CallOption) (*MsgSuspendLicenseResponse, error)
+	ReinstateLicense(ctx context.Context, in *MsgReinstateLicense, opts ...grpc.CallOption) (*MsgReinstateLicenseResponse, error)
+	// Document storage operations
+	StoreDocument(ctx context.Context, in *MsgStoreDocument, opts ...grpc.CallOption) (*MsgStoreDocumentResponse, error)
+	RenewPin(ctx context.Context, in *MsgRenewPin, opts ...grpc.CallOption) (*MsgRenewPinResponse, error)
+	Unpin(ctx context.Context, in *MsgUnpin, opts ...grpc.CallOption) (*MsgUnpinResponse, error)
+	AttestPinned(ctx context.Context, in *MsgAttestPinned, opts ...grpc.CallOption) (*MsgAttestPinnedResponse, error)
+	// Entity account operations
+	CreateEntity(ctx context.Context, in *MsgCreateEntity, opts ...grpc.CallOption) (*MsgCreateEntityResponse, error)
+	AddEntityMember(ctx context.Context, in *MsgAddEntityMember, opts ...grpc.CallOption) (*MsgAddEntityMemberResponse, error)
+	InviteMember(ctx context.Context, in *MsgInviteMember, opts ...grpc.CallOption) (*MsgInviteMemberResponse, error)
+	AcceptInvite(ctx context.Context, in *MsgAcceptInvite, opts ...grpc.CallOption) (*MsgAcceptInviteResponse, error)
+	DeclineInvite(ctx context.Context, in *MsgDeclineInvite, opts ...grpc.CallOption) (*MsgDeclineInviteResponse, error)
+	LeaveEntity(ctx context.Context, in *MsgLeaveEntity, opts ...grpc.CallOption) (*MsgLeaveEntityResponse, error)
+	RemoveEntityMember(ctx context.Context, in *MsgRemoveEntityMember, opts ...grpc.CallOption) (*MsgRemoveEntityMemberResponse, error)
+	UpdateMemberRole(ctx context.Context, in *MsgUpdateMemberRole, opts ...grpc.CallOption) (*MsgUpdateMemberRoleResponse, error)
+	FundEntity(ctx context.Context, in *MsgFundEntity, opts ...grpc.CallOption) (*MsgFundEntityResponse, error)
+	SetEntityFeeCap(ctx context.Context, in *MsgSetEntityFeeCap, opts ...grpc.CallOption) (*MsgSetEntityFeeCapResponse, error)
+	UpdateEntity(ctx context.Context, in *MsgUpdateEntity, opts ...grpc.CallOption) (*MsgUpdateEntityResponse, error)
+	TransferEntityOwnership(ctx context.Context, in *MsgTransferEntityOwnership, opts ...grpc.CallOption) (*MsgTransferEntityOwnershipResponse, error)
+	AcceptEntityOwnership(ctx context.Context, in *MsgAcceptEntityOwnership, opts ...grpc.CallOption) (*MsgAcceptEntityOwnershipResponse, error)
+	DeactivateEntity(ctx context.Context, in *MsgDeactivateEntity, opts ...grpc.CallOption) (*MsgDeactivateEntityResponse, error)
+	ReactivateEntity(ctx context.Context, in *MsgReactivateEntity, opts ...grpc.CallOption) (*MsgReactivateEntityResponse, error)
+	// Spec tracking operations
+	CreateSpecVersion(ctx context.Context, in *MsgCreateSpecVersion, opts ...grpc.CallOption) (*MsgCreateSpecVersionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateStamp(ctx context.Context, in *MsgCreateStamp, opts ...grpc.CallOption) (*MsgCreateStampResponse, error) {
+	out := new(MsgCreateStampResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/CreateStamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeStamp(ctx context.Context, in *MsgRevokeStamp, opts ...grpc.CallOption) (*MsgRevokeStampResponse, error) {
+	out := new(MsgRevokeStampResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/RevokeStamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SupersedeStamp(ctx context.Context, in *MsgSupersedeStamp, opts ...grpc.CallOption) (*MsgSupersedeStampResponse, error) {
+	out := new(MsgSupersedeStampResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/SupersedeStamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateStampBatch(ctx context.Context, in *MsgCreateStampBatch, opts ...grpc.CallOption) (*MsgCreateStampBatchResponse, error) {
+	out := new(MsgCreateStampBatchResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/CreateStampBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeStampBatch(ctx context.Context, in *MsgRevokeStampBatch, opts ...grpc.CallOption) (*MsgRevokeStampBatchResponse, error) {
+	out := new(MsgRevokeStampBatchResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/RevokeStampBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateMerkleStamp(ctx context.Context, in *MsgCreateMerkleStamp, opts ...grpc.CallOption) (*MsgCreateMerkleStampResponse, error) {
+	out := new(MsgCreateMerkleStampResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/CreateMerkleStamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeCoStamp(ctx context.Context, in *MsgProposeCoStamp, opts ...grpc.CallOption) (*MsgProposeCoStampResponse, error) {
+	out := new(MsgProposeCoStampResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/ProposeCoStamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddCoSignature(ctx context.Context, in *MsgAddCoSignature, opts ...grpc.CallOption) (*MsgAddCoSignatureResponse, error) {
+	out := new(MsgAddCoSignatureResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/AddCoSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterPE(ctx context.Context, in *MsgRegisterPE, opts ...grpc.CallOption) (*MsgRegisterPEResponse, error) {
+	out := new(MsgRegisterPEResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/RegisterPE", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotatePEKey(ctx context.Context, in *MsgRotatePEKey, opts ...grpc.CallOption) (*MsgRotatePEKeyResponse, error) {
+	out := new(MsgRotatePEKeyResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/RotatePEKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportKeyCompromise(ctx context.Context, in *MsgReportKeyCompromise, opts ...grpc.CallOption) (*MsgReportKeyCompromiseResponse, error) {
+	out := new(MsgReportKeyCompromiseResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/ReportKeyCompromise", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantStampingDelegation(ctx context.Context, in *MsgGrantStampingDelegation, opts ...grpc.CallOption) (*MsgGrantStampingDelegationResponse, error) {
+	out := new(MsgGrantStampingDelegationResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/GrantStampingDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeStampingDelegation(ctx context.Context, in *MsgRevokeStampingDelegation, opts ...grpc.CallOption) (*MsgRevokeStampingDelegationResponse, error) {
+	out := new(MsgRevokeStampingDelegationResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/RevokeStampingDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestLicense(ctx context.Context, in *MsgAttestLicense, opts ...grpc.CallOption) (*MsgAttestLicenseResponse, error) {
+	out := new(MsgAttestLicenseResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/AttestLicense", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SuspendLicense(ctx context.Context, in *MsgSuspendLicense, opts ...grpc.CallOption) (*MsgSuspendLicenseResponse, error) {
+	out := new(MsgSuspendLicenseResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/SuspendLicense", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReinstateLicense(ctx context.Context, in *MsgReinstateLicense, opts ...grpc.CallOption) (*MsgReinstateLicenseResponse, error) {
+	out := new(MsgReinstateLicenseResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/ReinstateLicense", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StoreDocument(ctx context.Context, in *MsgStoreDocument, opts ...grpc.CallOption) (*MsgStoreDocumentResponse, error) {
+	out := new(MsgStoreDocumentResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/StoreDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenewPin(ctx context.Context, in *MsgRenewPin, opts ...grpc.CallOption) (*MsgRenewPinResponse, error) {
+	out := new(MsgRenewPinResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/RenewPin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unpin(ctx context.Context, in *MsgUnpin, opts ...grpc.CallOption) (*MsgUnpinResponse, error) {
+	out := new(MsgUnpinResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/Unpin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestPinned(ctx context.Context, in *MsgAttestPinned, opts ...grpc.CallOption) (*MsgAttestPinnedResponse, error) {
+	out := new(MsgAttestPinnedResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/AttestPinned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateEntity(ctx context.Context, in *MsgCreateEntity, opts ...grpc.CallOption) (*MsgCreateEntityResponse, error) {
+	out := new(MsgCreateEntityResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/CreateEntity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddEntityMember(ctx context.Context, in *MsgAddEntityMember, opts ...grpc.CallOption) (*MsgAddEntityMemberResponse, error) {
+	out := new(MsgAddEntityMemberResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/AddEntityMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InviteMember(ctx context.Context, in *MsgInviteMember, opts ...grpc.CallOption) (*MsgInviteMemberResponse, error) {
+	out := new(MsgInviteMemberResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/InviteMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptInvite(ctx context.Context, in *MsgAcceptInvite, opts ...grpc.CallOption) (*MsgAcceptInviteResponse, error) {
+	out := new(MsgAcceptInviteResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/AcceptInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeclineInvite(ctx context.Context, in *MsgDeclineInvite, opts ...grpc.CallOption) (*MsgDeclineInviteResponse, error) {
+	out := new(MsgDeclineInviteResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/DeclineInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LeaveEntity(ctx context.Context, in *MsgLeaveEntity, opts ...grpc.CallOption) (*MsgLeaveEntityResponse, error) {
+	out := new(MsgLeaveEntityResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/LeaveEntity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveEntityMember(ctx context.Context, in *MsgRemoveEntityMember, opts ...grpc.CallOption) (*MsgRemoveEntityMemberResponse, error) {
+	out := new(MsgRemoveEntityMemberResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/RemoveEntityMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMemberRole(ctx context.Context, in *MsgUpdateMemberRole, opts ...grpc.CallOption) (*MsgUpdateMemberRoleResponse, error) {
+	out := new(MsgUpdateMemberRoleResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/UpdateMemberRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundEntity(ctx context.Context, in *MsgFundEntity, opts ...grpc.CallOption) (*MsgFundEntityResponse, error) {
+	out := new(MsgFundEntityResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/FundEntity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetEntityFeeCap(ctx context.Context, in *MsgSetEntityFeeCap, opts ...grpc.CallOption) (*MsgSetEntityFeeCapResponse, error) {
+	out := new(MsgSetEntityFeeCapResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/SetEntityFeeCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateEntity(ctx context.Context, in *MsgUpdateEntity, opts ...grpc.CallOption) (*MsgUpdateEntityResponse, error) {
+	out := new(MsgUpdateEntityResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/UpdateEntity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferEntityOwnership(ctx context.Context, in *MsgTransferEntityOwnership, opts ...grpc.CallOption) (*MsgTransferEntityOwnershipResponse, error) {
+	out := new(MsgTransferEntityOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/TransferEntityOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptEntityOwnership(ctx context.Context, in *MsgAcceptEntityOwnership, opts ...grpc.CallOption) (*MsgAcceptEntityOwnershipResponse, error) {
+	out := new(MsgAcceptEntityOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/AcceptEntityOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeactivateEntity(ctx context.Context, in *MsgDeactivateEntity, opts ...grpc.CallOption) (*MsgDeactivateEntityResponse, error) {
+	out := new(MsgDeactivateEntityResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/DeactivateEntity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReactivateEntity(ctx context.Context, in *MsgReactivateEntity, opts ...grpc.CallOption) (*MsgReactivateEntityResponse, error) {
+	out := new(MsgReactivateEntityResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/ReactivateEntity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateSpecVersion(ctx context.Context, in *MsgCreateSpecVersion, opts ...grpc.CallOption) (*MsgCreateSpecVersionResponse, error) {
+	out := new(MsgCreateSpecVersionResponse)
+	err := c.cc.Invoke(ctx, "/stampledgerchain.stampledgerchain.v1.Msg/CreateSpecVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// Stamp operations
+	CreateStamp(context.Context, *MsgCreateStamp) (*MsgCreateStampResponse, error)
+	RevokeStamp(context.Context, *MsgRevokeStamp) (*MsgRevokeStampResponse, error)
+	SupersedeStamp(context.Context, *MsgSupersedeStamp) (*MsgSupersedeStampResponse, error)
+	CreateStampBatch(context.Context, *MsgCreateStampBatch) (*MsgCreateStampBatchResponse, error)
+	RevokeStampBatch(context.Context, *MsgRevokeStampBatch) (*MsgRevokeStampBatchResponse, error)
+	CreateMerkleStamp(context.Context, *MsgCreateMerkleStamp) (*MsgCreateMerkleStampResponse, error)
+	ProposeCoStamp(context.Context, *MsgProposeCoStamp) (*MsgProposeCoStampResponse, error)
+	AddCoSignature(context.Context, *MsgAddCoSignature) (*MsgAddCoSignatureResponse, error)
+	// PE registry operations
+	RegisterPE(context.Context, *MsgRegisterPE) (*MsgRegisterPEResponse, error)
+	RotatePEKey(context.Context, *MsgRotatePEKey) (*MsgRotatePEKeyResponse, error)
+	ReportKeyCompromise(context.Context, *MsgReportKeyCompromise) (*MsgReportKeyCompromiseResponse, error)
+	GrantStampingDelegation(context.Context, *MsgGrantStampingDelegation) (*MsgGrantStampingDelegationResponse, error)
+	RevokeStampingDelegation(context.Context, *MsgRevokeStampingDelegation) (*MsgRevokeStampingDelegationResponse, error)
+	// Jurisdiction board operations
+	AttestLicense(context.Context, *MsgAttestLicense) (*MsgAttestLicenseResponse, error)
+	SuspendLicense(context.Context, *MsgSuspendLicense) (*MsgSuspendLicenseResponse, error)
+	ReinstateLicense(context.Context, *MsgReinstateLicense) (*MsgReinstateLicenseResponse, error)
+	// Document storage operations
+	StoreDocument(context.Context, *MsgStoreDocument) (*MsgStoreDocumentResponse, error)
+	RenewPin(context.Context, *MsgRenewPin) (*MsgRenewPinResponse, error)
+	Unpin(context.Context, *MsgUnpin) (*MsgUnpinResponse, error)
+	AttestPinned(context.Context, *MsgAttestPinned) (*MsgAttestPinnedResponse, error)
+	// Entity account operations
+	CreateEntity(context.Context, *MsgCreateEntity) (*MsgCreateEntityResponse, error)
+	AddEntityMember(context.Context, *MsgAddEntityMember) (*MsgAddEntityMemberResponse, error)
+	InviteMember(context.Context, *MsgInviteMember) (*MsgInviteMemberResponse, error)
+	AcceptInvite(context.Context, *MsgAcceptInvite) (*MsgAcceptInviteResponse, error)
+	DeclineInvite(context.Context, *MsgDeclineInvite) (*MsgDeclineInviteResponse, error)
+	LeaveEntity(context.Context, *MsgLeaveEntity) (*MsgLeaveEntityResponse, error)
+	RemoveEntityMember(context.Context, *MsgRemoveEntityMember) (*MsgRemoveEntityMemberResponse, error)
+	UpdateMemberRole(context.Context, *MsgUpdateMemberRole) (*MsgUpdateMemberRoleResponse, error)
+	FundEntity(context.Context, *MsgFundEntity) (*MsgFundEntityResponse, error)
+	SetEntityFeeCap(context.Context, *MsgSetEntityFeeCap) (*MsgSetEntityFeeCapResponse, error)
+	UpdateEntity(context.Context, *MsgUpdateEntity) (*MsgUpdateEntityResponse, error)
+	TransferEntityOwnership(context.Context, *MsgTransferEntityOwnership) (*MsgTransferEntityOwnershipResponse, error)
+	AcceptEntityOwnership(context.Context, *MsgAcceptEntityOwnership) (*MsgAcceptEntityOwnershipResponse, error)
+	DeactivateEntity(context.Context, *MsgDeactivateEntity) (*MsgDeactivateEntityResponse, error)
+	ReactivateEntity(context.Context, *MsgReactivateEntity) (*MsgReactivateEntityResponse, error)
+	// Spec tracking operations
+	CreateSpecVersion(context.Context, *MsgCreateSpecVersion) (*MsgCreateSpecVersionResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateStamp(ctx context.Context, req *MsgCreateStamp) (*MsgCreateStampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStamp not implemented")
+}
+func (*UnimplementedMsgServer) RevokeStamp(ctx context.Context, req *MsgRevokeStamp) (*MsgRevokeStampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeStamp not implemented")
+}
+func (*UnimplementedMsgServer) SupersedeStamp(ctx context.Context, req *MsgSupersedeStamp) (*MsgSupersedeStampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupersedeStamp not implemented")
+}
+func (*UnimplementedMsgServer) CreateStampBatch(ctx context.Context, req *MsgCreateStampBatch) (*MsgCreateStampBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStampBatch not implemented")
+}
+func (*UnimplementedMsgServer) RevokeStampBatch(ctx context.Context, req *MsgRevokeStampBatch) (*MsgRevokeStampBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeStampBatch not implemented")
+}
+func (*UnimplementedMsgServer) CreateMerkleStamp(ctx context.Context, req *MsgCreateMerkleStamp) (*MsgCreateMerkleStampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMerkleStamp not implemented")
+}
+func (*UnimplementedMsgServer) ProposeCoStamp(ctx context.Context, req *MsgProposeCoStamp) (*MsgProposeCoStampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeCoStamp not implemented")
+}
+func (*UnimplementedMsgServer) AddCoSignature(ctx context.Context, req *MsgAddCoSignature) (*MsgAddCoSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCoSignature not implemented")
+}
+func (*UnimplementedMsgServer) RegisterPE(ctx context.Context, req *MsgRegisterPE) (*MsgRegisterPEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPE not implemented")
+}
+func (*UnimplementedMsgServer) RotatePEKey(ctx context.Context, req *MsgRotatePEKey) (*MsgRotatePEKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotatePEKey not implemented")
+}
+func (*UnimplementedMsgServer) ReportKeyCompromise(ctx context.Context, req *MsgReportKeyCompromise) (*MsgReportKeyCompromiseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportKeyCompromise not implemented")
+}
+func (*UnimplementedMsgServer) GrantStampingDelegation(ctx context.Context, req *MsgGrantStampingDelegation) (*MsgGrantStampingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantStampingDelegation not implemented")
+}
+func (*UnimplementedMsgServer) RevokeStampingDelegation(ctx context.Context, req *MsgRevokeStampingDelegation) (*MsgRevokeStampingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeStampingDelegation not implemented")
+}
+func (*UnimplementedMsgServer) AttestLicense(ctx context.Context, req *MsgAttestLicense) (*MsgAttestLicenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestLicense not implemented")
+}
+func (*UnimplementedMsgServer) SuspendLicense(ctx context.Context, req *MsgSuspendLicense) (*MsgSuspendLicenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendLicense not implemented")
+}
+func (*UnimplementedMsgServer) ReinstateLicense(ctx context.Context, req *MsgReinstateLicense) (*MsgReinstateLicenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReinstateLicense not implemented")
+}
+func (*UnimplementedMsgServer) StoreDocument(ctx context.Context, req *MsgStoreDocument) (*MsgStoreDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreDocument not implemented")
+}
+func (*UnimplementedMsgServer) RenewPin(ctx context.Context, req *MsgRenewPin) (*MsgRenewPinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewPin not implemented")
+}
+func (*UnimplementedMsgServer) Unpin(ctx context.Context, req *MsgUnpin) (*MsgUnpinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpin not implemented")
+}
+func (*UnimplementedMsgServer) AttestPinned(ctx context.Context, req *MsgAttestPinned) (*MsgAttestPinnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestPinned not implemented")
+}
+func (*UnimplementedMsgServer) CreateEntity(ctx context.Context, req *MsgCreateEntity) (*MsgCreateEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEntity not implemented")
+}
+func (*UnimplementedMsgServer) AddEntityMember(ctx context.Context, req *MsgAddEntityMember) (*MsgAddEntityMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEntityMember not implemented")
+}
+func (*UnimplementedMsgServer) InviteMember(ctx context.Context, req *MsgInviteMember) (*MsgInviteMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteMember not implemented")
+}
+func (*UnimplementedMsgServer) AcceptInvite(ctx context.Context, req *MsgAcceptInvite) (*MsgAcceptInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
+}
+func (*UnimplementedMsgServer) DeclineInvite(ctx context.Context, req *MsgDeclineInvite) (*MsgDeclineInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineInvite not implemented")
+}
+func (*UnimplementedMsgServer) LeaveEntity(ctx context.Context, req *MsgLeaveEntity) (*MsgLeaveEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveEntity not implemented")
+}
+func (*UnimplementedMsgServer) RemoveEntityMember(ctx context.Context, req *MsgRemoveEntityMember) (*MsgRemoveEntityMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEntityMember not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMemberRole(ctx context.Context, req *MsgUpdateMemberRole) (*MsgUpdateMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemberRole not implemented")
+}
+func (*UnimplementedMsgServer) FundEntity(ctx context.Context, req *MsgFundEntity) (*MsgFundEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundEntity not implemented")
+}
+func (*UnimplementedMsgServer) SetEntityFeeCap(ctx context.Context, req *MsgSetEntityFeeCap) (*MsgSetEntityFeeCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEntityFeeCap not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEntity(ctx context.Context, req *MsgUpdateEntity) (*MsgUpdateEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEntity not implemented")
+}
+func (*UnimplementedMsgServer) TransferEntityOwnership(ctx context.Context, req *MsgTransferEntityOwnership) (*MsgTransferEntityOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferEntityOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AcceptEntityOwnership(ctx context.Context, req *MsgAcceptEntityOwnership) (*MsgAcceptEntityOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptEntityOwnership not implemented")
+}
+func (*UnimplementedMsgServer) DeactivateEntity(ctx context.Context, req *MsgDeactivateEntity) (*MsgDeactivateEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateEntity not implemented")
+}
+func (*UnimplementedMsgServer) ReactivateEntity(ctx context.Context, req *MsgReactivateEntity) (*MsgReactivateEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateEntity not implemented")
+}
+func (*UnimplementedMsgServer) CreateSpecVersion(ctx context.Context, req *MsgCreateSpecVersion) (*MsgCreateSpecVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSpecVersion not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateStamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateStamp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateStamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/CreateStamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateStamp(ctx, req.(*MsgCreateStamp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeStamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeStamp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeStamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/RevokeStamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeStamp(ctx, req.(*MsgRevokeStamp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SupersedeStamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSupersedeStamp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SupersedeStamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/SupersedeStamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SupersedeStamp(ctx, req.(*MsgSupersedeStamp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateStampBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateStampBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateStampBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/CreateStampBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateStampBatch(ctx, req.(*MsgCreateStampBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeStampBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeStampBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeStampBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/RevokeStampBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeStampBatch(ctx, req.(*MsgRevokeStampBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateMerkleStamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMerkleStamp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMerkleStamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/CreateMerkleStamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMerkleStamp(ctx, req.(*MsgCreateMerkleStamp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeCoStamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeCoStamp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeCoStamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/ProposeCoStamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeCoStamp(ctx, req.(*MsgProposeCoStamp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddCoSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCoSignature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddCoSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/AddCoSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCoSignature(ctx, req.(*MsgAddCoSignature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterPE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterPE)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterPE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/RegisterPE",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterPE(ctx, req.(*MsgRegisterPE))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotatePEKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotatePEKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotatePEKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/RotatePEKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotatePEKey(ctx, req.(*MsgRotatePEKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportKeyCompromise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportKeyCompromise)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportKeyCompromise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/ReportKeyCompromise",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportKeyCompromise(ctx, req.(*MsgReportKeyCompromise))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantStampingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantStampingDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantStampingDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/GrantStampingDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantStampingDelegation(ctx, req.(*MsgGrantStampingDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeStampingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeStampingDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeStampingDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/RevokeStampingDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeStampingDelegation(ctx, req.(*MsgRevokeStampingDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestLicense)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/AttestLicense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestLicense(ctx, req.(*MsgAttestLicense))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuspendLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuspendLicense)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuspendLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/SuspendLicense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuspendLicense(ctx, req.(*MsgSuspendLicense))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReinstateLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReinstateLicense)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReinstateLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/ReinstateLicense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReinstateLicense(ctx, req.(*MsgReinstateLicense))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StoreDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreDocument)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StoreDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/StoreDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StoreDocument(ctx, req.(*MsgStoreDocument))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenewPin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewPin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewPin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/RenewPin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewPin(ctx, req.(*MsgRenewPin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unpin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unpin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/Unpin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unpin(ctx, req.(*MsgUnpin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestPinned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestPinned)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestPinned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/AttestPinned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestPinned(ctx, req.(*MsgAttestPinned))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEntity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/CreateEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEntity(ctx, req.(*MsgCreateEntity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddEntityMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddEntityMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddEntityMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/AddEntityMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddEntityMember(ctx, req.(*MsgAddEntityMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InviteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInviteMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InviteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/InviteMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InviteMember(ctx, req.(*MsgInviteMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptInvite)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/AcceptInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptInvite(ctx, req.(*MsgAcceptInvite))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeclineInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeclineInvite)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeclineInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/DeclineInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeclineInvite(ctx, req.(*MsgDeclineInvite))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LeaveEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveEntity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/LeaveEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveEntity(ctx, req.(*MsgLeaveEntity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveEntityMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveEntityMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveEntityMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/RemoveEntityMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveEntityMember(ctx, req.(*MsgRemoveEntityMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMemberRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/UpdateMemberRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMemberRole(ctx, req.(*MsgUpdateMemberRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundEntity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/FundEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundEntity(ctx, req.(*MsgFundEntity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetEntityFeeCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEntityFeeCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEntityFeeCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/SetEntityFeeCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEntityFeeCap(ctx, req.(*MsgSetEntityFeeCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEntity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/UpdateEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEntity(ctx, req.(*MsgUpdateEntity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferEntityOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferEntityOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferEntityOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/TransferEntityOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferEntityOwnership(ctx, req.(*MsgTransferEntityOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptEntityOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptEntityOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptEntityOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/AcceptEntityOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptEntityOwnership(ctx, req.(*MsgAcceptEntityOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeactivateEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeactivateEntity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeactivateEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/DeactivateEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeactivateEntity(ctx, req.(*MsgDeactivateEntity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReactivateEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReactivateEntity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReactivateEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/ReactivateEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReactivateEntity(ctx, req.(*MsgReactivateEntity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSpecVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSpecVersion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSpecVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stampledgerchain.stampledgerchain.v1.Msg/CreateSpecVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSpecVersion(ctx, req.(*MsgCreateSpecVersion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stampledgerchain.stampledgerchain.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateStamp",
+			Handler:    _Msg_CreateStamp_Handler,
+		},
+		{
+			MethodName: "RevokeStamp",
+			Handler:    _Msg_RevokeStamp_Handler,
+		},
+		{
+			MethodName: "SupersedeStamp",
+			Handler:    _Msg_SupersedeStamp_Handler,
+		},
+		{
+			MethodName: "CreateStampBatch",
+			Handler:    _Msg_CreateStampBatch_Handler,
+		},
+		{
+			MethodName: "RevokeStampBatch",
+			Handler:    _Msg_RevokeStampBatch_Handler,
+		},
+		{
+			MethodName: "CreateMerkleStamp",
+			Handler:    _Msg_CreateMerkleStamp_Handler,
+		},
+		{
+			MethodName: "ProposeCoStamp",
+			Handler:    _Msg_ProposeCoStamp_Handler,
+		},
+		{
+			MethodName: "AddCoSignature",
+			Handler:    _Msg_AddCoSignature_Handler,
+		},
+		{
+			MethodName: "RegisterPE",
+			Handler:    _Msg_RegisterPE_Handler,
+		},
+		{
+			MethodName: "RotatePEKey",
+			Handler:    _Msg_RotatePEKey_Handler,
+		},
+		{
+			MethodName: "ReportKeyCompromise",
+			Handler:    _Msg_ReportKeyCompromise_Handler,
+		},
+		{
+			MethodName: "GrantStampingDelegation",
+			Handler:    _Msg_GrantStampingDelegation_Handler,
+		},
+		{
+			MethodName: "RevokeStampingDelegation",
+			Handler:    _Msg_RevokeStampingDelegation_Handler,
+		},
+		{
+			MethodName: "AttestLicense",
+			Handler:    _Msg_AttestLicense_Handler,
+		},
+		{
+			MethodName: "SuspendLicense",
+			Handler:    _Msg_SuspendLicense_Handler,
+		},
+		{
+			MethodName: "ReinstateLicense",
+			Handler:    _Msg_ReinstateLicense_Handler,
+		},
+		{
+			MethodName: "StoreDocument",
+			Handler:    _Msg_StoreDocument_Handler,
+		},
+		{
+			MethodName: "RenewPin",
+			Handler:    _Msg_RenewPin_Handler,
+		},
+		{
+			MethodName: "Unpin",
+			Handler:    _Msg_Unpin_Handler,
+		},
+		{
+			MethodName: "AttestPinned",
+			Handler:    _Msg_AttestPinned_Handler,
+		},
+		{
+			MethodName: "CreateEntity",
+			Handler:    _Msg_CreateEntity_Handler,
+		},
+		{
+			MethodName: "AddEntityMember",
+			Handler:    _Msg_AddEntityMember_Handler,
+		},
+		{
+			MethodName: "InviteMember",
+			Handler:    _Msg_InviteMember_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _Msg_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "DeclineInvite",
+			Handler:    _Msg_DeclineInvite_Handler,
+		},
+		{
+			MethodName: "LeaveEntity",
+			Handler:    _Msg_LeaveEntity_Handler,
+		},
+		{
+			MethodName: "RemoveEntityMember",
+			Handler:    _Msg_RemoveEntityMember_Handler,
+		},
+		{
+			MethodName: "UpdateMemberRole",
+			Handler:    _Msg_UpdateMemberRole_Handler,
+		},
+		{
+			MethodName: "FundEntity",
+			Handler:    _Msg_FundEntity_Handler,
+		},
+		{
+			MethodName: "SetEntityFeeCap",
+			Handler:    _Msg_SetEntityFeeCap_Handler,
+		},
+		{
+			MethodName: "UpdateEntity",
+			Handler:    _Msg_UpdateEntity_Handler,
+		},
+		{
+			MethodName: "TransferEntityOwnership",
+			Handler:    _Msg_TransferEntityOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptEntityOwnership",
+			Handler:    _Msg_AcceptEntityOwnership_Handler,
+		},
+		{
+			MethodName: "DeactivateEntity",
+			Handler:    _Msg_DeactivateEntity_Handler,
+		},
+		{
+			MethodName: "ReactivateEntity",
+			Handler:    _Msg_ReactivateEntity_Handler,
+		},
+		{
+			MethodName: "CreateSpecVersion",
+			Handler:    _Msg_CreateSpecVersion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stampledgerchain/stampledgerchain/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ValidUntil != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidUntil))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SignatureExpiry != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SignatureExpiry))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.DocumentFilename) > 0 {
+		i -= len(m.DocumentFilename)
+		copy(dAtA[i:], m.DocumentFilename)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentFilename)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.DocumentSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DocumentSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.DocumentIpfsHash) > 0 {
+		i -= len(m.DocumentIpfsHash)
+		copy(dAtA[i:], m.DocumentIpfsHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentIpfsHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PeName) > 0 {
+		i -= len(m.PeName)
+		copy(dAtA[i:], m.PeName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PeName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PeLicenseNumber) > 0 {
+		i -= len(m.PeLicenseNumber)
+		copy(dAtA[i:], m.PeLicenseNumber)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PeLicenseNumber)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.JurisdictionId) > 0 {
+		i -= len(m.JurisdictionId)
+		copy(dAtA[i:], m.JurisdictionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.JurisdictionId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PePublicKey) > 0 {
+		i -= len(m.PePublicKey)
+		copy(dAtA[i:], m.PePublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PePublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentHash) > 0 {
+		i -= len(m.DocumentHash)
+		copy(dAtA[i:], m.DocumentHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StampId) > 0 {
+		i -= len(m.StampId)
+		copy(dAtA[i:], m.StampId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StampId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeStamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeStamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeStamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EvidenceHash) > 0 {
+		i -= len(m.EvidenceHash)
+		copy(dAtA[i:], m.EvidenceHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ReasonCode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReasonCode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StampId) > 0 {
+		i -= len(m.StampId)
+		copy(dAtA[i:], m.StampId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StampId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeStampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeStampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeStampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StampBatchEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StampBatchEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StampBatchEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DocumentFilename) > 0 {
+		i -= len(m.DocumentFilename)
+		copy(dAtA[i:], m.DocumentFilename)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentFilename)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DocumentSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DocumentSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DocumentIpfsHash) > 0 {
+		i -= len(m.DocumentIpfsHash)
+		copy(dAtA[i:], m.DocumentIpfsHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentIpfsHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DocumentHash) > 0 {
+		i -= len(m.DocumentHash)
+		copy(dAtA[i:], m.DocumentHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStampBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStampBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStampBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ValidUntil != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidUntil))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SignatureExpiry != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SignatureExpiry))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PeName) > 0 {
+		i -= len(m.PeName)
+		copy(dAtA[i:], m.PeName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PeName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PeLicenseNumber) > 0 {
+		i -= len(m.PeLicenseNumber)
+		copy(dAtA[i:], m.PeLicenseNumber)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PeLicenseNumber)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.JurisdictionId) > 0 {
+		i -= len(m.JurisdictionId)
+		copy(dAtA[i:], m.JurisdictionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.JurisdictionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PePublicKey) > 0 {
+		i -= len(m.PePublicKey)
+		copy(dAtA[i:], m.PePublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PePublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStampBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStampBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStampBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StampIds) > 0 {
+		for iNdEx := len(m.StampIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StampIds[iNdEx])
+			copy(dAtA[i:], m.StampIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.StampIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BatchId) > 0 {
+		i -= len(m.BatchId)
+		copy(dAtA[i:], m.BatchId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BatchId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeStampBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeStampBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeStampBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EvidenceHash) > 0 {
+		i -= len(m.EvidenceHash)
+		copy(dAtA[i:], m.EvidenceHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ReasonCode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReasonCode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BatchId) > 0 {
+		i -= len(m.BatchId)
+		copy(dAtA[i:], m.BatchId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BatchId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeStampBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeStampBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeStampBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevokedCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RevokedCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMerkleStamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMerkleStamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMerkleStamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidUntil != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidUntil))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SignatureExpiry != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SignatureExpiry))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ManifestIpfsHash) > 0 {
+		i -= len(m.ManifestIpfsHash)
+		copy(dAtA[i:], m.ManifestIpfsHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ManifestIpfsHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PeName) > 0 {
+		i -= len(m.PeName)
+		copy(dAtA[i:], m.PeName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PeName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PeLicenseNumber) > 0 {
+		i -= len(m.PeLicenseNumber)
+		copy(dAtA[i:], m.PeLicenseNumber)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PeLicenseNumber)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.JurisdictionId) > 0 {
+		i -= len(m.JurisdictionId)
+		copy(dAtA[i:], m.JurisdictionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.JurisdictionId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PePublicKey) > 0 {
+		i -= len(m.PePublicKey)
+		copy(dAtA[i:], m.PePublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PePublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LeafCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LeafCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMerkleStampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMerkleStampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMerkleStampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StampId) > 0 {
+		i -= len(m.StampId)
+		copy(dAtA[i:], m.StampId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StampId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeCoStamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeCoStamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeCoStamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidUntil != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidUntil))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SignatureExpiry != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SignatureExpiry))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.CoSigners) > 0 {
+		for iNdEx := len(m.CoSigners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoSigners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DocumentFilename) > 0 {
+		i -= len(m.DocumentFilename)
+		copy(dAtA[i:], m.DocumentFilename)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentFilename)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DocumentSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DocumentSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DocumentIpfsHash) > 0 {
+		i -= len(m.DocumentIpfsHash)
+		copy(dAtA[i:], m.DocumentIpfsHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentIpfsHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.JurisdictionId) > 0 {
+		i -= len(m.JurisdictionId)
+		copy(dAtA[i:], m.JurisdictionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.JurisdictionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentHash) > 0 {
+		i -= len(m.DocumentHash)
+		copy(dAtA[i:], m.DocumentHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeCoStampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeCoStampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeCoStampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StampId) > 0 {
+		i -= len(m.StampId)
+		copy(dAtA[i:], m.StampId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StampId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCoSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCoSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCoSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PePublicKey) > 0 {
+		i -= len(m.PePublicKey)
+		copy(dAtA[i:], m.PePublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PePublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StampId) > 0 {
+		i -= len(m.StampId)
+		copy(dAtA[i:], m.StampId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StampId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCoSignatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCoSignatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCoSignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SignatureCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SignatureCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupersedeStamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupersedeStamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupersedeStamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidUntil != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidUntil))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.SignatureExpiry != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SignatureExpiry))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.DocumentFilename) > 0 {
+		i -= len(m.DocumentFilename)
+		copy(dAtA[i:], m.DocumentFilename)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentFilename)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.DocumentSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DocumentSize))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.DocumentIpfsHash) > 0 {
+		i -= len(m.DocumentIpfsHash)
+		copy(dAtA[i:], m.DocumentIpfsHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentIpfsHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PeName) > 0 {
+		i -= len(m.PeName)
+		copy(dAtA[i:], m.PeName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PeName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PeLicenseNumber) > 0 {
+		i -= len(m.PeLicenseNumber)
+		copy(dAtA[i:], m.PeLicenseNumber)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PeLicenseNumber)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.JurisdictionId) > 0 {
+		i -= len(m.JurisdictionId)
+		copy(dAtA[i:], m.JurisdictionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.JurisdictionId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PePublicKey) > 0 {
+		i -= len(m.PePublicKey)
+		copy(dAtA[i:], m.PePublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PePublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DocumentHash) > 0 {
+		i -= len(m.DocumentHash)
+		copy(dAtA[i:], m.DocumentHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SupersededStampId) > 0 {
+		i -= len(m.SupersededStampId)
+		copy(dAtA[i:], m.SupersededStampId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SupersededStampId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupersedeStampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupersedeStampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupersedeStampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StampId) > 0 {
+		i -= len(m.StampId)
+		copy(dAtA[i:], m.StampId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StampId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPE) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPE) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPE) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PopSignature) > 0 {
+		i -= len(m.PopSignature)
+		copy(dAtA[i:], m.PopSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PopSignature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Jurisdictions) > 0 {
+		for iNdEx := len(m.Jurisdictions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Jurisdictions[iNdEx])
+			copy(dAtA[i:], m.Jurisdictions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Jurisdictions[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LicenseNumber) > 0 {
+		i -= len(m.LicenseNumber)
+		copy(dAtA[i:], m.LicenseNumber)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LicenseNumber)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPEResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPEResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPEResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotatePEKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotatePEKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotatePEKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewKeySignature) > 0 {
+		i -= len(m.NewKeySignature)
+		copy(dAtA[i:], m.NewKeySignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewKeySignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OldKeySignature) > 0 {
+		i -= len(m.OldKeySignature)
+		copy(dAtA[i:], m.OldKeySignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OldKeySignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewPublicKey) > 0 {
+		i -= len(m.NewPublicKey)
+		copy(dAtA[i:], m.NewPublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewPublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldPublicKey) > 0 {
+		i -= len(m.OldPublicKey)
+		copy(dAtA[i:], m.OldPublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OldPublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotatePEKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotatePEKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotatePEKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportKeyCompromise) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportKeyCompromise) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportKeyCompromise) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompromisedSinceHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompromisedSinceHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportKeyCompromiseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportKeyCompromiseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportKeyCompromiseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RevokedCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RevokedCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantStampingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantStampingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantStampingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ProjectNames) > 0 {
+		for iNdEx := len(m.ProjectNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProjectNames[iNdEx])
+			copy(dAtA[i:], m.ProjectNames[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProjectNames[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.JurisdictionIds) > 0 {
+		for iNdEx := len(m.JurisdictionIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JurisdictionIds[iNdEx])
+			copy(dAtA[i:], m.JurisdictionIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.JurisdictionIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantStampingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantStampingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantStampingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeStampingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeStampingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeStampingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeStampingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeStampingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeStampingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestLicense) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestLicense) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestLicense) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeAccount) > 0 {
+		i -= len(m.PeAccount)
+		copy(dAtA[i:], m.PeAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PeAccount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LicenseNumber) > 0 {
+		i -= len(m.LicenseNumber)
+		copy(dAtA[i:], m.LicenseNumber)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LicenseNumber)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JurisdictionId) > 0 {
+		i -= len(m.JurisdictionId)
+		copy(dAtA[i:], m.JurisdictionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.JurisdictionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestLicenseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestLicenseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestLicenseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuspendLicense) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuspendLicense) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuspendLicense) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LicenseNumber) > 0 {
+		i -= len(m.LicenseNumber)
+		copy(dAtA[i:], m.LicenseNumber)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LicenseNumber)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JurisdictionId) > 0 {
+		i -= len(m.JurisdictionId)
+		copy(dAtA[i:], m.JurisdictionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.JurisdictionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuspendLicenseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuspendLicenseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuspendLicenseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReinstateLicense) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReinstateLicense) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReinstateLicense) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LicenseNumber) > 0 {
+		i -= len(m.LicenseNumber)
+		copy(dAtA[i:], m.LicenseNumber)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LicenseNumber)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JurisdictionId) > 0 {
+		i -= len(m.JurisdictionId)
+		copy(dAtA[i:], m.JurisdictionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.JurisdictionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReinstateLicenseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReinstateLicenseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReinstateLicenseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ContentSha256) > 0 {
+		i -= len(m.ContentSha256)
+		copy(dAtA[i:], m.ContentSha256)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentSha256)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PinForever {
+		i--
+		if m.PinForever {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IpfsHash) > 0 {
+		i -= len(m.IpfsHash)
+		copy(dAtA[i:], m.IpfsHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StampId) > 0 {
+		i -= len(m.StampId)
+		copy(dAtA[i:], m.StampId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StampId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IpfsUrl) > 0 {
+		i -= len(m.IpfsUrl)
+		copy(dAtA[i:], m.IpfsUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewPin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewPin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewPin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PinForever {
+		i--
+		if m.PinForever {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewPinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewPinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewPinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PinExpiresHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PinExpiresHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestPinned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestPinned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestPinned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PinnedUntilHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PinnedUntilHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReplicaCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReplicaCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestPinnedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestPinnedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestPinnedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEntity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEntity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEntity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityType) > 0 {
+		i -= len(m.EntityType)
+		copy(dAtA[i:], m.EntityType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEntityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEntityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEntityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddEntityMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddEntityMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddEntityMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MemberAddress) > 0 {
+		i -= len(m.MemberAddress)
+		copy(dAtA[i:], m.MemberAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MemberAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddEntityMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddEntityMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddEntityMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInviteMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInviteMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInviteMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Invitee) > 0 {
+		i -= len(m.Invitee)
+		copy(dAtA[i:], m.Invitee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Invitee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInviteMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInviteMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInviteMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptInvite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptInvite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptInvite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptInviteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptInviteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptInviteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeclineInvite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeclineInvite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeclineInvite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeclineInviteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeclineInviteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeclineInviteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveEntity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveEntity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveEntity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveEntityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveEntityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveEntityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveEntityMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveEntityMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveEntityMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MemberAddress) > 0 {
+		i -= len(m.MemberAddress)
+		copy(dAtA[i:], m.MemberAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MemberAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveEntityMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveEntityMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveEntityMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMemberRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMemberRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMemberRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MemberAddress) > 0 {
+		i -= len(m.MemberAddress)
+		copy(dAtA[i:], m.MemberAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MemberAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMemberRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMemberRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMemberRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundEntity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundEntity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundEntity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundEntityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundEntityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundEntityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEntityFeeCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEntityFeeCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEntityFeeCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MemberMonthlyCap) > 0 {
+		for iNdEx := len(m.MemberMonthlyCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberMonthlyCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEntityFeeCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEntityFeeCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEntityFeeCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEntity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEntity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEntity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EntityType) > 0 {
+		i -= len(m.EntityType)
+		copy(dAtA[i:], m.EntityType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEntityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEntityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEntityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferEntityOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferEntityOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferEntityOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferEntityOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferEntityOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferEntityOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptEntityOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptEntityOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptEntityOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptEntityOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptEntityOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptEntityOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateEntity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateEntity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateEntity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateEntityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateEntityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateEntityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReactivateEntity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReactivateEntity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReactivateEntity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReactivateEntityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReactivateEntityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReactivateEntityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSpecVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSpecVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSpecVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ParentVersionId) > 0 {
+		i -= len(m.ParentVersionId)
+		copy(dAtA[i:], m.ParentVersionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParentVersionId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Changelog) > 0 {
+		i -= len(m.Changelog)
+		copy(dAtA[i:], m.Changelog)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Changelog)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SpecIpfs) > 0 {
+		i -= len(m.SpecIpfs)
+		copy(dAtA[i:], m.SpecIpfs)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecIpfs)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SpecHash) > 0 {
+		i -= len(m.SpecHash)
+		copy(dAtA[i:], m.SpecHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProjectId) > 0 {
+		i -= len(m.ProjectId)
+		copy(dAtA[i:], m.ProjectId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSpecVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSpecVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSpecVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateStamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PePublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.JurisdictionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PeLicenseNumber)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PeName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DocumentIpfsHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DocumentSize != 0 {
+		n += 1 + sovTx(uint64(m.DocumentSize))
+	}
+	l = len(m.DocumentFilename)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SignatureExpiry != 0 {
+		n += 1 + sovTx(uint64(m.SignatureExpiry))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovTx(uint64(m.ValidUntil))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateStampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StampId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeStamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StampId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReasonCode != 0 {
+		n += 1 + sovTx(uint64(m.ReasonCode))
+	}
+	l = len(m.EvidenceHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeStampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *StampBatchEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DocumentIpfsHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DocumentSize != 0 {
+		n += 1 + sovTx(uint64(m.DocumentSize))
+	}
+	l = len(m.DocumentFilename)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateStampBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PePublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.JurisdictionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PeLicenseNumber)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PeName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SignatureExpiry != 0 {
+		n += 1 + sovTx(uint64(m.SignatureExpiry))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovTx(uint64(m.ValidUntil))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateStampBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BatchId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StampIds) > 0 {
+		for _, s := range m.StampIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevokeStampBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BatchId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReasonCode != 0 {
+		n += 1 + sovTx(uint64(m.ReasonCode))
+	}
+	l = len(m.EvidenceHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeStampBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RevokedCount != 0 {
+		n += 1 + sovTx(uint64(m.RevokedCount))
+	}
+	return n
+}
+
+func (m *MsgCreateMerkleStamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LeafCount != 0 {
+		n += 1 + sovTx(uint64(m.LeafCount))
+	}
+	l = len(m.PePublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.JurisdictionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PeLicenseNumber)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PeName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ManifestIpfsHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SignatureExpiry != 0 {
+		n += 1 + sovTx(uint64(m.SignatureExpiry))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovTx(uint64(m.ValidUntil))
+	}
+	return n
+}
+
+func (m *MsgCreateMerkleStampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StampId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeCoStamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.JurisdictionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DocumentIpfsHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DocumentSize != 0 {
+		n += 1 + sovTx(uint64(m.DocumentSize))
+	}
+	l = len(m.DocumentFilename)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CoSigners) > 0 {
+		for _, e := range m.CoSigners {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	if m.SignatureExpiry != 0 {
+		n += 1 + sovTx(uint64(m.SignatureExpiry))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovTx(uint64(m.ValidUntil))
+	}
+	return n
+}
+
+func (m *MsgProposeCoStampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StampId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddCoSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StampId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PePublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddCoSignatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignatureCount != 0 {
+		n += 1 + sovTx(uint64(m.SignatureCount))
+	}
+	if m.Complete {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSupersedeStamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SupersededStampId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PePublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.JurisdictionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PeLicenseNumber)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PeName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DocumentIpfsHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DocumentSize != 0 {
+		n += 1 + sovTx(uint64(m.DocumentSize))
+	}
+	l = len(m.DocumentFilename)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SignatureExpiry != 0 {
+		n += 1 + sovTx(uint64(m.SignatureExpiry))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovTx(uint64(m.ValidUntil))
+	}
+	return n
+}
+
+func (m *MsgSupersedeStampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StampId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterPE) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LicenseNumber)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Jurisdictions) > 0 {
+		for _, s := range m.Jurisdictions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.PopSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterPEResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotatePEKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OldPublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewPublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OldKeySignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewKeySignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotatePEKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportKeyCompromise) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CompromisedSinceHeight != 0 {
+		n += 1 + sovTx(uint64(m.CompromisedSinceHeight))
+	}
+	return n
+}
+
+func (m *MsgReportKeyCompromiseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RevokedCount != 0 {
+		n += 1 + sovTx(uint64(m.RevokedCount))
+	}
+	if m.Complete {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgGrantStampingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.JurisdictionIds) > 0 {
+		for _, s := range m.JurisdictionIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ProjectNames) > 0 {
+		for _, s := range m.ProjectNames {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovTx(uint64(m.Expiration))
+	}
+	return n
+}
+
+func (m *MsgGrantStampingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeStampingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeStampingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttestLicense) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.JurisdictionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LicenseNumber)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PeAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestLicenseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSuspendLicense) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.JurisdictionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LicenseNumber)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuspendLicenseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReinstateLicense) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.JurisdictionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LicenseNumber)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReinstateLicenseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStoreDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StampId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpfsHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovTx(uint64(m.Size_))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PinForever {
+		n += 2
+	}
+	l = len(m.ContentSha256)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStoreDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpfsUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRenewPin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PinForever {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRenewPinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PinExpiresHeight != 0 {
+		n += 1 + sovTx(uint64(m.PinExpiresHeight))
+	}
+	return n
+}
+
+func (m *MsgUnpin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttestPinned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReplicaCount != 0 {
+		n += 1 + sovTx(uint64(m.ReplicaCount))
+	}
+	if m.PinnedUntilHeight != 0 {
+		n += 1 + sovTx(uint64(m.PinnedUntilHeight))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestPinnedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateEntity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateEntityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddEntityMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MemberAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddEntityMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgInviteMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Invitee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInviteMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiresHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresHeight))
+	}
+	return n
+}
+
+func (m *MsgAcceptInvite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptInviteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeclineInvite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeclineInviteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLeaveEntity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLeaveEntityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveEntityMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MemberAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveEntityMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateMemberRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MemberAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMemberRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundEntity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundEntityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetEntityFeeCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MemberMonthlyCap) > 0 {
+		for _, e := range m.MemberMonthlyCap {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetEntityFeeCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateEntity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateEntityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferEntityOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferEntityOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptEntityOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptEntityOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeactivateEntity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeactivateEntityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReactivateEntity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgReactivateEntityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateSpecVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProjectId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpecHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpecIpfs)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Changelog)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ParentVersionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateSpecVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PePublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PePublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeLicenseNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeLicenseNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentIpfsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentIpfsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentSize", wireType)
+			}
+			m.DocumentSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentFilename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentFilename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureExpiry", wireType)
+			}
+			m.SignatureExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StampId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeStamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeStamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StampId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonCode", wireType)
+			}
+			m.ReasonCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReasonCode |= RevocationReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeStampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeStampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeStampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StampBatchEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StampBatchEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StampBatchEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentIpfsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentIpfsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentSize", wireType)
+			}
+			m.DocumentSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentFilename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentFilename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStampBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStampBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStampBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PePublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PePublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeLicenseNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeLicenseNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureExpiry", wireType)
+			}
+			m.SignatureExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, StampBatchEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStampBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStampBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStampBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StampIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StampIds = append(m.StampIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeStampBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeStampBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeStampBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonCode", wireType)
+			}
+			m.ReasonCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReasonCode |= RevocationReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeStampBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeStampBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeStampBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedCount", wireType)
+			}
+			m.RevokedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMerkleStamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMerkleStamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMerkleStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafCount", wireType)
+			}
+			m.LeafCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PePublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PePublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeLicenseNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeLicenseNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestIpfsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestIpfsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureExpiry", wireType)
+			}
+			m.SignatureExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMerkleStampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMerkleStampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMerkleStampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StampId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeCoStamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeCoStamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeCoStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentIpfsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentIpfsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentSize", wireType)
+			}
+			m.DocumentSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentFilename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentFilename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoSigners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoSigners = append(m.CoSigners, CoSigner{})
+			if err := m.CoSigners[len(m.CoSigners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureExpiry", wireType)
+			}
+			m.SignatureExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeCoStampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeCoStampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeCoStampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StampId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddCoSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCoSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCoSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StampId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PePublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PePublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddCoSignatureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCoSignatureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCoSignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureCount", wireType)
+			}
+			m.SignatureCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupersedeStamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupersedeStamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupersedeStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededStampId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersededStampId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PePublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PePublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeLicenseNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeLicenseNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentIpfsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentIpfsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentSize", wireType)
+			}
+			m.DocumentSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentFilename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentFilename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureExpiry", wireType)
+			}
+			m.SignatureExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupersedeStampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupersedeStampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupersedeStampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StampId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPE) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPE: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPE: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenseNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LicenseNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdictions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdictions = append(m.Jurisdictions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PopSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PopSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPEResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPEResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPEResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotatePEKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotatePEKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotatePEKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldPublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldKeySignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldKeySignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKeySignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewKeySignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotatePEKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotatePEKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotatePEKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportKeyCompromise) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportKeyCompromise: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportKeyCompromise: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompromisedSinceHeight", wireType)
+			}
+			m.CompromisedSinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompromisedSinceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportKeyCompromiseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportKeyCompromiseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportKeyCompromiseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedCount", wireType)
+			}
+			m.RevokedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantStampingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantStampingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantStampingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionIds = append(m.JurisdictionIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectNames = append(m.ProjectNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantStampingDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantStampingDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantStampingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeStampingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeStampingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeStampingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeStampingDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeStampingDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeStampingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestLicense) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestLicense: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestLicense: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenseNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LicenseNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestLicenseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestLicenseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestLicenseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuspendLicense) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuspendLicense: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuspendLicense: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenseNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LicenseNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuspendLicenseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuspendLicenseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuspendLicenseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReinstateLicense) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReinstateLicense: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReinstateLicense: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenseNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LicenseNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReinstateLicenseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReinstateLicenseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReinstateLicenseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StampId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinForever", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PinForever = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentSha256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentSha256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= DocumentRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewPin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewPin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewPin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinForever", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PinForever = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewPinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewPinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewPinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinExpiresHeight", wireType)
+			}
+			m.PinExpiresHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PinExpiresHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestPinned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestPinned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestPinned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaCount", wireType)
+			}
+			m.ReplicaCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinnedUntilHeight", wireType)
+			}
+			m.PinnedUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PinnedUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestPinnedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestPinnedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestPinnedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEntity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEntity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEntity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEntityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEntityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEntityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddEntityMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddEntityMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddEntityMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddEntityMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddEntityMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddEntityMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInviteMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInviteMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInviteMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invitee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invitee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInviteMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInviteMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInviteMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresHeight", wireType)
+			}
+			m.ExpiresHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptInvite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptInvite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptInvite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptInviteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptInviteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptInviteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeclineInvite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeclineInvite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeclineInvite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeclineInviteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeclineInviteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeclineInviteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRemoveEntityMemberResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgCreateSpecVersion) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProjectId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SpecHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SpecIpfs)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Changelog)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ParentVersionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgLeaveEntity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveEntity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveEntity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgCreateSpecVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VersionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgLeaveEntityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveEntityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveEntityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveEntityMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2564,15 +19109,47 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveEntityMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveEntityMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2600,13 +19177,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.EntityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2616,24 +19193,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MemberAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2656,7 +19232,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveEntityMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2679,12 +19255,32 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveEntityMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveEntityMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2706,7 +19302,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateStamp) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMemberRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2729,10 +19325,10 @@ func (m *MsgCreateStamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMemberRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMemberRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2769,7 +19365,7 @@ func (m *MsgCreateStamp) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2797,11 +19393,11 @@ func (m *MsgCreateStamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			m.EntityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PePublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2829,11 +19425,11 @@ func (m *MsgCreateStamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PePublicKey = string(dAtA[iNdEx:postIndex])
+			m.MemberAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2861,107 +19457,111 @@ func (m *MsgCreateStamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JurisdictionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JurisdictionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeLicenseNumber", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMemberRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeLicenseNumber = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeName", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMemberRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMemberRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundEntity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundEntity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundEntity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2989,11 +19589,11 @@ func (m *MsgCreateStamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentIpfsHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3021,32 +19621,13 @@ func (m *MsgCreateStamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentIpfsHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentSize", wireType)
-			}
-			m.DocumentSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DocumentSize |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentFilename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3056,23 +19637,25 @@ func (m *MsgCreateStamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentFilename = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3095,7 +19678,7 @@ func (m *MsgCreateStamp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateStampResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundEntityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3118,47 +19701,15 @@ func (m *MsgCreateStampResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStampResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundEntityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundEntityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StampId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3186,7 +19737,7 @@ func (m *MsgCreateStampResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3209,7 +19760,7 @@ func (m *MsgCreateStampResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeStamp) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEntityFeeCap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3232,10 +19783,10 @@ func (m *MsgRevokeStamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeStamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEntityFeeCap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEntityFeeCap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3272,7 +19823,7 @@ func (m *MsgRevokeStamp) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3300,13 +19851,13 @@ func (m *MsgRevokeStamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StampId = string(dAtA[iNdEx:postIndex])
+			m.EntityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberMonthlyCap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3316,23 +19867,25 @@ func (m *MsgRevokeStamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.MemberMonthlyCap = append(m.MemberMonthlyCap, types.Coin{})
+			if err := m.MemberMonthlyCap[len(m.MemberMonthlyCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3355,7 +19908,7 @@ func (m *MsgRevokeStamp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeStampResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEntityFeeCapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3378,32 +19931,12 @@ func (m *MsgRevokeStampResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeStampResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEntityFeeCapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeStampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEntityFeeCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3425,7 +19958,7 @@ func (m *MsgRevokeStampResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStoreDocument) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateEntity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3448,10 +19981,10 @@ func (m *MsgStoreDocument) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreDocument: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateEntity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateEntity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3488,7 +20021,7 @@ func (m *MsgStoreDocument) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StampId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3516,11 +20049,11 @@ func (m *MsgStoreDocument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StampId = string(dAtA[iNdEx:postIndex])
+			m.EntityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpfsHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3548,11 +20081,11 @@ func (m *MsgStoreDocument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IpfsHash = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3580,30 +20113,11 @@ func (m *MsgStoreDocument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
+			m.EntityType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
-			}
-			m.Size_ = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Size_ |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3631,28 +20145,58 @@ func (m *MsgStoreDocument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MimeType = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PinForever", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.PinForever = bool(v != 0)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEntityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEntityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEntityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3674,7 +20218,7 @@ func (m *MsgStoreDocument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStoreDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferEntityOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3697,15 +20241,15 @@ func (m *MsgStoreDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferEntityOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferEntityOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3733,11 +20277,43 @@ func (m *MsgStoreDocumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpfsUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3762,11 +20338,61 @@ func (m *MsgStoreDocumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferEntityOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IpfsUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferEntityOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferEntityOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3788,7 +20414,7 @@ func (m *MsgStoreDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateEntity) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptEntityOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3811,10 +20437,10 @@ func (m *MsgCreateEntity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateEntity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptEntityOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateEntity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptEntityOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3851,39 +20477,7 @@ func (m *MsgCreateEntity) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3911,7 +20505,7 @@ func (m *MsgCreateEntity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EntityType = string(dAtA[iNdEx:postIndex])
+			m.EntityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3934,7 +20528,7 @@ func (m *MsgCreateEntity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateEntityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptEntityOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3957,44 +20551,12 @@ func (m *MsgCreateEntityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateEntityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptEntityOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateEntityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptEntityOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EntityId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4016,7 +20578,7 @@ func (m *MsgCreateEntityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddEntityMember) Unmarshal(dAtA []byte) error {
+func (m *MsgDeactivateEntity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4039,10 +20601,10 @@ func (m *MsgAddEntityMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddEntityMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeactivateEntity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddEntityMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeactivateEntity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4111,39 +20673,7 @@ func (m *MsgAddEntityMember) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MemberAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4171,7 +20701,7 @@ func (m *MsgAddEntityMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4194,7 +20724,7 @@ func (m *MsgAddEntityMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddEntityMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeactivateEntityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4217,32 +20747,12 @@ func (m *MsgAddEntityMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddEntityMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeactivateEntityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddEntityMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeactivateEntityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4264,7 +20774,7 @@ func (m *MsgAddEntityMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveEntityMember) Unmarshal(dAtA []byte) error {
+func (m *MsgReactivateEntity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4287,10 +20797,10 @@ func (m *MsgRemoveEntityMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveEntityMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReactivateEntity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveEntityMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReactivateEntity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4357,38 +20867,6 @@ func (m *MsgRemoveEntityMember) Unmarshal(dAtA []byte) error {
 			}
 			m.EntityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MemberAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4410,7 +20888,7 @@ func (m *MsgRemoveEntityMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveEntityMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReactivateEntityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4433,32 +20911,12 @@ func (m *MsgRemoveEntityMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveEntityMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReactivateEntityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveEntityMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReactivateEntityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4733,6 +21191,38 @@ func (m *MsgCreateSpecVersion) Unmarshal(dAtA []byte) error {
 			}
 			m.ParentVersionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])